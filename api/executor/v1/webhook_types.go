@@ -128,44 +128,45 @@ type SecretRef struct {
 	Key string `json:"key"`
 }
 
-// +kubebuilder:validation:Enum=start-test;end-test-success;end-test-failed;end-test-aborted;end-test-timeout;become-test-up;become-test-down;become-test-failed;become-test-aborted;become-test-timeout;start-testsuite;end-testsuite-success;end-testsuite-failed;end-testsuite-aborted;end-testsuite-timeout;become-testsuite-up;become-testsuite-down;become-testsuite-failed;become-testsuite-aborted;become-testsuite-timeout;start-testworkflow;queue-testworkflow;end-testworkflow-success;end-testworkflow-failed;end-testworkflow-aborted;end-testworkflow-canceled;end-testworkflow-not-passed;become-testworkflow-up;become-testworkflow-down;become-testworkflow-failed;become-testworkflow-aborted;become-testworkflow-canceled;become-testworkflow-not-passed
+// +kubebuilder:validation:Enum=start-test;end-test-success;end-test-failed;end-test-aborted;end-test-timeout;become-test-up;become-test-down;become-test-failed;become-test-aborted;become-test-timeout;start-testsuite;end-testsuite-success;end-testsuite-failed;end-testsuite-aborted;end-testsuite-timeout;become-testsuite-up;become-testsuite-down;become-testsuite-failed;become-testsuite-aborted;become-testsuite-timeout;start-testworkflow;queue-testworkflow;end-testworkflow-success;end-testworkflow-failed;end-testworkflow-aborted;end-testworkflow-canceled;end-testworkflow-not-passed;approval-requested-testworkflow;become-testworkflow-up;become-testworkflow-down;become-testworkflow-failed;become-testworkflow-aborted;become-testworkflow-canceled;become-testworkflow-not-passed
 type EventType string
 
 // List of EventType
 const (
-	START_TEST_EventType                     EventType = "start-test"
-	END_TEST_SUCCESS_EventType               EventType = "end-test-success"
-	END_TEST_FAILED_EventType                EventType = "end-test-failed"
-	END_TEST_ABORTED_EventType               EventType = "end-test-aborted"
-	END_TEST_TIMEOUT_EventType               EventType = "end-test-timeout"
-	BECOME_TEST_UP_EventType                 EventType = "become-test-up"
-	BECOME_TEST_DOWN_EventType               EventType = "become-test-down"
-	BECOME_TEST_FAILED_EventType             EventType = "become-test-failed"
-	BECOME_TEST_ABORTED_EventType            EventType = "become-test-aborted"
-	BECOME_TEST_TIMEOUT_EventType            EventType = "become-test-timeout"
-	START_TESTSUITE_EventType                EventType = "start-testsuite"
-	END_TESTSUITE_SUCCESS_EventType          EventType = "end-testsuite-success"
-	END_TESTSUITE_FAILED_EventType           EventType = "end-testsuite-failed"
-	END_TESTSUITE_ABORTED_EventType          EventType = "end-testsuite-aborted"
-	END_TESTSUITE_TIMEOUT_EventType          EventType = "end-testsuite-timeout"
-	BECOME_TESTSUITE_UP_EventType            EventType = "become-testsuite-up"
-	BECOME_TESTSUITE_DOWN_EventType          EventType = "become-testsuite-down"
-	BECOME_TESTSUITE_FAILED_EventType        EventType = "become-testsuite-failed"
-	BECOME_TESTSUITE_ABORTED_EventType       EventType = "become-testsuite-aborted"
-	BECOME_TESTSUITE_TIMEOUT_EventType       EventType = "become-testsuite-timeout"
-	START_TESTWORKFLOW_EventType             EventType = "start-testworkflow"
-	QUEUE_TESTWORKFLOW_EventType             EventType = "queue-testworkflow"
-	END_TESTWORKFLOW_SUCCESS_EventType       EventType = "end-testworkflow-success"
-	END_TESTWORKFLOW_FAILED_EventType        EventType = "end-testworkflow-failed"
-	END_TESTWORKFLOW_ABORTED_EventType       EventType = "end-testworkflow-aborted"
-	END_TESTWORKFLOW_CANCELED_EventType      EventType = "end-testworkflow-canceled"
-	END_TESTWORKFLOW_NOT_PASSED_EventType    EventType = "end-testworkflow-not-passed"
-	BECOME_TESTWORKFLOW_UP_EventType         EventType = "become-testworkflow-up"
-	BECOME_TESTWORKFLOW_DOWN_EventType       EventType = "become-testworkflow-down"
-	BECOME_TESTWORKFLOW_FAILED_EventType     EventType = "become-testworkflow-failed"
-	BECOME_TESTWORKFLOW_ABORTED_EventType    EventType = "become-testworkflow-aborted"
-	BECOME_TESTWORKFLOW_CANCELED_EventType   EventType = "become-testworkflow-canceled"
-	BECOME_TESTWORKFLOW_NOT_PASSED_EventType EventType = "become-testworkflow-not-passed"
+	START_TEST_EventType                      EventType = "start-test"
+	END_TEST_SUCCESS_EventType                EventType = "end-test-success"
+	END_TEST_FAILED_EventType                 EventType = "end-test-failed"
+	END_TEST_ABORTED_EventType                EventType = "end-test-aborted"
+	END_TEST_TIMEOUT_EventType                EventType = "end-test-timeout"
+	BECOME_TEST_UP_EventType                  EventType = "become-test-up"
+	BECOME_TEST_DOWN_EventType                EventType = "become-test-down"
+	BECOME_TEST_FAILED_EventType              EventType = "become-test-failed"
+	BECOME_TEST_ABORTED_EventType             EventType = "become-test-aborted"
+	BECOME_TEST_TIMEOUT_EventType             EventType = "become-test-timeout"
+	START_TESTSUITE_EventType                 EventType = "start-testsuite"
+	END_TESTSUITE_SUCCESS_EventType           EventType = "end-testsuite-success"
+	END_TESTSUITE_FAILED_EventType            EventType = "end-testsuite-failed"
+	END_TESTSUITE_ABORTED_EventType           EventType = "end-testsuite-aborted"
+	END_TESTSUITE_TIMEOUT_EventType           EventType = "end-testsuite-timeout"
+	BECOME_TESTSUITE_UP_EventType             EventType = "become-testsuite-up"
+	BECOME_TESTSUITE_DOWN_EventType           EventType = "become-testsuite-down"
+	BECOME_TESTSUITE_FAILED_EventType         EventType = "become-testsuite-failed"
+	BECOME_TESTSUITE_ABORTED_EventType        EventType = "become-testsuite-aborted"
+	BECOME_TESTSUITE_TIMEOUT_EventType        EventType = "become-testsuite-timeout"
+	START_TESTWORKFLOW_EventType              EventType = "start-testworkflow"
+	QUEUE_TESTWORKFLOW_EventType              EventType = "queue-testworkflow"
+	END_TESTWORKFLOW_SUCCESS_EventType        EventType = "end-testworkflow-success"
+	END_TESTWORKFLOW_FAILED_EventType         EventType = "end-testworkflow-failed"
+	END_TESTWORKFLOW_ABORTED_EventType        EventType = "end-testworkflow-aborted"
+	END_TESTWORKFLOW_CANCELED_EventType       EventType = "end-testworkflow-canceled"
+	END_TESTWORKFLOW_NOT_PASSED_EventType     EventType = "end-testworkflow-not-passed"
+	APPROVAL_REQUESTED_TESTWORKFLOW_EventType EventType = "approval-requested-testworkflow"
+	BECOME_TESTWORKFLOW_UP_EventType          EventType = "become-testworkflow-up"
+	BECOME_TESTWORKFLOW_DOWN_EventType        EventType = "become-testworkflow-down"
+	BECOME_TESTWORKFLOW_FAILED_EventType      EventType = "become-testworkflow-failed"
+	BECOME_TESTWORKFLOW_ABORTED_EventType     EventType = "become-testworkflow-aborted"
+	BECOME_TESTWORKFLOW_CANCELED_EventType    EventType = "become-testworkflow-canceled"
+	BECOME_TESTWORKFLOW_NOT_PASSED_EventType  EventType = "become-testworkflow-not-passed"
 )
 
 // WebhookStatus defines the observed state of Webhook
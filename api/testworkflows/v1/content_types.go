@@ -78,6 +78,60 @@ type ContentTarball struct {
 	Path string `json:"path" expr:"template"`
 	// should it mount a new volume there
 	Mount *bool `json:"mount,omitempty" expr:"ignore"`
+	// expected SHA-256 checksum of the tarball, verified before extraction
+	Sha256 string `json:"sha256,omitempty" expr:"template"`
+}
+
+type ContentOci struct {
+	// reference to the OCI artifact, i.e. "registry.example.com/tests/e2e:1.2.3" or "registry.example.com/tests/e2e@sha256:..."
+	Ref string `json:"ref" expr:"template"`
+	// expected manifest digest; the artifact is pulled by this digest when provided
+	Digest string `json:"digest,omitempty" expr:"template"`
+	// path where the artifact contents should be extracted
+	Path string `json:"path" expr:"template"`
+	// should it mount a new volume there
+	Mount *bool `json:"mount,omitempty" expr:"ignore"`
+	// plain text username for the registry
+	Username string `json:"username,omitempty" expr:"template"`
+	// external username for the registry
+	UsernameFrom *corev1.EnvVarSource `json:"usernameFrom,omitempty" expr:"force"`
+	// plain text password or token for the registry
+	Password string `json:"password,omitempty" expr:"template"`
+	// external password or token for the registry
+	PasswordFrom *corev1.EnvVarSource `json:"passwordFrom,omitempty" expr:"force"`
+	// allow plain HTTP and self-signed certificates for the registry
+	Insecure bool `json:"insecure,omitempty" expr:"ignore"`
+}
+
+type ContentObjectStorage struct {
+	// S3-compatible endpoint, i.e. "s3.amazonaws.com" or "minio.example.com:9000" (defaults to the provider endpoint)
+	Endpoint string `json:"endpoint,omitempty" expr:"template"`
+	// region of the bucket
+	Region string `json:"region,omitempty" expr:"template"`
+	// bucket name
+	Bucket string `json:"bucket" expr:"template"`
+	// object key to fetch, or a prefix ending with "/" to fetch all the objects under it
+	Key string `json:"key,omitempty" expr:"template"`
+	// path where the object(s) should be stored
+	Path string `json:"path" expr:"template"`
+	// should it mount a new volume there
+	Mount *bool `json:"mount,omitempty" expr:"ignore"`
+	// unpack the object as a gzipped tarball instead of storing it as a file
+	Unpack bool `json:"unpack,omitempty" expr:"ignore"`
+	// expected SHA-256 checksum of the object, verified before storing; not supported for prefixes
+	Sha256 string `json:"sha256,omitempty" expr:"template"`
+	// plain text access key ID
+	AccessKeyId string `json:"accessKeyId,omitempty" expr:"template"`
+	// external access key ID
+	AccessKeyIdFrom *corev1.EnvVarSource `json:"accessKeyIdFrom,omitempty" expr:"force"`
+	// plain text secret access key
+	SecretAccessKey string `json:"secretAccessKey,omitempty" expr:"template"`
+	// external secret access key
+	SecretAccessKeyFrom *corev1.EnvVarSource `json:"secretAccessKeyFrom,omitempty" expr:"force"`
+	// connect over plain HTTP instead of HTTPS
+	DisableSsl bool `json:"disableSsl,omitempty" expr:"ignore"`
+	// skip verification of the server certificate
+	SkipVerify bool `json:"skipVerify,omitempty" expr:"ignore"`
 }
 
 type Content struct {
@@ -87,4 +141,10 @@ type Content struct {
 	Files []ContentFile `json:"files,omitempty" expr:"include"`
 	// tarballs to unpack
 	Tarball []ContentTarball `json:"tarball,omitempty" expr:"include"`
+	// OCI artifacts to pull
+	Oci []ContentOci `json:"oci,omitempty" expr:"include"`
+	// objects to fetch from S3 or any S3-compatible storage
+	S3 []ContentObjectStorage `json:"s3,omitempty" expr:"include"`
+	// objects to fetch from Google Cloud Storage, using HMAC keys for the interoperability API
+	Gcs []ContentObjectStorage `json:"gcs,omitempty" expr:"include"`
 }
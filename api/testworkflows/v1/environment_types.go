@@ -0,0 +1,47 @@
+package v1
+
+// EnvironmentSpec describes the ephemeral environment created for a single execution.
+// The dedicated namespace is provisioned before the steps, and it is deleted along with the execution resources.
+type EnvironmentSpec struct {
+	// content to fetch, containing the manifests, kustomize directories or the Helm chart
+	Content *Content `json:"content,omitempty" expr:"include"`
+
+	// working directory to resolve the paths from
+	WorkingDir *string `json:"workingDir,omitempty" expr:"template"`
+
+	// paths to the manifests or kustomize directories to apply
+	Manifests []string `json:"manifests,omitempty" expr:"template"`
+
+	// Helm chart to install
+	Helm *EnvironmentHelm `json:"helm,omitempty" expr:"include"`
+
+	// additional labels to attach to the namespace
+	Labels map[string]string `json:"labels,omitempty" expr:"template,template"`
+
+	// image with kubectl and helm binaries used to provision the environment
+	Image string `json:"image,omitempty" expr:"template"`
+
+	// maximum time to wait for the environment readiness (defaults to: "5m")
+	// +kubebuilder:validation:Pattern=^((0|[1-9][0-9]*)h)?((0|[1-9][0-9]*)m)?((0|[1-9][0-9]*)s)?((0|[1-9][0-9]*)ms)?$
+	Timeout string `json:"timeout,omitempty"`
+}
+
+type EnvironmentHelm struct {
+	// path to the chart directory, or the chart name when the repository is provided
+	Chart string `json:"chart" expr:"template"`
+
+	// chart repository URL
+	Repository string `json:"repository,omitempty" expr:"template"`
+
+	// chart version constraint
+	Version string `json:"version,omitempty" expr:"template"`
+
+	// release name (defaults to: "environment")
+	Release string `json:"release,omitempty" expr:"template"`
+
+	// paths to the values files
+	ValuesFiles []string `json:"valuesFiles,omitempty" expr:"template"`
+
+	// values to set
+	Values map[string]string `json:"values,omitempty" expr:"template,template"`
+}
@@ -0,0 +1,25 @@
+package v1
+
+// GateAction defines what happens when the gate condition is not satisfied.
+// +kubebuilder:validation:Enum=fail;warn
+type GateAction string
+
+const (
+	GateActionFail GateAction = "fail"
+	GateActionWarn GateAction = "warn"
+)
+
+type Gate struct {
+	// unique name of the gate
+	Name string `json:"name"`
+
+	// gate description to display
+	Description string `json:"description,omitempty" expr:"template"`
+
+	// expression that has to be truthy for the gate to pass,
+	// it may access "reports", "metrics", "resources" and "steps" of the finished execution
+	Condition string `json:"condition" expr:"expression"`
+
+	// what should happen when the condition is not satisfied (defaults to: "fail")
+	OnFailure GateAction `json:"onFailure,omitempty"`
+}
@@ -0,0 +1,33 @@
+package v1
+
+// OutputType defines how the output value should be interpreted.
+// +kubebuilder:validation:Enum=string;integer;number;boolean;json
+type OutputType string
+
+const (
+	OutputTypeString  OutputType = "string"
+	OutputTypeInteger OutputType = "integer"
+	OutputTypeNumber  OutputType = "number"
+	OutputTypeBoolean OutputType = "boolean"
+	OutputTypeJSON    OutputType = "json"
+)
+
+type StepOutput struct {
+	// name of the output, available for the next steps as steps.<id>.outputs.<name>
+	// +kubebuilder:validation:Pattern=^[a-zA-Z_][a-zA-Z0-9_]*$
+	Name string `json:"name"`
+
+	// type of the output value (defaults to: "string")
+	Type OutputType `json:"type,omitempty"`
+
+	// output description to display
+	Description string `json:"description,omitempty" expr:"template"`
+}
+
+type WorkflowOutput struct {
+	StepOutput `json:",inline" expr:"include"`
+
+	// expression computing the value out of the steps outputs, like "steps.build.outputs.version",
+	// defaults to the output with the same name declared by any of the steps
+	Value string `json:"value,omitempty" expr:"expression"`
+}
@@ -17,6 +17,40 @@ type RetryPolicy struct {
 
 	// until when it should retry (defaults to: "passed")
 	Until string `json:"until,omitempty" expr:"expression"`
+
+	// delay before the next attempt (e.g. "30s"), retries immediately when not provided
+	Delay string `json:"delay,omitempty" expr:"template"`
+
+	// how the delay grows with the next attempts (defaults to: "constant")
+	Backoff RetryBackoff `json:"backoff,omitempty" expr:"template"`
+
+	// the upper bound for the delay growing with the backoff (e.g. "5m")
+	MaxDelay string `json:"maxDelay,omitempty" expr:"template"`
+
+	// percentage of the delay to randomly add or subtract, to avoid retrying at the same time
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Jitter int32 `json:"jitter,omitempty"`
+
+	// retry only when the failure matches any of the conditions
+	RetryOn *RetryOn `json:"retryOn,omitempty" expr:"include"`
+}
+
+// +kubebuilder:validation:Enum=constant;linear;exponential
+type RetryBackoff string
+
+const (
+	RetryBackoffConstant    RetryBackoff = "constant"
+	RetryBackoffLinear      RetryBackoff = "linear"
+	RetryBackoffExponential RetryBackoff = "exponential"
+)
+
+type RetryOn struct {
+	// exit codes of the step that should be retried
+	ExitCodes []int32 `json:"exitCodes,omitempty"`
+
+	// regular expression matching the step logs that should be retried
+	Logs string `json:"logs,omitempty" expr:"template"`
 }
 
 type StepMeta struct {
@@ -34,6 +68,9 @@ type StepMeta struct {
 
 	// mark the step as pure, applying optimizations to merge the containers together
 	Pure *bool `json:"pure,omitempty"`
+
+	// outputs produced by this step, written as files in /testkube/outputs or as "name=value" lines to $TK_OUTPUT
+	Outputs []StepOutput `json:"outputs,omitempty" expr:"include"`
 }
 
 type StepSource struct {
@@ -82,6 +119,9 @@ type StepOperations struct {
 
 	// scrape artifacts from the volumes
 	Artifacts *StepArtifacts `json:"artifacts,omitempty" expr:"include"`
+
+	// wait for the human approval before continuing
+	Approval *StepApproval `json:"approval,omitempty" expr:"include"`
 }
 
 type IndependentStep struct {
@@ -422,6 +462,26 @@ type StepArtifacts struct {
 	Paths []string `json:"paths,omitempty" expr:"template"`
 }
 
+// +kubebuilder:validation:Enum=approved;rejected
+type ApprovalDecision string
+
+const (
+	ApprovalDecisionApproved ApprovalDecision = "approved"
+	ApprovalDecisionRejected ApprovalDecision = "rejected"
+)
+
+type StepApproval struct {
+	// message displayed to the reviewers
+	Message string `json:"message,omitempty" expr:"template"`
+	// list of people allowed to approve or reject, anyone may decide when empty
+	Approvers []string `json:"approvers,omitempty" expr:"template"`
+	// maximum time to wait for the decision
+	// +kubebuilder:validation:Pattern=^((0|[1-9][0-9]*)h)?((0|[1-9][0-9]*)m)?((0|[1-9][0-9]*)s)?((0|[1-9][0-9]*)ms)?$
+	Timeout string `json:"timeout,omitempty"`
+	// decision to apply when the timeout is reached (defaults to: "rejected")
+	Default ApprovalDecision `json:"default,omitempty"`
+}
+
 type ArtifactCompression struct {
 	// artifact name
 	// +kubebuilder:validation:Required
@@ -28,6 +28,9 @@ type TestWorkflowSpec struct {
 
 	TestWorkflowSpecBase `json:",inline" expr:"include"`
 
+	// ephemeral environment to provision in a dedicated namespace before the steps
+	Environment *EnvironmentSpec `json:"environment,omitempty" expr:"include"`
+
 	// list of accompanying services to start
 	Services map[string]ServiceSpec `json:"services,omitempty" expr:"template,include"`
 
@@ -40,6 +43,12 @@ type TestWorkflowSpec struct {
 	// steps to run at the end of the workflow
 	After []Step `json:"after,omitempty" expr:"include"`
 
+	// result gates evaluated against the aggregated data, after all the steps are finished
+	Gates []Gate `json:"gates,omitempty" expr:"include"`
+
+	// outputs returned by the execution, computed out of the steps outputs
+	Outputs []WorkflowOutput `json:"outputs,omitempty" expr:"include"`
+
 	// list of accompanying permanent volume claims
 	Pvcs map[string]corev1.PersistentVolumeClaimSpec `json:"pvcs,omitempty" expr:"template,include"`
 }
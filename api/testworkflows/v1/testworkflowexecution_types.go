@@ -193,6 +193,8 @@ type TestWorkflowResult struct {
 	Pauses          []TestWorkflowPause               `json:"pauses,omitempty"`
 	Initialization  *TestWorkflowStepResult           `json:"initialization,omitempty"`
 	Steps           map[string]TestWorkflowStepResult `json:"steps,omitempty"`
+	// outcomes of the result gates
+	Gates []TestWorkflowGateResult `json:"gates,omitempty"`
 }
 
 // TestWorkflowStatus has status of TestWorkflow
@@ -230,6 +232,32 @@ type TestWorkflowStepResult struct {
 	StartedAt metav1.Time `json:"startedAt,omitempty"`
 	// when the container was finished
 	FinishedAt metav1.Time `json:"finishedAt,omitempty"`
+	// details of the human approval requested by the step
+	Approval *TestWorkflowApproval `json:"approval,omitempty"`
+}
+
+// TestWorkflowApproval contains the human approval request and its decision
+type TestWorkflowApproval struct {
+	// step reference
+	Ref string `json:"ref,omitempty"`
+	// message displayed to the reviewers
+	Message string `json:"message,omitempty"`
+	// list of people allowed to decide
+	Approvers []string `json:"approvers,omitempty"`
+	// when the approval has been requested
+	RequestedAt metav1.Time `json:"requestedAt,omitempty"`
+	// when the default decision will be applied
+	Deadline metav1.Time `json:"deadline,omitempty"`
+	// the decision taken
+	Decision ApprovalDecision `json:"decision,omitempty"`
+	// who has taken the decision
+	DecidedBy string `json:"decidedBy,omitempty"`
+	// when the decision has been taken
+	DecidedAt metav1.Time `json:"decidedAt,omitempty"`
+	// additional comment from the reviewer
+	Comment string `json:"comment,omitempty"`
+	// whether the default decision has been applied after the timeout
+	TimedOut bool `json:"timedOut,omitempty"`
 }
 
 // TestWorkfloStepwStatus has step status of TestWorkflow
@@ -248,6 +276,31 @@ const (
 	ABORTED_TestWorkflowStepStatus TestWorkflowStepStatus = "aborted"
 )
 
+// TestWorkflowGateResult contains the outcome of a single result gate
+type TestWorkflowGateResult struct {
+	// gate name
+	Name string `json:"name"`
+	// condition that has been evaluated
+	Condition string `json:"condition,omitempty"`
+	// gate evaluation status
+	Status TestWorkflowGateStatus `json:"status"`
+	// action taken when the gate is not satisfied
+	OnFailure GateAction `json:"onFailure,omitempty"`
+	// details about the failure or evaluation error
+	Message string `json:"message,omitempty"`
+}
+
+// TestWorkflowGateStatus has status of the TestWorkflow result gate
+// +kubebuilder:validation:Enum=passed;failed;error
+type TestWorkflowGateStatus string
+
+// List of TestWorkflowGateStatus
+const (
+	PASSED_TestWorkflowGateStatus TestWorkflowGateStatus = "passed"
+	FAILED_TestWorkflowGateStatus TestWorkflowGateStatus = "failed"
+	ERROR_TestWorkflowGateStatus  TestWorkflowGateStatus = "error"
+)
+
 // TestWorkflowOutput defines output of TestWorkflow
 type TestWorkflowOutput struct {
 	// step reference
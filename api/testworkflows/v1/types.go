@@ -251,4 +251,14 @@ type TestWorkflowExecutionSchema struct {
 
 	// When true, SilentMode is activated for all executions (Webhooks, Insights, Health, Metrics, Cdevents all set to true).
 	Silent *bool `json:"silent,omitempty" expr:"template"`
+
+	// automatically re-queue the execution when it has been terminated by the infrastructure
+	// (eviction, preemption, node loss, image pull or scheduling timeout)
+	RetryOnInfrastructureFailure *RetryOnInfrastructureFailurePolicy `json:"retryOnInfrastructureFailure,omitempty" expr:"include"`
+}
+
+type RetryOnInfrastructureFailurePolicy struct {
+	// maximum number of attempts, including the original execution
+	// +kubebuilder:validation:Minimum=1
+	MaxAttempts int32 `json:"maxAttempts"`
 }
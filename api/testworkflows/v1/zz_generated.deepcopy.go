@@ -134,6 +134,27 @@ func (in *Content) DeepCopyInto(out *Content) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Oci != nil {
+		in, out := &in.Oci, &out.Oci
+		*out = make([]ContentOci, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = make([]ContentObjectStorage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Gcs != nil {
+		in, out := &in.Gcs, &out.Gcs
+		*out = make([]ContentObjectStorage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Content.
@@ -231,6 +252,66 @@ func (in *ContentGitRetry) DeepCopy() *ContentGitRetry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentObjectStorage) DeepCopyInto(out *ContentObjectStorage) {
+	*out = *in
+	if in.Mount != nil {
+		in, out := &in.Mount, &out.Mount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccessKeyIdFrom != nil {
+		in, out := &in.AccessKeyIdFrom, &out.AccessKeyIdFrom
+		*out = new(corev1.EnvVarSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKeyFrom != nil {
+		in, out := &in.SecretAccessKeyFrom, &out.SecretAccessKeyFrom
+		*out = new(corev1.EnvVarSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentObjectStorage.
+func (in *ContentObjectStorage) DeepCopy() *ContentObjectStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentObjectStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentOci) DeepCopyInto(out *ContentOci) {
+	*out = *in
+	if in.Mount != nil {
+		in, out := &in.Mount, &out.Mount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsernameFrom != nil {
+		in, out := &in.UsernameFrom, &out.UsernameFrom
+		*out = new(corev1.EnvVarSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordFrom != nil {
+		in, out := &in.PasswordFrom, &out.PasswordFrom
+		*out = new(corev1.EnvVarSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentOci.
+func (in *ContentOci) DeepCopy() *ContentOci {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentOci)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContentTarball) DeepCopyInto(out *ContentTarball) {
 	*out = *in
@@ -318,6 +399,75 @@ func (in *EnvVar) DeepCopy() *EnvVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentHelm) DeepCopyInto(out *EnvironmentHelm) {
+	*out = *in
+	if in.ValuesFiles != nil {
+		in, out := &in.ValuesFiles, &out.ValuesFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentHelm.
+func (in *EnvironmentHelm) DeepCopy() *EnvironmentHelm {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentHelm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+	*out = *in
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(Content)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkingDir != nil {
+		in, out := &in.WorkingDir, &out.WorkingDir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(EnvironmentHelm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
+func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -338,6 +488,21 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gate) DeepCopyInto(out *Gate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gate.
+func (in *Gate) DeepCopy() *Gate {
+	if in == nil {
+		return nil
+	}
+	out := new(Gate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IndependentServiceSpec) DeepCopyInto(out *IndependentServiceSpec) {
 	*out = *in
@@ -769,9 +934,49 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryOn) DeepCopyInto(out *RetryOn) {
+	*out = *in
+	if in.ExitCodes != nil {
+		in, out := &in.ExitCodes, &out.ExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOn.
+func (in *RetryOn) DeepCopy() *RetryOn {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryOn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryOnInfrastructureFailurePolicy) DeepCopyInto(out *RetryOnInfrastructureFailurePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOnInfrastructureFailurePolicy.
+func (in *RetryOnInfrastructureFailurePolicy) DeepCopy() *RetryOnInfrastructureFailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryOnInfrastructureFailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = new(RetryOn)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
@@ -865,6 +1070,26 @@ func (in *Step) DeepCopy() *Step {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepApproval) DeepCopyInto(out *StepApproval) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepApproval.
+func (in *StepApproval) DeepCopy() *StepApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(StepApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepArtifacts) DeepCopyInto(out *StepArtifacts) {
 	*out = *in
@@ -901,7 +1126,7 @@ func (in *StepControl) DeepCopyInto(out *StepControl) {
 	if in.Retry != nil {
 		in, out := &in.Retry, &out.Retry
 		*out = new(RetryPolicy)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1084,6 +1309,11 @@ func (in *StepMeta) DeepCopyInto(out *StepMeta) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]StepOutput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepMeta.
@@ -1114,6 +1344,11 @@ func (in *StepOperations) DeepCopyInto(out *StepOperations) {
 		*out = new(StepArtifacts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(StepApproval)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepOperations.
@@ -1126,6 +1361,21 @@ func (in *StepOperations) DeepCopy() *StepOperations {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepOutput) DeepCopyInto(out *StepOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepOutput.
+func (in *StepOutput) DeepCopy() *StepOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(StepOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepParallel) DeepCopyInto(out *StepParallel) {
 	*out = *in
@@ -1475,6 +1725,29 @@ func (in *TestWorkflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestWorkflowApproval) DeepCopyInto(out *TestWorkflowApproval) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	in.Deadline.DeepCopyInto(&out.Deadline)
+	in.DecidedAt.DeepCopyInto(&out.DecidedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestWorkflowApproval.
+func (in *TestWorkflowApproval) DeepCopy() *TestWorkflowApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(TestWorkflowApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestWorkflowExecution) DeepCopyInto(out *TestWorkflowExecution) {
 	*out = *in
@@ -1817,6 +2090,11 @@ func (in *TestWorkflowExecutionSchema) DeepCopyInto(out *TestWorkflowExecutionSc
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RetryOnInfrastructureFailure != nil {
+		in, out := &in.RetryOnInfrastructureFailure, &out.RetryOnInfrastructureFailure
+		*out = new(RetryOnInfrastructureFailurePolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestWorkflowExecutionSchema.
@@ -1955,6 +2233,21 @@ func (in *TestWorkflowExecutionSummary) DeepCopy() *TestWorkflowExecutionSummary
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestWorkflowGateResult) DeepCopyInto(out *TestWorkflowGateResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestWorkflowGateResult.
+func (in *TestWorkflowGateResult) DeepCopy() *TestWorkflowGateResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TestWorkflowGateResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestWorkflowList) DeepCopyInto(out *TestWorkflowList) {
 	*out = *in
@@ -2096,6 +2389,11 @@ func (in *TestWorkflowResult) DeepCopyInto(out *TestWorkflowResult) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Gates != nil {
+		in, out := &in.Gates, &out.Gates
+		*out = make([]TestWorkflowGateResult, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestWorkflowResult.
@@ -2234,6 +2532,11 @@ func (in *TestWorkflowSpec) DeepCopyInto(out *TestWorkflowSpec) {
 		}
 	}
 	in.TestWorkflowSpecBase.DeepCopyInto(&out.TestWorkflowSpecBase)
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(EnvironmentSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Services != nil {
 		in, out := &in.Services, &out.Services
 		*out = make(map[string]ServiceSpec, len(*in))
@@ -2262,6 +2565,16 @@ func (in *TestWorkflowSpec) DeepCopyInto(out *TestWorkflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Gates != nil {
+		in, out := &in.Gates, &out.Gates
+		*out = make([]Gate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]WorkflowOutput, len(*in))
+		copy(*out, *in)
+	}
 	if in.Pvcs != nil {
 		in, out := &in.Pvcs, &out.Pvcs
 		*out = make(map[string]corev1.PersistentVolumeClaimSpec, len(*in))
@@ -2391,6 +2704,11 @@ func (in *TestWorkflowStepResult) DeepCopyInto(out *TestWorkflowStepResult) {
 	in.QueuedAt.DeepCopyInto(&out.QueuedAt)
 	in.StartedAt.DeepCopyInto(&out.StartedAt)
 	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(TestWorkflowApproval)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestWorkflowStepResult.
@@ -2581,6 +2899,22 @@ func (in *TestWorkflowTimeouts) DeepCopy() *TestWorkflowTimeouts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowOutput) DeepCopyInto(out *WorkflowOutput) {
+	*out = *in
+	out.StepOutput = in.StepOutput
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowOutput.
+func (in *WorkflowOutput) DeepCopy() *WorkflowOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowPodSecurityContext) DeepCopyInto(out *WorkflowPodSecurityContext) {
 	*out = *in
@@ -9,6 +9,7 @@ import (
 	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/configmap"
 	postgresdb "github.com/kubeshop/testkube/pkg/database/postgres"
 	postgresmigrations "github.com/kubeshop/testkube/pkg/database/postgres/migrations"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
 	"github.com/kubeshop/testkube/pkg/dbmigrator"
 	"github.com/kubeshop/testkube/pkg/event"
 	"github.com/kubeshop/testkube/pkg/event/bus"
@@ -113,6 +115,22 @@ func MustGetConfigMapConfig(ctx context.Context, name string, namespace string,
 	return configMapConfig
 }
 
+// MustGetConfigRepository loads the API server config from the embedded SQLite database when it is enabled,
+// or from the ConfigMap otherwise
+func MustGetConfigRepository(ctx context.Context, cfg *config.Config) configRepo.Repository {
+	if cfg.APISQLitePath == "" {
+		configMapConfig := MustGetConfigMapConfig(ctx, cfg.APIServerConfig, cfg.TestkubeNamespace, cfg.TestkubeAnalyticsEnabled)
+		log.DefaultLogger.Info("ConfigMap configuration loaded successfully")
+		return configMapConfig
+	}
+
+	sqliteConfig := configRepo.NewSQLiteConfig(MustGetSQLiteDatabase(ctx, cfg))
+	err := sqliteConfig.Load(ctx, cfg.TestkubeAnalyticsEnabled)
+	ExitOnError("loading SQLite configuration", err)
+	log.DefaultLogger.Info("SQLite configuration loaded successfully")
+	return sqliteConfig
+}
+
 func MustGetMinioClient(cfg *config.Config) domainstorage.Client {
 	opts := minio.GetTLSOptions(cfg.StorageSSL, cfg.StorageSkipVerify, cfg.StorageCertFile, cfg.StorageKeyFile, cfg.StorageCAFile)
 	if cfg.StorageUseVirtualHostedStyle {
@@ -224,6 +242,30 @@ func MustGetPostgresDatabase(ctx context.Context, cfg *config.Config, migrate bo
 	return pool
 }
 
+var (
+	sqliteDatabase     *sqlitedb.DB
+	sqliteDatabaseOnce sync.Once
+)
+
+// MustGetSQLiteDatabase opens the embedded SQLite database, and applies the migrations.
+// The database is shared between all the repositories, as it allows a single connection only.
+func MustGetSQLiteDatabase(ctx context.Context, cfg *config.Config) *sqlitedb.DB {
+	sqliteDatabaseOnce.Do(func() {
+		db, err := sqlitedb.Open(ctx, cfg.APISQLitePath)
+		ExitOnError("Getting SQLite database", err)
+
+		results, err := db.Migrate(ctx)
+		ExitOnError("Applying SQLite migrations", err)
+		if len(results) == 0 {
+			log.DefaultLogger.Info("No SQLite migrations to apply.")
+		} else {
+			log.DefaultLogger.Info(fmt.Sprintf("Applied SQLite migrations with results %v", results))
+		}
+		sqliteDatabase = db
+	})
+	return sqliteDatabase
+}
+
 func runPostgresMigrations(ctx context.Context, db *sql.DB) error {
 	provider, err := goose.NewProvider(goose.DialectPostgres, db, postgresmigrations.Fs, goose.WithAllowOutofOrder(true))
 	if err != nil {
@@ -66,7 +66,9 @@ import (
 	"github.com/kubeshop/testkube/pkg/event/bus"
 	"github.com/kubeshop/testkube/pkg/event/kind/cdevent"
 	"github.com/kubeshop/testkube/pkg/event/kind/k8sevent"
+	"github.com/kubeshop/testkube/pkg/event/kind/testworkflowexecutionlogs"
 	"github.com/kubeshop/testkube/pkg/event/kind/testworkflowexecutionmetrics"
+	"github.com/kubeshop/testkube/pkg/event/kind/testworkflowexecutionretry"
 	"github.com/kubeshop/testkube/pkg/event/kind/testworkflowexecutions"
 	"github.com/kubeshop/testkube/pkg/event/kind/testworkflowexecutiontelemetry"
 	"github.com/kubeshop/testkube/pkg/event/kind/webhook"
@@ -93,6 +95,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/secretmanager"
 	"github.com/kubeshop/testkube/pkg/server"
 	"github.com/kubeshop/testkube/pkg/tcl/schedulertcl"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowconfig"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowexecutor"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/presets"
@@ -146,8 +149,7 @@ func main() {
 	commons.MustFreePort(cfg.GRPCServerPort)
 
 	log.DefaultLogger.Info("initializing...")
-	configMapConfig := commons.MustGetConfigMapConfig(ctx, cfg.APIServerConfig, cfg.TestkubeNamespace, cfg.TestkubeAnalyticsEnabled)
-	log.DefaultLogger.Info("ConfigMap configuration loaded successfully")
+	configRepository := commons.MustGetConfigRepository(ctx, cfg)
 
 	// k8s
 	log.DefaultLogger.Info("connecting to Kubernetes cluster...")
@@ -318,8 +320,8 @@ func main() {
 		}
 	}
 
-	clusterId, _ := configMapConfig.GetUniqueClusterId(ctx)
-	telemetryEnabled, _ := configMapConfig.GetTelemetryEnabled(ctx)
+	clusterId, _ := configRepository.GetUniqueClusterId(ctx)
+	telemetryEnabled, _ := configRepository.GetTelemetryEnabled(ctx)
 
 	// k8s clients
 	var webhooksClient executorsclientv1.WebhooksInterface = executorsclientv1.NewWebhooksClient(kubeClient, cfg.TestkubeNamespace)
@@ -549,7 +551,7 @@ func main() {
 	}
 	runner := runner2.New(
 		executionWorker,
-		configMapConfig,
+		configRepository,
 		client,
 		eventsEmitter,
 		metrics,
@@ -668,7 +670,17 @@ func main() {
 
 	// Send the telemetry data regarding the Test Workflow Execution
 	// TODO: Disable it if Control Plane does that
-	eventsEmitter.RegisterLoader(testworkflowexecutiontelemetry.NewLoader(ctx, configMapConfig))
+	eventsEmitter.RegisterLoader(testworkflowexecutiontelemetry.NewLoader(ctx, configRepository))
+
+	// Index the Test Workflow Execution logs for the search
+	if controlPlane != nil && cfg.LogsStorage != "none" {
+		eventsEmitter.RegisterLoader(testworkflowexecutionlogs.NewLoader(ctx, testWorkflowOutputRepository, controlPlane.GetRepositoryManager().LogSearch()))
+	}
+
+	// Re-queue the Test Workflow Executions terminated by the infrastructure
+	if controlPlane != nil {
+		eventsEmitter.RegisterLoader(testworkflowexecutionretry.NewLoader(ctx, testWorkflowExecutor))
+	}
 
 	// Update TestWorkflowExecution Kubernetes resource objects on status change
 	eventsEmitter.RegisterLoader(testworkflowexecutions.NewLoader(ctx, cfg.TestkubeNamespace, kubeClient))
@@ -742,7 +754,12 @@ func main() {
 
 	// Create HTTP server
 	log.DefaultLogger.Infow("creating HTTP server...", "port", cfg.APIServerPort)
-	httpServer := server.NewServer(server.Config{Port: cfg.APIServerPort, EnableTracing: cfg.TracingEnabled})
+	httpServer := server.NewServer(server.Config{
+		Port:          cfg.APIServerPort,
+		EnableTracing: cfg.TracingEnabled,
+		// The execution archives for import are read from the stream, with their own size limit
+		StreamedBodyPaths: []string{"/v1/test-workflow-executions/import"},
+	})
 	httpServer.Routes.Use(cors.New())
 
 	isStandalone := mode == common.ModeStandalone
@@ -767,7 +784,7 @@ func main() {
 		testworkflowsclientv1.NewClient(kubeClient, cfg.TestkubeNamespace),
 		testWorkflowTemplatesClient,
 		testworkflowsclientv1.NewTestWorkflowTemplatesClient(kubeClient, cfg.TestkubeNamespace),
-		configMapConfig,
+		configRepository,
 		secretManager,
 		secretConfig,
 		executionWorker,
@@ -782,6 +799,18 @@ func main() {
 		cfg.ExportArchiveMaxSize,
 	)
 	api.ClusterDiscoverer = clusterdiscovery.New(clientset, cfg.TestkubeNamespace).WithSchemas(apiextClient)
+	if controlPlane != nil {
+		api.LogSearch = controlPlane.GetRepositoryManager().LogSearch()
+		api.ExecutionImporter = controlPlane.GetExecutionImporter()
+	}
+	api.Identities = cfg.APIIdentityTokens
+	api.ImportArchiveMaxSize = int64(cfg.ImportArchiveMaxSize)
+	api.ImportArchiveMaxExtractedSize = cfg.ImportArchiveMaxExtractedSize
+	for _, keyPath := range cfg.ImportArchiveTrustedKeys {
+		key, err := executionarchive.LoadPublicKey(keyPath)
+		commons.ExitOnError("Loading trusted execution archive key", err)
+		api.ImportTrustedKeys = append(api.ImportTrustedKeys, key)
+	}
 	api.Init(httpServer)
 
 	// Push watchable cluster-resources snapshot to CP on startup, on CRD
@@ -943,7 +972,7 @@ func main() {
 	leaderTasks = append(leaderTasks, leader.Task{
 		Name: "telemetry-heartbeat",
 		Start: func(taskCtx context.Context) error {
-			services.HandleTelemetryHeartbeat(taskCtx, clusterId, configMapConfig, capabilities)
+			services.HandleTelemetryHeartbeat(taskCtx, clusterId, configRepository, capabilities)
 			return nil
 		},
 	})
@@ -14,6 +14,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/controlplane"
 	"github.com/kubeshop/testkube/pkg/controlplane/scheduling"
 	database "github.com/kubeshop/testkube/pkg/database/postgres"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
 	"github.com/kubeshop/testkube/pkg/event"
 	"github.com/kubeshop/testkube/pkg/k8sclient"
 	"github.com/kubeshop/testkube/pkg/log"
@@ -21,6 +22,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/newclients/testworkflowtemplateclient"
 	kubeclient "github.com/kubeshop/testkube/pkg/operator/client"
 	"github.com/kubeshop/testkube/pkg/repository"
+	"github.com/kubeshop/testkube/pkg/repository/migration"
 	"github.com/kubeshop/testkube/pkg/repository/storage"
 	testworkflowrepo "github.com/kubeshop/testkube/pkg/repository/testworkflow"
 	miniorepo "github.com/kubeshop/testkube/pkg/repository/testworkflow/minio"
@@ -42,14 +44,21 @@ func CreateControlPlane(ctx context.Context, cfg *config.Config, eventsEmitter *
 	// Connect to storages
 	secretClient := secret.NewClientFor(clientset, cfg.TestkubeNamespace)
 
-	var factory repository.RepositoryFactory
+	var factory, mirrorFactory repository.RepositoryFactory
 	if cfg.APIMongoDSN != "" {
 		mongoDb := commons.MustGetMongoDatabase(ctx, cfg, secretClient, !cfg.DisableMongoMigrations)
 		factory, err = CreateMongoFactory(ctx, cfg, mongoDb)
 	}
 	if cfg.APIPostgresDSN != "" {
 		postgresDb := commons.MustGetPostgresDatabase(ctx, cfg, !cfg.DisablePostgresMigrations)
-		factory, err = CreatePostgresFactory(postgresDb)
+		if cfg.APIPostgresDualWrite && factory != nil {
+			mirrorFactory, err = CreatePostgresFactory(postgresDb)
+		} else {
+			factory, err = CreatePostgresFactory(postgresDb)
+		}
+	}
+	if cfg.APISQLitePath != "" {
+		factory, err = CreateSQLiteFactory(commons.MustGetSQLiteDatabase(ctx, cfg))
 	}
 	commons.ExitOnError("Creating factory for database", err)
 
@@ -62,6 +71,12 @@ func CreateControlPlane(ctx context.Context, cfg *config.Config, eventsEmitter *
 	// Build repositories
 	repoManager := repository.NewRepositoryManager(factory)
 	testWorkflowResultsRepository := repoManager.TestWorkflow()
+	var dualWriteRepository *migration.DualWriteRepository
+	if mirrorFactory != nil {
+		log.DefaultLogger.Infow("Mirroring the execution writes to PostgreSQL for the migration window")
+		dualWriteRepository = migration.NewDualWriteRepository(testWorkflowResultsRepository, mirrorFactory.NewTestWorkflowRepository(), log.DefaultLogger)
+		testWorkflowResultsRepository = dualWriteRepository
+	}
 	storageClient := commons.MustGetMinioClient(cfg)
 
 	var testWorkflowOutputRepository testworkflowrepo.OutputRepository
@@ -78,6 +93,10 @@ func CreateControlPlane(ctx context.Context, cfg *config.Config, eventsEmitter *
 		envID, cfg.GlobalWorkflowTemplateName, cfg.GlobalWorkflowTemplateInline != "")
 	scheduler := factory.NewScheduler()
 	executionController := factory.NewExecutionController()
+	if dualWriteRepository != nil {
+		scheduler = dualWriteRepository.Scheduler(scheduler)
+		executionController = dualWriteRepository.Controller(executionController)
+	}
 	executionQuerier := factory.NewExecutionQuerier()
 
 	// Ensure the buckets exist (retry in background until they do).
@@ -200,3 +219,9 @@ func CreatePostgresFactory(db *pgxpool.Pool) (repository.RepositoryFactory, erro
 
 	return factory, nil
 }
+
+func CreateSQLiteFactory(db *sqlitedb.DB) (repository.RepositoryFactory, error) {
+	return repository.NewFactoryBuilder().WithSQLite(repository.SQLiteFactoryConfig{
+		Database: db,
+	}).Build()
+}
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
+	"github.com/kubeshop/testkube/pkg/apply"
+	"github.com/kubeshop/testkube/pkg/k8sclient"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const (
+	applyModeAPI = "api"
+	applyModeCRD = "crd"
+)
+
+func NewApplyCmd() *cobra.Command {
+	var (
+		files    []string
+		prune    bool
+		all      bool
+		selector string
+		dryRun   bool
+		showDiff bool
+		mode     string
+	)
+
+	cmd := &cobra.Command{
+		Use:         "apply -f <path>...",
+		Short:       "Apply the resources from the files declaratively",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Long: `Synchronize the Test Workflows, Test Workflow Templates, Test Triggers, Workflow Triggers,
+Webhooks and Webhook Templates with the YAML files, creating or updating them in the dependency order.
+
+The changes are computed with the three-way merge between the previously applied manifest
+(stored in the "` + apply.LastAppliedAnnotationName + `" annotation), the new manifest and the live resource,
+so the fields removed from the files are removed from the resources too.
+
+With --prune, the resources applied before, but not present in the files anymore, are deleted.`,
+		Example: `  kubectl testkube apply -f workflows/
+  kubectl testkube apply -f workflows/ --prune --selector owner=team-a --dry-run`,
+		Args: cobra.NoArgs,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			cfg, err := config.Load()
+			ui.ExitOnError("loading config", err)
+			common.UiContextHeader(cmd, cfg)
+
+			if mode == applyModeAPI {
+				validator.PersistentPreRunVersionCheck(cmd, common.Version)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(files) == 0 {
+				ui.Failf("pass at least one file or directory with --file")
+			}
+			opts := apply.Options{Prune: prune}
+			if prune && selector == "" && !all {
+				ui.Failf("--prune requires either --selector or --all")
+			}
+			if selector != "" {
+				parsed, err := labels.Parse(selector)
+				ui.ExitOnError("parsing selector", err)
+				opts.Selector = parsed
+			}
+
+			resources, err := apply.Load(files...)
+			ui.ExitOnError("loading resources", err)
+
+			namespace := cmd.Flag("namespace").Value.String()
+			var backend apply.Backend
+			switch mode {
+			case applyModeAPI:
+				client, _, err := common.GetClient(cmd)
+				ui.ExitOnError("getting client", err)
+				backend = apply.NewAPIBackend(client, namespace)
+			case applyModeCRD:
+				client, err := k8sclient.ConnectToK8sDynamic()
+				ui.ExitOnError("connecting to Kubernetes", err)
+				backend = apply.NewKubernetesBackend(client, namespace)
+			default:
+				ui.Failf("invalid --mode value: %s", mode)
+			}
+
+			changes, err := apply.Plan(cmd.Context(), backend, resources, opts)
+			ui.ExitOnError("computing changes", err)
+
+			suffix := ""
+			if dryRun {
+				suffix = " (dry run)"
+			}
+			for _, change := range changes {
+				if showDiff && change.Action != apply.ActionUnchanged {
+					diff, err := change.Diff()
+					ui.ExitOnError("computing diff", err)
+					printApplyDiff(diff)
+				}
+				if !dryRun {
+					err = apply.Execute(cmd.Context(), backend, change)
+					ui.ExitOnError(fmt.Sprintf("applying %s/%s", change.Kind.DisplayName(), change.Name), err)
+				}
+				printApplyChange(change, suffix)
+			}
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&files, "file", "f", nil, "file or directory with the resources to apply")
+	cmd.Flags().BoolVar(&prune, "prune", false, "delete the previously applied resources that are not in the files anymore")
+	cmd.Flags().BoolVar(&all, "all", false, "prune all the previously applied resources, regardless of the labels")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector limiting the pruned resources, i.e. owner=team-a")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only show the changes, without applying them")
+	cmd.Flags().BoolVar(&showDiff, "diff", true, "show the diff of the changes")
+	cmd.Flags().StringVar(&mode, "mode", applyModeAPI, "apply the resources through the Testkube API (api), or directly as Kubernetes custom resources (crd)")
+
+	return cmd
+}
+
+func printApplyChange(change apply.Change, suffix string) {
+	name := fmt.Sprintf("%s/%s", change.Kind.DisplayName(), change.Name)
+	switch change.Action {
+	case apply.ActionCreate:
+		ui.Printf("%s %s%s\n", name, ui.Green(string(change.Action)), suffix)
+	case apply.ActionConfigure:
+		ui.Printf("%s %s%s\n", name, ui.Yellow(string(change.Action)), suffix)
+	case apply.ActionPrune:
+		ui.Printf("%s %s%s\n", name, ui.Red(string(change.Action)), suffix)
+	default:
+		ui.Printf("%s %s\n", name, ui.LightGray(string(change.Action)))
+	}
+}
+
+func printApplyDiff(diff string) {
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			ui.Printf("%s", ui.LightGray(line))
+		case strings.HasPrefix(line, "@@"):
+			ui.Printf("%s", ui.Cyan(line))
+		case strings.HasPrefix(line, "+"):
+			ui.Printf("%s", ui.Green(line))
+		case strings.HasPrefix(line, "-"):
+			ui.Printf("%s", ui.Red(line))
+		default:
+			ui.Printf("%s", line)
+		}
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	commands "github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/diagnostics"
-	"github.com/kubeshop/testkube/pkg/diagnostics"
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -21,17 +21,24 @@ func NewDiagnosticsCmd() *cobra.Command {
 	cmd.Flags().StringP("key-override", "k", "", "Pass License key manually (we will not try to locate it automatically)")
 	cmd.Flags().StringP("file-override", "f", "", "Pass License file manually (we will not try to locate it automatically)")
 
+	cmd.PersistentFlags().String("deployment", loader.DefaultAgentDeploymentName, "Name of the Testkube Agent deployment to check")
+	cmd.PersistentFlags().StringP("output", "o", "pretty", "Output format - one of: pretty, json")
+
 	cmd.AddCommand(commands.NewLicenseCheckCmd())
 	cmd.AddCommand(commands.NewInstallCheckCmd())
+	cmd.AddCommand(commands.NewClusterCheckCmd())
 
 	return cmd
 }
 
 func NewRunDiagnosticsCmdFunc() func(cmd *cobra.Command, args []string) {
 	return func(cmd *cobra.Command, args []string) {
-		d := diagnostics.New()
+		d := commands.New(cmd)
 
 		commands.RegisterInstallValidators(cmd, d)
+		if err := commands.RegisterClusterValidators(cmd, d); err != nil {
+			ui.Warn("Skipping cluster checks, Testkube installation not loaded:", err.Error())
+		}
 		commands.RegisterLicenseValidators(cmd, d)
 
 		err := d.Run()
@@ -0,0 +1,75 @@
+package diagnostics
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics"
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/renderer"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators/cluster"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+// RegisterClusterValidators adds the health checks of the Testkube installation in the cluster
+func RegisterClusterValidators(cmd *cobra.Command, d diagnostics.Diagnostics) error {
+	namespace := cmd.Flag("namespace").Value.String()
+	deployment := cmd.Flag("deployment").Value.String()
+
+	inst, err := loader.GetInstallation(context.Background(), namespace, deployment)
+	if err != nil {
+		return err
+	}
+
+	d.AddValidatorGroup("cluster.crds", inst).
+		AddValidator(cluster.NewCRDVersionsValidator())
+	d.AddValidatorGroup("cluster.rbac", inst).
+		AddValidator(cluster.NewServiceAccountRBACValidator())
+	d.AddValidatorGroup("cluster.storage", inst).
+		AddValidator(cluster.NewStorageValidator())
+	d.AddValidatorGroup("cluster.database", inst).
+		AddValidator(cluster.NewDatabaseValidator())
+	d.AddValidatorGroup("cluster.nats", inst).
+		AddValidator(cluster.NewNATSValidator())
+	agentGroup := d.AddValidatorGroup("cluster.agent", inst)
+	agentGroup.AddValidator(cluster.NewControlPlaneConnectionValidator())
+	agentGroup.AddValidator(cluster.NewLeaseValidator())
+	d.AddValidatorGroup("cluster.images", inst).
+		AddValidator(cluster.NewWorkerImagesValidator())
+	d.AddValidatorGroup("cluster.webhooks", inst).
+		AddValidator(cluster.NewWebhooksValidator())
+	return nil
+}
+
+func NewClusterCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cluster",
+		Aliases: []string{"installation", "c"},
+		Short:   "Diagnose health of the Testkube installation in the cluster",
+		Run:     RunClusterCheckFunc(),
+	}
+
+	return cmd
+}
+
+func RunClusterCheckFunc() func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		d := New(cmd)
+		err := RegisterClusterValidators(cmd, d)
+		ui.ExitOnError("Loading Testkube installation", err)
+
+		err = d.Run()
+		ui.ExitOnError("Running validations", err)
+		ui.NL(2)
+	}
+}
+
+// New creates the diagnostics with the renderer selected by the output flag
+func New(cmd *cobra.Command) diagnostics.Diagnostics {
+	d := diagnostics.New()
+	if flag := cmd.Flag("output"); flag != nil && flag.Value.String() == "json" {
+		d.Renderer = renderer.NewJSONRenderer()
+	}
+	return d
+}
@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/internal/crdcommon"
+	testworkflowmappers "github.com/kubeshop/testkube/pkg/mapper/testworkflows"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowexpressions"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+type evalResultOutput struct {
+	Input            string      `json:"input"`
+	Tokens           []string    `json:"tokens,omitempty"`
+	Result           string      `json:"result,omitempty"`
+	Value            interface{} `json:"value,omitempty"`
+	Static           bool        `json:"static"`
+	Type             string      `json:"type,omitempty"`
+	Unresolved       []string    `json:"unresolved,omitempty"`
+	UnknownFunctions []string    `json:"unknownFunctions,omitempty"`
+	Error            string      `json:"error,omitempty"`
+}
+
+func NewEvalCmd() *cobra.Command {
+	var (
+		file         string
+		workflowName string
+		config       map[string]string
+		env          map[string]string
+		template     bool
+		interactive  bool
+		output       string
+	)
+
+	cmd := &cobra.Command{
+		Use:         "eval [expression]",
+		Short:       "Evaluate the Test Workflow expressions",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Long: `Evaluate the expression or template (when it contains "{{") in the context of the Test Workflow.
+
+The "config", "env", "workflow" and "execution" values are provided from the workflow and the flags,
+while everything else stays unresolved. Without expression passed, the interactive mode is started.`,
+		Example: `  kubectl testkube eval 'config.vus * 2' -f workflow.yaml --config vus=5
+  kubectl testkube eval '{{workflow.name}}-{{execution.number}}' --workflow k6-load
+  kubectl testkube eval -i -f workflow.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if output != string(render.OutputPretty) && output != string(render.OutputJSON) {
+				ui.Failf("invalid output type: %s", output)
+			}
+			if file != "" && workflowName != "" {
+				ui.Failf("pass either --file or --workflow")
+			}
+
+			var workflow *testworkflowsv1.TestWorkflow
+			if file != "" {
+				content, err := os.ReadFile(file)
+				ui.ExitOnError("reading workflow file", err)
+				workflow = new(testworkflowsv1.TestWorkflow)
+				err = crdcommon.DeserializeCRD(workflow, content)
+				ui.ExitOnError("deserializing workflow", err)
+			} else if workflowName != "" {
+				client, _, err := common.GetClient(cmd)
+				ui.ExitOnError("getting client", err)
+				w, err := client.GetTestWorkflow(workflowName)
+				ui.ExitOnError("getting test workflow", err)
+				workflow = testworkflowmappers.MapAPIToKube(&w)
+			}
+
+			machine, err := testworkflowexpressions.CreateMachine(testworkflowexpressions.MachineOptions{
+				Workflow: workflow,
+				Config:   config,
+				Env:      env,
+			})
+			ui.ExitOnError("building expressions context", err)
+
+			evaluate := func(input string) {
+				isTemplate := template || testworkflowexpressions.IsTemplate(input)
+				result := testworkflowexpressions.Evaluate(input, isTemplate, machine)
+				if output == string(render.OutputJSON) {
+					err := json.NewEncoder(cmd.OutOrStdout()).Encode(toEvalResultOutput(result))
+					ui.ExitOnError("encoding result", err)
+					return
+				}
+				printEvalResult(result)
+			}
+
+			if len(args) == 1 && !interactive {
+				evaluate(args[0])
+				return
+			}
+			if len(args) == 1 {
+				evaluate(args[0])
+			}
+			runEvalRepl(cmd, evaluate)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the Test Workflow YAML to use as the context")
+	cmd.Flags().StringVar(&workflowName, "workflow", "", "name of the Test Workflow in the cluster to use as the context")
+	cmd.Flags().StringToStringVar(&config, "config", map[string]string{}, "config parameters to use, i.e. --config vus=10")
+	cmd.Flags().StringToStringVar(&env, "env", map[string]string{}, "environment variables to use, i.e. --env MODE=smoke")
+	cmd.Flags().BoolVarP(&template, "template", "t", false, "treat the input as a template, even without {{ }}")
+	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "start the interactive mode")
+	cmd.Flags().StringVarP(&output, "output", "o", string(render.OutputPretty), "output type can be one of pretty|json")
+
+	return cmd
+}
+
+func runEvalRepl(cmd *cobra.Command, evaluate func(input string)) {
+	ui.Info("Type the expression to evaluate, or", "exit", "to quit.")
+	scanner := bufio.NewScanner(cmd.InOrStdin())
+	for {
+		fmt.Fprint(cmd.OutOrStdout(), "> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(cmd.OutOrStdout())
+			break
+		}
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+			continue
+		case "exit", "quit", ":q":
+			return
+		}
+		evaluate(line)
+	}
+	ui.ExitOnError("reading input", scanner.Err())
+}
+
+func toEvalResultOutput(result testworkflowexpressions.Result) evalResultOutput {
+	v := evalResultOutput{
+		Input:            result.Input,
+		Tokens:           result.Tokens,
+		Static:           result.Static(),
+		Type:             string(result.Type()),
+		Unresolved:       result.Unresolved,
+		UnknownFunctions: result.UnknownFunctions,
+	}
+	if result.Error != nil {
+		v.Error = result.Error.Error()
+		return v
+	}
+	v.Result = evalResultString(result)
+	if result.Static() {
+		v.Value = result.Resolved.Static().Value()
+	}
+	return v
+}
+
+func evalResultString(result testworkflowexpressions.Result) string {
+	if result.Template {
+		return result.Resolved.Template()
+	}
+	return result.Resolved.String()
+}
+
+func printEvalResult(result testworkflowexpressions.Result) {
+	if result.Error != nil {
+		ui.Errf("%s", result.Error.Error())
+		return
+	}
+
+	resultType := string(result.Type())
+	if resultType == "" {
+		resultType = "unknown"
+	}
+	properties := [][]string{{"Result", evalResultString(result)}, {"Type", resultType}}
+	if len(result.Tokens) > 0 {
+		properties = append(properties, []string{"Tokens", strings.Join(result.Tokens, " ")})
+	}
+	if len(result.Unresolved) > 0 {
+		properties = append(properties, []string{"Unresolved", strings.Join(result.Unresolved, ", ")})
+	}
+	if len(result.UnknownFunctions) > 0 {
+		properties = append(properties, []string{"Unknown functions", strings.Join(result.UnknownFunctions, ", ")})
+	}
+	ui.Properties(properties)
+	if !result.Static() {
+		ui.Warn("Partially resolved:", "the value depends on the execution")
+	}
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "export <resourceName>",
+		Short:       "Export resources to portable archives",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			ui.PrintOnError("Displaying help", err)
+		},
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			cfg, err := config.Load()
+			ui.ExitOnError("loading config", err)
+			common.UiContextHeader(cmd, cfg)
+
+			validator.PersistentPreRunVersionCheck(cmd, common.Version)
+		},
+	}
+
+	cmd.AddCommand(testworkflows.NewExportTestWorkflowExecutionCmd())
+
+	return cmd
+}
+
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "import <resourceName>",
+		Short:       "Import resources from portable archives",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			ui.PrintOnError("Displaying help", err)
+		},
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			cfg, err := config.Load()
+			ui.ExitOnError("loading config", err)
+			common.UiContextHeader(cmd, cfg)
+
+			validator.PersistentPreRunVersionCheck(cmd, common.Version)
+		},
+	}
+
+	cmd.AddCommand(testworkflows.NewImportTestWorkflowExecutionCmd())
+
+	return cmd
+}
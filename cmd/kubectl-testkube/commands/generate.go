@@ -19,6 +19,7 @@ func NewGenerateCmd() *cobra.Command {
 		}}
 
 	cmd.AddCommand(generate.NewDocsCmd())
+	cmd.AddCommand(generate.NewWorkflowCmd())
 
 	return cmd
 }
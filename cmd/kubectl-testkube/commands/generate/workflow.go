@@ -0,0 +1,84 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowimport"
+	"github.com/kubeshop/testkube/pkg/ui"
+	"github.com/kubeshop/testkube/pkg/ui/uicrd"
+)
+
+func NewWorkflowCmd() *cobra.Command {
+	var (
+		from       string
+		name       string
+		repository string
+		revision   string
+		secretName string
+	)
+
+	sources := strings.Join(common.MapSlice(testworkflowimport.Sources, func(s testworkflowimport.Source) string {
+		return string(s)
+	}), ", ")
+
+	cmd := &cobra.Command{
+		Use:     "workflow <file>",
+		Aliases: []string{"testworkflow", "tw"},
+		Short:   "Generate Test Workflow from the CI pipeline",
+		Long: `Generate the Test Workflow from the test jobs of GitHub Actions, GitLab CI or Jenkins (declarative) pipeline.
+
+The jobs, services, matrices, environment variables, artifacts and caches are converted,
+while the constructs that can't be converted are reported as warnings on the standard error output.`,
+		Example: `  kubectl testkube generate workflow --from github-actions .github/workflows/ci.yaml > workflow.yaml
+  kubectl testkube generate workflow .gitlab-ci.yml --repository https://gitlab.com/org/repo.git > workflow.yaml`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.UseStderr()
+			filePath := args[0]
+
+			source := testworkflowimport.Source(from)
+			if source == "" {
+				detected, ok := testworkflowimport.DetectSource(filePath)
+				if !ok {
+					ui.Failf("can't detect the CI system of %s, pass it with --from (%s)", filePath, sources)
+				}
+				source = detected
+			}
+			if name == "" {
+				base := filepath.Base(filePath)
+				name = testworkflowimport.SanitizeName(strings.TrimSuffix(base, filepath.Ext(base)))
+			}
+
+			content, err := os.ReadFile(filePath)
+			ui.ExitOnError("reading "+filePath+" file", err)
+
+			result, err := testworkflowimport.Import(source, content, testworkflowimport.Options{
+				Name:       name,
+				Repository: repository,
+				Revision:   revision,
+				SecretName: secretName,
+			})
+			ui.ExitOnError(fmt.Sprintf("converting %s pipeline", source), err)
+
+			for _, warning := range result.Warnings {
+				ui.Warn("warning:", warning.String())
+			}
+			uicrd.PrintCRD(*result.Workflow, "TestWorkflow", testworkflowsv1.GroupVersion)
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "CI system of the pipeline: "+sources+" (detected from the file name by default)")
+	cmd.Flags().StringVar(&name, "name", "", "name of the generated Test Workflow (defaults to the file name)")
+	cmd.Flags().StringVar(&repository, "repository", "", "Git repository to clone (defaults to the required config parameter)")
+	cmd.Flags().StringVar(&revision, "revision", "", "Git revision to clone")
+	cmd.Flags().StringVar(&secretName, "secret-name", "", "Kubernetes secret to read the CI secrets from (defaults to <name>-secrets)")
+
+	return cmd
+}
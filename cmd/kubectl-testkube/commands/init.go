@@ -54,6 +54,7 @@ func NewInitCmd() *cobra.Command {
 	cmd.AddCommand(standaloneCmd)
 	cmd.AddCommand(NewInitCmdDemo())
 	cmd.AddCommand(pro.NewInitCmd())
+	cmd.AddCommand(NewInitCmdWorkflow())
 	cmd.Flags().BoolVarP(&export, "export", "", false, "Export the values.yaml")
 
 	return cmd
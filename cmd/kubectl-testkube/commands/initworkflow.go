@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/marketplace"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowimport"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowscaffold"
+	"github.com/kubeshop/testkube/pkg/ui"
+	"github.com/kubeshop/testkube/pkg/ui/uicrd"
+)
+
+func NewInitCmdWorkflow() *cobra.Command {
+	var (
+		name           string
+		repository     string
+		revision       string
+		cache          bool
+		inline         bool
+		useMarketplace bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "workflow [path|git-uri]",
+		Aliases: []string{"testworkflow", "tw"},
+		Short:   "Generate Test Workflow for the test projects found in the repository",
+		Long: `Generate the Test Workflow running the tests of the local or remote Git repository.
+
+The repository is inspected for the Playwright, Cypress, Node.js, Maven, Gradle, Go, pytest, k6 and JMeter projects,
+and each of them is run with the matching image, dependencies installation, JUnit reporting and artifacts collection.
+The official Test Workflow Templates are used where they exist, and the Testkube Marketplace workflows with --marketplace.`,
+		Example: `  kubectl testkube init workflow > workflow.yaml
+  kubectl testkube init workflow https://github.com/kubeshop/testkube.git --revision main --cache > workflow.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.UseStderr()
+			source := "."
+			if len(args) > 0 {
+				source = args[0]
+			}
+			options := testworkflowscaffold.Options{
+				Name:       name,
+				Repository: repository,
+				Revision:   revision,
+				Cache:      cache,
+				Inline:     inline,
+			}
+			ui.ExitOnError("generating test workflow", initWorkflow(cmd.Context(), source, options, useMarketplace))
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "name of the generated Test Workflow (defaults to the repository name)")
+	cmd.Flags().StringVar(&repository, "repository", "", "Git repository to clone (defaults to the origin remote of the local repository)")
+	cmd.Flags().StringVar(&revision, "revision", "", "Git revision to clone (defaults to the current branch of the local repository)")
+	cmd.Flags().BoolVar(&cache, "cache", false, "persist the package manager caches on the <name>-cache PersistentVolumeClaim")
+	cmd.Flags().BoolVar(&inline, "inline", false, "inline the official templates, so the workflow doesn't depend on them")
+	cmd.Flags().BoolVar(&useMarketplace, "marketplace", false, "use the Testkube Marketplace workflows for the detected frameworks")
+
+	return cmd
+}
+
+// initWorkflow generates the Test Workflow for the local directory or the remote repository,
+// so the temporary clone is removed before the command exits
+func initWorkflow(ctx context.Context, source string, options testworkflowscaffold.Options, useMarketplace bool) error {
+	dir := source
+	if isGitURI(source) {
+		tmpDir, err := os.MkdirTemp("", "testkube-init-workflow")
+		if err != nil {
+			return errors.Wrap(err, "creating temporary directory")
+		}
+		defer os.RemoveAll(tmpDir)
+		if err = cloneRepository(source, options.Revision, tmpDir); err != nil {
+			return errors.Wrap(err, "cloning "+source)
+		}
+		dir = tmpDir
+		if options.Repository == "" {
+			options.Repository = source
+		}
+		if options.Name == "" {
+			options.Name = strings.TrimSuffix(path.Base(strings.TrimSuffix(source, "/")), ".git")
+		}
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return errors.Wrap(err, "resolving "+dir+" path")
+	}
+
+	// The projects are run from their location in the repository
+	subDir := "."
+	if root, ok := testworkflowscaffold.FindRepositoryRoot(dir); ok {
+		subDir, err = filepath.Rel(root, absDir)
+		if err != nil {
+			return errors.Wrap(err, "resolving "+dir+" path")
+		}
+		uri, branch := testworkflowscaffold.GitOrigin(os.DirFS(root))
+		if options.Repository == "" {
+			options.Repository = uri
+		}
+		if options.Revision == "" && options.Repository == uri {
+			options.Revision = branch
+		}
+		if options.Name == "" {
+			options.Name = filepath.Base(root)
+		}
+	}
+	if options.Name == "" {
+		options.Name = filepath.Base(absDir)
+	}
+	options.Name = testworkflowimport.SanitizeName(options.Name)
+
+	detections, err := testworkflowscaffold.Detect(os.DirFS(dir))
+	if err != nil {
+		return errors.Wrap(err, "inspecting "+source)
+	}
+	for i := range detections {
+		detections[i].Dir = path.Join(filepath.ToSlash(subDir), detections[i].Dir)
+		ui.Info(fmt.Sprintf("detected %s in %s", detections[i].Framework, detections[i].Dir), strings.Join(detections[i].Evidence, ", "))
+	}
+
+	if useMarketplace && len(detections) > 0 {
+		options.Catalog, err = testworkflowscaffold.FindCatalogEntries(ctx, marketplace.NewClient(), detections)
+		if err != nil {
+			return errors.Wrap(err, "fetching marketplace catalog")
+		}
+	}
+
+	result, err := testworkflowscaffold.Generate(detections, options)
+	if err != nil {
+		return err
+	}
+	for _, warning := range result.Warnings {
+		ui.Warn("warning:", warning.String())
+	}
+	uicrd.PrintCRD(*result.Workflow, "TestWorkflow", testworkflowsv1.GroupVersion)
+	return nil
+}
+
+func isGitURI(source string) bool {
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git://", "git@"} {
+		if strings.HasPrefix(source, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// cloneRepository makes the shallow clone of the repository to inspect it.
+// The revision is fetched directly, so it may be a branch, a tag or a commit SHA.
+func cloneRepository(uri, revision, dir string) error {
+	if revision == "" {
+		revision = "HEAD"
+	}
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"remote", "add", "origin", uri},
+		{"fetch", "--quiet", "--depth", "1", "origin", revision},
+		{"checkout", "--quiet", "FETCH_HEAD"},
+	} {
+		command := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		command.Stderr = os.Stderr
+		if err := command.Run(); err != nil {
+			return errors.Wrap(err, "git "+args[0])
+		}
+	}
+	return nil
+}
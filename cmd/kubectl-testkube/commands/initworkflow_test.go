@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCloneRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	source := t.TempDir()
+	git := func(dir string, args ...string) string {
+		command := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		output, err := command.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s: %v: %s", strings.Join(args, " "), err, output)
+		}
+		return strings.TrimSpace(string(output))
+	}
+	git(source, "init", "--quiet")
+	git(source, "config", "uploadpack.allowReachableSHA1InWant", "true")
+	if err := os.WriteFile(filepath.Join(source, "version.txt"), []byte("first"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git(source, "add", "version.txt")
+	git(source, "commit", "--quiet", "-m", "first")
+	sha := git(source, "rev-parse", "HEAD")
+	if err := os.WriteFile(filepath.Join(source, "version.txt"), []byte("second"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git(source, "commit", "--quiet", "-am", "second")
+
+	for revision, want := range map[string]string{"": "second", sha: "first"} {
+		dir := t.TempDir()
+		if err := cloneRepository(source, revision, dir); err != nil {
+			t.Fatalf("cloning %q: %v", revision, err)
+		}
+		content, err := os.ReadFile(filepath.Join(dir, "version.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != want {
+			t.Fatalf("expected %q at revision %q, got %q", want, revision, content)
+		}
+	}
+}
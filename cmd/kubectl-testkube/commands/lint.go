@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlint"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const outputSARIF = "sarif"
+
+func NewLintCmd() *cobra.Command {
+	var (
+		output    string
+		disabled  []string
+		failOn    string
+		listRules bool
+	)
+
+	cmd := &cobra.Command{
+		Use:         "lint <path>...",
+		Short:       "Analyze test workflows and templates against the best practices",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Long: `Analyze test workflows and templates from the YAML files against the catalog of rules.
+
+Rules may be suppressed for a single resource with the "` + testworkflowlint.IgnoreAnnotationName + `" annotation,
+containing comma-separated list of the rule IDs or names ("*" for all of them).`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if listRules {
+				printLintRules(output)
+				return
+			}
+			if len(args) == 0 {
+				ui.Failf("pass at least one file or directory to analyze")
+			}
+			severity := testworkflowlint.Severity(failOn)
+			if severity != testworkflowlint.SeverityError && severity != testworkflowlint.SeverityWarning && severity != testworkflowlint.SeverityInfo {
+				ui.Failf("invalid --fail-on value: %s", failOn)
+			}
+
+			resources, err := testworkflowlint.Load(args...)
+			ui.ExitOnError("loading test workflows", err)
+			findings := testworkflowlint.Lint(resources, testworkflowlint.Options{Disabled: disabled})
+
+			switch output {
+			case string(render.OutputJSON):
+				err = json.NewEncoder(os.Stdout).Encode(findings)
+				ui.ExitOnError("encoding findings", err)
+			case outputSARIF:
+				err = json.NewEncoder(os.Stdout).Encode(testworkflowlint.ToSARIF(findings, common.Version))
+				ui.ExitOnError("encoding findings", err)
+			case string(render.OutputPretty):
+				printLintFindings(findings, len(resources))
+			default:
+				ui.Failf("invalid output type: %s", output)
+			}
+
+			if testworkflowlint.HasSeverity(findings, severity) {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", string(render.OutputPretty), "output type can be one of pretty|json|sarif")
+	cmd.Flags().StringSliceVar(&disabled, "disable", nil, "IDs or names of the rules to skip")
+	cmd.Flags().StringVar(&failOn, "fail-on", string(testworkflowlint.SeverityError), "minimum severity to exit with failure: error|warning|info")
+	cmd.Flags().BoolVar(&listRules, "list-rules", false, "list the available rules")
+
+	return cmd
+}
+
+func printLintFindings(findings []testworkflowlint.Finding, resourcesCount int) {
+	for _, f := range findings {
+		message := fmt.Sprintf("%s %s/%s: %s", f.RuleID, f.Kind, f.Name, f.Message)
+		location := fmt.Sprintf("%s (%s)", f.File, f.Path)
+		switch f.Severity {
+		case testworkflowlint.SeverityError:
+			ui.Errf("%s", message)
+		case testworkflowlint.SeverityWarning:
+			ui.Warn(message)
+		default:
+			ui.Info(message)
+		}
+		ui.Print("    " + location)
+		ui.Hint(f.Hint)
+	}
+	if len(findings) > 0 {
+		ui.NL()
+	}
+	ui.Info(fmt.Sprintf("Analyzed %d resources", resourcesCount), fmt.Sprintf("%d findings", len(findings)))
+}
+
+func printLintRules(output string) {
+	if output == string(render.OutputJSON) {
+		err := json.NewEncoder(os.Stdout).Encode(testworkflowlint.Rules)
+		ui.ExitOnError("encoding rules", err)
+		return
+	}
+	table := [][]string{{"ID", "Name", "Severity", "Description"}}
+	for _, rule := range testworkflowlint.Rules {
+		table = append(table, []string{rule.ID, rule.Name, string(rule.Severity), rule.Description})
+	}
+	ui.Table(ui.NewArrayTable(table), os.Stdout)
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	testworkflowmappers "github.com/kubeshop/testkube/pkg/mapper/testworkflows"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlsp"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewLspCmd() *cobra.Command {
+	var (
+		cluster bool
+		stdio   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:         "lsp",
+		Short:       "Start the language server for Test Workflow YAML files",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Long: `Start the Language Server Protocol server for the Test Workflows and Test Workflow Templates, communicating over stdio.
+
+It provides diagnostics for the schema, expressions and templates, completion of properties, template names and expressions,
+documentation on hover and going to the template definition. The templates are read from the workspace,
+and (with --cluster) from the current Testkube context.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			// The stdout is reserved for the protocol messages
+			ui.UseStderr()
+
+			sources := make([]testworkflowlsp.TemplateSource, 0)
+			if cluster {
+				client, _, err := common.GetClient(cmd)
+				if err != nil {
+					ui.Warn("Templates from the cluster are not available:", err.Error())
+				} else {
+					sources = append(sources, testworkflowlsp.NewClusterTemplateSource(func() ([]testworkflowsv1.TestWorkflowTemplate, error) {
+						templates, err := client.ListTestWorkflowTemplates("")
+						if err != nil {
+							return nil, err
+						}
+						result := make([]testworkflowsv1.TestWorkflowTemplate, len(templates))
+						for i := range templates {
+							result[i] = *testworkflowmappers.MapTemplateAPIToKube(&templates[i])
+						}
+						return result, nil
+					}, filepath.Join(os.TempDir(), "testkube-lsp", "templates")))
+				}
+			}
+
+			server := testworkflowlsp.NewServer(common.Version, sources...).WithLogger(func(format string, args ...interface{}) {
+				ui.Warn(fmt.Sprintf(format, args...))
+			})
+			err := server.Serve(context.Background(), os.Stdin, os.Stdout)
+			ui.ExitOnError("running language server", err)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cluster, "cluster", false, "read the templates from the cluster in the current context")
+	cmd.Flags().BoolVar(&stdio, "stdio", true, "communicate over stdio (the only supported transport)")
+
+	return cmd
+}
@@ -30,7 +30,8 @@ Capabilities:
 
 We welcome feedback on Slack: https://bit.ly/testkube-slack
 
-The MCP server requires OAuth authentication and uses your current Testkube context.
+The MCP server uses your current Testkube context: OAuth authentication for the Control Plane,
+or the Open Source agent API otherwise.
 
 Documentation: https://docs.testkube.io/articles/mcp-overview
 Configuration: https://docs.testkube.io/articles/mcp-configuration`,
@@ -68,6 +69,11 @@ Requirements:
 • OAuth authentication (run 'testkube login')
 • Testkube environment with proper context
 
+When the current context is not connected to the Control Plane, the server talks directly
+to the Open Source agent API (--base-url, or the API URI from the context, e.g. after
+'kubectl port-forward svc/testkube-api-server 8088'). Tools that are available only in
+the Control Plane, like insights, agents and resource groups, are hidden then.
+
 The server runs silently by default to avoid interfering with JSON-RPC communication
 over stdio. Use --verbose to see detailed output during startup.
 
@@ -89,7 +95,7 @@ Configuration Examples: https://docs.testkube.io/articles/mcp-configuration`,
 			if envMode {
 				runEnvironmentMode(cmd, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile)
 			} else {
-				runDefaultMode(cmd, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile)
+				runDefaultMode(cmd, mcpBaseURL, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile)
 			}
 		},
 	}
@@ -202,7 +208,7 @@ func runEnvironmentMode(cmd *cobra.Command, debug bool, transport, shttpHost str
 	displayConfiguration(configData, "environment variable")
 
 	// Start the MCP server
-	if err := startMCPServer(common.ResolveSkipTLS(cmd, nil), accessToken, orgID, envID, baseURL, dashboardURL, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile, "cli-env"); err != nil {
+	if err := startMCPServer(common.ResolveSkipTLS(cmd, nil), false, accessToken, orgID, envID, baseURL, dashboardURL, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile, "cli-env"); err != nil {
 		if ui.IsVerbose() {
 			fmt.Fprintf(os.Stderr, "Failed to start MCP server: %v\n", err)
 		}
@@ -210,7 +216,7 @@ func runEnvironmentMode(cmd *cobra.Command, debug bool, transport, shttpHost str
 }
 
 // runDefaultMode handles the default mode logic (OAuth + config file)
-func runDefaultMode(cmd *cobra.Command, debug bool, transport, shttpHost string, shttpPort int, shttpTLS bool, shttpCertFile, shttpKeyFile string) {
+func runDefaultMode(cmd *cobra.Command, baseURL string, debug bool, transport, shttpHost string, shttpPort int, shttpTLS bool, shttpCertFile, shttpKeyFile string) {
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -220,6 +226,12 @@ func runDefaultMode(cmd *cobra.Command, debug bool, transport, shttpHost string,
 		return
 	}
 
+	// Connect directly to the Open Source API when there is no control plane
+	if cfg.ContextType != config.ContextTypeCloud {
+		runOpenSourceMode(cmd, cfg, baseURL, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile)
+		return
+	}
+
 	// Validate authentication
 	if cfg.CloudContext.ApiKey == "" && !common.IsOAuthAuthenticated() {
 		if ui.IsVerbose() {
@@ -270,7 +282,7 @@ func runDefaultMode(cmd *cobra.Command, debug bool, transport, shttpHost string,
 	displayConfiguration(configData, "default")
 
 	// Start the MCP server
-	if err := startMCPServer(common.ResolveSkipTLS(cmd, &cfg), accessToken, cfg.CloudContext.OrganizationId, cfg.CloudContext.EnvironmentId, cfg.CloudContext.ApiUri, cfg.CloudContext.UiUri, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile, "cli-direct"); err != nil {
+	if err := startMCPServer(common.ResolveSkipTLS(cmd, &cfg), false, accessToken, cfg.CloudContext.OrganizationId, cfg.CloudContext.EnvironmentId, cfg.CloudContext.ApiUri, cfg.CloudContext.UiUri, debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile, "cli-direct"); err != nil {
 		if ui.IsVerbose() {
 			fmt.Fprintf(os.Stderr, "Failed to start MCP server: %v\n", err)
 		}
@@ -283,7 +295,31 @@ func runDefaultMode(cmd *cobra.Command, debug bool, transport, shttpHost string,
 	}
 }
 
-func startMCPServer(skipTLS bool, accessToken, orgID, envID, baseURL, dashboardURL string, debug bool, transport, shttpHost string, shttpPort int, shttpTLS bool, shttpCertFile, shttpKeyFile string, source string) error {
+// runOpenSourceMode handles the Open Source agent context, talking to its /v1 API
+func runOpenSourceMode(cmd *cobra.Command, cfg config.Data, baseURL string, debug bool, transport, shttpHost string, shttpPort int, shttpTLS bool, shttpCertFile, shttpKeyFile string) {
+	if baseURL == "" {
+		baseURL = cmd.Flag("api-uri").Value.String()
+	}
+	if baseURL == "" {
+		baseURL = cfg.APIURI
+	}
+	baseURL = strings.TrimSuffix(strings.TrimSuffix(baseURL, "/"), "/v1")
+
+	configData := map[string]string{
+		"Context":   string(config.ContextTypeKubeconfig),
+		"API URL":   baseURL,
+		"Transport": transport,
+	}
+	displayConfiguration(configData, "open source")
+
+	if err := startMCPServer(common.ResolveSkipTLS(cmd, &cfg), true, "", "", "", baseURL, "", debug, transport, shttpHost, shttpPort, shttpTLS, shttpCertFile, shttpKeyFile, "cli-oss"); err != nil {
+		if ui.IsVerbose() {
+			fmt.Fprintf(os.Stderr, "Failed to start MCP server: %v\n", err)
+		}
+	}
+}
+
+func startMCPServer(skipTLS, openSource bool, accessToken, orgID, envID, baseURL, dashboardURL string, debug bool, transport, shttpHost string, shttpPort int, shttpTLS bool, shttpCertFile, shttpKeyFile string, source string) error {
 	// Load config to check telemetry settings
 	cfg, err := config.Load()
 	telemetryEnabled := true
@@ -309,6 +345,7 @@ func startMCPServer(skipTLS bool, accessToken, orgID, envID, baseURL, dashboardU
 		AccessToken:      accessToken,
 		OrgId:            orgID,
 		EnvId:            envID,
+		OpenSource:       openSource,
 		Debug:            debug,
 		TelemetryEnabled: telemetryEnabled,
 		Source:           source,
@@ -6,6 +6,7 @@ import (
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/agent"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/data"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -31,6 +32,7 @@ func NewMigrateCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(agent.NewMigrateAgentCmd())
+	cmd.AddCommand(data.NewMigrateDataCmd())
 
 	return cmd
 }
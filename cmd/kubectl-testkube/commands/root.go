@@ -40,6 +40,7 @@ func init() {
 	// New commands
 	RootCmd.AddCommand(NewCreateCmd())
 	RootCmd.AddCommand(NewUpdateCmd())
+	RootCmd.AddCommand(NewApplyCmd())
 
 	RootCmd.AddCommand(NewGetCmd())
 	RootCmd.AddCommand(NewSetCmd())
@@ -47,6 +48,16 @@ func init() {
 	RootCmd.AddCommand(NewDeleteCmd())
 	RootCmd.AddCommand(NewAbortCmd())
 	RootCmd.AddCommand(NewCancelCmd())
+	RootCmd.AddCommand(NewApproveCmd())
+	RootCmd.AddCommand(NewRejectCmd())
+	RootCmd.AddCommand(NewTriageCmd())
+	RootCmd.AddCommand(NewTestCmd())
+	RootCmd.AddCommand(NewLintCmd())
+	RootCmd.AddCommand(NewEvalCmd())
+	RootCmd.AddCommand(NewLspCmd())
+	RootCmd.AddCommand(NewSearchCmd())
+	RootCmd.AddCommand(NewExportCmd())
+	RootCmd.AddCommand(NewImportCmd())
 
 	RootCmd.AddCommand(NewEnableCmd())
 	RootCmd.AddCommand(NewDisableCmd())
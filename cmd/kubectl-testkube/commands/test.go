@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewTestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "test <resourceName>",
+		Short:       "Test resources offline, without the cluster",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			ui.PrintOnError("Displaying help", err)
+		},
+	}
+
+	cmd.AddCommand(testworkflows.NewTestTestWorkflowsCmd())
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package testworkflows
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewApproveTestWorkflowExecutionCmd() *cobra.Command {
+	return newDecideTestWorkflowExecutionCmd(testkube.APPROVED_TestWorkflowApprovalDecision)
+}
+
+func NewRejectTestWorkflowExecutionCmd() *cobra.Command {
+	return newDecideTestWorkflowExecutionCmd(testkube.REJECTED_TestWorkflowApprovalDecision)
+}
+
+func newDecideTestWorkflowExecutionCmd(decision testkube.TestWorkflowApprovalDecision) *cobra.Command {
+	var request testkube.TestWorkflowApprovalRequest
+
+	action, past := "Approve", "approved"
+	if decision == testkube.REJECTED_TestWorkflowApprovalDecision {
+		action, past = "Reject", "rejected"
+	}
+
+	cmd := &cobra.Command{
+		Use:     "execution <executionName>",
+		Aliases: []string{"testworkflowexecution", "twe", "testworkflows-execution", "testworkflow-execution"},
+		Short:   fmt.Sprintf("%s the step waiting for the decision in test workflow execution", action),
+		Long: fmt.Sprintf(`%s the step waiting for the decision in test workflow execution.
+
+When the step is restricted to the selected approvers, the decision is recorded for the user authenticated
+with the API token (API_IDENTITY_TOKENS setting of the API server), i.e. --header Authorization="Bearer <token>".`, action),
+		Args: validator.ExecutionName,
+
+		Run: func(cmd *cobra.Command, args []string) {
+			executionID := args[0]
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			if decision == testkube.APPROVED_TestWorkflowApprovalDecision {
+				err = client.ApproveTestWorkflowExecution(executionID, request)
+			} else {
+				err = client.RejectTestWorkflowExecution(executionID, request)
+			}
+			ui.ExitOnError(fmt.Sprintf("recording the decision for test workflow execution %s", executionID), err)
+
+			ui.SuccessAndExit(fmt.Sprintf("Successfully %s test workflow execution", past), executionID)
+		},
+	}
+
+	cmd.Flags().StringVar(&request.Step, "step", "", "reference of the approval step, required when multiple steps are waiting")
+	cmd.Flags().StringVar(&request.Comment, "comment", "", "comment attached to the decision")
+	return cmd
+}
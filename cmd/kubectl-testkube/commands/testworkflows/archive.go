@@ -0,0 +1,200 @@
+package testworkflows
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows/renderer"
+	"github.com/kubeshop/testkube/pkg/testworkflows"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewExportTestWorkflowExecutionCmd() *cobra.Command {
+	var (
+		file           string
+		signingKeyPath string
+		skipArtifacts  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "testworkflowexecution <executionID>",
+		Aliases: []string{"twe", "tw-execution", "twexecution", "execution"},
+		Short:   "Export the test workflow execution to the signed archive",
+		Long: `Export the finished test workflow execution with its result, resolved workflow, logs, reports and artifacts
+to the signed archive, that can be imported to another Testkube instance, or opened offline with 'kubectl testkube view'.
+
+The archive is signed with the ed25519 key, that may be generated with:
+  openssl genpkey -algorithm ed25519 -out signing-key.pem
+  openssl pkey -in signing-key.pem -pubout -out signing-key.pub.pem
+The Testkube instance importing the archive must trust the public key.`,
+		Example: `kubectl testkube export execution 6630f0b1c3b5a1e2f4d5c6b7 --signing-key signing-key.pem`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if signingKeyPath == "" {
+				ui.Failf("--signing-key is required, the imported archives must be signed with the trusted key")
+			}
+			key, err := executionarchive.LoadPrivateKey(signingKeyPath)
+			ui.ExitOnError("loading signing key", err)
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			execution, err := client.GetTestWorkflowExecution(args[0])
+			ui.ExitOnError("getting test workflow execution", err)
+			if execution.Result == nil || !execution.Result.IsFinished() {
+				ui.Failf("Execution %s is still running, only finished executions can be exported", execution.Id)
+			}
+
+			if file == "" {
+				file = execution.Name + executionarchive.FileExtension
+			}
+			out, err := os.Create(file)
+			ui.ExitOnError("creating archive file", err)
+			defer out.Close()
+			writer := executionarchive.NewWriter(out, key)
+
+			err = writer.WriteExecution(execution)
+			ui.ExitOnError("writing execution", err)
+
+			logs, err := client.GetTestWorkflowExecutionLogs(execution.Id)
+			ui.ExitOnError("getting execution logs", err)
+			err = writer.WriteLog(logs)
+			ui.ExitOnError("writing execution logs", err)
+
+			if !skipArtifacts {
+				artifacts, err := client.GetTestWorkflowExecutionArtifacts(execution.Id)
+				ui.ExitOnError("getting artifacts list", err)
+
+				tmpDir, err := os.MkdirTemp("", "testkube-export-*")
+				ui.ExitOnError("creating temp directory", err)
+				defer os.RemoveAll(tmpDir)
+
+				for _, artifact := range artifacts {
+					ui.Debug("downloading artifact", artifact.Name)
+					path, err := client.DownloadTestWorkflowArtifact(execution.Id, artifact.Name, tmpDir)
+					ui.ExitOnError("downloading artifact "+artifact.Name, err)
+					err = writeArchiveArtifact(writer, artifact.Name, path)
+					ui.ExitOnError("writing artifact "+artifact.Name, err)
+				}
+			}
+
+			err = writer.Close()
+			ui.ExitOnError("finalizing archive", err)
+			ui.Success("Execution exported to", file)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "path of the archive, defaults to <execution name>"+executionarchive.FileExtension)
+	cmd.Flags().StringVar(&signingKeyPath, "signing-key", "", "path to the PEM-encoded ed25519 private key to sign the archive with")
+	cmd.Flags().BoolVar(&skipArtifacts, "skip-artifacts", false, "do not include the artifacts in the archive")
+
+	return cmd
+}
+
+func writeArchiveArtifact(writer *executionarchive.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return writer.WriteArtifact(name, stat.Size(), f)
+}
+
+func NewImportTestWorkflowExecutionCmd() *cobra.Command {
+	var trustedKeyPath string
+
+	cmd := &cobra.Command{
+		Use:     "testworkflowexecution <archive>",
+		Aliases: []string{"twe", "tw-execution", "twexecution", "execution"},
+		Short:   "Import the test workflow execution from the signed archive",
+		Long: `Recreate the test workflow execution exported with 'kubectl testkube export execution',
+with its logs and artifacts. The imported execution is read-only.`,
+		Example: `kubectl testkube import execution my-workflow-12.tkx.tar.gz --trusted-key signing-key.pub.pem`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			// Verify the archive locally first, to fail fast before uploading it
+			archive, cleanup := openExecutionArchive(args[0], trustedKeyPath, "")
+			cleanup()
+
+			data, err := os.ReadFile(args[0])
+			ui.ExitOnError("reading archive", err)
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			execution, err := client.ImportTestWorkflowExecution(data)
+			ui.ExitOnError("importing test workflow execution", err)
+			ui.Success("Execution imported", fmt.Sprintf("%s (%s), signed by %s", execution.Name, execution.Id, archive.Manifest.Fingerprint()))
+		},
+	}
+
+	cmd.Flags().StringVar(&trustedKeyPath, "trusted-key", "", "path to the PEM-encoded ed25519 public key, that must have signed the archive")
+
+	return cmd
+}
+
+// ViewExecutionArchive prints the execution from the archive, without connecting to Testkube
+func ViewExecutionArchive(path, trustedKeyPath, extractDir string) {
+	archive, _ := openExecutionArchive(path, trustedKeyPath, extractDir)
+
+	err := renderer.TestWorkflowExecutionRenderer(nil, ui.NewUI(ui.Verbose, os.Stdout), archive.Execution)
+	ui.ExitOnError("rendering execution", err)
+
+	if archive.HasLog() {
+		logs, err := os.ReadFile(archive.Path(executionarchive.LogFile))
+		ui.ExitOnError("reading execution logs", err)
+		printRawLogLines(logs, testworkflows.FlattenSignatures(archive.Execution.Signature), archive.Execution)
+	}
+
+	ui.NL()
+	ui.Info("Archive", fmt.Sprintf("created at %s, signed by %s", archive.Manifest.CreatedAt.Format("2006-01-02 15:04:05"), archive.Manifest.Fingerprint()))
+	artifacts := archive.Manifest.Artifacts()
+	if len(artifacts) > 0 {
+		ui.Info("Artifacts", fmt.Sprintf("extracted to %s", archive.Path(executionarchive.ArtifactsDir)))
+		for _, artifact := range artifacts {
+			ui.Printf("  %s (%d bytes)\n", filepath.FromSlash(artifact.Path), artifact.Size)
+		}
+	}
+}
+
+// openExecutionArchive extracts and verifies the archive, and returns the function to remove the extracted files
+func openExecutionArchive(path, trustedKeyPath, extractDir string) (*executionarchive.Archive, func()) {
+	f, err := os.Open(path)
+	ui.ExitOnError("opening archive", err)
+	defer f.Close()
+
+	cleanup := func() {}
+	if extractDir == "" {
+		extractDir, err = os.MkdirTemp("", "testkube-archive-*")
+		ui.ExitOnError("creating temp directory", err)
+		cleanup = func() {
+			_ = os.RemoveAll(extractDir)
+		}
+	}
+
+	archive, err := executionarchive.Extract(f, extractDir, executionarchive.DefaultMaxExtractedSize)
+	if err != nil {
+		cleanup()
+		ui.ExitOnError("verifying archive", err)
+	}
+	if trustedKeyPath != "" {
+		trusted, err := executionarchive.LoadPublicKey(trustedKeyPath)
+		if err == nil {
+			err = archive.Verify(trusted)
+		}
+		if err != nil {
+			cleanup()
+			ui.ExitOnError("verifying archive signer", err)
+		}
+	}
+	return archive, cleanup
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows/renderer"
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/instructions"
 	tc "github.com/kubeshop/testkube/pkg/api/v1/client"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/testworkflows"
@@ -22,8 +24,13 @@ func NewGetTestWorkflowExecutionsCmd() *cobra.Command {
 		selectors                              []string
 		testWorkflowName, actorName, actorType string
 		logsOnly                               bool
+		logsStep                               string
+		logsTail                               int
+		logsSince                              time.Duration
 		tags                                   []string
 		status                                 string
+		triageCategory, triageAssignee         string
+		triaged                                bool
 	)
 
 	cmd := &cobra.Command{
@@ -53,11 +60,16 @@ func NewGetTestWorkflowExecutionsCmd() *cobra.Command {
 				ui.ExitOnError("getting client", err)
 
 				options := tc.FilterTestWorkflowExecutionOptions{
-					Selector:    strings.Join(selectors, ","),
-					TagSelector: strings.Join(tags, ","),
-					ActorName:   actorName,
-					ActorType:   testkube.TestWorkflowRunningContextActorType(actorType),
-					Status:      status,
+					Selector:       strings.Join(selectors, ","),
+					TagSelector:    strings.Join(tags, ","),
+					ActorName:      actorName,
+					ActorType:      testkube.TestWorkflowRunningContextActorType(actorType),
+					Status:         status,
+					TriageCategory: triageCategory,
+					TriageAssignee: triageAssignee,
+				}
+				if cmd.Flags().Changed("triaged") {
+					options.Triaged = &triaged
 				}
 				executions, err := client.ListTestWorkflowExecutions(testWorkflowName, limit, options)
 				ui.ExitOnError("getting test workflow executions list", err)
@@ -78,12 +90,23 @@ func NewGetTestWorkflowExecutionsCmd() *cobra.Command {
 				if execution.Result != nil && execution.Result.IsFinished() {
 					ui.Info("Getting logs for test workflow execution", execution.Id)
 
-					logs, err := client.GetTestWorkflowExecutionLogs(execution.Id)
-					ui.ExitOnError("getting logs from test workflow", err)
-
-					sigs := testworkflows.FlattenSignatures(execution.Signature)
-
-					printRawLogLines(logs, sigs, execution)
+					// Fetch only the requested part of the log, that doesn't contain the whole steps structure
+					if logsStep != "" || logsTail > 0 || logsSince > 0 {
+						logs, err := client.GetTestWorkflowExecutionLogsWithOptions(execution.Id, tc.GetTestWorkflowExecutionLogsOptions{
+							Step:  logsStep,
+							Tail:  logsTail,
+							Since: logsSince,
+						})
+						ui.ExitOnError("getting logs from test workflow", err)
+						printFilteredLogLines(logs)
+					} else {
+						logs, err := client.GetTestWorkflowExecutionLogs(execution.Id)
+						ui.ExitOnError("getting logs from test workflow", err)
+
+						sigs := testworkflows.FlattenSignatures(execution.Signature)
+
+						printRawLogLines(logs, sigs, execution)
+					}
 				} else {
 					ui.Info("Logs are not available yet, the test workflow execution is still in progress", execution.Id)
 				}
@@ -100,14 +123,34 @@ func NewGetTestWorkflowExecutionsCmd() *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 1000, "max number of records to return")
 	cmd.Flags().StringSliceVarP(&selectors, "label", "l", nil, "label key value pair: --label key1=value1")
 	cmd.Flags().BoolVar(&logsOnly, "logs-only", false, "show only execution logs")
+	cmd.Flags().BoolVar(&logsOnly, "logs", false, "show only execution logs, alias for --logs-only")
+	cmd.Flags().StringVar(&logsStep, "step", "", "show only the logs of the step with this reference")
+	cmd.Flags().IntVar(&logsTail, "tail", 0, "show only the last lines of the logs")
+	cmd.Flags().DurationVar(&logsSince, "since", 0, "show only the logs printed within this duration, i.e. 10m")
 	cmd.Flags().StringSliceVarP(&tags, "tag", "", nil, "tag key value pair: --tag key1=value1")
 	cmd.Flags().StringVarP(&actorName, "actor-name", "", "", "test workflow running context actor name")
 	cmd.Flags().StringVarP(&actorType, "actor-type", "", "", "test workflow running context actor type one of cron|testtrigger|user|testworkfow|testworkflowexecution|program|gitintegration")
 	cmd.Flags().StringVarP(&status, "status", "", "", "test workflow execution status filter, supports comma-separated list of statuses (e.g., 'running', 'passed,failed')")
+	cmd.Flags().StringVar(&triageCategory, "triage-category", "", "triage category filter, one of product-bug|test-bug|infra|flaky")
+	cmd.Flags().StringVar(&triageAssignee, "triage-assignee", "", "triage assignee filter")
+	cmd.Flags().BoolVar(&triaged, "triaged", false, "show only triaged executions, or only not triaged ones with --triaged=false")
 
 	return cmd
 }
 
+// printFilteredLogLines prints the part of the logs, skipping the timestamps and the instructions
+func printFilteredLogLines(logs []byte) {
+	for len(logs) > 0 {
+		var line string
+		line, logs = extractNextLine(logs)
+		line = trimTimestamp(line)
+		if instruction, _, _ := instructions.DetectInstruction([]byte(line)); instruction != nil {
+			continue
+		}
+		fmt.Println(line)
+	}
+}
+
 func validateActorType(actorType testkube.TestWorkflowRunningContextActorType) error {
 	if actorType == "" {
 		return nil
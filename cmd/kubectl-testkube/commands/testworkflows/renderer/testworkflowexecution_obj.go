@@ -3,6 +3,8 @@ package renderer
 import (
 	"fmt"
 	"io"
+	"maps"
+	"slices"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -11,6 +13,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/api/v1/client"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	tclcmd "github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/cmd"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionretry"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowoutputs"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -86,6 +90,23 @@ func printPrettyOutput(ui *ui.UI, execution testkube.TestWorkflowExecution) {
 				ui.Warn("Finished at:         ", execution.Result.FinishedAt.String())
 				ui.Warn("Duration:            ", execution.Result.Duration)
 			}
+			if reason := execution.Result.InfrastructureFailureReason(); reason != "" {
+				ui.Warn("Infra failure:       ", reason)
+			}
+		}
+		if retryOf := execution.Tags[executionretry.RetryOfTagKey]; retryOf != "" {
+			ui.Warn("Attempt:             ", fmt.Sprintf("%d (retry of %s after %s)",
+				executionretry.Attempt(&execution), retryOf, execution.Tags[executionretry.RetryReasonTagKey]))
+		}
+		if outputs := testworkflowoutputs.Values(&execution); len(outputs) > 0 {
+			ui.NL()
+			for _, name := range slices.Sorted(maps.Keys(outputs)) {
+				ui.Warn(fmt.Sprintf("Output %s:", name), fmt.Sprint(outputs[name]))
+			}
+		}
+		if execution.Triage != nil {
+			ui.NL()
+			PrintTestWorkflowExecutionTriage(ui, execution.Triage)
 		}
 	}
 
@@ -94,3 +115,18 @@ func printPrettyOutput(ui *ui.UI, execution testkube.TestWorkflowExecution) {
 		ui.Err(errors.New(execution.Result.Initialization.ErrorMessage))
 	}
 }
+
+func PrintTestWorkflowExecutionTriage(ui *ui.UI, triage *testkube.TestWorkflowExecutionTriage) {
+	if triage.IsTriaged() {
+		ui.Warn("Triage category:     ", string(triage.GetCategory()))
+	}
+	if triage.Assignee != "" {
+		ui.Warn("Triage assignee:     ", triage.Assignee)
+	}
+	for _, issue := range triage.Issues {
+		ui.Warn("Linked issue:        ", issue)
+	}
+	for _, note := range triage.Notes {
+		ui.Warn("Note:                ", fmt.Sprintf("%s (%s, %s)", note.Text, note.Author, note.CreatedAt.Format("2006-01-02 15:04:05")))
+	}
+}
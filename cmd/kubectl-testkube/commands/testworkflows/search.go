@@ -0,0 +1,92 @@
+package testworkflows
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	apiclientv1 "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewSearchTestWorkflowExecutionLogsCmd() *cobra.Command {
+	var (
+		workflowNames []string
+		since         time.Duration
+		limit         int
+		contextLines  int
+	)
+
+	cmd := &cobra.Command{
+		Use:     "logs <query>",
+		Aliases: []string{"log"},
+		Short:   "Search the test workflow execution logs",
+		Long: `Find the lines of the test workflow execution logs containing all the words of the query,
+starting from the latest execution. Only the logs of the executions finished after enabling the log search are indexed.`,
+		Example: `kubectl testkube search logs ECONNRESET --since 168h
+kubectl testkube search logs "connection refused" --workflow api-tests -o json`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			matches, err := client.SearchTestWorkflowExecutionLogs(apiclientv1.SearchTestWorkflowExecutionLogsOptions{
+				Query:         strings.Join(args, " "),
+				WorkflowNames: workflowNames,
+				Since:         since,
+				Limit:         limit,
+				ContextLines:  contextLines,
+			})
+			ui.ExitOnError("searching test workflow execution logs", err)
+
+			if render.OutputType(cmd.Flag("output").Value.String()) != render.OutputPretty {
+				err = render.List(cmd, matches, os.Stdout)
+				ui.ExitOnError("rendering matches", err)
+				return
+			}
+			printLogMatches(matches)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&workflowNames, "workflow", nil, "search only the logs of the test workflows")
+	cmd.Flags().DurationVar(&since, "since", 0, "search only the executions scheduled within the duration, i.e. 168h")
+	cmd.Flags().IntVar(&limit, "limit", 100, "maximum number of the matched lines")
+	cmd.Flags().IntVarP(&contextLines, "context", "C", 2, "number of the lines displayed before and after the matched line")
+
+	return cmd
+}
+
+func printLogMatches(matches []testkube.TestWorkflowExecutionLogMatch) {
+	if len(matches) == 0 {
+		ui.Info("No matching lines found")
+		return
+	}
+	lastHeader := ""
+	for _, match := range matches {
+		header := fmt.Sprintf("%s %s", ui.LightCyan(match.ExecutionName), ui.DarkGray("("+match.WorkflowName+")"))
+		if match.Step != "" {
+			header += " " + ui.LightGray("step "+match.Step)
+		}
+		if header != lastHeader {
+			if lastHeader != "" {
+				fmt.Println()
+			}
+			fmt.Println(header)
+			lastHeader = header
+		}
+		line := int(match.Line)
+		for i, text := range match.Before {
+			fmt.Printf("%s %s\n", ui.DarkGray(fmt.Sprintf("%6d-", line-len(match.Before)+i)), text)
+		}
+		fmt.Printf("%s %s\n", ui.Green(fmt.Sprintf("%6d:", line)), match.Text)
+		for i, text := range match.After {
+			fmt.Printf("%s %s\n", ui.DarkGray(fmt.Sprintf("%6d-", line+1+i)), text)
+		}
+	}
+}
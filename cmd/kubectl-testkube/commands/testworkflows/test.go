@@ -0,0 +1,81 @@
+package testworkflows
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowtest"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewTestTestWorkflowsCmd() *cobra.Command {
+	var (
+		update bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "workflows <path>...",
+		Aliases: []string{"workflow", "testworkflows", "testworkflow", "tw"},
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Test the test workflows and templates offline",
+		Long: `Test the test workflows and templates offline.
+
+Loads TestWorkflow, TestWorkflowTemplate and TestWorkflowTest documents from the YAML files,
+resolves the templates and configuration, and processes the workflows into the Kubernetes resources
+without the cluster access. Each TestWorkflowTest verifies the output with the expressions,
+the golden files or the expected error. The golden files should be named *.golden.yaml,
+so they are not loaded as the input.
+
+The expressions have access to: workflow, job, pod, containers and signature.`,
+		Example: `kind: TestWorkflowTest
+apiVersion: testworkflows.testkube.io/v1
+metadata:
+  name: k6 uses provided virtual users
+spec:
+  workflow: k6-load
+  config:
+    vus: "10"
+  expect:
+  - 'containers.1.image == "grafana/k6:0.49.0"'
+  golden:
+    workflow: k6-load.golden.yaml
+    job: k6-load-job.golden.yaml`,
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace := cmd.Flag("namespace").Value.String()
+
+			suite, err := testworkflowtest.Load(args...)
+			ui.ExitOnError("loading test workflows", err)
+			if len(suite.Tests) == 0 {
+				ui.Failf("no %s found in %v", testworkflowtest.TestKind, args)
+			}
+
+			failed := 0
+			results := suite.Run(cmd.Context(), testworkflowtest.RunOptions{Namespace: namespace, UpdateGolden: update})
+			for _, result := range results {
+				for _, path := range result.Updated {
+					ui.Info("Updated golden file", path)
+				}
+				if result.Passed() {
+					ui.Success(result.Test.Name, result.Test.File())
+					continue
+				}
+				failed++
+				ui.Errf("%s (%s)", result.Test.Name, result.Test.File())
+				for _, failure := range result.Failures {
+					fmt.Printf("    %s\n", failure)
+				}
+			}
+
+			ui.NL()
+			if failed > 0 {
+				ui.Failf("%d of %d tests failed", failed, len(results))
+			}
+			ui.SuccessAndExit(fmt.Sprintf("All %d tests passed", len(results)))
+		},
+	}
+
+	cmd.Flags().BoolVar(&update, "update", false, "update the golden files with the rendered output")
+
+	return cmd
+}
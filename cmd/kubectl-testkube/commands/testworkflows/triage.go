@@ -0,0 +1,105 @@
+package testworkflows
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows/renderer"
+	apiclientv1 "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewTriageTestWorkflowExecutionCmd() *cobra.Command {
+	var (
+		request            testkube.TestWorkflowExecutionTriageRequest
+		category, assignee string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "execution <executionName>",
+		Aliases: []string{"testworkflowexecution", "twe", "testworkflows-execution", "testworkflow-execution"},
+		Short:   "Update the triage of the finished test workflow execution",
+		Long: `Set the failure category and the assignee, add the note and link the issues to the finished test workflow execution.
+Each change is recorded in the triage history. Pass the empty value to clear the category or the assignee.
+
+The changes are recorded for the user authenticated with the API token (API_IDENTITY_TOKENS setting of the API server),
+i.e. --header Authorization="Bearer <token>".`,
+		Example: `kubectl testkube triage execution 6630f0b1c3b5a1e2f4d5c6b7 --category infra --assignee jane --note "node evicted"
+kubectl testkube triage execution api-tests-12 --add-issue https://github.com/org/repo/issues/1`,
+		Args: validator.ExecutionName,
+
+		Run: func(cmd *cobra.Command, args []string) {
+			executionID := args[0]
+			if cmd.Flags().Changed("category") {
+				request.Category = &category
+			}
+			if cmd.Flags().Changed("assignee") {
+				request.Assignee = &assignee
+			}
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			triage, err := client.UpdateTestWorkflowExecutionTriage(executionID, request)
+			ui.ExitOnError("updating triage for test workflow execution "+executionID, err)
+
+			if render.OutputType(cmd.Flag("output").Value.String()) != render.OutputPretty {
+				err = render.Obj(cmd, triage, os.Stdout)
+				ui.ExitOnError("rendering triage", err)
+				return
+			}
+			renderer.PrintTestWorkflowExecutionTriage(ui.NewUI(ui.Verbose, os.Stdout), &triage)
+			ui.NL()
+			ui.SuccessAndExit("Successfully updated triage for test workflow execution", executionID)
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "", "failure category, one of product-bug|test-bug|infra|flaky")
+	cmd.Flags().StringVar(&assignee, "assignee", "", "person responsible for the failure")
+	cmd.Flags().StringVar(&request.Note, "note", "", "note added to the triage thread")
+	cmd.Flags().StringSliceVar(&request.AddIssues, "add-issue", nil, "URL of the issue to link")
+	cmd.Flags().StringSliceVar(&request.RemoveIssues, "remove-issue", nil, "URL of the issue to unlink")
+	return cmd
+}
+
+func NewGetTestWorkflowTriageStatsCmd() *cobra.Command {
+	var (
+		options   apiclientv1.GetTestWorkflowTriageStatsOptions
+		selectors []string
+		workflow  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show the share of the failures per triage category for each test workflow",
+		Long: `Show the share of the failures per triage category for each test workflow.
+Failed and aborted executions are considered, unless the --status is provided.`,
+		Example: `kubectl testkube triage stats --last 30
+kubectl testkube triage stats --testworkflow api-tests -o json`,
+		Args: cobra.NoArgs,
+
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Selector = strings.Join(selectors, ",")
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			stats, err := client.GetTestWorkflowTriageStats(workflow, options)
+			ui.ExitOnError("getting triage stats", err)
+			err = render.List(cmd, testkube.TestWorkflowTriageStatsList(stats), os.Stdout)
+			ui.ExitOnError("rendering triage stats", err)
+		},
+	}
+
+	cmd.Flags().StringVarP(&workflow, "testworkflow", "w", "", "test workflow name")
+	cmd.Flags().StringSliceVarP(&selectors, "label", "l", nil, "label key value pair: --label key1=value1")
+	cmd.Flags().StringVar(&options.Status, "status", "", "test workflow execution status filter, supports comma-separated list of statuses")
+	cmd.Flags().IntVar(&options.LastNDays, "last", 0, "consider only the executions from the last days")
+	return cmd
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
 	apiclientv1 "github.com/kubeshop/testkube/pkg/api/v1/client"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -33,6 +34,8 @@ func NewViewCmd() *cobra.Command {
 	var skipArtifacts bool
 	var force bool
 	var wait bool
+	var trustedKeyPath string
+	var extractDir string
 	cmd := &cobra.Command{
 		Use:   "view <executionId|executionName>",
 		Short: "View a test workflow execution in the browser",
@@ -44,9 +47,16 @@ directly in the dashboard — no data is uploaded.
 When running standalone (kubeconfig context) the execution data is uploaded
 as a public tokenized preview and the viewer URL is opened instead.
 
-Accepts either an execution ID (UUID) or an execution name (e.g. my-workflow-12345).`,
+Accepts either an execution ID (UUID) or an execution name (e.g. my-workflow-12345).
+
+Accepts also the path to the archive from 'testkube export execution', that is opened offline.`,
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
+			if stat, err := os.Stat(args[0]); err == nil && stat.Mode().IsRegular() {
+				testworkflows.ViewExecutionArchive(args[0], trustedKeyPath, extractDir)
+				return
+			}
+
 			cfg, err := config.Load()
 			ui.ExitOnError("loading config file", err)
 
@@ -117,6 +127,8 @@ Accepts either an execution ID (UUID) or an execution name (e.g. my-workflow-123
 	cmd.Flags().BoolVar(&skipArtifacts, "skip-artifacts", false, "skip uploading artifacts when sharing an execution")
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "skip confirmation prompt")
 	cmd.Flags().BoolVarP(&wait, "wait", "w", false, "wait for the execution to finish before uploading")
+	cmd.Flags().StringVar(&trustedKeyPath, "trusted-key", "", "path to the ed25519 public key, that must have signed the opened archive")
+	cmd.Flags().StringVar(&extractDir, "extract-dir", "", "directory to extract the opened archive to, defaults to the temporary directory")
 	return cmd
 }
 
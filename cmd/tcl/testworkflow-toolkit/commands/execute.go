@@ -12,6 +12,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"sync"
 	"time"
 
@@ -33,6 +35,7 @@ import (
 	commonmapper "github.com/kubeshop/testkube/pkg/mapper/common"
 	"github.com/kubeshop/testkube/pkg/mapper/testworkflows"
 	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowoutputs"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/constants"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -47,6 +50,8 @@ const (
 	ExecutionResultPollingTime = 200 * time.Millisecond
 )
 
+var nonIdentifierCharsRe = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
 type testWorkflowExecutionDetails struct {
 	Id               string `json:"id"`
 	Name             string `json:"name"`
@@ -184,6 +189,11 @@ func buildWorkflowExecution(workflow testworkflowsv1.StepExecuteWorkflow, async
 
 				instructions.PrintOutput(config.Ref(), "testworkflow-end", &executionResult{Id: exec.Id, Status: string(status)})
 				fmt.Printf("%s • %s\n", color(exec.Name), string(status))
+
+				// Pass the outputs returned by the child execution to the execute step
+				if err := writeExecutionOutputs(os.Getenv(data.OutputFileEnvName), exec.Workflow.Name, testworkflowoutputs.Values(&exec)); err != nil {
+					ui.Errf("failed to save the outputs of %s: %s", exec.Name, err.Error())
+				}
 			}(execs[i])
 		}
 		wg.Wait()
@@ -201,6 +211,38 @@ func buildWorkflowExecution(workflow testworkflowsv1.StepExecuteWorkflow, async
 	}, nil
 }
 
+var executionOutputsMu sync.Mutex
+
+// writeExecutionOutputs stores the outputs returned by the child execution as the outputs of the execute step,
+// both as <name> and <workflow>.<name>, so the next steps may read them with steps.<id>.outputs.<name>.
+// Each output is written as a separate file next to the step output file, to keep the multi-line values.
+func writeExecutionOutputs(outputFilePath, workflowName string, values map[string]interface{}) error {
+	if outputFilePath == "" || len(values) == 0 {
+		return nil
+	}
+	dir := filepath.Dir(outputFilePath)
+	prefix := nonIdentifierCharsRe.ReplaceAllString(workflowName, "_") + "."
+
+	executionOutputsMu.Lock()
+	defer executionOutputsMu.Unlock()
+	for name, value := range values {
+		content, ok := value.(string)
+		if !ok {
+			serialized, err := json.Marshal(value)
+			if err != nil {
+				return errors.Wrapf(err, "serializing '%s' output", name)
+			}
+			content = string(serialized)
+		}
+		for _, fileName := range []string{name, prefix + name} {
+			if err := os.WriteFile(filepath.Join(dir, fileName), []byte(content), 0666); err != nil {
+				return errors.Wrapf(err, "writing '%s' output", name)
+			}
+		}
+	}
+	return nil
+}
+
 func registerTransfer(transferSrv transfer.Server, request map[string]testworkflowsv1.TarballRequest, machines ...expressions.Machine) (expressions.Machine, error) {
 	err := expressions.Finalize(&request, machines...)
 	if err != nil {
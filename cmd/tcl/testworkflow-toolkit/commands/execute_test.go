@@ -0,0 +1,53 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+// 	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/data"
+	"github.com/kubeshop/testkube/pkg/expressions"
+)
+
+func TestWriteExecutionOutputs(t *testing.T) {
+	dir, prevDir := t.TempDir(), data.GetOutputsDir()
+	data.SetOutputsDir(dir)
+	t.Cleanup(func() {
+		data.SetOutputsDir(prevDir)
+	})
+
+	err := writeExecutionOutputs(data.GetOutputFilePath(), "build-app", map[string]interface{}{
+		"version": "1.2.3",
+		"port":    float64(8080),
+		"meta":    map[string]interface{}{"tags": []interface{}{"a", "b"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, data.ScanStepOutputs("run"))
+
+	resolve := func(expr string) string {
+		compiled, err := expressions.CompileAndResolve(expr, data.StepMachine)
+		require.NoError(t, err)
+		str, _ := compiled.Static().StringValue()
+		return str
+	}
+	assert.Equal(t, "1.2.3", resolve("steps.run.outputs.version"))
+	assert.Equal(t, "1.2.3", resolve("steps.run.outputs.build_app.version"))
+	assert.Equal(t, "8080", resolve("steps.run.outputs.build_app.port"))
+	assert.Equal(t, `{"tags":["a","b"]}`, resolve("steps.run.outputs.meta"))
+
+	// Nothing is written outside of the step
+	require.NoError(t, writeExecutionOutputs("", "build-app", map[string]interface{}{"version": "1.2.3"}))
+	matches, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	assert.Len(t, matches, 6)
+}
@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	InstructionStart     = "start"
 	InstructionEnd       = "end"
@@ -7,6 +9,13 @@ const (
 	InstructionPause     = "pause"
 	InstructionResume    = "resume"
 	InstructionIteration = "iteration"
+	InstructionApproval  = "approval"
+	InstructionAttempt   = "attempt"
+)
+
+const (
+	// OutputStepOutputs is the name of the output reporting the values of the declared outputs
+	OutputStepOutputs = "outputs"
 )
 
 type ExecutionResult struct {
@@ -14,3 +23,12 @@ type ExecutionResult struct {
 	Details   string `json:"details,omitempty"`
 	Iteration int    `json:"iteration,omitempty"`
 }
+
+type AttemptResult struct {
+	Number     int32      `json:"number"`
+	Status     StepStatus `json:"status"`
+	ExitCode   uint8      `json:"code"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt time.Time  `json:"finishedAt"`
+	DelayMs    int64      `json:"delayMs,omitempty"`
+}
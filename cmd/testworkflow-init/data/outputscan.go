@@ -13,12 +13,22 @@ var (
 
 const (
 	MaxOutputSize = 4096
+
+	// OutputFileEnvName is the environment variable pointing to the file,
+	// where the step may write its outputs as "name=value" lines
+	OutputFileEnvName = "TK_OUTPUT"
+	outputFileName    = ".output"
 )
 
 func GetOutputsDir() string {
 	return outputsDir
 }
 
+// GetOutputFilePath returns the path of the file accepting "name=value" lines as the step outputs
+func GetOutputFilePath() string {
+	return filepath.Join(outputsDir, outputFileName)
+}
+
 func SetOutputsDir(dir string) {
 	outputsDir = dir
 }
@@ -67,6 +77,38 @@ func scanStepOutputsFrom(dir, stepId string) error {
 
 		state.SetStepOutput(stepId, name, strings.TrimSpace(string(content)))
 	}
+	return scanStepOutputFile(filepath.Join(dir, outputFileName), stepId)
+}
+
+// scanStepOutputFile reads the "name=value" lines written to the output file,
+// overriding the values written as separate files.
+func scanStepOutputFile(path, stepId string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read output file: %w", err)
+	}
+
+	state := GetState()
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			fmt.Fprintf(os.Stderr, "warn: invalid step output at line %d of $%s, expected name=value\n", i+1, OutputFileEnvName)
+			continue
+		}
+		if len(value) > MaxOutputSize {
+			fmt.Fprintf(os.Stderr, "warn: step output %q exceeds %d byte limit, skipping (use step.results for large files)\n", name, MaxOutputSize)
+			continue
+		}
+		state.SetStepOutput(stepId, name, strings.TrimSpace(value))
+	}
 	return nil
 }
 
@@ -3,7 +3,9 @@ package data
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"slices"
 	"strings"
@@ -11,8 +13,10 @@ import (
 
 	"github.com/kubeshop/testkube/cmd/testworkflow-init/constants"
 	"github.com/kubeshop/testkube/cmd/testworkflow-init/output"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/expressions"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowconfig"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowoutputs"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/action/actiontypes/lite"
 )
 
@@ -149,7 +153,39 @@ func (s *state) GetStepOutput(stepId, name string) (interface{}, bool, error) {
 	if !ok {
 		return nil, false, nil
 	}
-	return v, true, nil
+
+	// Convert the value to the declared type
+	step := s.GetStepByID(stepId)
+	if step == nil {
+		return v, true, nil
+	}
+	outputType, declared := step.Outputs[name]
+	if !declared {
+		return v, true, nil
+	}
+	value, err := testworkflowoutputs.Parse(testkube.TestWorkflowOutputType(outputType), v)
+	if err != nil {
+		return nil, true, fmt.Errorf("step '%s' output '%s' is not %s: %w", stepId, name, outputType, err)
+	}
+	return value, true, nil
+}
+
+// GetDeclaredStepOutputs returns the values of the outputs declared for the step.
+// The outputs that are missing or invalid are skipped, and reported in the returned error.
+func (s *state) GetDeclaredStepOutputs(step *StepData) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(step.Outputs))
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(step.Outputs)) {
+		value, ok, err := s.GetStepOutput(step.Id, name)
+		if err != nil {
+			errs = append(errs, err)
+		} else if !ok {
+			errs = append(errs, fmt.Errorf("step '%s' has not produced the '%s' output", step.Id, name))
+		} else {
+			values[name] = value
+		}
+	}
+	return values, errors.Join(errs...)
 }
 
 func (s *state) GetSubSteps(ref string) []*StepData {
@@ -2,6 +2,9 @@ package data
 
 import (
 	"errors"
+	"math"
+	"math/rand/v2"
+	"regexp"
 	"slices"
 	"sync"
 	"time"
@@ -11,8 +14,54 @@ import (
 )
 
 type RetryPolicy struct {
-	Count int32  `json:"count,omitempty"`
-	Until string `json:"until,omitempty" expr:"expression"`
+	Count     int32         `json:"count,omitempty"`
+	Until     string        `json:"until,omitempty" expr:"expression"`
+	Delay     time.Duration `json:"delay,omitempty"`
+	Backoff   string        `json:"backoff,omitempty"`
+	MaxDelay  time.Duration `json:"maxDelay,omitempty"`
+	Jitter    int32         `json:"jitter,omitempty"`
+	ExitCodes []int32       `json:"exitCodes,omitempty"`
+	Logs      string        `json:"logs,omitempty"`
+}
+
+// NextDelay computes how long to wait after the specified attempt (starting from 1), before the next one.
+func (p RetryPolicy) NextDelay(attempt int32) time.Duration {
+	delay := p.Delay
+	switch p.Backoff {
+	case "linear":
+		delay = p.Delay * time.Duration(attempt)
+	case "exponential":
+		// Saturate instead of overflowing for the high number of attempts
+		shift := min(max(attempt-1, 0), 62)
+		delay = p.Delay << shift
+		if delay>>shift != p.Delay {
+			delay = math.MaxInt64
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 && delay > 0 {
+		spread := int64(delay) / 100 * int64(min(p.Jitter, 100))
+		delay = time.Duration(max(int64(delay)+rand.Int64N(spread+1)-rand.Int64N(spread+1), 0))
+	}
+	return max(delay, 0)
+}
+
+// ShouldRetryOn determines if the failure matches the retry conditions.
+// Any failure matches, when there are no conditions.
+func (p RetryPolicy) ShouldRetryOn(exitCode uint8, logs string) bool {
+	if len(p.ExitCodes) == 0 && p.Logs == "" {
+		return true
+	}
+	if slices.Contains(p.ExitCodes, int32(exitCode)) {
+		return true
+	}
+	if p.Logs == "" {
+		return false
+	}
+	re, err := regexp.Compile(p.Logs)
+	return err == nil && re.MatchString(logs)
 }
 
 type StepData struct {
@@ -28,6 +77,7 @@ type StepData struct {
 	Retry         RetryPolicy           `json:"r,omitempty"`
 	Result        string                `json:"R,omitempty"`
 	Iteration     int32                 `json:"i,omitempty"`
+	Outputs       map[string]string     `json:"O,omitempty"`
 
 	// Pausing
 	PausedNs    int64      `json:"n,omitempty"`
@@ -83,6 +133,11 @@ func (s *StepData) SetId(id string) *StepData {
 	return s
 }
 
+func (s *StepData) SetOutputs(outputs map[string]string) *StepData {
+	s.Outputs = outputs
+	return s
+}
+
 func (s *StepData) SetCondition(expression string) *StepData {
 	s.Condition = expression
 	return s
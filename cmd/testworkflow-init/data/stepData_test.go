@@ -0,0 +1,48 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_NextDelay(t *testing.T) {
+	tests := map[string]struct {
+		policy  RetryPolicy
+		attempt int32
+		want    time.Duration
+	}{
+		"no delay":             {policy: RetryPolicy{}, attempt: 3, want: 0},
+		"constant":             {policy: RetryPolicy{Delay: 10 * time.Second}, attempt: 3, want: 10 * time.Second},
+		"linear":               {policy: RetryPolicy{Delay: 10 * time.Second, Backoff: "linear"}, attempt: 3, want: 30 * time.Second},
+		"exponential":          {policy: RetryPolicy{Delay: 10 * time.Second, Backoff: "exponential"}, attempt: 3, want: 40 * time.Second},
+		"exponential first":    {policy: RetryPolicy{Delay: 10 * time.Second, Backoff: "exponential"}, attempt: 1, want: 10 * time.Second},
+		"capped":               {policy: RetryPolicy{Delay: 10 * time.Second, Backoff: "exponential", MaxDelay: time.Minute}, attempt: 10, want: time.Minute},
+		"exponential overflow": {policy: RetryPolicy{Delay: time.Hour, Backoff: "exponential", MaxDelay: 2 * time.Hour}, attempt: 100, want: 2 * time.Hour},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.policy.NextDelay(tc.attempt))
+		})
+	}
+}
+
+func TestRetryPolicy_NextDelay_Jitter(t *testing.T) {
+	policy := RetryPolicy{Delay: 10 * time.Second, Jitter: 20}
+	for i := 0; i < 100; i++ {
+		delay := policy.NextDelay(1)
+		assert.GreaterOrEqual(t, delay, 8*time.Second)
+		assert.LessOrEqual(t, delay, 12*time.Second)
+	}
+}
+
+func TestRetryPolicy_ShouldRetryOn(t *testing.T) {
+	policy := RetryPolicy{ExitCodes: []int32{137, 143}, Logs: "connection (refused|reset)"}
+
+	assert.True(t, RetryPolicy{}.ShouldRetryOn(1, ""))
+	assert.True(t, policy.ShouldRetryOn(137, ""))
+	assert.True(t, policy.ShouldRetryOn(1, "dial tcp 10.0.0.1:5432: connection refused"))
+	assert.False(t, policy.ShouldRetryOn(1, "assertion failed"))
+	assert.False(t, RetryPolicy{ExitCodes: []int32{137}}.ShouldRetryOn(1, "connection refused"))
+}
@@ -8,7 +8,8 @@ import (
 )
 
 const (
-	stepPrefix = "step."
+	stepPrefix  = "step."
+	stepsPrefix = "steps."
 )
 
 var (
@@ -31,13 +32,17 @@ func StepResultsDir(id string) string {
 }
 
 // StepMachine resolves step-scoped expressions like step.results,
-// step.<id>.results, and step.<id>.outputs.<key>.
+// step.<id>.results, and step.<id>.outputs.<key> (or steps.<id>.outputs.<key>).
 var StepMachine = expressions.NewMachine().
 	RegisterAccessorExt(func(name string) (interface{}, bool, error) {
-		if !strings.HasPrefix(name, stepPrefix) {
+		var suffix string
+		if strings.HasPrefix(name, stepPrefix) {
+			suffix = name[len(stepPrefix):]
+		} else if strings.HasPrefix(name, stepsPrefix) {
+			suffix = name[len(stepsPrefix):]
+		} else {
 			return nil, false, nil
 		}
-		suffix := name[len(stepPrefix):]
 		state := GetState()
 
 		if suffix == "results" {
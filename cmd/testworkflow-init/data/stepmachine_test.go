@@ -132,6 +132,51 @@ func TestStepMachine_Outputs(t *testing.T) {
 	}
 }
 
+func TestStepMachine_TypedOutputs(t *testing.T) {
+	setupTestState(map[string]*StepData{
+		"ref1": {Id: "build", Outputs: map[string]string{"port": "integer", "ready": "boolean", "meta": "json", "name": ""}},
+	}, "ref1")
+	GetState().SetStepOutput("build", "port", "8080")
+	GetState().SetStepOutput("build", "ready", "true")
+	GetState().SetStepOutput("build", "meta", `{"tags":["a","b"]}`)
+	GetState().SetStepOutput("build", "name", "api")
+
+	compiled, err := expressions.CompileAndResolve("steps.build.outputs.port + 1", StepMachine)
+	require.NoError(t, err)
+	val, _ := compiled.Static().IntValue()
+	assert.Equal(t, int64(8081), val)
+
+	compiled, err = expressions.CompileAndResolve("at(steps.build.outputs.meta.tags, 1)", StepMachine)
+	require.NoError(t, err)
+	str, _ := compiled.Static().StringValue()
+	assert.Equal(t, "b", str)
+
+	values, err := GetState().GetDeclaredStepOutputs(GetState().GetStepByID("build"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"port":  int64(8080),
+		"ready": true,
+		"meta":  map[string]interface{}{"tags": []interface{}{"a", "b"}},
+		"name":  "api",
+	}, values)
+}
+
+func TestGetDeclaredStepOutputs_Invalid(t *testing.T) {
+	setupTestState(map[string]*StepData{
+		"ref1": {Id: "build", Outputs: map[string]string{"port": "integer", "version": "string"}},
+	}, "ref1")
+	GetState().SetStepOutput("build", "port", "not-a-number")
+
+	values, err := GetState().GetDeclaredStepOutputs(GetState().GetStepByID("build"))
+	assert.Empty(t, values)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "output 'port' is not integer")
+	assert.Contains(t, err.Error(), "has not produced the 'version' output")
+
+	_, err = expressions.CompileAndResolve("steps.build.outputs.port", StepMachine)
+	assert.Error(t, err)
+}
+
 func TestStepMachine_TemplateExpression(t *testing.T) {
 	setupTestState(map[string]*StepData{"ref1": {Id: "auth"}}, "ref1")
 	GetState().SetStepOutput("auth", "token", "mytoken")
@@ -190,6 +235,27 @@ func TestScanStepOutputs(t *testing.T) {
 		assert.False(t, ok)
 	})
 
+	t.Run("reads name=value lines from the output file", func(t *testing.T) {
+		dir := t.TempDir()
+		setupTestState(map[string]*StepData{"ref1": {Id: "build"}}, "ref1")
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "version"), []byte("1.0.0"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, outputFileName), []byte("# build info\nversion=1.2.3\nurl=http://app:8080/?a=b\ninvalid\n"), 0644))
+
+		require.NoError(t, scanStepOutputsFrom(dir, "build"))
+
+		val, ok := resolveStepExpr(t, "step.build.outputs.version")
+		assert.True(t, ok)
+		assert.Equal(t, "1.2.3", val)
+
+		val, ok = resolveStepExpr(t, "steps.build.outputs.url")
+		assert.True(t, ok)
+		assert.Equal(t, "http://app:8080/?a=b", val)
+
+		_, ok = resolveStepExpr(t, "step.build.outputs.invalid")
+		assert.False(t, ok)
+	})
+
 	t.Run("noop for empty id or missing dir", func(t *testing.T) {
 		assert.NoError(t, scanStepOutputsFrom("/nonexistent", ""))
 		assert.NoError(t, scanStepOutputsFrom("/nonexistent", "build"))
@@ -31,6 +31,7 @@ type executionGroup struct {
 	aborted   atomic.Bool
 	outStream io.Writer
 	errStream io.Writer
+	tail      *tailBuffer
 
 	executions   []*execution
 	executionsMu sync.Mutex
@@ -45,6 +46,7 @@ func newExecutionGroup(outStream io.Writer, errStream io.Writer) *executionGroup
 	return &executionGroup{
 		outStream: outStream,
 		errStream: errStream,
+		tail:      newTailBuffer(tailBufferSize),
 	}
 }
 
@@ -52,8 +54,9 @@ func (e *executionGroup) Create(cmd string, args []string) *execution {
 	// Instantiate the execution
 	ex := &execution{group: e}
 	ex.cmd = exec.CommandContext(context.Background(), cmd, args...)
-	ex.cmd.Stdout = e.outStream
-	ex.cmd.Stderr = e.errStream
+	e.tail.Reset()
+	ex.cmd.Stdout = io.MultiWriter(e.outStream, e.tail)
+	ex.cmd.Stderr = io.MultiWriter(e.errStream, e.tail)
 
 	// Append to the list TODO: delete that after finish
 	e.executionsMu.Lock()
@@ -71,8 +74,9 @@ func (e *executionGroup) CreateWithContext(ctx context.Context, cmd string, args
 		return ex.cmd.Process.Signal(syscall.SIGTERM)
 	}
 	ex.cmd.WaitDelay = 10 * time.Second
-	ex.cmd.Stdout = e.outStream
-	ex.cmd.Stderr = e.errStream
+	e.tail.Reset()
+	ex.cmd.Stdout = io.MultiWriter(e.outStream, e.tail)
+	ex.cmd.Stderr = io.MultiWriter(e.errStream, e.tail)
 
 	// Append to the list TODO: delete that after finish
 	e.executionsMu.Lock()
@@ -82,6 +86,11 @@ func (e *executionGroup) CreateWithContext(ctx context.Context, cmd string, args
 	return ex
 }
 
+// Output returns the last part of the output from the latest execution
+func (e *executionGroup) Output() string {
+	return e.tail.String()
+}
+
 func (e *executionGroup) Pause() (err error) {
 	// Lock running
 	swapped := e.paused.CompareAndSwap(false, true)
@@ -0,0 +1,47 @@
+package orchestration
+
+import (
+	"sync"
+)
+
+const (
+	tailBufferSize = 64 * 1024
+)
+
+// tailBuffer keeps only the last part of the written data,
+// so the output of the long-running commands may be inspected without keeping all of it in memory.
+type tailBuffer struct {
+	mu   sync.Mutex
+	data []byte
+	size int
+}
+
+func newTailBuffer(size int) *tailBuffer {
+	return &tailBuffer{size: size}
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(p) >= t.size {
+		t.data = append(t.data[:0], p[len(p)-t.size:]...)
+		return len(p), nil
+	}
+	if overflow := len(t.data) + len(p) - t.size; overflow > 0 {
+		t.data = append(t.data[:0], t.data[overflow:]...)
+	}
+	t.data = append(t.data, p...)
+	return len(p), nil
+}
+
+func (t *tailBuffer) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.data = t.data[:0]
+}
+
+func (t *tailBuffer) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return string(t.data)
+}
@@ -0,0 +1,24 @@
+package orchestration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTailBuffer(t *testing.T) {
+	buf := newTailBuffer(8)
+
+	_, _ = buf.Write([]byte("abc"))
+	_, _ = buf.Write([]byte("defgh"))
+	assert.Equal(t, "abcdefgh", buf.String())
+
+	_, _ = buf.Write([]byte("ij"))
+	assert.Equal(t, "cdefghij", buf.String())
+
+	_, _ = buf.Write([]byte("0123456789"))
+	assert.Equal(t, "23456789", buf.String())
+
+	buf.Reset()
+	assert.Equal(t, "", buf.String())
+}
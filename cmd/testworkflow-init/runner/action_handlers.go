@@ -1,11 +1,13 @@
 package runner
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 	"sync/atomic"
@@ -15,6 +17,7 @@ import (
 	"github.com/kubeshop/testkube/cmd/testworkflow-init/constants"
 	"github.com/kubeshop/testkube/cmd/testworkflow-init/data"
 	"github.com/kubeshop/testkube/cmd/testworkflow-init/orchestration"
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/output"
 	"github.com/kubeshop/testkube/cmd/testworkflow-init/runtime"
 	"github.com/kubeshop/testkube/cmd/testworkflow-toolkit/artifacts"
 	"github.com/kubeshop/testkube/pkg/expressions"
@@ -25,7 +28,7 @@ import (
 )
 
 func handleDeclareAction(step *data.StepData, action *lite.ActionDeclare) {
-	step.SetId(action.Id).SetCondition(action.Condition).SetParents(action.Parents)
+	step.SetId(action.Id).SetOutputs(action.Outputs).SetCondition(action.Condition).SetParents(action.Parents)
 }
 
 func handlePauseAction(step *data.StepData, action *lite.ActionPause) {
@@ -41,10 +44,31 @@ func handleTimeoutAction(step *data.StepData, action *lite.ActionTimeout) {
 }
 
 func handleRetryAction(step *data.StepData, action *lite.ActionRetry) {
-	step.SetRetryPolicy(data.RetryPolicy{
-		Count: action.Count,
-		Until: action.Until,
-	})
+	policy := data.RetryPolicy{
+		Count:     action.Count,
+		Until:     action.Until,
+		Backoff:   action.Backoff,
+		Jitter:    action.Jitter,
+		ExitCodes: action.ExitCodes,
+		Logs:      action.Logs,
+	}
+	var err error
+	if action.Delay != "" {
+		if policy.Delay, err = time.ParseDuration(action.Delay); err != nil {
+			output.ExitErrorf(constants.CodeInputError, "invalid retry delay: %s: %s", action.Delay, err.Error())
+		}
+	}
+	if action.MaxDelay != "" {
+		if policy.MaxDelay, err = time.ParseDuration(action.MaxDelay); err != nil {
+			output.ExitErrorf(constants.CodeInputError, "invalid retry max delay: %s: %s", action.MaxDelay, err.Error())
+		}
+	}
+	if action.Logs != "" {
+		if _, err = regexp.Compile(action.Logs); err != nil {
+			output.ExitErrorf(constants.CodeInputError, "invalid retry logs pattern: %s: %s", action.Logs, err.Error())
+		}
+	}
+	step.SetRetryPolicy(policy)
 }
 
 func handleContainerTransition(container *lite.LiteActionContainer, actions []lite.LiteAction, currentIndex int, state interface{ GetStep(string) *data.StepData }, stdout interface{ SetSensitiveWords([]string) }) (*lite.LiteActionContainer, error) {
@@ -203,6 +227,7 @@ func handleExecuteAction(action *lite.ActionExecute, ctx *ExecutionContext) Acti
 					ErrorCode:         constants.CodeInternal,
 				}
 			}
+			_ = os.Setenv(data.OutputFileEnvName, data.GetOutputFilePath())
 		}
 
 		hasTimeout.Store(false)
@@ -228,7 +253,25 @@ func handleExecuteAction(action *lite.ActionExecute, ctx *ExecutionContext) Acti
 
 		_ = orchestration.Executions.Kill()
 
-		if !shouldRetry(step, hasTimeout.Load(), hasOwnTimeout.Load(), ctx.Stdout) {
+		retry := shouldRetry(step, hasTimeout.Load(), hasOwnTimeout.Load(), orchestration.Executions.Output(), ctx.Stdout)
+		attempt := constants.AttemptResult{
+			Number:     step.Iteration + 1,
+			ExitCode:   step.ExitCode,
+			StartedAt:  *step.StartedAt,
+			FinishedAt: time.Now(),
+		}
+		if step.Status != nil {
+			attempt.Status = *step.Status
+		}
+		var delay time.Duration
+		if retry {
+			delay = step.Retry.NextDelay(attempt.Number)
+			attempt.DelayMs = delay.Milliseconds()
+		}
+		if step.Retry.Count > 0 {
+			ctx.Stdout.HintDetails(step.Ref, constants.InstructionAttempt, attempt)
+		}
+		if !retry {
 			break
 		}
 
@@ -238,7 +281,15 @@ func handleExecuteAction(action *lite.ActionExecute, ctx *ExecutionContext) Acti
 		if hasOwnTimeout.Load() {
 			message = "Timed out"
 		}
-		ctx.StdoutUnsafe.Printf("\n%s • Retrying: attempt #%d (of %d):\n", message, step.Iteration, step.Retry.Count)
+		if delay > 0 {
+			ctx.StdoutUnsafe.Printf("\n%s • Retrying in %s: attempt #%d (of %d):\n", message, delay.Round(time.Millisecond), step.Iteration, step.Retry.Count)
+			if !waitForRetry(ctx.Context, delay) {
+				step.SetStatus(constants.StepStatusAborted)
+				return ActionResult{ContinueExecution: false, ExitCode: int(constants.CodeAborted)}
+			}
+		} else {
+			ctx.StdoutUnsafe.Printf("\n%s • Retrying: attempt #%d (of %d):\n", message, step.Iteration, step.Retry.Count)
+		}
 
 		now := time.Now()
 		step.StartedAt = &now
@@ -249,6 +300,15 @@ func handleExecuteAction(action *lite.ActionExecute, ctx *ExecutionContext) Acti
 		if err := data.ScanStepOutputs(step.Id); err != nil {
 			fmt.Fprintf(os.Stderr, "warn: failed to scan step outputs: %s\n", err.Error())
 		}
+
+		// Report the declared outputs, so they are available in the execution
+		if len(step.Outputs) > 0 {
+			values, err := data.GetState().GetDeclaredStepOutputs(step)
+			if err != nil {
+				ctx.StdoutUnsafe.Warnf("warn: %s\n", strings.ReplaceAll(err.Error(), "\n", "\nwarn: "))
+			}
+			ctx.Stdout.Output(step.Ref, constants.OutputStepOutputs, values)
+		}
 	}
 
 	return ActionResult{ContinueExecution: true}
@@ -384,12 +444,38 @@ func scrapeMetricsPostProcessor(path, step string, config testworkflowconfig.Int
 	}
 }
 
-func shouldRetry(step *data.StepData, hasTimeout bool, hasOwnTimeout bool, stdout interface {
+// retryAbortCheckInterval is how often the abort is checked while waiting for the next attempt
+const retryAbortCheckInterval = 100 * time.Millisecond
+
+// waitForRetry waits before the next attempt, unless the execution is interrupted
+func waitForRetry(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	ticker := time.NewTicker(retryAbortCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			if orchestration.Executions.IsAborted() {
+				return false
+			}
+		case <-timer.C:
+			return !orchestration.Executions.IsAborted()
+		}
+	}
+}
+
+func shouldRetry(step *data.StepData, hasTimeout bool, hasOwnTimeout bool, logs string, stdout interface {
 	Printf(format string, args ...interface{})
 }) bool {
 	if step.Iteration >= step.Retry.Count || (!hasOwnTimeout && hasTimeout) {
 		return false
 	}
+	if !step.Retry.ShouldRetryOn(step.ExitCode, logs) {
+		return false
+	}
 
 	until := step.Retry.Until
 	if until == "" {
@@ -1,16 +1,19 @@
 package runner
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kubeshop/testkube/cmd/testworkflow-init/data"
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/orchestration"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowconfig"
 )
 
@@ -146,3 +149,18 @@ func TestUploadMetricsFiles(t *testing.T) {
 		assert.Len(t, storage.saved, 2)
 	})
 }
+
+func TestWaitForRetry_Abort(t *testing.T) {
+	orchestration.Executions.ClearAbortedStatus()
+	t.Cleanup(orchestration.Executions.ClearAbortedStatus)
+
+	assert.True(t, waitForRetry(context.Background(), 10*time.Millisecond))
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		orchestration.Executions.Abort()
+	}()
+	started := time.Now()
+	assert.False(t, waitForRetry(context.Background(), time.Minute))
+	assert.Less(t, time.Since(started), 5*time.Second, "should wake up on abort instead of waiting for the whole delay")
+}
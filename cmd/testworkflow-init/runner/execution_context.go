@@ -62,6 +62,7 @@ func (s *simpleStateAccessor) SetCurrentStatus(status string) {
 type StdoutWriter interface {
 	SetSensitiveWords(words []string)
 	HintDetails(ref, name string, value interface{})
+	Output(ref, name string, value interface{})
 	Printf(format string, args ...interface{})
 }
 
@@ -59,6 +59,10 @@ func (m *mockStdoutWriter) HintDetails(ref, name string, value interface{}) {
 	m.Called(ref, name, value)
 }
 
+func (m *mockStdoutWriter) Output(ref, name string, value interface{}) {
+	m.Called(ref, name, value)
+}
+
 func (m *mockStdoutWriter) Printf(format string, args ...interface{}) {
 	m.Called(format, args)
 }
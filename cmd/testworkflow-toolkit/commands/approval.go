@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/instructions"
+	"github.com/kubeshop/testkube/cmd/testworkflow-toolkit/env"
+	"github.com/kubeshop/testkube/cmd/testworkflow-toolkit/env/config"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const (
+	// ApprovalPollingTime defines how often the decision is checked
+	ApprovalPollingTime = 5 * time.Second
+)
+
+// ApprovalDecisionGetter provides the decision recorded for the approval step
+type ApprovalDecisionGetter func(ctx context.Context) (*testkube.TestWorkflowApproval, error)
+
+func NewApprovalCmd() *cobra.Command {
+	var (
+		message         string
+		approvers       []string
+		timeout         string
+		defaultDecision string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "approval",
+		Short: "Wait for the human approval",
+		Args:  cobra.NoArgs,
+
+		Run: func(cmd *cobra.Command, _ []string) {
+			approval, err := NewApprovalRequest(config.Ref(), message, approvers, timeout, defaultDecision, time.Now())
+			ui.ExitOnError("preparing the approval request", err)
+
+			cfg := config.Config()
+			client, err := env.Cloud()
+			ui.ExitOnError("connecting to the Control Plane", err)
+			getDecision := func(ctx context.Context) (*testkube.TestWorkflowApproval, error) {
+				execution, err := client.GetExecution(ctx, cfg.Execution.EnvironmentId, cfg.Execution.Id)
+				if err != nil {
+					return nil, err
+				}
+				return execution.GetApprovalDecision(approval.Ref), nil
+			}
+
+			result := WaitForApproval(cmd.Context(), approval, testkube.TestWorkflowApprovalDecision(defaultDecision), getDecision, ApprovalPollingTime)
+			if result.IsApproved() {
+				os.Exit(0)
+			}
+			os.Exit(1)
+		},
+	}
+
+	cmd.Flags().StringVar(&message, "message", "", "message displayed to the reviewers")
+	cmd.Flags().StringArrayVar(&approvers, "approver", nil, "person allowed to decide")
+	cmd.Flags().StringVar(&timeout, "timeout", "", "maximum time to wait for the decision")
+	cmd.Flags().StringVar(&defaultDecision, "default", string(testkube.REJECTED_TestWorkflowApprovalDecision), "decision to apply after the timeout")
+
+	return cmd
+}
+
+// NewApprovalRequest builds the approval request based on the step configuration
+func NewApprovalRequest(ref, message string, approvers []string, timeout, defaultDecision string, now time.Time) (*testkube.TestWorkflowApproval, error) {
+	approval := &testkube.TestWorkflowApproval{
+		Ref:         ref,
+		Message:     message,
+		RequestedAt: now.UTC(),
+	}
+	for _, approver := range approvers {
+		if approver = strings.TrimSpace(approver); approver != "" {
+			approval.Approvers = append(approval.Approvers, approver)
+		}
+	}
+	if timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+		approval.Deadline = approval.RequestedAt.Add(duration)
+	}
+	switch testkube.TestWorkflowApprovalDecision(defaultDecision) {
+	case testkube.APPROVED_TestWorkflowApprovalDecision, testkube.REJECTED_TestWorkflowApprovalDecision:
+	default:
+		return nil, fmt.Errorf("invalid default decision: %s", defaultDecision)
+	}
+	return approval, nil
+}
+
+// WaitForApproval announces the approval request and waits until the decision is taken or the deadline is reached
+func WaitForApproval(ctx context.Context, approval *testkube.TestWorkflowApproval, defaultDecision testkube.TestWorkflowApprovalDecision, getDecision ApprovalDecisionGetter, pollingTime time.Duration) *testkube.TestWorkflowApproval {
+	instructions.PrintHintDetails(approval.Ref, constants.InstructionApproval, approval)
+	if approval.Message != "" {
+		fmt.Printf("%s\n", approval.Message)
+	}
+	if len(approval.Approvers) > 0 {
+		fmt.Printf("Allowed approvers: %s\n", ui.LightCyan(strings.Join(approval.Approvers, ", ")))
+	}
+	if approval.Deadline.IsZero() {
+		fmt.Printf("Waiting for the approval...\n")
+	} else {
+		fmt.Printf("Waiting for the approval until %s...\n", ui.LightCyan(approval.Deadline.Format(time.RFC3339)))
+	}
+
+	result := approval.Clone()
+	for {
+		decision, err := getDecision(ctx)
+		if err != nil {
+			ui.Errf("error while checking the approval decision: %s", err.Error())
+		} else if decision != nil {
+			result.Decision = decision.Decision
+			result.DecidedBy = decision.DecidedBy
+			result.DecidedAt = decision.DecidedAt
+			result.Comment = decision.Comment
+			break
+		}
+		if !approval.Deadline.IsZero() && !time.Now().Before(approval.Deadline) {
+			result.Decision = common.Ptr(defaultDecision)
+			result.DecidedAt = time.Now().UTC()
+			result.TimedOut = true
+			break
+		}
+		select {
+		case <-ctx.Done():
+			result.Decision = common.Ptr(testkube.REJECTED_TestWorkflowApprovalDecision)
+			result.DecidedAt = time.Now().UTC()
+			instructions.PrintHintDetails(approval.Ref, constants.InstructionApproval, result)
+			return result
+		case <-time.After(pollingTime):
+		}
+	}
+
+	instructions.PrintHintDetails(approval.Ref, constants.InstructionApproval, result)
+	printApprovalDecision(result)
+	return result
+}
+
+func printApprovalDecision(result *testkube.TestWorkflowApproval) {
+	decidedBy := ""
+	if result.DecidedBy != "" {
+		decidedBy = fmt.Sprintf(" by %s", ui.LightCyan(result.DecidedBy))
+	}
+	switch {
+	case result.TimedOut:
+		fmt.Printf("No decision before the deadline, applied the default: %s\n", ui.LightCyan(string(*result.Decision)))
+	case result.IsApproved():
+		fmt.Printf("Approved%s\n", decidedBy)
+	default:
+		fmt.Printf("Rejected%s\n", decidedBy)
+	}
+	if result.Comment != "" {
+		fmt.Printf("Comment: %s\n", result.Comment)
+	}
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestNewApprovalRequest(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	t.Run("builds the request", func(t *testing.T) {
+		approval, err := NewApprovalRequest("rabc", "Deploy?", []string{"alice", " ", " bob "}, "1h", "approved", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "rabc", approval.Ref)
+		assert.Equal(t, "Deploy?", approval.Message)
+		assert.Equal(t, []string{"alice", "bob"}, approval.Approvers)
+		assert.Equal(t, now.Add(time.Hour), approval.Deadline)
+		assert.True(t, approval.IsPending())
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		approval, err := NewApprovalRequest("rabc", "", nil, "", "rejected", now)
+
+		require.NoError(t, err)
+		assert.True(t, approval.Deadline.IsZero())
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		_, err := NewApprovalRequest("rabc", "", nil, "soon", "rejected", now)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid default decision", func(t *testing.T) {
+		_, err := NewApprovalRequest("rabc", "", nil, "", "maybe", now)
+		assert.Error(t, err)
+	})
+}
+
+func TestWaitForApproval(t *testing.T) {
+	t.Run("applies the decision", func(t *testing.T) {
+		calls := 0
+		getDecision := func(ctx context.Context) (*testkube.TestWorkflowApproval, error) {
+			calls++
+			switch calls {
+			case 1:
+				return nil, errors.New("temporary failure")
+			case 2:
+				return nil, nil
+			}
+			return &testkube.TestWorkflowApproval{
+				Decision:  common.Ptr(testkube.APPROVED_TestWorkflowApprovalDecision),
+				DecidedBy: "alice",
+				Comment:   "LGTM",
+			}, nil
+		}
+		approval := &testkube.TestWorkflowApproval{Ref: "rabc", RequestedAt: time.Now()}
+
+		result := WaitForApproval(context.Background(), approval, testkube.REJECTED_TestWorkflowApprovalDecision, getDecision, time.Millisecond)
+
+		assert.Equal(t, 3, calls)
+		assert.True(t, result.IsApproved())
+		assert.False(t, result.TimedOut)
+		assert.Equal(t, "alice", result.DecidedBy)
+		assert.Equal(t, "LGTM", result.Comment)
+		assert.True(t, approval.IsPending())
+	})
+
+	t.Run("applies the default decision after the deadline", func(t *testing.T) {
+		getDecision := func(ctx context.Context) (*testkube.TestWorkflowApproval, error) {
+			return nil, nil
+		}
+		approval := &testkube.TestWorkflowApproval{Ref: "rabc", RequestedAt: time.Now(), Deadline: time.Now().Add(5 * time.Millisecond)}
+
+		result := WaitForApproval(context.Background(), approval, testkube.APPROVED_TestWorkflowApprovalDecision, getDecision, time.Millisecond)
+
+		assert.True(t, result.IsApproved())
+		assert.True(t, result.TimedOut)
+		assert.Empty(t, result.DecidedBy)
+	})
+
+	t.Run("rejects when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		getDecision := func(ctx context.Context) (*testkube.TestWorkflowApproval, error) {
+			cancel()
+			return nil, nil
+		}
+		approval := &testkube.TestWorkflowApproval{Ref: "rabc", RequestedAt: time.Now()}
+
+		result := WaitForApproval(ctx, approval, testkube.APPROVED_TestWorkflowApprovalDecision, getDecision, time.Hour)
+
+		assert.False(t, result.IsApproved())
+		assert.False(t, result.IsPending())
+	})
+}
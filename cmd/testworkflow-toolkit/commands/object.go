@@ -0,0 +1,170 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-toolkit/common"
+	"github.com/kubeshop/testkube/pkg/storage/minio"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/constants"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const (
+	// ObjectRetryMaxAttempts defines the maximum number of download attempts per object before giving up
+	ObjectRetryMaxAttempts = 5
+)
+
+// ObjectOptions encapsulates all options for the object command
+type ObjectOptions struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Key             string
+	AccessKeyID     string
+	SecretAccessKey string
+	DisableSSL      bool
+	SkipVerify      bool
+	Sha256          string
+	Unpack          bool
+	CacheDir        string
+}
+
+func NewObjectCmd() *cobra.Command {
+	opts := &ObjectOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "object <outputPath>",
+		Short: "Fetch the object(s) from S3-compatible storage",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := ProcessObject(cmd.Context(), args[0], opts, cmd.OutOrStdout()); err != nil {
+				ui.Fail(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Endpoint, "endpoint", constants.DefaultS3Endpoint, "S3-compatible endpoint")
+	cmd.Flags().StringVar(&opts.Region, "region", "", "region of the bucket")
+	cmd.Flags().StringVar(&opts.Bucket, "bucket", "", "bucket name")
+	cmd.Flags().StringVar(&opts.Key, "key", "", "object key, or a prefix ending with \"/\" to fetch all the objects under it")
+	cmd.Flags().StringVar(&opts.AccessKeyID, "access-key-id", "", "access key ID for authentication")
+	cmd.Flags().StringVar(&opts.SecretAccessKey, "secret-access-key", "", "secret access key for authentication")
+	cmd.Flags().BoolVar(&opts.DisableSSL, "disable-ssl", false, "connect over plain HTTP")
+	cmd.Flags().BoolVar(&opts.SkipVerify, "skip-verify", false, "skip verification of the server certificate")
+	cmd.Flags().StringVar(&opts.Sha256, "sha256", "", "expected SHA-256 checksum of the object")
+	cmd.Flags().BoolVar(&opts.Unpack, "unpack", false, "unpack the object(s) as gzipped tarballs")
+	cmd.Flags().StringVar(&opts.CacheDir, "cache-dir", "", "directory to cache the verified objects in")
+
+	return cmd
+}
+
+// ProcessObject fetches a single object, or all the objects under the prefix, into the output path.
+func ProcessObject(ctx context.Context, outputPath string, opts *ObjectOptions, output io.Writer) error {
+	if opts.Bucket == "" {
+		return errors.New("bucket name is required")
+	}
+	isPrefix := opts.Key == "" || strings.HasSuffix(opts.Key, "/")
+	if isPrefix && opts.Sha256 != "" {
+		return errors.New("sha256 checksum is supported only for a single object, not for a prefix")
+	}
+
+	client := minio.NewClient(opts.Endpoint, opts.AccessKeyID, opts.SecretAccessKey, opts.Region, "", opts.Bucket,
+		minio.GetTLSOptions(!opts.DisableSSL, opts.SkipVerify, "", "", "")...)
+	if err := client.Connect(); err != nil {
+		return errors.Wrap(err, "connect to the storage")
+	}
+
+	if !isPrefix {
+		fmt.Fprintf(output, "Fetching s3://%s/%s from %s to %s...\n", opts.Bucket, opts.Key, opts.Endpoint, outputPath)
+		return fetchObject(ctx, client, "", opts.Key, outputPath, opts, output)
+	}
+
+	fmt.Fprintf(output, "Fetching s3://%s/%s* from %s to %s...\n", opts.Bucket, opts.Key, opts.Endpoint, outputPath)
+	files, err := client.ListFilesFromBucket(ctx, opts.Bucket, opts.Key)
+	if err != nil {
+		return errors.Wrap(err, "list objects")
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no objects found in s3://%s/%s", opts.Bucket, opts.Key)
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name, "/") {
+			continue
+		}
+		if err = fetchObject(ctx, client, strings.Trim(opts.Key, "/"), file.Name, outputPath, opts, output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fetchObject(ctx context.Context, client *minio.Client, folder, key, outputPath string, opts *ObjectOptions, output io.Writer) error {
+	target := outputPath
+	if !opts.Unpack && folder != "" {
+		if common.IsUnsafePath(key) {
+			return fmt.Errorf("unsafe object key: %s", key)
+		}
+		target = filepath.Join(outputPath, filepath.FromSlash(key))
+	} else if !opts.Unpack {
+		target = filepath.Join(outputPath, path.Base(key))
+	}
+
+	var err error
+	for attempt := 1; attempt <= ObjectRetryMaxAttempts; attempt++ {
+		err = fetchObjectOnce(ctx, client, folder, key, target, opts, output)
+		if err == nil || errors.Is(err, common.ErrChecksumMismatch) {
+			return err
+		}
+		fmt.Fprintf(output, "failed to fetch %s: %s\n", key, err.Error())
+		if attempt < ObjectRetryMaxAttempts {
+			fmt.Fprintf(output, "retrying - attempt %d/%d.\n", attempt+1, ObjectRetryMaxAttempts)
+		}
+	}
+	return errors.Wrapf(err, "fetch %s", key)
+}
+
+func fetchObjectOnce(ctx context.Context, client *minio.Client, folder, key, target string, opts *ObjectOptions, output io.Writer) error {
+	download := func() (io.ReadCloser, error) {
+		reader, _, err := client.DownloadFileFromBucket(ctx, opts.Bucket, folder, key)
+		if err != nil {
+			return nil, err
+		}
+		if closer, ok := reader.(io.ReadCloser); ok {
+			return closer, nil
+		}
+		return io.NopCloser(reader), nil
+	}
+
+	var stream io.ReadCloser
+	if opts.Sha256 != "" {
+		file, cached, err := common.NewContentCache(opts.CacheDir).Fetch(opts.Sha256, download)
+		if err != nil {
+			return err
+		}
+		if cached {
+			fmt.Fprintf(output, "using the cached %s\n", key)
+		}
+		stream = file
+	} else {
+		var err error
+		if stream, err = download(); err != nil {
+			return err
+		}
+	}
+	defer stream.Close()
+
+	if opts.Unpack {
+		fmt.Fprintf(output, "unpacking %s\n", key)
+		return common.UnpackTarball(target, stream)
+	}
+	fmt.Fprintf(output, "storing %s\n", key)
+	return common.WriteFile(target, stream)
+}
@@ -0,0 +1,192 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-toolkit/common"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const (
+	// OciTitleAnnotation is the standard annotation with the file name of the artifact layer
+	OciTitleAnnotation = "org.opencontainers.image.title"
+	// OciUnpackAnnotation marks the directories pushed by ORAS as gzipped tarballs
+	OciUnpackAnnotation = "io.deis.oras.content.unpack"
+)
+
+// OciOptions encapsulates all options for the oci command
+type OciOptions struct {
+	Username string
+	Password string
+	Digest   string
+	Insecure bool
+	CacheDir string
+}
+
+func NewOciCmd() *cobra.Command {
+	opts := &OciOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "oci <ref> <outputPath>",
+		Short: "Pull and unpack the OCI artifact",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := ProcessOci(cmd.Context(), args[0], args[1], opts, cmd.OutOrStdout()); err != nil {
+				ui.Fail(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Username, "username", "u", "", "registry username for authentication")
+	cmd.Flags().StringVarP(&opts.Password, "password", "p", "", "registry password or token for authentication")
+	cmd.Flags().StringVar(&opts.Digest, "digest", "", "expected manifest digest to pull the artifact by")
+	cmd.Flags().BoolVar(&opts.Insecure, "insecure", false, "allow plain HTTP and self-signed certificates for the registry")
+	cmd.Flags().StringVar(&opts.CacheDir, "cache-dir", "", "directory to cache the artifact layers in")
+
+	return cmd
+}
+
+// ProcessOci pulls the OCI artifact by its reference and extracts its layers into the output path.
+// Layers with a title annotation are stored as files (or unpacked directories, for ORAS),
+// while the regular image layers are unpacked directly into the output path.
+func ProcessOci(ctx context.Context, rawRef, outputPath string, opts *OciOptions, output io.Writer) error {
+	ref, err := resolveOciReference(rawRef, opts)
+	if err != nil {
+		return err
+	}
+	remoteOpts := ociRemoteOptions(ctx, opts)
+
+	fmt.Fprintf(output, "Pulling OCI artifact %s to %s...\n", ref.String(), outputPath)
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return errors.Wrap(err, "fetch the artifact manifest")
+	}
+	if opts.Digest != "" && desc.Digest.String() != opts.Digest {
+		return fmt.Errorf("%w: expected manifest %s, got %s", common.ErrChecksumMismatch, opts.Digest, desc.Digest.String())
+	}
+	if desc.MediaType.IsIndex() {
+		return fmt.Errorf("%s: expected a single artifact manifest, got an index (%s)", rawRef, desc.MediaType)
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return errors.Wrap(err, "parse the artifact manifest")
+	}
+	fmt.Fprintf(output, "Resolved %s\n", desc.Digest.String())
+
+	if err = os.MkdirAll(outputPath, 0755); err != nil {
+		return errors.Wrapf(err, "%s: create directory", outputPath)
+	}
+	cache := common.NewContentCache(opts.CacheDir)
+	for _, layer := range manifest.Layers {
+		if err = processOciLayer(ref.Context(), layer, outputPath, cache, remoteOpts, output); err != nil {
+			return errors.Wrapf(err, "layer %s", layer.Digest.String())
+		}
+	}
+	return nil
+}
+
+func resolveOciReference(rawRef string, opts *OciOptions) (name.Reference, error) {
+	var nameOpts []name.Option
+	if opts.Insecure {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+	ref, err := name.ParseReference(rawRef, nameOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid OCI reference")
+	}
+	if opts.Digest == "" {
+		return ref, nil
+	}
+	if digest, ok := ref.(name.Digest); ok && digest.DigestStr() != opts.Digest {
+		return nil, fmt.Errorf("reference digest %s doesn't match the expected %s", digest.DigestStr(), opts.Digest)
+	}
+	ref, err = name.NewDigest(ref.Context().Name()+"@"+opts.Digest, nameOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid digest")
+	}
+	return ref, nil
+}
+
+func ociRemoteOptions(ctx context.Context, opts *OciOptions) []remote.Option {
+	result := []remote.Option{remote.WithContext(ctx)}
+	if opts.Username != "" {
+		result = append(result, remote.WithAuth(authn.FromConfig(authn.AuthConfig{Username: opts.Username, Password: opts.Password})))
+	} else if opts.Password != "" {
+		result = append(result, remote.WithAuth(authn.FromConfig(authn.AuthConfig{RegistryToken: opts.Password})))
+	} else {
+		result = append(result, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	}
+	if opts.Insecure {
+		transport := remote.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+		result = append(result, remote.WithTransport(transport))
+	}
+	return result
+}
+
+func processOciLayer(repo name.Repository, desc v1.Descriptor, outputPath string, cache *common.ContentCache, opts []remote.Option, output io.Writer) error {
+	if desc.Digest.Algorithm != "sha256" {
+		return fmt.Errorf("unsupported digest algorithm: %s", desc.Digest.Algorithm)
+	}
+
+	file, cached, err := cache.Fetch(desc.Digest.Hex, func() (io.ReadCloser, error) {
+		layer, err := remote.Layer(repo.Digest(desc.Digest.String()), opts...)
+		if err != nil {
+			return nil, err
+		}
+		return layer.Compressed()
+	})
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if cached {
+		fmt.Fprintf(output, "using the cached layer %s\n", desc.Digest.String())
+	}
+
+	title := desc.Annotations[OciTitleAnnotation]
+	if title != "" {
+		if common.IsUnsafePath(title) {
+			return fmt.Errorf("unsafe file name in the artifact: %s", title)
+		}
+		target := filepath.Join(outputPath, title)
+		if desc.Annotations[OciUnpackAnnotation] == "true" {
+			fmt.Fprintf(output, "unpacking %s\n", title)
+			return unpackOciLayer(desc.MediaType, outputPath, file)
+		}
+		fmt.Fprintf(output, "storing %s\n", title)
+		return common.WriteFile(target, file)
+	}
+
+	fmt.Fprintf(output, "unpacking layer %s\n", desc.Digest.String())
+	return unpackOciLayer(desc.MediaType, outputPath, file)
+}
+
+func unpackOciLayer(mediaType types.MediaType, dirPath string, stream io.Reader) error {
+	switch mediaType {
+	case types.OCIUncompressedLayer, types.DockerUncompressedLayer:
+		return common.UnpackTar(dirPath, stream)
+	case types.OCILayer, types.DockerLayer:
+		return common.UnpackTarball(dirPath, stream)
+	}
+	if strings.HasSuffix(string(mediaType), "tar+gzip") || strings.HasSuffix(string(mediaType), ".tar.gzip") {
+		return common.UnpackTarball(dirPath, stream)
+	}
+	return fmt.Errorf("unsupported layer media type without the %s annotation: %s", OciTitleAnnotation, mediaType)
+}
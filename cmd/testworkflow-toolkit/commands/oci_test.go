@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pushTestArtifact publishes an artifact with a tarball layer and a titled file layer to the test registry
+func pushTestArtifact(t *testing.T, host string) (string, string) {
+	img := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	img, err := mutate.Append(img,
+		mutate.Addendum{Layer: static.NewLayer(createTestTarball(t), types.OCILayer)},
+		mutate.Addendum{
+			Layer:       static.NewLayer([]byte("suite: e2e\n"), "application/vnd.testkube.suite.v1+yaml"),
+			Annotations: map[string]string{OciTitleAnnotation: "config/suite.yaml"},
+		},
+	)
+	require.NoError(t, err)
+
+	ref := host + "/tests/e2e:1.0.0"
+	tag, err := name.ParseReference(ref)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return ref, digest.String()
+}
+
+func TestProcessOci(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	ref, digest := pushTestArtifact(t, host)
+
+	t.Run("pulls and extracts the artifact by tag", func(t *testing.T) {
+		tempDir := t.TempDir()
+		outputPath := filepath.Join(tempDir, "e2e")
+
+		output := &bytes.Buffer{}
+		err := ProcessOci(context.Background(), ref, outputPath, &OciOptions{CacheDir: filepath.Join(tempDir, "cache")}, output)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(outputPath, "test.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "test content\n", string(content))
+		content, err = os.ReadFile(filepath.Join(outputPath, "config", "suite.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "suite: e2e\n", string(content))
+		assert.Contains(t, output.String(), "Resolved "+digest)
+	})
+
+	t.Run("pulls by the expected digest and reuses the cached layers", func(t *testing.T) {
+		tempDir := t.TempDir()
+		opts := &OciOptions{Digest: digest, CacheDir: filepath.Join(tempDir, "cache")}
+
+		require.NoError(t, ProcessOci(context.Background(), ref, filepath.Join(tempDir, "first"), opts, &bytes.Buffer{}))
+		output := &bytes.Buffer{}
+		require.NoError(t, ProcessOci(context.Background(), ref, filepath.Join(tempDir, "second"), opts, output))
+
+		assert.FileExists(t, filepath.Join(tempDir, "second", "test.txt"))
+		assert.Contains(t, output.String(), "@"+digest)
+		assert.Contains(t, output.String(), "using the cached layer")
+	})
+
+	t.Run("fails when the reference digest doesn't match the expected one", func(t *testing.T) {
+		opts := &OciOptions{Digest: "sha256:" + strings.Repeat("0", 64)}
+		err := ProcessOci(context.Background(), host+"/tests/e2e@"+digest, t.TempDir(), opts, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "doesn't match")
+	})
+
+	t.Run("fails for missing artifact", func(t *testing.T) {
+		err := ProcessOci(context.Background(), host+"/tests/missing:1.0.0", t.TempDir(), &OciOptions{}, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "fetch the artifact manifest")
+	})
+}
@@ -19,8 +19,11 @@ import (
 func init() {
 	RootCmd.AddCommand(NewCloneCmd())
 	RootCmd.AddCommand(NewTarballCmd())
+	RootCmd.AddCommand(NewOciCmd())
+	RootCmd.AddCommand(NewObjectCmd())
 	RootCmd.AddCommand(NewTransferCmd())
 	RootCmd.AddCommand(NewArtifactsCmd())
+	RootCmd.AddCommand(NewApprovalCmd())
 
 	// Pro functionalities
 	RootCmd.AddCommand(commands.NewExecuteCmd())
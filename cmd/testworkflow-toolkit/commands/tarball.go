@@ -24,30 +24,60 @@ var (
 	ErrInvalidPairFormat = errors.New("invalid tarball pair format, expected: path=url")
 )
 
+// TarballOptions configures the verification and caching of the downloaded tarballs
+type TarballOptions struct {
+	// Checksums maps the extraction path to the expected SHA-256 checksum of its tarball
+	Checksums map[string]string
+	// CacheDir is the directory for the verified tarballs (defaults to constants.DefaultContentCacheDir)
+	CacheDir string
+}
+
 func NewTarballCmd() *cobra.Command {
+	var (
+		checksums []string
+		cacheDir  string
+	)
 	cmd := &cobra.Command{
 		Use:   "tarball <pathUrlPairs>",
 		Short: "Download and unpack tarball file(s)",
 
 		Run: func(cmd *cobra.Command, pairs []string) {
-			exitCode := ProcessTarballs(pairs, cmd.OutOrStdout())
+			opts := TarballOptions{Checksums: make(map[string]string, len(checksums)), CacheDir: cacheDir}
+			for _, checksum := range checksums {
+				dirPath, digest, found := strings.Cut(checksum, "=")
+				if !found {
+					fmt.Fprintf(cmd.OutOrStdout(), "error: invalid checksum format, expected: path=sha256 - %s\n", checksum)
+					os.Exit(1)
+				}
+				opts.Checksums[dirPath] = digest
+			}
+			exitCode := ProcessTarballsWithOptions(pairs, opts, cmd.OutOrStdout())
 			os.Exit(exitCode)
 		},
 	}
 
+	cmd.Flags().StringArrayVar(&checksums, "sha256", nil, "expected SHA-256 checksum of the tarball for the path (format: path=sha256)")
+	cmd.Flags().StringVar(&cacheDir, "cache-dir", "", "directory to cache the verified tarballs in")
+
 	return cmd
 }
 
 // ProcessTarballs handles multiple tarball pairs
 // Returns 0 if all succeed, 1 if any fail
 func ProcessTarballs(pairs []string, output io.Writer) int {
+	return ProcessTarballsWithOptions(pairs, TarballOptions{}, output)
+}
+
+// ProcessTarballsWithOptions handles multiple tarball pairs, verifying the checksums when provided
+// Returns 0 if all succeed, 1 if any fail
+func ProcessTarballsWithOptions(pairs []string, opts TarballOptions, output io.Writer) int {
 	if len(pairs) == 0 {
 		fmt.Fprintln(output, "nothing to fetch and unpack")
 		return 0
 	}
 
 	for _, pair := range pairs {
-		if exitCode := ProcessTarballPair(pair, output); exitCode != 0 {
+		if exitCode := processTarballPair(pair, opts, output); exitCode != 0 {
 			return exitCode
 		}
 	}
@@ -57,37 +87,73 @@ func ProcessTarballs(pairs []string, output io.Writer) int {
 // ProcessTarballPair downloads and unpacks a single tarball
 // Returns 0 on success, 1 on failure
 func ProcessTarballPair(pair string, output io.Writer) int {
+	return processTarballPair(pair, TarballOptions{}, output)
+}
+
+func processTarballPair(pair string, opts TarballOptions, output io.Writer) int {
 	dirPath, url, found := strings.Cut(pair, "=")
 	if !found {
 		fmt.Fprintf(output, "error: %v - %s\n", ErrInvalidPairFormat, pair)
 		return 1
 	}
+	checksum := opts.Checksums[dirPath]
+	if checksum != "" {
+		return processVerifiedTarball(dirPath, url, checksum, common.NewContentCache(opts.CacheDir), output)
+	}
 	fmt.Fprintf(output, "Downloading and unpacking %s to %s...\n", url, dirPath)
 
 	// Start downloading the file
 	for attempt := 1; attempt <= TarballRetryMaxAttempts; attempt++ {
-		req, reqErr := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
-		if reqErr != nil {
-			fmt.Fprintf(output, "failed to create request for tarball: %s\n", reqErr.Error())
-			return 1
+		resp, err := downloadTarball(url)
+		if err == nil {
+			// Process the files
+			err = common.UnpackTarball(dirPath, resp)
+			resp.Close()
+			if err == nil {
+				return 0
+			}
+			fmt.Fprintf(output, "failed to unpack the tarball: %s\n", err.Error())
+		} else {
+			fmt.Fprintf(output, "failed to download the tarball: %s\n", err.Error())
 		}
-		resp, err := http.DefaultClient.Do(req)
-		if err == nil && resp.StatusCode != http.StatusOK {
-			err = fmt.Errorf("status code %d", resp.StatusCode)
+
+		// Check if we should retry
+		if attempt < TarballRetryMaxAttempts {
+			fmt.Fprintf(output, "retrying - attempt %d/%d.\n", attempt+1, TarballRetryMaxAttempts)
 		}
+	}
+
+	return 1
+}
 
+// processVerifiedTarball downloads the tarball to the cache, verifies its checksum and unpacks it.
+// When the tarball with the same checksum is already cached, it is not downloaded again.
+func processVerifiedTarball(dirPath, url, checksum string, cache *common.ContentCache, output io.Writer) int {
+	if _, err := common.NormalizeSha256(checksum); err != nil {
+		fmt.Fprintf(output, "error: %s\n", err.Error())
+		return 1
+	}
+	fmt.Fprintf(output, "Downloading and unpacking %s to %s (sha256: %s)...\n", url, dirPath, checksum)
+
+	for attempt := 1; attempt <= TarballRetryMaxAttempts; attempt++ {
+		file, cached, err := cache.Fetch(checksum, func() (io.ReadCloser, error) {
+			return downloadTarball(url)
+		})
+		if errors.Is(err, common.ErrChecksumMismatch) {
+			fmt.Fprintf(output, "failed to verify the tarball: %s\n", err.Error())
+			return 1
+		}
 		if err == nil {
-			// Process the files
-			err = common.UnpackTarball(dirPath, resp.Body)
-			resp.Body.Close()
+			if cached {
+				fmt.Fprintln(output, "using the cached tarball")
+			}
+			err = common.UnpackTarball(dirPath, file)
+			file.Close()
 			if err == nil {
 				return 0
 			}
 			fmt.Fprintf(output, "failed to unpack the tarball: %s\n", err.Error())
 		} else {
-			if resp != nil {
-				resp.Body.Close()
-			}
 			fmt.Fprintf(output, "failed to download the tarball: %s\n", err.Error())
 		}
 
@@ -99,3 +165,19 @@ func ProcessTarballPair(pair string, output io.Writer) int {
 
 	return 1
 }
+
+func downloadTarball(url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for tarball: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("status code %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
@@ -4,10 +4,13 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -199,6 +202,79 @@ func TestProcessTarballs(t *testing.T) {
 	})
 }
 
+func TestProcessTarballsWithOptions(t *testing.T) {
+	tarballData := createTestTarball(t)
+	sum := sha256.Sum256(tarballData)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("verifies the checksum and caches the tarball", func(t *testing.T) {
+		callCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			w.Write(tarballData)
+		}))
+		defer server.Close()
+
+		tempDir := t.TempDir()
+		opts := TarballOptions{
+			Checksums: map[string]string{
+				filepath.Join(tempDir, "dir1"): checksum,
+				filepath.Join(tempDir, "dir2"): "sha256:" + checksum,
+			},
+			CacheDir: filepath.Join(tempDir, "cache"),
+		}
+		pairs := []string{
+			filepath.Join(tempDir, "dir1") + "=" + server.URL + "/tar.tar.gz",
+			filepath.Join(tempDir, "dir2") + "=" + server.URL + "/tar.tar.gz",
+		}
+
+		output := &bytes.Buffer{}
+		exitCode := ProcessTarballsWithOptions(pairs, opts, output)
+
+		assert.Equal(t, 0, exitCode)
+		assert.Equal(t, 1, callCount, "should download the same tarball only once")
+		assert.FileExists(t, filepath.Join(tempDir, "dir1", "test.txt"))
+		assert.FileExists(t, filepath.Join(tempDir, "dir2", "test.txt"))
+		assert.Contains(t, output.String(), "using the cached tarball")
+	})
+
+	t.Run("fails without retrying on checksum mismatch", func(t *testing.T) {
+		callCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			w.Write(tarballData)
+		}))
+		defer server.Close()
+
+		tempDir := t.TempDir()
+		extractPath := filepath.Join(tempDir, "extracted")
+		opts := TarballOptions{
+			Checksums: map[string]string{extractPath: strings.Repeat("0", 64)},
+			CacheDir:  filepath.Join(tempDir, "cache"),
+		}
+
+		output := &bytes.Buffer{}
+		exitCode := ProcessTarballsWithOptions([]string{extractPath + "=" + server.URL + "/tar.tar.gz"}, opts, output)
+
+		assert.Equal(t, 1, exitCode)
+		assert.Equal(t, 1, callCount)
+		assert.Contains(t, output.String(), "checksum mismatch")
+		assert.NoDirExists(t, extractPath)
+	})
+
+	t.Run("rejects invalid checksum", func(t *testing.T) {
+		tempDir := t.TempDir()
+		extractPath := filepath.Join(tempDir, "extracted")
+		opts := TarballOptions{Checksums: map[string]string{extractPath: "invalid"}}
+
+		output := &bytes.Buffer{}
+		exitCode := ProcessTarballsWithOptions([]string{extractPath + "=http://localhost/tar.tar.gz"}, opts, output)
+
+		assert.Equal(t, 1, exitCode)
+		assert.Contains(t, output.String(), "invalid sha256 digest")
+	})
+}
+
 // createTestTarball creates a simple tarball for testing
 func createTestTarball(t *testing.T) []byte {
 	var buf bytes.Buffer
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/constants"
+)
+
+const (
+	// ContentCacheDirEnvName is the environment variable to override the content cache directory,
+	// i.e. to point it to a persistent volume shared between executions
+	ContentCacheDirEnvName = "TK_CONTENT_CACHE_DIR"
+)
+
+var (
+	// ErrChecksumMismatch is returned when the downloaded content doesn't match the expected checksum
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+
+	sha256Re = regexp.MustCompile(`^[a-f0-9]{64}$`)
+)
+
+// ContentCache stores the downloaded content addressed by its SHA-256 digest,
+// so the same content is fetched only once for the cache directory.
+type ContentCache struct {
+	dir string
+}
+
+func NewContentCache(dir string) *ContentCache {
+	if dir == "" {
+		dir = os.Getenv(ContentCacheDirEnvName)
+	}
+	if dir == "" {
+		dir = constants.DefaultContentCacheDir
+	}
+	return &ContentCache{dir: dir}
+}
+
+// NormalizeSha256 returns lower-case hex digest, accepting also the "sha256:" prefixed format.
+func NormalizeSha256(digest string) (string, error) {
+	digest = strings.ToLower(strings.TrimSpace(digest))
+	digest = strings.TrimPrefix(digest, "sha256:")
+	if !sha256Re.MatchString(digest) {
+		return "", fmt.Errorf("invalid sha256 digest: %s", digest)
+	}
+	return digest, nil
+}
+
+func (c *ContentCache) path(digest string) string {
+	return filepath.Join(c.dir, "sha256", digest)
+}
+
+// Open returns the cached content for the digest, or os.ErrNotExist when it's not cached yet.
+func (c *ContentCache) Open(digest string) (*os.File, error) {
+	digest, err := NormalizeSha256(digest)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(c.path(digest))
+}
+
+// Store reads the whole stream into the cache and returns the cached file, opened for reading.
+// When the expected digest is provided, the content is verified before it's stored.
+func (c *ContentCache) Store(expected string, src io.Reader) (*os.File, string, error) {
+	if expected != "" {
+		var err error
+		expected, err = NormalizeSha256(expected)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(c.dir, "sha256"), 0755); err != nil {
+		return nil, "", errors.Wrap(err, "create cache directory")
+	}
+	tmp, err := os.CreateTemp(c.dir, "download-*")
+	if err != nil {
+		return nil, "", errors.Wrap(err, "create temporary file")
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, hash), src)
+	_ = tmp.Close()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "download content")
+	}
+	digest := hex.EncodeToString(hash.Sum(nil))
+	if expected != "" && digest != expected {
+		return nil, digest, fmt.Errorf("%w: expected sha256:%s, got sha256:%s", ErrChecksumMismatch, expected, digest)
+	}
+
+	if err = os.Rename(tmp.Name(), c.path(digest)); err != nil {
+		return nil, digest, errors.Wrap(err, "store content in cache")
+	}
+	file, err := os.Open(c.path(digest))
+	return file, digest, err
+}
+
+// Fetch returns the cached content for the expected digest, or downloads it using the provided function.
+func (c *ContentCache) Fetch(expected string, download func() (io.ReadCloser, error)) (*os.File, bool, error) {
+	if expected != "" {
+		file, err := c.Open(expected)
+		if err == nil {
+			return file, true, nil
+		} else if !os.IsNotExist(err) {
+			return nil, false, err
+		}
+	}
+	stream, err := download()
+	if err != nil {
+		return nil, false, err
+	}
+	defer stream.Close()
+	file, _, err := c.Store(expected, stream)
+	return file, false, err
+}
@@ -85,7 +85,11 @@ func UnpackTarball(dirPath string, stream io.Reader) error {
 	if err != nil {
 		return errors.Wrap(err, "start reading gzip")
 	}
-	tarReader := tar.NewReader(uncompressedStream)
+	return UnpackTar(dirPath, uncompressedStream)
+}
+
+func UnpackTar(dirPath string, stream io.Reader) error {
+	tarReader := tar.NewReader(stream)
 
 	// Unpack them
 	for {
@@ -96,7 +100,7 @@ func UnpackTarball(dirPath string, stream io.Reader) error {
 		if err != nil {
 			return errors.Wrap(err, "get next entry from tarball")
 		}
-		if filepath.IsAbs(header.Name) || relativeCheckRe.MatchString(filepath.ToSlash(header.Name)) {
+		if IsUnsafePath(header.Name) {
 			return fmt.Errorf("unsafe file path in the tarball: %s", header.Name)
 		}
 
@@ -104,7 +108,7 @@ func UnpackTarball(dirPath string, stream io.Reader) error {
 
 		switch header.Typeflag {
 		case tar.TypeDir:
-			err := os.Mkdir(filePath, 0755)
+			err := os.MkdirAll(filePath, 0755)
 			if err != nil {
 				return errors.Wrapf(err, "%s: create directory", filePath)
 			}
@@ -138,3 +142,25 @@ func UnpackTarball(dirPath string, stream io.Reader) error {
 	}
 	return nil
 }
+
+// IsUnsafePath checks if the path may point outside the target directory.
+func IsUnsafePath(path string) bool {
+	return filepath.IsAbs(path) || relativeCheckRe.MatchString(filepath.ToSlash(path))
+}
+
+func WriteFile(filePath string, stream io.Reader) error {
+	err := os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return errors.Wrapf(err, "%s: create directory tree", filePath)
+	}
+	outFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "%s: create file", filePath)
+	}
+	_, err = io.Copy(outFile, stream)
+	_ = outFile.Close()
+	if err != nil {
+		return errors.Wrapf(err, "%s: write file", filePath)
+	}
+	return nil
+}
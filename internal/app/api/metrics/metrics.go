@@ -3,6 +3,7 @@ package metrics
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/testworkflows"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionretry"
 )
 
 var testExecutionsCount = promauto.NewCounterVec(prometheus.CounterOpts{
@@ -100,6 +102,11 @@ var testWorkflowExecutionsDurationMs = promauto.NewSummaryVec(prometheus.Summary
 	Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.95: 0.005, 0.99: 0.001},
 }, []string{"name", "result", "labels", "testworkflow_uri", "triggered_by", "tags"})
 
+var testWorkflowInfrastructureFailuresCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "testkube_testworkflow_infrastructure_failures_count",
+	Help: "The total number of test workflow executions terminated by the infrastructure, not by the tests",
+}, []string{"name", "reason", "attempt"})
+
 var testWorkflowAbortCount = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name: "testkube_testworkflow_aborts_count",
 	Help: "The total number of test workflows aborted by type events",
@@ -184,6 +191,7 @@ func NewMetrics() Metrics {
 		TestTriggerBulkDeletes:                 testTriggerBulkDeletesCount,
 		TestWorkflowExecutionsCount:            testWorkflowExecutionsCount,
 		TestWorkflowExecutionsDurationMs:       testWorkflowExecutionsDurationMs,
+		TestWorkflowInfrastructureFailures:     testWorkflowInfrastructureFailuresCount,
 		TestWorkflowAbort:                      testWorkflowAbortCount,
 		TestWorkflowCreations:                  testWorkflowCreationCount,
 		TestWorkflowUpdates:                    testWorkflowUpdatesCount,
@@ -218,6 +226,7 @@ type Metrics struct {
 	TestTriggerBulkDeletes                 *prometheus.CounterVec
 	TestWorkflowExecutionsCount            *prometheus.CounterVec
 	TestWorkflowExecutionsDurationMs       *prometheus.SummaryVec
+	TestWorkflowInfrastructureFailures     *prometheus.CounterVec
 	TestWorkflowAbort                      *prometheus.CounterVec
 	TestWorkflowCreations                  *prometheus.CounterVec
 	TestWorkflowUpdates                    *prometheus.CounterVec
@@ -483,6 +492,14 @@ func (m Metrics) IncAndObserveExecuteTestWorkflow(execution testkube.TestWorkflo
 		"tags":             strings.Join(tags, ","),
 	}).Inc()
 
+	if reason := execution.Result.InfrastructureFailureReason(); reason != "" {
+		m.TestWorkflowInfrastructureFailures.With(map[string]string{
+			"name":    name,
+			"reason":  reason,
+			"attempt": strconv.Itoa(executionretry.Attempt(&execution)),
+		}).Inc()
+	}
+
 	if execution.Result != nil {
 		m.TestWorkflowExecutionsDurationMs.With(map[string]string{
 			"name":             name,
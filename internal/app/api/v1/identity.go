@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const identityLocalsKey = "testkube-identity"
+
+// IdentityMiddleware authenticates the request with the bearer token from the Identities,
+// and stores the identity for the handlers that need to know who takes the action.
+// Requests without a known token are passed through without the identity.
+func (s *TestkubeAPI) IdentityMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// The identity can't be injected by the request itself
+		c.Locals(identityLocalsKey, nil)
+		token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+		if !ok || token == "" {
+			return c.Next()
+		}
+		for known, name := range s.Identities {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(known)) == 1 {
+				c.Locals(identityLocalsKey, name)
+				break
+			}
+		}
+		return c.Next()
+	}
+}
+
+// requestIdentity returns the authenticated identity of the request, or empty string if there is none
+func requestIdentity(c *fiber.Ctx) string {
+	name, _ := c.Locals(identityLocalsKey).(string)
+	return name
+}
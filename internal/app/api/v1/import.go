@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
+)
+
+// ImportTestWorkflowExecutionHandler recreates the execution from the archive sent as the request body,
+// signed with one of the trusted keys. The imported execution is read-only.
+func (s *TestkubeAPI) ImportTestWorkflowExecutionHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		errPrefix := "failed to import test workflow execution"
+		if s.ExecutionImporter == nil {
+			return s.Error(c, http.StatusNotImplemented, fmt.Errorf("%s: import is not supported on this instance", errPrefix))
+		}
+
+		// The archives are larger than the default body limit, so they are read from the stream when it's available.
+		// The stream may be left unread on failure, so the connection can't be reused.
+		body := c.Context().RequestBodyStream()
+		if body != nil {
+			c.Context().SetConnectionClose()
+		} else {
+			body = bytes.NewReader(c.Body())
+		}
+
+		if len(s.ImportTrustedKeys) == 0 {
+			return s.Error(c, http.StatusForbidden, fmt.Errorf("%s: there are no trusted signing keys configured for the import", errPrefix))
+		}
+
+		dir, err := os.MkdirTemp("", "testkube-import-*")
+		if err != nil {
+			return s.InternalError(c, errPrefix, "creating temporary directory", err)
+		}
+		defer os.RemoveAll(dir)
+
+		maxSize := s.ImportArchiveMaxSize
+		if maxSize <= 0 {
+			maxSize = defaultMaxSize
+		}
+		if int64(c.Request().Header.ContentLength()) > maxSize {
+			return s.Error(c, http.StatusRequestEntityTooLarge, fmt.Errorf("%s: the archive exceeds %d bytes", errPrefix, maxSize))
+		}
+		maxExtractedSize := s.ImportArchiveMaxExtractedSize
+		if maxExtractedSize <= 0 {
+			maxExtractedSize = executionarchive.DefaultMaxExtractedSize
+		}
+		archive, err := executionarchive.Extract(io.LimitReader(body, maxSize), dir, maxExtractedSize)
+		if errors.Is(err, executionarchive.ErrArchiveTooLarge) {
+			return s.Error(c, http.StatusRequestEntityTooLarge, fmt.Errorf("%s: %w", errPrefix, err))
+		} else if err != nil {
+			return s.BadRequest(c, errPrefix, "invalid archive", err)
+		}
+		if !isTrustedArchive(archive, s.ImportTrustedKeys) {
+			return s.Error(c, http.StatusForbidden, fmt.Errorf("%s: %w", errPrefix, executionarchive.ErrUntrustedKey))
+		}
+
+		execution, err := s.ExecutionImporter.Import(c.Context(), archive)
+		switch {
+		case errors.Is(err, executionarchive.ErrExecutionExists):
+			return s.Error(c, http.StatusConflict, fmt.Errorf("%s: %w", errPrefix, err))
+		case errors.Is(err, executionarchive.ErrExecutionNotFinished):
+			return s.BadRequest(c, errPrefix, "invalid execution", err)
+		case err != nil:
+			return s.InternalError(c, errPrefix, "storing execution", err)
+		}
+
+		s.Log.Infow("imported test workflow execution", "id", execution.Id, "signer", archive.Manifest.Fingerprint())
+		c.Status(http.StatusCreated)
+		return c.JSON(execution)
+	}
+}
+
+// isTrustedArchive checks if the archive is signed with any of the trusted keys
+func isTrustedArchive(archive *executionarchive.Archive, trusted []ed25519.PublicKey) bool {
+	for _, key := range trusted {
+		if archive.Verify(key) == nil {
+			return true
+		}
+	}
+	return false
+}
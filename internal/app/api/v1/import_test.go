@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/server"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
+)
+
+func buildImportArchive(t *testing.T, key ed25519.PrivateKey, artifactSize int) []byte {
+	buf := &bytes.Buffer{}
+	writer := executionarchive.NewWriter(buf, key)
+	require.NoError(t, writer.WriteExecution(testkube.TestWorkflowExecution{
+		Id:       "exec-1",
+		Name:     "api-tests-1",
+		Workflow: &testkube.TestWorkflow{Name: "api-tests"},
+		Result: &testkube.TestWorkflowResult{
+			Status:     common.Ptr(testkube.PASSED_TestWorkflowStatus),
+			FinishedAt: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		},
+	}))
+	if artifactSize > 0 {
+		// The random content doesn't compress, so the archive is larger than the default body limit
+		content := make([]byte, artifactSize)
+		_, _ = rand.New(rand.NewSource(1)).Read(content)
+		require.NoError(t, writer.WriteArtifact("data.bin", int64(len(content)), bytes.NewReader(content)))
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestImportTestWorkflowExecutionHandler(t *testing.T) {
+	trusted, err := executionarchive.GenerateKey()
+	require.NoError(t, err)
+	other, err := executionarchive.GenerateKey()
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		trustedKeys []ed25519.PublicKey
+		key         ed25519.PrivateKey
+		wantStatus  int
+	}{
+		"403 without the trusted keys": {
+			key:        trusted,
+			wantStatus: http.StatusForbidden,
+		},
+		"403 for the archive signed with the untrusted key": {
+			trustedKeys: []ed25519.PublicKey{trusted.Public().(ed25519.PublicKey)},
+			key:         other,
+			wantStatus:  http.StatusForbidden,
+		},
+		"201 for the archive signed with the trusted key": {
+			trustedKeys: []ed25519.PublicKey{other.Public().(ed25519.PublicKey), trusted.Public().(ed25519.PublicKey)},
+			key:         trusted,
+			wantStatus:  http.StatusCreated,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			results := testworkflow.NewMockRepository(ctrl)
+			output := testworkflow.NewMockOutputRepository(ctrl)
+			if tc.wantStatus == http.StatusCreated {
+				results.EXPECT().Get(gomock.Any(), gomock.Any()).Return(testkube.TestWorkflowExecution{}, mongo.ErrNoDocuments).Times(2)
+				results.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			testAPI := &TestkubeAPI{
+				Log:               log.DefaultLogger,
+				ExecutionImporter: executionarchive.NewImporter(results, output, nil, "artifacts"),
+				ImportTrustedKeys: tc.trustedKeys,
+			}
+			httpServer := server.NewServer(server.Config{})
+			httpServer.Routes.Post("/test-workflow-executions/import", testAPI.ImportTestWorkflowExecutionHandler())
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/v1/test-workflow-executions/import", bytes.NewReader(buildImportArchive(t, tc.key, 0)))
+			resp, err := httpServer.Mux.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestImportTestWorkflowExecutionHandler_BodyLimit(t *testing.T) {
+	key, err := executionarchive.GenerateKey()
+	require.NoError(t, err)
+	archive := buildImportArchive(t, key, 2*1024*1024)
+
+	testAPI := &TestkubeAPI{
+		Log:               log.DefaultLogger,
+		ExecutionImporter: executionarchive.NewImporter(nil, nil, nil, "artifacts"),
+		// The archive is extracted before the signer is checked, so the size limits apply first
+		ImportTrustedKeys:    []ed25519.PublicKey{make(ed25519.PublicKey, ed25519.PublicKeySize)},
+		ImportArchiveMaxSize: 4 * 1024 * 1024,
+	}
+	httpServer := server.NewServer(server.Config{
+		Http:              fiber.Config{BodyLimit: 1024 * 1024},
+		StreamedBodyPaths: []string{"/v1/test-workflow-executions/import"},
+	})
+	httpServer.Routes.Post("/test-workflow-executions/import", testAPI.ImportTestWorkflowExecutionHandler())
+	httpServer.Routes.Post("/test-workflows", func(c *fiber.Ctx) error {
+		return c.SendStatus(http.StatusNoContent)
+	})
+
+	send := func(path string, body []byte) int {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, path, bytes.NewReader(body))
+		resp, err := httpServer.Mux.Test(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// Only the import route accepts the bodies over the default limit
+	assert.Equal(t, http.StatusRequestEntityTooLarge, send("/v1/test-workflows", archive))
+	assert.Equal(t, http.StatusNoContent, send("/v1/test-workflows", []byte(`{}`)))
+	assert.Equal(t, http.StatusForbidden, send("/v1/test-workflow-executions/import", archive))
+
+	testAPI.ImportArchiveMaxSize = 1024 * 1024
+	assert.Equal(t, http.StatusRequestEntityTooLarge, send("/v1/test-workflow-executions/import", archive))
+
+	testAPI.ImportArchiveMaxSize = 4 * 1024 * 1024
+	testAPI.ImportArchiveMaxExtractedSize = 1024 * 1024
+	assert.Equal(t, http.StatusRequestEntityTooLarge, send("/v1/test-workflow-executions/import", archive))
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+)
+
+// SearchTestWorkflowExecutionLogsHandler finds the lines of the indexed execution logs
+// containing all the words of the ?query, starting from the latest execution.
+// The results may be narrowed with ?workflow (repeatable or comma-separated) and ?since (duration).
+func (s *TestkubeAPI) SearchTestWorkflowExecutionLogsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		errPrefix := "failed to search test workflow execution logs"
+		if s.LogSearch == nil {
+			return s.Error(c, http.StatusNotImplemented, fmt.Errorf("%s: log search is not configured on this instance", errPrefix))
+		}
+
+		options := logsearch.SearchOptions{
+			Query:        c.Query("query"),
+			Limit:        c.QueryInt("limit", logsearch.DefaultLimit),
+			ContextLines: c.QueryInt("context", logsearch.DefaultContextLines),
+		}
+		for _, value := range c.Context().QueryArgs().PeekMulti("workflow") {
+			for _, name := range strings.Split(string(value), ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					options.WorkflowNames = append(options.WorkflowNames, name)
+				}
+			}
+		}
+		if since := c.Query("since"); since != "" {
+			duration, err := time.ParseDuration(since)
+			if err != nil {
+				return s.BadRequest(c, errPrefix, "invalid since duration", err)
+			}
+			options.Since = time.Now().Add(-duration)
+		}
+
+		matches, err := logsearch.Search(c.Context(), s.LogSearch, options)
+		if errors.Is(err, logsearch.ErrEmptyQuery) {
+			return s.BadRequest(c, errPrefix, "invalid query", err)
+		}
+		if err != nil {
+			return s.Error(c, http.StatusBadGateway, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+		return c.JSON(matches)
+	}
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+)
+
+func TestSearchTestWorkflowExecutionLogsHandler(t *testing.T) {
+	document := logsearch.Document{
+		ExecutionId:   "exec-1",
+		ExecutionName: "api-tests-1",
+		WorkflowName:  "api-tests",
+		StepRef:       "rstep1",
+		FirstLine:     1,
+		Content:       "starting\nError: read ECONNRESET",
+	}
+
+	tests := map[string]struct {
+		repository func(ctrl *gomock.Controller) logsearch.Repository
+		query      string
+		wantStatus int
+		wantLines  []int32
+	}{
+		"501 when log search is not configured": {
+			repository: func(*gomock.Controller) logsearch.Repository { return nil },
+			query:      "?query=econnreset",
+			wantStatus: http.StatusNotImplemented,
+		},
+		"400 when the query has no words": {
+			repository: func(ctrl *gomock.Controller) logsearch.Repository { return logsearch.NewMockRepository(ctrl) },
+			query:      "?query=%3A",
+			wantStatus: http.StatusBadRequest,
+		},
+		"400 when since is not a duration": {
+			repository: func(ctrl *gomock.Controller) logsearch.Repository { return logsearch.NewMockRepository(ctrl) },
+			query:      "?query=econnreset&since=week",
+			wantStatus: http.StatusBadRequest,
+		},
+		"502 when the index fails": {
+			repository: func(ctrl *gomock.Controller) logsearch.Repository {
+				repo := logsearch.NewMockRepository(ctrl)
+				repo.EXPECT().Find(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+				return repo
+			},
+			query:      "?query=econnreset",
+			wantStatus: http.StatusBadGateway,
+		},
+		"200 with the matched lines": {
+			repository: func(ctrl *gomock.Controller) logsearch.Repository {
+				repo := logsearch.NewMockRepository(ctrl)
+				repo.EXPECT().Find(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter logsearch.Filter) ([]logsearch.Document, error) {
+					assert.Equal(t, []string{"econnreset"}, filter.Terms)
+					assert.Equal(t, []string{"api-tests", "ui-tests"}, filter.WorkflowNames)
+					assert.WithinDuration(t, time.Now().Add(-168*time.Hour), filter.Since, time.Minute)
+					return []logsearch.Document{document}, nil
+				})
+				return repo
+			},
+			query:      "?query=ECONNRESET&workflow=api-tests,ui-tests&since=168h",
+			wantStatus: http.StatusOK,
+			wantLines:  []int32{2},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			testAPI := &TestkubeAPI{
+				LogSearch: tc.repository(ctrl),
+				Log:       log.DefaultLogger,
+			}
+			app := fiber.New()
+			app.Get("/test-workflow-executions/logs/search", testAPI.SearchTestWorkflowExecutionLogsHandler())
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/test-workflow-executions/logs/search"+tc.query, nil)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			var got []testkube.TestWorkflowExecutionLogMatch
+			require.NoError(t, json.Unmarshal(body, &got))
+			gotLines := make([]int32, 0, len(got))
+			for _, match := range got {
+				gotLines = append(gotLines, match.Line)
+			}
+			assert.Equal(t, tc.wantLines, gotLines)
+		})
+	}
+}
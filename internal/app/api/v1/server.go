@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"crypto/ed25519"
+
 	"go.uber.org/zap"
 
 	"github.com/kubeshop/testkube/internal/app/api/metrics"
@@ -18,10 +20,12 @@ import (
 	executorsclientv1 "github.com/kubeshop/testkube/pkg/operator/client/executors/v1"
 	testworkflowsv1 "github.com/kubeshop/testkube/pkg/operator/client/testworkflows/v1"
 	repoConfig "github.com/kubeshop/testkube/pkg/repository/config"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
 	"github.com/kubeshop/testkube/pkg/secretmanager"
 	"github.com/kubeshop/testkube/pkg/server"
 	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
 	"github.com/kubeshop/testkube/pkg/testworkflows/executionworker/executionworkertypes"
 	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowexecutor"
 )
@@ -136,9 +140,27 @@ type TestkubeAPI struct {
 
 	// Optional; when nil the /cluster-resources endpoint returns 501.
 	ClusterDiscoverer *clusterdiscovery.Discoverer
+
+	// Optional; when nil the /test-workflow-executions/logs/search endpoint returns 501.
+	LogSearch logsearch.Repository
+
+	// Optional; when nil the /test-workflow-executions/import endpoint returns 501.
+	ExecutionImporter *executionarchive.Importer
+	// ImportTrustedKeys are the public keys the imported archives must be signed with.
+	// Optional; when empty, the import is refused.
+	ImportTrustedKeys []ed25519.PublicKey
+	// ImportArchiveMaxSize limits the uploaded archive, and ImportArchiveMaxExtractedSize its extracted files.
+	ImportArchiveMaxSize          int64
+	ImportArchiveMaxExtractedSize int64
+
+	// Identities maps the API bearer tokens to the names of their users.
+	// Optional; when empty, the requests have no authenticated identity.
+	Identities map[string]string
 }
 
 func (s *TestkubeAPI) Init(server server.HTTPServer) {
+	server.Routes.Use(s.IdentityMiddleware())
+
 	// TODO: Consider extracting outside?
 	server.Routes.Get("/info", s.InfoHandler())
 	server.Routes.Get("/debug", s.DebugHandler())
@@ -177,18 +199,25 @@ func (s *TestkubeAPI) Init(server server.HTTPServer) {
 	testWorkflows.Post("/:id/executions", s.ExecuteTestWorkflowHandler())
 	testWorkflows.Get("/:id/tags", s.ListTagsHandler())
 	testWorkflows.Get("/:id/metrics", s.GetTestWorkflowMetricsHandler())
+	testWorkflows.Get("/:id/triage/stats", s.GetTestWorkflowTriageStatsHandler())
 	testWorkflows.Get("/:id/executions/:executionID", s.GetTestWorkflowExecutionHandler())
 	testWorkflows.Post("/:id/abort", s.AbortAllTestWorkflowExecutionsHandler())
 	testWorkflows.Post("/:id/executions/:executionID/abort", s.AbortTestWorkflowExecutionHandler())
 	testWorkflows.Post("/:id/executions/:executionID/pause", s.PauseTestWorkflowExecutionHandler())
 	testWorkflows.Post("/:id/executions/:executionID/resume", s.ResumeTestWorkflowExecutionHandler())
+	testWorkflows.Post("/:id/executions/:executionID/approve", s.ApproveTestWorkflowExecutionHandler())
+	testWorkflows.Post("/:id/executions/:executionID/reject", s.RejectTestWorkflowExecutionHandler())
 	testWorkflows.Get("/:id/executions/:executionID/logs", s.GetTestWorkflowExecutionLogsHandler())
 	testWorkflows.Post("/:id/executions/:executionID/rerun", s.ReRunTestWorkflowExecutionHandler())
 	testWorkflows.Patch("/:id/executions/:executionID/tags", s.UpdateTestWorkflowExecutionTagsHandler())
+	testWorkflows.Patch("/:id/executions/:executionID/triage", s.UpdateTestWorkflowExecutionTriageHandler())
 
 	testWorkflowExecutions := root.Group("/test-workflow-executions")
 	testWorkflowExecutions.Get("/", s.ListTestWorkflowExecutionsHandler())
 	testWorkflowExecutions.Post("/", s.ExecuteTestWorkflowHandler())
+	testWorkflowExecutions.Get("/logs/search", s.SearchTestWorkflowExecutionLogsHandler())
+	testWorkflowExecutions.Post("/import", s.ImportTestWorkflowExecutionHandler())
+	testWorkflowExecutions.Get("/triage/stats", s.GetTestWorkflowTriageStatsHandler())
 	testWorkflowExecutions.Get("/:executionID", s.GetTestWorkflowExecutionHandler())
 	testWorkflowExecutions.Get("/:executionID/notifications", s.StreamTestWorkflowExecutionNotificationsHandler())
 	testWorkflowExecutions.Get("/:executionID/notifications/services/:serviceName/:serviceIndex<int>", s.StreamTestWorkflowExecutionServiceNotificationsHandler())
@@ -199,12 +228,15 @@ func (s *TestkubeAPI) Init(server server.HTTPServer) {
 	testWorkflowExecutions.Post("/:executionID/abort", s.AbortTestWorkflowExecutionHandler())
 	testWorkflowExecutions.Post("/:executionID/pause", s.PauseTestWorkflowExecutionHandler())
 	testWorkflowExecutions.Post("/:executionID/resume", s.ResumeTestWorkflowExecutionHandler())
+	testWorkflowExecutions.Post("/:executionID/approve", s.ApproveTestWorkflowExecutionHandler())
+	testWorkflowExecutions.Post("/:executionID/reject", s.RejectTestWorkflowExecutionHandler())
 	testWorkflowExecutions.Get("/:executionID/logs", s.GetTestWorkflowExecutionLogsHandler())
 	testWorkflowExecutions.Get("/:executionID/artifacts", s.ListTestWorkflowExecutionArtifactsHandler())
 	testWorkflowExecutions.Get("/:executionID/artifacts/:filename", s.GetTestWorkflowArtifactHandler())
 	testWorkflowExecutions.Get("/:executionID/artifact-archive", s.GetTestWorkflowArtifactArchiveHandler())
 	testWorkflowExecutions.Post("/:executionID/rerun", s.ReRunTestWorkflowExecutionHandler())
 	testWorkflowExecutions.Patch("/:executionID/tags", s.UpdateTestWorkflowExecutionTagsHandler())
+	testWorkflowExecutions.Patch("/:executionID/triage", s.UpdateTestWorkflowExecutionTriageHandler())
 
 	testWorkflowWithExecutions := root.Group("/test-workflow-with-executions")
 	testWorkflowWithExecutions.Get("/", s.ListTestWorkflowWithExecutionsHandler())
@@ -218,7 +218,7 @@ func (s *TestkubeAPI) UpdateTestTriggerHandler() fiber.Handler {
 		// merge that changes Event without sending Match would otherwise slip
 		// past an empty-request check and persist an incompatible combination
 		// (e.g. event=created with a preserved changed_to match).
-		validatedCRDTrigger := testtriggersmapper.MapTestTriggerUpsertRequestToTestTriggerCRD(mapAPITestTriggerToUpsertRequest(apiTrigger))
+		validatedCRDTrigger := testtriggersmapper.MapTestTriggerUpsertRequestToTestTriggerCRD(testtriggersmapper.MapAPIToTestTriggerUpsertRequest(*apiTrigger))
 		if errs := validatedCRDTrigger.Spec.Validate(); len(errs) > 0 {
 			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, errors.Join(errs...)))
 		}
@@ -405,32 +405,6 @@ func (s *TestkubeAPI) GetTestTriggerKeyMapHandler() fiber.Handler {
 	}
 }
 
-func mapAPITestTriggerToUpsertRequest(trigger *testkube.TestTrigger) testkube.TestTriggerUpsertRequest {
-	return testkube.TestTriggerUpsertRequest{
-		Namespace:         trigger.Namespace,
-		Name:              trigger.Name,
-		Labels:            trigger.Labels,
-		Annotations:       trigger.Annotations,
-		Selector:          trigger.Selector,
-		Resource:          trigger.Resource,
-		ResourceRef:       trigger.ResourceRef,
-		ResourceSelector:  trigger.ResourceSelector,
-		Event:             trigger.Event,
-		Match:             trigger.Match,
-		ConditionSpec:     trigger.ConditionSpec,
-		ProbeSpec:         trigger.ProbeSpec,
-		ContentSelector:   trigger.ContentSelector,
-		Action:            trigger.Action,
-		ActionParameters:  trigger.ActionParameters,
-		Execution:         trigger.Execution,
-		TestSelector:      trigger.TestSelector,
-		ConcurrencyPolicy: trigger.ConcurrencyPolicy,
-		Disabled:          trigger.Disabled,
-		Sync:              trigger.Sync,
-		Listener:          trigger.Listener,
-	}
-}
-
 // generateTestTriggerName function generates a trigger name from the TestTrigger spec
 // function also takes care of name collisions, not exceeding k8s max object name (63 characters) and not ending with a hyphen '-'
 func generateTestTriggerName(t *testtriggersv1.TestTrigger) string {
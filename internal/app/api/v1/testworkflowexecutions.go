@@ -22,7 +22,9 @@ import (
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/datefilter"
 	testworkflow2 "github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
 	"github.com/kubeshop/testkube/pkg/testworkflows/executionworker/executionworkertypes"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
 )
 
 const workflowNotificationHeartbeatInterval = 20 * time.Second
@@ -508,14 +510,47 @@ func (s *TestkubeAPI) GetTestWorkflowExecutionHandler() fiber.Handler {
 	}
 }
 
+// parseLogReadOptions reads the part of the log to return from the ?step, ?since (time or duration), ?tail, ?offset and ?length
+func parseLogReadOptions(c *fiber.Ctx) (options testworkflowlogs.ReadOptions, err error) {
+	options.Step = c.Query("step")
+	if since := c.Query("since"); since != "" {
+		if options.Since, err = time.Parse(time.RFC3339Nano, since); err != nil {
+			duration, durationErr := time.ParseDuration(since)
+			if durationErr != nil {
+				return options, fmt.Errorf("invalid since: %s is neither time nor duration", since)
+			}
+			options.Since, err = time.Now().Add(-duration), nil
+		}
+	}
+	numbers := map[string]*int64{"offset": &options.Offset, "length": &options.Length}
+	for name, target := range numbers {
+		if value := c.Query(name); value != "" {
+			if *target, err = strconv.ParseInt(value, 10, 64); err != nil || *target < 0 {
+				return options, fmt.Errorf("invalid %s: %s", name, value)
+			}
+		}
+	}
+	if tail := c.Query("tail"); tail != "" {
+		if options.Tail, err = strconv.Atoi(tail); err != nil || options.Tail < 0 {
+			return options, fmt.Errorf("invalid tail: %s", tail)
+		}
+	}
+	return options, nil
+}
+
 func (s *TestkubeAPI) GetTestWorkflowExecutionLogsHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		ctx := c.Context()
 		id := c.Params("id", "")
 		executionID := c.Params("executionID")
+		errPrefix := fmt.Sprintf("failed to get test workflow execution logs '%s'", executionID)
+
+		options, err := parseLogReadOptions(c)
+		if err != nil {
+			return s.BadRequest(c, errPrefix, "invalid query", err)
+		}
 
 		var execution testkube.TestWorkflowExecution
-		var err error
 		if id == "" {
 			execution, err = s.TestWorkflowResults.Get(ctx, executionID)
 		} else {
@@ -525,7 +560,23 @@ func (s *TestkubeAPI) GetTestWorkflowExecutionLogsHandler() fiber.Handler {
 			return s.ClientError(c, "get execution", err)
 		}
 
-		rc, err := s.TestWorkflowOutput.ReadLog(ctx, executionID, execution.Workflow.Name)
+		// Stream the live log, when the execution is still running
+		if c.QueryBool("follow") && (execution.Result == nil || !execution.Result.IsFinished()) {
+			notifications := s.ExecutionWorkerClient.Notifications(ctx, execution.Id, executionworkertypes.NotificationsOptions{
+				Hints: executionworkertypes.Hints{
+					Namespace:   execution.Namespace,
+					ScheduledAt: common.Ptr(execution.ScheduledAt),
+					Signature:   execution.Signature,
+				},
+			})
+			if notifications.Err() != nil {
+				return s.BadRequest(c, errPrefix, "fetching notifications", notifications.Err())
+			}
+			s.streamLogs(ctx, execution.Id, notifications, options)
+			return nil
+		}
+
+		rc, err := s.TestWorkflowOutput.ReadLogWithOptions(ctx, execution.Id, execution.Workflow.Name, options)
 		if err != nil {
 			return s.InternalError(c, "can't get log", executionID, err)
 		}
@@ -537,6 +588,54 @@ func (s *TestkubeAPI) GetTestWorkflowExecutionLogsHandler() fiber.Handler {
 	}
 }
 
+// streamLogs writes the live log lines matching the options.
+// The byte range is not known for the live log, so it is ignored,
+// while the tail is applied to the lines printed before the request.
+func (s *TestkubeAPI) streamLogs(ctx *fasthttp.RequestCtx, id string, notifications executionworkertypes.NotificationsWatcher, options testworkflowlogs.ReadOptions) {
+	ctx.SetContentType(mediaTypePlainText)
+	ctx.Response.Header.Set("Cache-Control", "no-cache")
+	ctx.Response.Header.Set("Transfer-Encoding", "chunked")
+
+	requestedAt := time.Now()
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		var tail []string
+		if options.Tail > 0 {
+			tail = make([]string, 0, options.Tail)
+		}
+		flushTail := func() {
+			for _, line := range tail {
+				_, _ = w.WriteString(line)
+			}
+			tail = nil
+		}
+		for n := range notifications.Channel() {
+			if n.Log == "" || n.Temporary || (options.Step != "" && n.Ref != options.Step) || (!options.Since.IsZero() && n.Ts.Before(options.Since)) {
+				continue
+			}
+			if tail != nil && n.Ts.Before(requestedAt) {
+				if len(tail) == options.Tail {
+					tail = tail[1:]
+				}
+				tail = append(tail, n.Log)
+				continue
+			}
+			flushTail()
+			if _, err := w.WriteString(n.Log); err != nil {
+				s.Log.Errorw("could not write log line", "error", err, "id", id)
+				return
+			}
+			if err := w.Flush(); err != nil {
+				s.Log.Errorw("could not flush stream body", "error", err, "id", id)
+				return
+			}
+		}
+		flushTail()
+		if err := w.Flush(); err != nil {
+			s.Log.Errorw("could not flush stream body", "error", err, "id", id)
+		}
+	})
+}
+
 func (s *TestkubeAPI) AbortTestWorkflowExecutionHandler() fiber.Handler {
 	if !s.isStandalone {
 		return s.abortTestWorkflowExecutionHandlerPro() //nolint
@@ -950,6 +1049,9 @@ func (s *TestkubeAPI) UpdateTestWorkflowExecutionTagsHandler() fiber.Handler {
 		if err != nil {
 			return s.ClientError(c, errPrefix, err)
 		}
+		if executionarchive.IsImported(&execution) {
+			return s.Error(c, http.StatusForbidden, fmt.Errorf("%s: the imported execution is read-only", errPrefix))
+		}
 
 		if err := s.TestWorkflowResults.UpdateTags(ctx, execution.Id, tags); err != nil {
 			return s.ClientError(c, errPrefix, err)
@@ -960,6 +1062,82 @@ func (s *TestkubeAPI) UpdateTestWorkflowExecutionTagsHandler() fiber.Handler {
 	}
 }
 
+func (s *TestkubeAPI) ApproveTestWorkflowExecutionHandler() fiber.Handler {
+	return s.decideTestWorkflowExecutionApprovalHandler(testkube.APPROVED_TestWorkflowApprovalDecision)
+}
+
+func (s *TestkubeAPI) RejectTestWorkflowExecutionHandler() fiber.Handler {
+	return s.decideTestWorkflowExecutionApprovalHandler(testkube.REJECTED_TestWorkflowApprovalDecision)
+}
+
+func (s *TestkubeAPI) decideTestWorkflowExecutionApprovalHandler(decision testkube.TestWorkflowApprovalDecision) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		name := c.Params("id")
+		executionID := c.Params("executionID")
+		errPrefix := fmt.Sprintf("failed to record the decision for test workflow execution '%s'", executionID)
+
+		var request testkube.TestWorkflowApprovalRequest
+		if body := c.Body(); len(body) > 0 {
+			if err := json.Unmarshal(body, &request); err != nil {
+				return s.BadRequest(c, errPrefix, "invalid request body", err)
+			}
+		}
+
+		var execution testkube.TestWorkflowExecution
+		var err error
+		if name == "" {
+			execution, err = s.TestWorkflowResults.Get(ctx, executionID)
+		} else {
+			execution, err = s.TestWorkflowResults.GetByNameAndTestWorkflow(ctx, executionID, name)
+		}
+		if err != nil {
+			return s.ClientError(c, errPrefix, err)
+		}
+
+		// Find the approval request to decide on
+		pending := execution.PendingApprovals()
+		var approval *testkube.TestWorkflowApproval
+		for i := range pending {
+			if request.Step == "" || pending[i].Ref == request.Step {
+				approval = &pending[i]
+				break
+			}
+		}
+		switch {
+		case approval == nil && request.Step != "":
+			return s.BadRequest(c, errPrefix, "checking approval", fmt.Errorf("step '%s' is not waiting for the approval", request.Step))
+		case approval == nil:
+			return s.BadRequest(c, errPrefix, "checking approval", errors.New("execution is not waiting for the approval"))
+		case request.Step == "" && len(pending) > 1:
+			return s.BadRequest(c, errPrefix, "checking approval", errors.New("multiple steps are waiting for the approval, select the step"))
+		}
+		// Only the authenticated identity is trusted to be one of the allowed approvers
+		reviewer := requestIdentity(c)
+		if len(approval.Approvers) > 0 && reviewer == "" {
+			return s.Error(c, http.StatusForbidden, fmt.Errorf("%s: the approval is restricted to the selected approvers, authenticate with the API token", errPrefix))
+		}
+		if !approval.CanDecide(reviewer) {
+			return s.Error(c, http.StatusForbidden, fmt.Errorf("%s: reviewer '%s' is not allowed to decide", errPrefix, reviewer))
+		}
+
+		approval.Decision = common.Ptr(decision)
+		approval.DecidedBy = reviewer
+		approval.DecidedAt = time.Now().UTC()
+		approval.Comment = request.Comment
+		added, err := s.TestWorkflowResults.AddApproval(ctx, execution.Id, *approval)
+		if err != nil {
+			return s.ClientError(c, errPrefix, err)
+		}
+		if !added {
+			return s.BadRequest(c, errPrefix, "checking approval", errors.New("decision has been already taken"))
+		}
+
+		c.Status(http.StatusNoContent)
+		return nil
+	}
+}
+
 func getWorkflowExecutionsFilterFromRequest(c *fiber.Ctx) testworkflow2.Filter {
 	filter := testworkflow2.NewExecutionsFilter()
 	name := c.Params("id", "")
@@ -1021,5 +1199,20 @@ func getWorkflowExecutionsFilterFromRequest(c *fiber.Ctx) testworkflow2.Filter {
 		filter = filter.WithActorType(testkube.TestWorkflowRunningContextActorType(actorType))
 	}
 
+	triageCategory := c.Query("triageCategory")
+	if triageCategory != "" {
+		filter = filter.WithTriageCategory(testkube.TestWorkflowTriageCategory(triageCategory))
+	}
+
+	triageAssignee := c.Query("triageAssignee")
+	if triageAssignee != "" {
+		filter = filter.WithTriageAssignee(triageAssignee)
+	}
+
+	triaged, err := strconv.ParseBool(c.Query("triaged", ""))
+	if err == nil {
+		filter = filter.WithTriaged(triaged)
+	}
+
 	return filter
 }
@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+)
+
+func TestApproveTestWorkflowExecutionHandler(t *testing.T) {
+	execution := func(approvers ...string) testkube.TestWorkflowExecution {
+		return testkube.TestWorkflowExecution{
+			Id: "exec-1",
+			Result: &testkube.TestWorkflowResult{
+				Status: common.Ptr(testkube.RUNNING_TestWorkflowStatus),
+				Steps: map[string]testkube.TestWorkflowStepResult{
+					"gate": {Approval: &testkube.TestWorkflowApproval{Approvers: approvers}},
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		execution    testkube.TestWorkflowExecution
+		token        string
+		body         string
+		wantStatus   int
+		wantReviewer string
+	}{
+		"403 for the self-declared approver": {
+			execution:  execution("alice"),
+			body:       `{"reviewer":"alice"}`,
+			wantStatus: http.StatusForbidden,
+		},
+		"403 for the unknown token": {
+			execution:  execution("alice"),
+			token:      "forged",
+			wantStatus: http.StatusForbidden,
+		},
+		"403 for the authenticated user not allowed to decide": {
+			execution:  execution("alice"),
+			token:      "bob-token",
+			wantStatus: http.StatusForbidden,
+		},
+		"204 for the authenticated approver": {
+			execution:    execution("alice"),
+			token:        "alice-token",
+			wantStatus:   http.StatusNoContent,
+			wantReviewer: "alice",
+		},
+		"204 for anyone without the approvers": {
+			execution:  execution(),
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			results := testworkflow.NewMockRepository(ctrl)
+			results.EXPECT().Get(gomock.Any(), "exec-1").Return(tc.execution, nil)
+			if tc.wantStatus == http.StatusNoContent {
+				results.EXPECT().AddApproval(gomock.Any(), "exec-1", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, approval testkube.TestWorkflowApproval) (bool, error) {
+						assert.Equal(t, tc.wantReviewer, approval.DecidedBy)
+						assert.True(t, approval.IsApproved())
+						return true, nil
+					})
+			}
+			testAPI := &TestkubeAPI{
+				TestWorkflowResults: results,
+				Log:                 log.DefaultLogger,
+				Identities:          map[string]string{"alice-token": "alice", "bob-token": "bob"},
+			}
+			app := fiber.New()
+			app.Use(testAPI.IdentityMiddleware())
+			app.Post("/test-workflow-executions/:executionID/approve", testAPI.ApproveTestWorkflowExecutionHandler())
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/test-workflow-executions/exec-1/approve", strings.NewReader(tc.body))
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+		})
+	}
+}
@@ -86,6 +86,12 @@ func (s *TestkubeAPI) DeleteTestWorkflowHandler() fiber.Handler {
 			if err != nil {
 				return s.ClientError(c, "deleting executions", err)
 			}
+			if s.LogSearch != nil {
+				err = s.LogSearch.DeleteByTestWorkflows(context.Background(), []string{name}) //nolint:contextcheck // see above
+				if err != nil {
+					return s.ClientError(c, "deleting executions logs index", err)
+				}
+			}
 		}
 		return c.SendStatus(http.StatusNoContent)
 	}
@@ -163,6 +169,12 @@ func (s *TestkubeAPI) DeleteTestWorkflowsHandler() fiber.Handler {
 			if err != nil {
 				return s.ClientError(c, "deleting executions", err)
 			}
+			if s.LogSearch != nil {
+				err = s.LogSearch.DeleteByTestWorkflows(context.Background(), names) //nolint:contextcheck // see above
+				if err != nil {
+					return s.ClientError(c, "deleting executions logs index", err)
+				}
+			}
 		}
 
 		if deleteErr != nil {
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testworkflow2 "github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
+)
+
+// triageUpdateAttempts is the number of times the triage changes are applied when it's modified concurrently
+const triageUpdateAttempts = 5
+
+// UpdateTestWorkflowExecutionTriageHandler applies the requested triage changes to the finished execution,
+// and returns the updated triage with its history.
+func (s *TestkubeAPI) UpdateTestWorkflowExecutionTriageHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		name := c.Params("id")
+		executionID := c.Params("executionID")
+		errPrefix := fmt.Sprintf("failed to update triage for test workflow execution '%s'", executionID)
+
+		var request testkube.TestWorkflowExecutionTriageRequest
+		if body := c.Body(); len(body) > 0 {
+			if err := json.Unmarshal(body, &request); err != nil {
+				return s.BadRequest(c, errPrefix, "invalid request body", err)
+			}
+		}
+
+		// The triage is replaced only when nobody has changed it meanwhile, otherwise the changes are applied again
+		for attempt := 0; attempt < triageUpdateAttempts; attempt++ {
+			var execution testkube.TestWorkflowExecution
+			var err error
+			if name == "" {
+				execution, err = s.TestWorkflowResults.Get(ctx, executionID)
+			} else {
+				execution, err = s.TestWorkflowResults.GetByNameAndTestWorkflow(ctx, executionID, name)
+			}
+			if err != nil {
+				return s.ClientError(c, errPrefix, err)
+			}
+			if executionarchive.IsImported(&execution) {
+				return s.Error(c, http.StatusForbidden, fmt.Errorf("%s: the imported execution is read-only", errPrefix))
+			}
+			if execution.Result == nil || !execution.Result.IsFinished() {
+				return s.BadRequest(c, errPrefix, "checking execution", errors.New("only finished executions can be triaged"))
+			}
+
+			triage, err := execution.Triage.Apply(request, requestIdentity(c), triageUpdateTime(execution.Triage))
+			if err != nil {
+				return s.BadRequest(c, errPrefix, "invalid triage", err)
+			}
+			updated, err := s.TestWorkflowResults.UpdateTriage(ctx, execution.Id, execution.Triage, triage)
+			if err != nil {
+				return s.ClientError(c, errPrefix, err)
+			}
+			if updated {
+				return c.JSON(triage)
+			}
+		}
+		return s.Error(c, http.StatusConflict, fmt.Errorf("%s: the triage has been changed concurrently, try again", errPrefix))
+	}
+}
+
+// triageUpdateTime returns the current time with the precision kept by all the databases,
+// that is always after the previous update, so the next version can't be mistaken for the previous one.
+func triageUpdateTime(previous *testkube.TestWorkflowExecutionTriage) time.Time {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	if previous != nil && !now.After(previous.UpdatedAt) {
+		return previous.UpdatedAt.Truncate(time.Millisecond).Add(time.Millisecond)
+	}
+	return now
+}
+
+// GetTestWorkflowTriageStatsHandler returns the share of the failures per triage category for each workflow.
+// It accepts the same filters as the executions list, and considers failed and aborted executions by default.
+func (s *TestkubeAPI) GetTestWorkflowTriageStatsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		errPrefix := "failed to get test workflow triage stats"
+
+		filter := getWorkflowExecutionsFilterFromRequest(c).(*testworkflow2.FilterImpl)
+		if !filter.StatusesDefined() {
+			filter = filter.WithStatus(fmt.Sprintf("%s,%s", testkube.FAILED_TestWorkflowStatus, testkube.ABORTED_TestWorkflowStatus))
+		}
+
+		counts, err := s.TestWorkflowResults.GetTriageStats(c.Context(), filter)
+		if err != nil {
+			return s.ClientError(c, errPrefix, err)
+		}
+		return c.JSON(testworkflow2.BuildTriageStats(counts))
+	}
+}
@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
+)
+
+func TestUpdateTestWorkflowExecutionTriageHandler(t *testing.T) {
+	finished := testkube.TestWorkflowExecution{
+		Id: "exec-1",
+		Result: &testkube.TestWorkflowResult{
+			Status:     common.Ptr(testkube.FAILED_TestWorkflowStatus),
+			FinishedAt: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	running := finished
+	running.Result = &testkube.TestWorkflowResult{Status: common.Ptr(testkube.RUNNING_TestWorkflowStatus)}
+	imported := finished
+	imported.Tags = map[string]string{executionarchive.ImportedTagKey: "true"}
+
+	tests := map[string]struct {
+		execution  testkube.TestWorkflowExecution
+		body       string
+		token      string
+		conflicts  int
+		wantStatus int
+		wantActor  string
+	}{
+		"403 for the imported execution": {
+			execution:  imported,
+			body:       `{"category":"infra"}`,
+			wantStatus: http.StatusForbidden,
+		},
+		"400 for the running execution": {
+			execution:  running,
+			body:       `{"category":"infra"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		"400 for the unknown category": {
+			execution:  finished,
+			body:       `{"category":"cosmic-rays"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		"200 with the updated triage": {
+			execution:  finished,
+			body:       `{"category":"infra","note":"node evicted","addIssues":["https://github.com/org/repo/issues/1"]}`,
+			token:      "jane-token",
+			wantStatus: http.StatusOK,
+			wantActor:  "jane",
+		},
+		"200 without the self-declared actor": {
+			execution:  finished,
+			body:       `{"actor":"jane","category":"infra","note":"node evicted","addIssues":["https://github.com/org/repo/issues/1"]}`,
+			wantStatus: http.StatusOK,
+		},
+		"200 after the concurrent update": {
+			execution:  finished,
+			body:       `{"category":"infra","note":"node evicted","addIssues":["https://github.com/org/repo/issues/1"]}`,
+			token:      "jane-token",
+			conflicts:  2,
+			wantStatus: http.StatusOK,
+			wantActor:  "jane",
+		},
+		"409 when the triage keeps changing": {
+			execution:  finished,
+			body:       `{"category":"infra"}`,
+			conflicts:  triageUpdateAttempts,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			results := testworkflow.NewMockRepository(ctrl)
+			attempts := min(tc.conflicts+1, triageUpdateAttempts)
+			if tc.wantStatus != http.StatusOK && tc.wantStatus != http.StatusConflict {
+				attempts = 1
+			}
+			results.EXPECT().Get(gomock.Any(), "exec-1").Return(tc.execution, nil).Times(attempts)
+			if tc.wantStatus == http.StatusOK || tc.wantStatus == http.StatusConflict {
+				conflicts := tc.conflicts
+				results.EXPECT().UpdateTriage(gomock.Any(), "exec-1", tc.execution.Triage, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _, triage *testkube.TestWorkflowExecutionTriage) (bool, error) {
+						assert.Equal(t, tc.wantActor, triage.UpdatedBy)
+						conflicts--
+						return conflicts < 0, nil
+					}).Times(attempts)
+			}
+			testAPI := &TestkubeAPI{
+				TestWorkflowResults: results,
+				Log:                 log.DefaultLogger,
+				Identities:          map[string]string{"jane-token": "jane"},
+			}
+			app := fiber.New()
+			app.Use(testAPI.IdentityMiddleware())
+			app.Patch("/test-workflow-executions/:executionID/triage", testAPI.UpdateTestWorkflowExecutionTriageHandler())
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPatch, "/test-workflow-executions/exec-1/triage", strings.NewReader(tc.body))
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			var got testkube.TestWorkflowExecutionTriage
+			require.NoError(t, json.Unmarshal(body, &got))
+			assert.Equal(t, testkube.INFRA_TestWorkflowTriageCategory, got.GetCategory())
+			assert.Equal(t, tc.wantActor, got.UpdatedBy)
+			assert.Len(t, got.Notes, 1)
+			assert.Len(t, got.History, 3)
+		})
+	}
+}
+
+func TestGetTestWorkflowTriageStatsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	results := testworkflow.NewMockRepository(ctrl)
+	results.EXPECT().GetTriageStats(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter testworkflow.Filter) ([]testworkflow.TriageCount, error) {
+		assert.Equal(t, "api-tests", filter.Name())
+		assert.Equal(t, []testkube.TestWorkflowStatus{testkube.FAILED_TestWorkflowStatus, testkube.ABORTED_TestWorkflowStatus}, filter.Statuses())
+		return []testworkflow.TriageCount{
+			{Workflow: "api-tests", Category: testkube.INFRA_TestWorkflowTriageCategory, Count: 3},
+			{Workflow: "api-tests", Count: 1},
+		}, nil
+	})
+	testAPI := &TestkubeAPI{
+		TestWorkflowResults: results,
+		Log:                 log.DefaultLogger,
+	}
+	app := fiber.New()
+	app.Get("/test-workflows/:id/triage/stats", testAPI.GetTestWorkflowTriageStatsHandler())
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/test-workflows/api-tests/triage/stats", nil)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var got []testkube.TestWorkflowTriageStats
+	require.NoError(t, json.Unmarshal(body, &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, int32(4), got[0].Failures)
+	assert.Equal(t, int32(1), got[0].Untriaged)
+	require.Len(t, got[0].Categories, 1)
+	assert.Equal(t, float64(75), got[0].Categories[0].Share)
+}
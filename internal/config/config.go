@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"strings"
 	"time"
 
@@ -34,6 +35,12 @@ type OSSControlPlaneConfig struct {
 
 	// Postgres
 	APIPostgresDSN string `envconfig:"API_POSTGRES_DSN" default:""`
+	// APIPostgresDualWrite keeps MongoDB as the primary database, and mirrors the execution writes to PostgreSQL for the migration window
+	APIPostgresDualWrite bool `envconfig:"API_POSTGRES_DUAL_WRITE" default:"false"`
+
+	// SQLite
+	// APISQLitePath stores everything in the embedded SQLite database file instead of MongoDB or PostgreSQL, for single-binary and edge installs
+	APISQLitePath string `envconfig:"API_SQLITE_PATH" default:""`
 
 	// Minio
 	StorageEndpoint              string `envconfig:"STORAGE_ENDPOINT" default:"localhost:9000"`
@@ -252,21 +259,25 @@ type Config struct {
 	TestkubeDefaultRunnerCPULimit   string   `envconfig:"TESTKUBE_DEFAULT_RUNNER_CPU_LIMIT" default:""`
 	TestkubeDefaultRunnerMemLimit   string   `envconfig:"TESTKUBE_DEFAULT_RUNNER_MEMORY_LIMIT" default:""`
 
-	ExportArchiveMaxSize                     int           `envconfig:"EXPORT_ARCHIVE_MAX_SIZE" default:"104857600"`
-	FeatureCloudStorage                      bool          `envconfig:"FEATURE_CLOUD_STORAGE" default:"false"`
-	TestWorkflowLogArchiveRequired           bool          `envconfig:"TESTWORKFLOW_LOG_ARCHIVE_REQUIRED" default:"true"`
-	WorkflowLogsInsecureSkipTLSVerifyBackend bool          `envconfig:"TESTKUBE_WORKFLOW_LOGS_INSECURE_SKIP_TLS_VERIFY_BACKEND" default:"false"`
-	WorkflowLogsTLSRetryMaxAttempts          int           `envconfig:"TESTKUBE_WORKFLOW_LOGS_TLS_RETRY_MAX_ATTEMPTS" default:"7"`
-	WorkflowLogsTLSRetryInitialDelay         time.Duration `envconfig:"TESTKUBE_WORKFLOW_LOGS_TLS_RETRY_INITIAL_DELAY" default:"500ms"`
-	WorkflowLogsTLSRetryMaxDelay             time.Duration `envconfig:"TESTKUBE_WORKFLOW_LOGS_TLS_RETRY_MAX_DELAY" default:"30s"`
-	TestTriggerControlPlane                  bool          `envconfig:"TEST_TRIGGER_CONTROL_PLANE" default:"false"`
-	TestTriggerGitInformerRepoDepth          int           `envconfig:"TEST_TRIGGER_GIT_INFORMER_REPO_DEPTH" default:"500"`
-	TestTriggerGitInformerListTimeout        int           `envconfig:"TEST_TRIGGER_GIT_INFORMER_LIST_TIMEOUT" default:"15"`
-	TestTriggerGitInformerMaxCommitsScan     int           `envconfig:"TEST_TRIGGER_GIT_INFORMER_MAX_COMMITS_SCAN" default:"500"`
-	TestTriggerGitInformerReconcileInterval  time.Duration `envconfig:"TEST_TRIGGER_GIT_INFORMER_RECONCILE_INTERVAL" default:"1m"`
-	TestTriggerGitInformerPullRetries        int           `envconfig:"TEST_TRIGGER_GIT_INFORMER_PULL_RETRIES" default:"2"`
-	TestTriggerGitInformerPullRetryDelay     time.Duration `envconfig:"TEST_TRIGGER_GIT_INFORMER_PULL_RETRY_DELAY" default:"2s"`
-	ForceSuperAgentMode                      bool          `envconfig:"WARNING_UNSAFE_FORCE_SUPERAGENT_MODE" default:"false"`
+	ExportArchiveMaxSize                     int               `envconfig:"EXPORT_ARCHIVE_MAX_SIZE" default:"104857600"`
+	ImportArchiveMaxSize                     int               `envconfig:"IMPORT_ARCHIVE_MAX_SIZE" default:"104857600"`
+	ImportArchiveMaxExtractedSize            int64             `envconfig:"IMPORT_ARCHIVE_MAX_EXTRACTED_SIZE" default:"1073741824"`
+	ImportArchiveTrustedKeys                 []string          `envconfig:"IMPORT_ARCHIVE_TRUSTED_KEYS" default:""`
+	APIIdentityTokens                        map[string]string `envconfig:"API_IDENTITY_TOKENS" default:""`
+	FeatureCloudStorage                      bool              `envconfig:"FEATURE_CLOUD_STORAGE" default:"false"`
+	TestWorkflowLogArchiveRequired           bool              `envconfig:"TESTWORKFLOW_LOG_ARCHIVE_REQUIRED" default:"true"`
+	WorkflowLogsInsecureSkipTLSVerifyBackend bool              `envconfig:"TESTKUBE_WORKFLOW_LOGS_INSECURE_SKIP_TLS_VERIFY_BACKEND" default:"false"`
+	WorkflowLogsTLSRetryMaxAttempts          int               `envconfig:"TESTKUBE_WORKFLOW_LOGS_TLS_RETRY_MAX_ATTEMPTS" default:"7"`
+	WorkflowLogsTLSRetryInitialDelay         time.Duration     `envconfig:"TESTKUBE_WORKFLOW_LOGS_TLS_RETRY_INITIAL_DELAY" default:"500ms"`
+	WorkflowLogsTLSRetryMaxDelay             time.Duration     `envconfig:"TESTKUBE_WORKFLOW_LOGS_TLS_RETRY_MAX_DELAY" default:"30s"`
+	TestTriggerControlPlane                  bool              `envconfig:"TEST_TRIGGER_CONTROL_PLANE" default:"false"`
+	TestTriggerGitInformerRepoDepth          int               `envconfig:"TEST_TRIGGER_GIT_INFORMER_REPO_DEPTH" default:"500"`
+	TestTriggerGitInformerListTimeout        int               `envconfig:"TEST_TRIGGER_GIT_INFORMER_LIST_TIMEOUT" default:"15"`
+	TestTriggerGitInformerMaxCommitsScan     int               `envconfig:"TEST_TRIGGER_GIT_INFORMER_MAX_COMMITS_SCAN" default:"500"`
+	TestTriggerGitInformerReconcileInterval  time.Duration     `envconfig:"TEST_TRIGGER_GIT_INFORMER_RECONCILE_INTERVAL" default:"1m"`
+	TestTriggerGitInformerPullRetries        int               `envconfig:"TEST_TRIGGER_GIT_INFORMER_PULL_RETRIES" default:"2"`
+	TestTriggerGitInformerPullRetryDelay     time.Duration     `envconfig:"TEST_TRIGGER_GIT_INFORMER_PULL_RETRY_DELAY" default:"2s"`
+	ForceSuperAgentMode                      bool              `envconfig:"WARNING_UNSAFE_FORCE_SUPERAGENT_MODE" default:"false"`
 }
 
 type DeprecatedConfig struct {
@@ -331,5 +342,24 @@ func Get() (*Config, error) {
 		c.TestkubeProMigrate = deprecated.TestkubeCloudMigrate
 	}
 
+	if err := c.validateDatabase(); err != nil {
+		return nil, err
+	}
+
 	return &c, nil
 }
+
+// validateDatabase ensures the embedded SQLite database is not combined with the other databases,
+// as it would replace them after they have been already connected and migrated
+func (c *Config) validateDatabase() error {
+	if c.APISQLitePath == "" {
+		return nil
+	}
+	if c.APIMongoDSN != "" || c.APIPostgresDSN != "" {
+		return errors.New("API_SQLITE_PATH can't be used together with API_MONGO_DSN or API_POSTGRES_DSN")
+	}
+	if c.APIPostgresDualWrite {
+		return errors.New("API_SQLITE_PATH can't be used together with API_POSTGRES_DUAL_WRITE")
+	}
+	return nil
+}
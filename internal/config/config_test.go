@@ -17,3 +17,23 @@ func TestGet(t *testing.T) {
 	assertion.NoError(err)
 	assertion.IsType(&Config{}, cfg)
 }
+
+func TestGet_SQLiteWithOtherDatabases(t *testing.T) {
+	t.Setenv("API_SQLITE_PATH", "/data/testkube.db")
+	_, err := Get()
+	require.NoError(t, err)
+
+	t.Setenv("API_MONGO_DSN", "mongodb://localhost:27017")
+	_, err = Get()
+	require.Error(t, err)
+
+	t.Setenv("API_MONGO_DSN", "")
+	t.Setenv("API_POSTGRES_DSN", "postgres://localhost:5432/testkube")
+	_, err = Get()
+	require.Error(t, err)
+
+	t.Setenv("API_POSTGRES_DSN", "")
+	t.Setenv("API_POSTGRES_DUAL_WRITE", "true")
+	_, err = Get()
+	require.Error(t, err)
+}
@@ -290,6 +290,10 @@ Define API environment in standalone mode
   value: "{{ .Values.postgresql.dsn }}"
   {{- end }}
 {{- end }}
+{{- if .Values.sqlite.enabled }}
+- name: API_SQLITE_PATH
+  value: "{{ .Values.sqlite.path }}"
+{{- end }}
 - name: "NATS_EMBEDDED"
   value: "{{ .Values.nats.embedded }}"
 - name: NATS_URI
@@ -19,6 +19,18 @@ import (
 var Templates embed.FS
 
 func ParseAllOfficialTemplates() (officials []testkube.TestWorkflowTemplate, skipped []string, err error) {
+	kube, skipped, err := ParseAllOfficialKubeTemplates()
+	if err != nil {
+		return
+	}
+	for i := range kube {
+		officials = append(officials, *testworkflows.MapTemplateKubeToAPI(&kube[i]))
+	}
+	return
+}
+
+// ParseAllOfficialKubeTemplates reads the official templates as the Kubernetes resources.
+func ParseAllOfficialKubeTemplates() (officials []v1.TestWorkflowTemplate, skipped []string, err error) {
 	entries, err := Templates.ReadDir(".")
 	if err != nil {
 		return
@@ -39,7 +51,7 @@ func ParseAllOfficialTemplates() (officials []testkube.TestWorkflowTemplate, ski
 	return
 }
 
-func parseOfficialTemplate(e fs.DirEntry) (*testkube.TestWorkflowTemplate, error) {
+func parseOfficialTemplate(e fs.DirEntry) (*v1.TestWorkflowTemplate, error) {
 	file, err := Templates.ReadFile(e.Name())
 	if err != nil {
 		return nil, err
@@ -50,7 +62,5 @@ func parseOfficialTemplate(e fs.DirEntry) (*testkube.TestWorkflowTemplate, error
 	if err := decoder.Decode(&template); err != nil {
 		return nil, err
 	}
-
-	result := testworkflows.MapTemplateKubeToAPI(&template)
-	return result, nil
+	return &template, nil
 }
@@ -20,6 +20,9 @@ func NewProxyAPIClient(client kubernetes.Interface, config APIConfig) APIClient
 			NewProxyClient[testkube.TestWorkflowExecution](client, config),
 			NewProxyClient[testkube.TestWorkflowExecutionsResult](client, config),
 			NewProxyClient[testkube.Artifact](client, config),
+			NewProxyClient[testkube.TestWorkflowExecutionLogMatch](client, config),
+			NewProxyClient[testkube.TestWorkflowExecutionTriage](client, config),
+			NewProxyClient[testkube.TestWorkflowTriageStats](client, config),
 		),
 		TestWorkflowTemplateClient: NewTestWorkflowTemplateClient(NewProxyClient[testkube.TestWorkflowTemplate](client, config)),
 		TestTriggerClient:          NewTestTriggerClient(NewProxyClient[testkube.TestTrigger](client, config)),
@@ -44,6 +47,9 @@ func NewDirectAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewDirectClient[testkube.TestWorkflowExecution](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.TestWorkflowExecutionsResult](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.TestWorkflowExecutionLogMatch](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.TestWorkflowExecutionTriage](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.TestWorkflowTriageStats](httpClient, apiURI, apiPathPrefix),
 		),
 		TestWorkflowTemplateClient: NewTestWorkflowTemplateClient(NewDirectClient[testkube.TestWorkflowTemplate](httpClient, apiURI, apiPathPrefix)),
 		TestTriggerClient:          NewTestTriggerClient(NewDirectClient[testkube.TestTrigger](httpClient, apiURI, apiPathPrefix)),
@@ -68,6 +74,9 @@ func NewCloudAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewCloudClient[testkube.TestWorkflowExecution](httpClient, apiURI, apiPathPrefix, insecure...),
 			NewCloudClient[testkube.TestWorkflowExecutionsResult](httpClient, apiURI, apiPathPrefix, insecure...),
 			NewCloudClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix, insecure...),
+			NewCloudClient[testkube.TestWorkflowExecutionLogMatch](httpClient, apiURI, apiPathPrefix, insecure...),
+			NewCloudClient[testkube.TestWorkflowExecutionTriage](httpClient, apiURI, apiPathPrefix, insecure...),
+			NewCloudClient[testkube.TestWorkflowTriageStats](httpClient, apiURI, apiPathPrefix, insecure...),
 		),
 		TestWorkflowTemplateClient: NewTestWorkflowTemplateClient(NewCloudClient[testkube.TestWorkflowTemplate](httpClient, apiURI, apiPathPrefix, insecure...)),
 		TestTriggerClient:          NewTestTriggerClient(NewCloudClient[testkube.TestTrigger](httpClient, apiURI, apiPathPrefix, insecure...)),
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 )
@@ -122,6 +123,7 @@ type TestWorkflowAPI interface {
 	GetTestWorkflowExecutionNotifications(id string) (chan testkube.TestWorkflowExecutionNotification, error)
 	GetTestWorkflowExecutionNotificationsWithOptions(id string, options TestWorkflowExecutionNotificationsOptions) (chan testkube.TestWorkflowExecutionNotification, error)
 	GetTestWorkflowExecutionLogs(id string) ([]byte, error)
+	GetTestWorkflowExecutionLogsWithOptions(id string, options GetTestWorkflowExecutionLogsOptions) ([]byte, error)
 	GetTestWorkflowExecutionServiceNotifications(id, serviceName string, serviceIndex int) (chan testkube.TestWorkflowExecutionNotification, error)
 	GetTestWorkflowExecutionServiceNotificationsWithOptions(id, serviceName string, serviceIndex int, options TestWorkflowExecutionNotificationsOptions) (chan testkube.TestWorkflowExecutionNotification, error)
 	GetTestWorkflowExecutionParallelStepNotifications(id, ref string, workerIndex int) (chan testkube.TestWorkflowExecutionNotification, error)
@@ -136,11 +138,17 @@ type TestWorkflowExecutionAPI interface {
 	AbortTestWorkflowExecutions(workflow string) error
 	PauseTestWorkflowExecution(workflow string, id string) error
 	ResumeTestWorkflowExecution(workflow string, id string) error
+	ApproveTestWorkflowExecution(executionID string, request testkube.TestWorkflowApprovalRequest) error
+	RejectTestWorkflowExecution(executionID string, request testkube.TestWorkflowApprovalRequest) error
 	GetTestWorkflowExecutionArtifacts(executionID string) (artifacts testkube.Artifacts, err error)
 	DownloadTestWorkflowArtifact(executionID, fileName, destination string) (artifact string, err error)
 	DownloadTestWorkflowArtifactArchive(executionID, destination string, masks []string) (archive string, err error)
 	ReRunTestWorkflowExecution(workflow string, id string, runningContext *testkube.TestWorkflowRunningContext, latest bool) (testkube.TestWorkflowExecution, error)
 	UpdateTestWorkflowExecutionTags(executionID string, tags map[string]string) error
+	UpdateTestWorkflowExecutionTriage(executionID string, request testkube.TestWorkflowExecutionTriageRequest) (testkube.TestWorkflowExecutionTriage, error)
+	GetTestWorkflowTriageStats(workflow string, options GetTestWorkflowTriageStatsOptions) ([]testkube.TestWorkflowTriageStats, error)
+	SearchTestWorkflowExecutionLogs(options SearchTestWorkflowExecutionLogsOptions) ([]testkube.TestWorkflowExecutionLogMatch, error)
+	ImportTestWorkflowExecution(archive []byte) (testkube.TestWorkflowExecution, error)
 	ValidateTestWorkflow(body []byte) error
 	ExportExecutions(destination string, since string) (fileName string, err error)
 }
@@ -174,19 +182,48 @@ type CreateTestTriggerOptions testkube.TestTriggerUpsertRequest
 // UpdateTestTriggerOptions - is mapping for now to OpenAPI schema for changing trigger request
 type UpdateTestTriggerOptions testkube.TestTriggerUpsertRequest
 
+// SearchTestWorkflowExecutionLogsOptions contains the execution logs search options
+type SearchTestWorkflowExecutionLogsOptions struct {
+	Query         string
+	WorkflowNames []string
+	Since         time.Duration
+	Limit         int
+	ContextLines  int
+}
+
+// GetTestWorkflowExecutionLogsOptions contains the options to read the part of the execution logs
+type GetTestWorkflowExecutionLogsOptions struct {
+	Step   string
+	Since  time.Duration
+	Tail   int
+	Offset int64
+	Length int64
+}
+
 // FilterTestWorkflowExecutionOptions contains filter test workflow execution options
 type FilterTestWorkflowExecutionOptions struct {
-	Selector    string
-	TagSelector string
-	ActorName   string
-	ActorType   testkube.TestWorkflowRunningContextActorType
-	Status      string
+	Selector       string
+	TagSelector    string
+	ActorName      string
+	ActorType      testkube.TestWorkflowRunningContextActorType
+	Status         string
+	TriageCategory string
+	TriageAssignee string
+	Triaged        *bool
+}
+
+// GetTestWorkflowTriageStatsOptions contains the filters for the triage stats
+type GetTestWorkflowTriageStatsOptions struct {
+	Selector  string
+	Status    string
+	LastNDays int
 }
 
 // Gettable is an interface of gettable objects
 type Gettable interface {
 	testkube.Webhook | testkube.Artifact | testkube.ServerInfo | testkube.Config | testkube.DebugInfo |
-		testkube.TestWorkflow | testkube.TestWorkflowWithExecution | testkube.TestWorkflowTemplate | testkube.TestWorkflowExecution |
+		testkube.TestWorkflow | testkube.TestWorkflowWithExecution | testkube.TestWorkflowTemplate | testkube.TestWorkflowExecution | testkube.TestWorkflowExecutionLogMatch |
+		testkube.TestWorkflowExecutionTriage | testkube.TestWorkflowTriageStats |
 		testkube.TestTrigger | testkube.WorkflowTrigger | testkube.WebhookTemplate | map[string][]string
 }
 
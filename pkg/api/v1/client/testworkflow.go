@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 )
@@ -18,6 +19,9 @@ func NewTestWorkflowClient(
 	testWorkflowExecutionTransport Transport[testkube.TestWorkflowExecution],
 	testWorkflowExecutionsResultTransport Transport[testkube.TestWorkflowExecutionsResult],
 	artifactTransport Transport[testkube.Artifact],
+	logMatchTransport Transport[testkube.TestWorkflowExecutionLogMatch],
+	triageTransport Transport[testkube.TestWorkflowExecutionTriage],
+	triageStatsTransport Transport[testkube.TestWorkflowTriageStats],
 ) TestWorkflowClient {
 	return TestWorkflowClient{
 		testWorkflowTransport:                 testWorkflowTransport,
@@ -25,6 +29,9 @@ func NewTestWorkflowClient(
 		testWorkflowExecutionTransport:        testWorkflowExecutionTransport,
 		testWorkflowExecutionsResultTransport: testWorkflowExecutionsResultTransport,
 		artifactTransport:                     artifactTransport,
+		logMatchTransport:                     logMatchTransport,
+		triageTransport:                       triageTransport,
+		triageStatsTransport:                  triageStatsTransport,
 	}
 }
 
@@ -35,6 +42,9 @@ type TestWorkflowClient struct {
 	testWorkflowExecutionTransport        Transport[testkube.TestWorkflowExecution]
 	testWorkflowExecutionsResultTransport Transport[testkube.TestWorkflowExecutionsResult]
 	artifactTransport                     Transport[testkube.Artifact]
+	logMatchTransport                     Transport[testkube.TestWorkflowExecutionLogMatch]
+	triageTransport                       Transport[testkube.TestWorkflowExecutionTriage]
+	triageStatsTransport                  Transport[testkube.TestWorkflowTriageStats]
 }
 
 // GetTestWorkflow returns single test workflow by id
@@ -265,6 +275,15 @@ func (c TestWorkflowClient) ListTestWorkflowExecutions(id string, limit int, opt
 		"actorType":   string(options.ActorType),
 		"status":      options.Status,
 	}
+	if options.TriageCategory != "" {
+		params["triageCategory"] = options.TriageCategory
+	}
+	if options.TriageAssignee != "" {
+		params["triageAssignee"] = options.TriageAssignee
+	}
+	if options.Triaged != nil {
+		params["triaged"] = strconv.FormatBool(*options.Triaged)
+	}
 	return c.testWorkflowExecutionsResultTransport.Execute(http.MethodGet, uri, nil, params)
 }
 
@@ -280,6 +299,27 @@ func (c TestWorkflowClient) ResumeTestWorkflowExecution(workflow, id string) err
 	return c.testWorkflowTransport.ExecuteMethod(http.MethodPost, uri, nil, false)
 }
 
+// ApproveTestWorkflowExecution approves the step waiting for the decision in selected execution
+func (c TestWorkflowClient) ApproveTestWorkflowExecution(executionID string, request testkube.TestWorkflowApprovalRequest) error {
+	return c.decideTestWorkflowExecutionApproval(executionID, "approve", request)
+}
+
+// RejectTestWorkflowExecution rejects the step waiting for the decision in selected execution
+func (c TestWorkflowClient) RejectTestWorkflowExecution(executionID string, request testkube.TestWorkflowApprovalRequest) error {
+	return c.decideTestWorkflowExecutionApproval(executionID, "reject", request)
+}
+
+func (c TestWorkflowClient) decideTestWorkflowExecutionApproval(executionID, action string, request testkube.TestWorkflowApprovalRequest) error {
+	uri := c.testWorkflowExecutionTransport.GetURI("/test-workflow-executions/%s/%s", executionID, action)
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	return c.testWorkflowExecutionTransport.Validate(http.MethodPost, uri, body, nil)
+}
+
 // AbortTestWorkflowExecution aborts selected execution
 func (c TestWorkflowClient) AbortTestWorkflowExecution(workflow, id string, force bool) error {
 	uri := c.testWorkflowTransport.GetURI("/test-workflows/%s/executions/%s/abort", workflow, id)
@@ -316,6 +356,28 @@ func (c TestWorkflowClient) GetTestWorkflowExecutionLogs(id string) (result []by
 	return c.testWorkflowTransport.GetRawBody(http.MethodGet, uri, nil, nil)
 }
 
+// GetTestWorkflowExecutionLogsWithOptions returns the part of text logs from storage
+func (c TestWorkflowClient) GetTestWorkflowExecutionLogsWithOptions(id string, options GetTestWorkflowExecutionLogsOptions) (result []byte, err error) {
+	uri := c.testWorkflowTransport.GetURI("/test-workflow-executions/%s/logs", id)
+	params := map[string]string{}
+	if options.Step != "" {
+		params["step"] = options.Step
+	}
+	if options.Since > 0 {
+		params["since"] = options.Since.String()
+	}
+	if options.Tail > 0 {
+		params["tail"] = strconv.Itoa(options.Tail)
+	}
+	if options.Offset > 0 {
+		params["offset"] = strconv.FormatInt(options.Offset, 10)
+	}
+	if options.Length > 0 {
+		params["length"] = strconv.FormatInt(options.Length, 10)
+	}
+	return c.testWorkflowTransport.GetRawBody(http.MethodGet, uri, nil, params)
+}
+
 // UpdateTestWorkflowExecutionTags updates tags on a test workflow execution
 func (c TestWorkflowClient) UpdateTestWorkflowExecutionTags(executionID string, tags map[string]string) error {
 	uri := c.testWorkflowExecutionTransport.GetURI("/test-workflow-executions/%s/tags", executionID)
@@ -328,6 +390,59 @@ func (c TestWorkflowClient) UpdateTestWorkflowExecutionTags(executionID string,
 	return c.testWorkflowExecutionTransport.Validate(http.MethodPatch, uri, body, nil)
 }
 
+// UpdateTestWorkflowExecutionTriage applies the triage changes to the finished execution, and returns the updated triage
+func (c TestWorkflowClient) UpdateTestWorkflowExecutionTriage(executionID string, request testkube.TestWorkflowExecutionTriageRequest) (testkube.TestWorkflowExecutionTriage, error) {
+	uri := c.triageTransport.GetURI("/test-workflow-executions/%s/triage", executionID)
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return testkube.TestWorkflowExecutionTriage{}, err
+	}
+
+	return c.triageTransport.Execute(http.MethodPatch, uri, body, nil)
+}
+
+// GetTestWorkflowTriageStats returns the share of the failures per triage category for each workflow
+func (c TestWorkflowClient) GetTestWorkflowTriageStats(workflow string, options GetTestWorkflowTriageStatsOptions) ([]testkube.TestWorkflowTriageStats, error) {
+	uri := c.triageStatsTransport.GetURI("/test-workflow-executions/triage/stats")
+	if workflow != "" {
+		uri = c.triageStatsTransport.GetURI("/test-workflows/%s/triage/stats", workflow)
+	}
+	params := map[string]string{
+		"selector": options.Selector,
+		"status":   options.Status,
+	}
+	if options.LastNDays > 0 {
+		params["last"] = strconv.Itoa(options.LastNDays)
+	}
+
+	return c.triageStatsTransport.ExecuteMultiple(http.MethodGet, uri, nil, params)
+}
+
+// SearchTestWorkflowExecutionLogs finds the lines of the execution logs containing all the words of the query
+func (c TestWorkflowClient) SearchTestWorkflowExecutionLogs(options SearchTestWorkflowExecutionLogsOptions) ([]testkube.TestWorkflowExecutionLogMatch, error) {
+	uri := c.logMatchTransport.GetURI("/test-workflow-executions/logs/search")
+	params := map[string]string{
+		"query":   options.Query,
+		"limit":   strconv.Itoa(options.Limit),
+		"context": strconv.Itoa(options.ContextLines),
+	}
+	if len(options.WorkflowNames) > 0 {
+		params["workflow"] = strings.Join(options.WorkflowNames, ",")
+	}
+	if options.Since > 0 {
+		params["since"] = options.Since.String()
+	}
+
+	return c.logMatchTransport.ExecuteMultiple(http.MethodGet, uri, nil, params)
+}
+
+// ImportTestWorkflowExecution recreates the execution from the signed archive
+func (c TestWorkflowClient) ImportTestWorkflowExecution(archive []byte) (testkube.TestWorkflowExecution, error) {
+	uri := c.testWorkflowExecutionTransport.GetURI("/test-workflow-executions/import")
+	return c.testWorkflowExecutionTransport.Execute(http.MethodPost, uri, archive, nil)
+}
+
 // ReRunTestWorkflowExecution reruns selected execution.
 // When latest is true, the current workflow definition is used instead of the original resolved snapshot,
 // while keeping the original parameter set.
@@ -21,6 +21,9 @@ func newTestWorkflowClientForServer(server *httptest.Server) TestWorkflowClient
 		NewDirectClient[testkube.TestWorkflowExecution](server.Client(), server.URL, ""),
 		NewDirectClient[testkube.TestWorkflowExecutionsResult](server.Client(), server.URL, ""),
 		NewDirectClient[testkube.Artifact](server.Client(), server.URL, ""),
+		NewDirectClient[testkube.TestWorkflowExecutionLogMatch](server.Client(), server.URL, ""),
+		NewDirectClient[testkube.TestWorkflowExecutionTriage](server.Client(), server.URL, ""),
+		NewDirectClient[testkube.TestWorkflowTriageStats](server.Client(), server.URL, ""),
 	)
 }
 
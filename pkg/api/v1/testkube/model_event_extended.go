@@ -104,6 +104,17 @@ func NewEventEndTestWorkflowNotPassed(execution *TestWorkflowExecution, groupId
 	}
 }
 
+func NewEventApprovalRequestedTestWorkflow(execution *TestWorkflowExecution, groupId string) Event {
+	return Event{
+		Id:                    uuid.NewString(),
+		GroupId:               groupId,
+		Type_:                 EventApprovalRequestedTestWorkflow,
+		TestWorkflowExecution: execution,
+		Resource:              common.Ptr(TESTWORKFLOWEXECUTION_EventResource),
+		ResourceId:            execution.Id,
+	}
+}
+
 func (e Event) Type() EventType {
 	if e.Type_ != nil {
 		return *e.Type_
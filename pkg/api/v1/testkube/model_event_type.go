@@ -13,20 +13,21 @@ type EventType string
 
 // List of EventType
 const (
-	QUEUE_TESTWORKFLOW_EventType             EventType = "queue-testworkflow"
-	START_TESTWORKFLOW_EventType             EventType = "start-testworkflow"
-	END_TESTWORKFLOW_SUCCESS_EventType       EventType = "end-testworkflow-success"
-	END_TESTWORKFLOW_FAILED_EventType        EventType = "end-testworkflow-failed"
-	END_TESTWORKFLOW_ABORTED_EventType       EventType = "end-testworkflow-aborted"
-	END_TESTWORKFLOW_CANCELED_EventType      EventType = "end-testworkflow-canceled"
-	END_TESTWORKFLOW_NOT_PASSED_EventType    EventType = "end-testworkflow-not-passed"
-	BECOME_TESTWORKFLOW_UP_EventType         EventType = "become-testworkflow-up"
-	BECOME_TESTWORKFLOW_DOWN_EventType       EventType = "become-testworkflow-down"
-	BECOME_TESTWORKFLOW_FAILED_EventType     EventType = "become-testworkflow-failed"
-	BECOME_TESTWORKFLOW_ABORTED_EventType    EventType = "become-testworkflow-aborted"
-	BECOME_TESTWORKFLOW_CANCELED_EventType   EventType = "become-testworkflow-canceled"
-	BECOME_TESTWORKFLOW_NOT_PASSED_EventType EventType = "become-testworkflow-not-passed"
-	CREATED_EventType                        EventType = "created"
-	UPDATED_EventType                        EventType = "updated"
-	DELETED_EventType                        EventType = "deleted"
+	QUEUE_TESTWORKFLOW_EventType              EventType = "queue-testworkflow"
+	START_TESTWORKFLOW_EventType              EventType = "start-testworkflow"
+	END_TESTWORKFLOW_SUCCESS_EventType        EventType = "end-testworkflow-success"
+	END_TESTWORKFLOW_FAILED_EventType         EventType = "end-testworkflow-failed"
+	END_TESTWORKFLOW_ABORTED_EventType        EventType = "end-testworkflow-aborted"
+	END_TESTWORKFLOW_CANCELED_EventType       EventType = "end-testworkflow-canceled"
+	END_TESTWORKFLOW_NOT_PASSED_EventType     EventType = "end-testworkflow-not-passed"
+	APPROVAL_REQUESTED_TESTWORKFLOW_EventType EventType = "approval-requested-testworkflow"
+	BECOME_TESTWORKFLOW_UP_EventType          EventType = "become-testworkflow-up"
+	BECOME_TESTWORKFLOW_DOWN_EventType        EventType = "become-testworkflow-down"
+	BECOME_TESTWORKFLOW_FAILED_EventType      EventType = "become-testworkflow-failed"
+	BECOME_TESTWORKFLOW_ABORTED_EventType     EventType = "become-testworkflow-aborted"
+	BECOME_TESTWORKFLOW_CANCELED_EventType    EventType = "become-testworkflow-canceled"
+	BECOME_TESTWORKFLOW_NOT_PASSED_EventType  EventType = "become-testworkflow-not-passed"
+	CREATED_EventType                         EventType = "created"
+	UPDATED_EventType                         EventType = "updated"
+	DELETED_EventType                         EventType = "deleted"
 )
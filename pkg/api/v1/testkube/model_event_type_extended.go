@@ -7,6 +7,7 @@ var AllEventTypes = []EventType{
 	END_TESTWORKFLOW_FAILED_EventType,
 	END_TESTWORKFLOW_ABORTED_EventType,
 	END_TESTWORKFLOW_CANCELED_EventType,
+	APPROVAL_REQUESTED_TESTWORKFLOW_EventType,
 	CREATED_EventType,
 	DELETED_EventType,
 	UPDATED_EventType,
@@ -21,16 +22,17 @@ func EventTypePtr(t EventType) *EventType {
 }
 
 var (
-	EventQueueTestWorkflow        = EventTypePtr(QUEUE_TESTWORKFLOW_EventType)
-	EventStartTestWorkflow        = EventTypePtr(START_TESTWORKFLOW_EventType)
-	EventEndTestWorkflowSuccess   = EventTypePtr(END_TESTWORKFLOW_SUCCESS_EventType)
-	EventEndTestWorkflowFailed    = EventTypePtr(END_TESTWORKFLOW_FAILED_EventType)
-	EventEndTestWorkflowAborted   = EventTypePtr(END_TESTWORKFLOW_ABORTED_EventType)
-	EventEndTestWorkflowCanceled  = EventTypePtr(END_TESTWORKFLOW_CANCELED_EventType)
-	EventEndTestWorkflowNotPassed = EventTypePtr(END_TESTWORKFLOW_NOT_PASSED_EventType)
-	EventCreated                  = EventTypePtr(CREATED_EventType)
-	EventDeleted                  = EventTypePtr(DELETED_EventType)
-	EventUpdated                  = EventTypePtr(UPDATED_EventType)
+	EventQueueTestWorkflow             = EventTypePtr(QUEUE_TESTWORKFLOW_EventType)
+	EventStartTestWorkflow             = EventTypePtr(START_TESTWORKFLOW_EventType)
+	EventEndTestWorkflowSuccess        = EventTypePtr(END_TESTWORKFLOW_SUCCESS_EventType)
+	EventEndTestWorkflowFailed         = EventTypePtr(END_TESTWORKFLOW_FAILED_EventType)
+	EventEndTestWorkflowAborted        = EventTypePtr(END_TESTWORKFLOW_ABORTED_EventType)
+	EventEndTestWorkflowCanceled       = EventTypePtr(END_TESTWORKFLOW_CANCELED_EventType)
+	EventEndTestWorkflowNotPassed      = EventTypePtr(END_TESTWORKFLOW_NOT_PASSED_EventType)
+	EventApprovalRequestedTestWorkflow = EventTypePtr(APPROVAL_REQUESTED_TESTWORKFLOW_EventType)
+	EventCreated                       = EventTypePtr(CREATED_EventType)
+	EventDeleted                       = EventTypePtr(DELETED_EventType)
+	EventUpdated                       = EventTypePtr(UPDATED_EventType)
 )
 
 func (t EventType) IsBecome() bool {
@@ -0,0 +1,36 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+type TestWorkflowApproval struct {
+	// step reference
+	Ref string `json:"ref,omitempty"`
+	// message displayed to the reviewers
+	Message string `json:"message,omitempty"`
+	// list of people allowed to decide
+	Approvers []string `json:"approvers,omitempty"`
+	// when the approval has been requested
+	RequestedAt time.Time `json:"requestedAt,omitempty"`
+	// when the default decision will be applied
+	Deadline time.Time                     `json:"deadline,omitempty"`
+	Decision *TestWorkflowApprovalDecision `json:"decision,omitempty"`
+	// who has taken the decision
+	DecidedBy string `json:"decidedBy,omitempty"`
+	// when the decision has been taken
+	DecidedAt time.Time `json:"decidedAt,omitempty"`
+	// additional comment from the reviewer
+	Comment string `json:"comment,omitempty"`
+	// whether the default decision has been applied after the timeout
+	TimedOut bool `json:"timedOut,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowApprovalDecision string
+
+// List of TestWorkflowApprovalDecision
+const (
+	APPROVED_TestWorkflowApprovalDecision TestWorkflowApprovalDecision = "approved"
+	REJECTED_TestWorkflowApprovalDecision TestWorkflowApprovalDecision = "rejected"
+)
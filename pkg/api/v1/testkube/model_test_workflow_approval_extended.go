@@ -0,0 +1,37 @@
+package testkube
+
+import (
+	"slices"
+
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+func (a *TestWorkflowApproval) Clone() *TestWorkflowApproval {
+	if a == nil {
+		return nil
+	}
+	v := *a
+	v.Approvers = slices.Clone(a.Approvers)
+	if a.Decision != nil {
+		v.Decision = common.Ptr(*a.Decision)
+	}
+	return &v
+}
+
+// IsPending determines if the approval is still waiting for the decision
+func (a *TestWorkflowApproval) IsPending() bool {
+	return a != nil && a.Decision == nil
+}
+
+// IsApproved determines if the approval has been granted
+func (a *TestWorkflowApproval) IsApproved() bool {
+	return a != nil && a.Decision != nil && *a.Decision == APPROVED_TestWorkflowApprovalDecision
+}
+
+// CanDecide determines if the reviewer is allowed to take the decision
+func (a *TestWorkflowApproval) CanDecide(reviewer string) bool {
+	if a == nil || len(a.Approvers) == 0 {
+		return true
+	}
+	return slices.Contains(a.Approvers, reviewer)
+}
@@ -0,0 +1,48 @@
+package testkube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestWorkflowApproval_CanDecide(t *testing.T) {
+	assert.True(t, (&TestWorkflowApproval{}).CanDecide(""))
+	assert.True(t, (&TestWorkflowApproval{}).CanDecide("alice"))
+	assert.True(t, (&TestWorkflowApproval{Approvers: []string{"alice", "bob"}}).CanDecide("bob"))
+	assert.False(t, (&TestWorkflowApproval{Approvers: []string{"alice", "bob"}}).CanDecide("eve"))
+	assert.False(t, (&TestWorkflowApproval{Approvers: []string{"alice"}}).CanDecide(""))
+}
+
+func TestTestWorkflowExecution_PendingApprovals(t *testing.T) {
+	requested := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	approved := APPROVED_TestWorkflowApprovalDecision
+	running := RUNNING_TestWorkflowStatus
+	execution := &TestWorkflowExecution{
+		Result: &TestWorkflowResult{
+			Status: &running,
+			Steps: map[string]TestWorkflowStepResult{
+				"r2":    {Approval: &TestWorkflowApproval{RequestedAt: requested.Add(time.Minute)}},
+				"r1":    {Approval: &TestWorkflowApproval{RequestedAt: requested}},
+				"done":  {Approval: &TestWorkflowApproval{RequestedAt: requested}},
+				"other": {},
+			},
+		},
+		Approvals: []TestWorkflowApproval{{Ref: "done", Decision: &approved, DecidedBy: "alice"}},
+	}
+
+	pending := execution.PendingApprovals()
+
+	require.Len(t, pending, 2)
+	assert.Equal(t, "r1", pending[0].Ref)
+	assert.Equal(t, "r2", pending[1].Ref)
+	assert.Equal(t, "alice", execution.GetApprovalDecision("done").DecidedBy)
+	assert.Nil(t, execution.GetApprovalDecision("r1"))
+
+	passed := PASSED_TestWorkflowStatus
+	execution.Result.Status = &passed
+	execution.Result.FinishedAt = requested.Add(time.Hour)
+	assert.Empty(t, execution.PendingApprovals())
+}
@@ -0,0 +1,17 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowApprovalRequest struct {
+	// reference of the approval step, required when there are multiple pending approvals
+	Step string `json:"step,omitempty"`
+	// additional comment for the decision
+	Comment string `json:"comment,omitempty"`
+}
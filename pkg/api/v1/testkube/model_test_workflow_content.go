@@ -10,7 +10,10 @@
 package testkube
 
 type TestWorkflowContent struct {
-	Git     *TestWorkflowContentGit      `json:"git,omitempty"`
-	Files   []TestWorkflowContentFile    `json:"files,omitempty"`
-	Tarball []TestWorkflowContentTarball `json:"tarball,omitempty"`
+	Git     *TestWorkflowContentGit            `json:"git,omitempty"`
+	Files   []TestWorkflowContentFile          `json:"files,omitempty"`
+	Tarball []TestWorkflowContentTarball       `json:"tarball,omitempty"`
+	Oci     []TestWorkflowContentOci           `json:"oci,omitempty"`
+	S3      []TestWorkflowContentObjectStorage `json:"s3,omitempty"`
+	Gcs     []TestWorkflowContentObjectStorage `json:"gcs,omitempty"`
 }
@@ -0,0 +1,38 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowContentObjectStorage struct {
+	// S3-compatible endpoint, i.e. \"s3.amazonaws.com\" or \"minio.example.com:9000\" (defaults to the provider endpoint)
+	Endpoint string `json:"endpoint,omitempty"`
+	// region of the bucket
+	Region string `json:"region,omitempty"`
+	// bucket name
+	Bucket string `json:"bucket"`
+	// object key to fetch, or a prefix ending with \"/\" to fetch all the objects under it
+	Key string `json:"key,omitempty"`
+	// path where the object(s) should be stored
+	Path  string        `json:"path"`
+	Mount *BoxedBoolean `json:"mount,omitempty"`
+	// unpack the object as a gzipped tarball instead of storing it as a file
+	Unpack bool `json:"unpack,omitempty"`
+	// expected SHA-256 checksum of the object, verified before storing; not supported for prefixes
+	Sha256 string `json:"sha256,omitempty"`
+	// plain text access key ID
+	AccessKeyId     string        `json:"accessKeyId,omitempty"`
+	AccessKeyIdFrom *EnvVarSource `json:"accessKeyIdFrom,omitempty"`
+	// plain text secret access key
+	SecretAccessKey     string        `json:"secretAccessKey,omitempty"`
+	SecretAccessKeyFrom *EnvVarSource `json:"secretAccessKeyFrom,omitempty"`
+	// connect over plain HTTP instead of HTTPS
+	DisableSsl bool `json:"disableSsl,omitempty"`
+	// skip verification of the server certificate
+	SkipVerify bool `json:"skipVerify,omitempty"`
+}
@@ -0,0 +1,28 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowContentOci struct {
+	// reference to the OCI artifact, i.e. \"registry.example.com/tests/e2e:1.2.3\" or \"registry.example.com/tests/e2e@sha256:...\"
+	Ref string `json:"ref"`
+	// expected manifest digest; the artifact is pulled by this digest when provided
+	Digest string `json:"digest,omitempty"`
+	// path where the artifact contents should be extracted
+	Path  string        `json:"path"`
+	Mount *BoxedBoolean `json:"mount,omitempty"`
+	// plain text username for the registry
+	Username     string        `json:"username,omitempty"`
+	UsernameFrom *EnvVarSource `json:"usernameFrom,omitempty"`
+	// plain text password or token for the registry
+	Password     string        `json:"password,omitempty"`
+	PasswordFrom *EnvVarSource `json:"passwordFrom,omitempty"`
+	// allow plain HTTP and self-signed certificates for the registry
+	Insecure bool `json:"insecure,omitempty"`
+}
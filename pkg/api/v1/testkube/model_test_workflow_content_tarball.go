@@ -15,4 +15,6 @@ type TestWorkflowContentTarball struct {
 	// path where the tarball should be extracted
 	Path  string        `json:"path"`
 	Mount *BoxedBoolean `json:"mount,omitempty"`
+	// expected SHA-256 checksum of the tarball, verified before extraction
+	Sha256 string `json:"sha256,omitempty"`
 }
@@ -0,0 +1,25 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowEnvironmentHelm struct {
+	// path to the chart directory, or the chart name when the repository is provided
+	Chart string `json:"chart"`
+	// chart repository URL
+	Repository string `json:"repository,omitempty"`
+	// chart version constraint
+	Version string `json:"version,omitempty"`
+	// release name
+	Release string `json:"release,omitempty"`
+	// paths to the values files
+	ValuesFiles []string `json:"valuesFiles,omitempty"`
+	// values to set
+	Values map[string]string `json:"values,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowEnvironmentSpec struct {
+	Content *TestWorkflowContent `json:"content,omitempty"`
+	// working directory to resolve the paths from
+	WorkingDir *BoxedString `json:"workingDir,omitempty"`
+	// paths to the manifests or kustomize directories to apply
+	Manifests []string                     `json:"manifests,omitempty"`
+	Helm      *TestWorkflowEnvironmentHelm `json:"helm,omitempty"`
+	// additional labels to attach to the namespace
+	Labels map[string]string `json:"labels,omitempty"`
+	// image with kubectl and helm binaries used to provision the environment
+	Image string `json:"image,omitempty"`
+	// maximum time to wait for the environment readiness
+	Timeout string `json:"timeout,omitempty"`
+}
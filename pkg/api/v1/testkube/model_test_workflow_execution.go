@@ -40,7 +40,9 @@ type TestWorkflowExecution struct {
 	// additional information from the steps, like referenced executed tests or artifacts
 	Output []TestWorkflowOutput `json:"output,omitempty"`
 	// generated reports from the steps, like junit
-	Reports              []TestWorkflowReport                             `json:"reports,omitempty"`
+	Reports []TestWorkflowReport `json:"reports,omitempty"`
+	// decisions taken for the approval steps
+	Approvals            []TestWorkflowApproval                           `json:"approvals,omitempty"`
 	ResourceAggregations *TestWorkflowExecutionResourceAggregationsReport `json:"resourceAggregations,omitempty"`
 	Workflow             *TestWorkflow                                    `json:"workflow"`
 	ResolvedWorkflow     *TestWorkflow                                    `json:"resolvedWorkflow,omitempty"`
@@ -53,4 +55,5 @@ type TestWorkflowExecution struct {
 	RunningContext  *TestWorkflowRunningContext                 `json:"runningContext,omitempty"`
 	ConfigParams    map[string]TestWorkflowExecutionConfigValue `json:"configParams,omitempty"`
 	Runtime         *TestWorkflowExecutionRuntime               `json:"runtime,omitempty"`
+	Triage          *TestWorkflowExecutionTriage                `json:"triage,omitempty"`
 }
@@ -3,6 +3,7 @@ package testkube
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/gookit/color"
 
@@ -132,6 +133,39 @@ func (e *TestWorkflowExecution) GetParallelStepReference(nameOrReference string)
 	return ""
 }
 
+// GetApprovalDecision returns the decision recorded for the approval step, if any
+func (e *TestWorkflowExecution) GetApprovalDecision(ref string) *TestWorkflowApproval {
+	if e == nil {
+		return nil
+	}
+	for i := range e.Approvals {
+		if e.Approvals[i].Ref == ref && e.Approvals[i].Decision != nil {
+			return &e.Approvals[i]
+		}
+	}
+	return nil
+}
+
+// PendingApprovals returns the approval requests that are still waiting for the decision
+func (e *TestWorkflowExecution) PendingApprovals() []TestWorkflowApproval {
+	if e == nil || e.Result == nil || e.Result.IsFinished() {
+		return nil
+	}
+	result := make([]TestWorkflowApproval, 0)
+	for ref, step := range e.Result.Steps {
+		if !step.Approval.IsPending() || e.GetApprovalDecision(ref) != nil {
+			continue
+		}
+		approval := *step.Approval
+		approval.Ref = ref
+		result = append(result, approval)
+	}
+	slices.SortFunc(result, func(a, b TestWorkflowApproval) int {
+		return a.RequestedAt.Compare(b.RequestedAt)
+	})
+	return result
+}
+
 func (e *TestWorkflowExecution) Assigned() bool {
 	return e.Result.IsFinished() || len(e.Signature) > 0
 }
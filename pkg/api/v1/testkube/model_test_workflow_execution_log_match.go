@@ -0,0 +1,35 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+type TestWorkflowExecutionLogMatch struct {
+	// execution id
+	ExecutionId string `json:"executionId"`
+	// execution name
+	ExecutionName string `json:"executionName,omitempty"`
+	// test workflow name
+	WorkflowName string `json:"workflowName,omitempty"`
+	// when the execution has been scheduled
+	ScheduledAt time.Time `json:"scheduledAt,omitempty"`
+	// reference of the step that printed the line
+	Step string `json:"step"`
+	// line number in the step log, starting from 1
+	Line int32 `json:"line"`
+	// matched line
+	Text string `json:"text"`
+	// lines printed before the matched line
+	Before []string `json:"before,omitempty"`
+	// lines printed after the matched line
+	After []string `json:"after,omitempty"`
+}
@@ -0,0 +1,31 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// triage of the finished execution
+type TestWorkflowExecutionTriage struct {
+	Category *TestWorkflowTriageCategory `json:"category,omitempty"`
+	// person responsible for the investigation
+	Assignee string `json:"assignee,omitempty"`
+	// discussion about the failure
+	Notes []TestWorkflowExecutionTriageNote `json:"notes,omitempty"`
+	// links to the related issues
+	Issues []string `json:"issues,omitempty"`
+	// audit log of the changes
+	History []TestWorkflowExecutionTriageChange `json:"history,omitempty"`
+	// who has updated the triage last time
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// when the triage has been updated last time
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
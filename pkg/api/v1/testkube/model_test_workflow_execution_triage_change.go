@@ -0,0 +1,27 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+type TestWorkflowExecutionTriageChange struct {
+	// who has made the change
+	Actor string `json:"actor,omitempty"`
+	// changed field: category, assignee, notes or issues
+	Field string `json:"field"`
+	// previous value
+	From string `json:"from,omitempty"`
+	// new value
+	To string `json:"to,omitempty"`
+	// when the change has been made
+	ChangedAt time.Time `json:"changedAt,omitempty"`
+}
@@ -0,0 +1,139 @@
+package testkube
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+const (
+	TriageFieldCategory = "category"
+	TriageFieldAssignee = "assignee"
+	TriageFieldNotes    = "notes"
+	TriageFieldIssues   = "issues"
+)
+
+var TestWorkflowTriageCategories = []TestWorkflowTriageCategory{
+	PRODUCT_BUG_TestWorkflowTriageCategory,
+	TEST_BUG_TestWorkflowTriageCategory,
+	INFRA_TestWorkflowTriageCategory,
+	FLAKY_TestWorkflowTriageCategory,
+}
+
+// ParseTestWorkflowTriageCategory validates the triage category
+func ParseTestWorkflowTriageCategory(value string) (TestWorkflowTriageCategory, error) {
+	category := TestWorkflowTriageCategory(value)
+	if !slices.Contains(TestWorkflowTriageCategories, category) {
+		return "", fmt.Errorf("unknown triage category '%s', expected one of: %s", value, strings.Join(common.MapSlice(TestWorkflowTriageCategories, common.MapEnumToString), ", "))
+	}
+	return category, nil
+}
+
+// IsTriaged determines if the failure category has been selected
+func (t *TestWorkflowExecutionTriage) IsTriaged() bool {
+	return t != nil && t.Category != nil && *t.Category != ""
+}
+
+// GetCategory returns the failure category, or empty string when it's not selected
+func (t *TestWorkflowExecutionTriage) GetCategory() TestWorkflowTriageCategory {
+	if !t.IsTriaged() {
+		return ""
+	}
+	return *t.Category
+}
+
+func (t *TestWorkflowExecutionTriage) Clone() *TestWorkflowExecutionTriage {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	if t.Category != nil {
+		v.Category = common.Ptr(*t.Category)
+	}
+	v.Notes = slices.Clone(t.Notes)
+	v.Issues = slices.Clone(t.Issues)
+	v.History = slices.Clone(t.History)
+	return &v
+}
+
+// Apply builds the triage with the requested changes made by the actor, and records them in the history.
+// The original triage is not modified.
+func (t *TestWorkflowExecutionTriage) Apply(request TestWorkflowExecutionTriageRequest, actor string, now time.Time) (*TestWorkflowExecutionTriage, error) {
+	result := t.Clone()
+	if result == nil {
+		result = &TestWorkflowExecutionTriage{}
+	}
+	changes := make([]TestWorkflowExecutionTriageChange, 0)
+	change := func(field, from, to string) {
+		changes = append(changes, TestWorkflowExecutionTriageChange{Actor: actor, Field: field, From: from, To: to, ChangedAt: now})
+	}
+
+	if request.Category != nil {
+		var category TestWorkflowTriageCategory
+		if *request.Category != "" {
+			var err error
+			if category, err = ParseTestWorkflowTriageCategory(*request.Category); err != nil {
+				return nil, err
+			}
+		}
+		if previous := result.GetCategory(); previous != category {
+			result.Category = nil
+			if category != "" {
+				result.Category = common.Ptr(category)
+			}
+			change(TriageFieldCategory, string(previous), string(category))
+		}
+	}
+
+	if request.Assignee != nil {
+		assignee := strings.TrimSpace(*request.Assignee)
+		if result.Assignee != assignee {
+			change(TriageFieldAssignee, result.Assignee, assignee)
+			result.Assignee = assignee
+		}
+	}
+
+	if note := strings.TrimSpace(request.Note); note != "" {
+		result.Notes = append(result.Notes, TestWorkflowExecutionTriageNote{Author: actor, Text: note, CreatedAt: now})
+		change(TriageFieldNotes, "", note)
+	}
+
+	for _, issue := range request.RemoveIssues {
+		if index := slices.Index(result.Issues, issue); index != -1 {
+			result.Issues = slices.Delete(result.Issues, index, index+1)
+			change(TriageFieldIssues, issue, "")
+		}
+	}
+	for _, issue := range request.AddIssues {
+		issue = strings.TrimSpace(issue)
+		if err := validateIssueURL(issue); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(result.Issues, issue) {
+			result.Issues = append(result.Issues, issue)
+			change(TriageFieldIssues, "", issue)
+		}
+	}
+
+	if len(changes) > 0 {
+		result.History = append(result.History, changes...)
+		result.UpdatedBy = actor
+		result.UpdatedAt = now
+	}
+	return result, nil
+}
+
+func validateIssueURL(issue string) error {
+	u, err := url.Parse(issue)
+	if err != nil {
+		return fmt.Errorf("invalid issue URL '%s': %w", issue, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid issue URL '%s': only absolute http(s) links are allowed", issue)
+	}
+	return nil
+}
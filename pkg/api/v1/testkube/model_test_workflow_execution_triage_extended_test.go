@@ -0,0 +1,60 @@
+package testkube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+func TestTestWorkflowExecutionTriage_Apply(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	var triage *TestWorkflowExecutionTriage
+
+	triage, err := triage.Apply(TestWorkflowExecutionTriageRequest{
+		Category:  common.Ptr("infra"),
+		Assignee:  common.Ptr("bob"),
+		Note:      "node was evicted",
+		AddIssues: []string{"https://github.com/kubeshop/testkube/issues/1"},
+	}, "alice", now)
+	require.NoError(t, err)
+	assert.Equal(t, INFRA_TestWorkflowTriageCategory, triage.GetCategory())
+	assert.Equal(t, "bob", triage.Assignee)
+	assert.Equal(t, []TestWorkflowExecutionTriageNote{{Author: "alice", Text: "node was evicted", CreatedAt: now}}, triage.Notes)
+	assert.Equal(t, []string{"https://github.com/kubeshop/testkube/issues/1"}, triage.Issues)
+	assert.Len(t, triage.History, 4)
+	assert.Equal(t, "alice", triage.UpdatedBy)
+
+	later := now.Add(time.Hour)
+	updated, err := triage.Apply(TestWorkflowExecutionTriageRequest{
+		Category:     common.Ptr("flaky"),
+		Assignee:     common.Ptr("bob"),
+		RemoveIssues: []string{"https://github.com/kubeshop/testkube/issues/1"},
+	}, "bob", later)
+	require.NoError(t, err)
+	assert.Equal(t, FLAKY_TestWorkflowTriageCategory, updated.GetCategory())
+	assert.Empty(t, updated.Issues)
+	assert.Equal(t, []TestWorkflowExecutionTriageChange{
+		{Actor: "bob", Field: TriageFieldCategory, From: "infra", To: "flaky", ChangedAt: later},
+		{Actor: "bob", Field: TriageFieldIssues, From: "https://github.com/kubeshop/testkube/issues/1", ChangedAt: later},
+	}, updated.History[4:])
+
+	// The original triage is kept intact
+	assert.Equal(t, INFRA_TestWorkflowTriageCategory, triage.GetCategory())
+	assert.Len(t, triage.History, 4)
+
+	cleared, err := updated.Apply(TestWorkflowExecutionTriageRequest{Category: common.Ptr("")}, "", later)
+	require.NoError(t, err)
+	assert.False(t, cleared.IsTriaged())
+}
+
+func TestTestWorkflowExecutionTriage_ApplyInvalid(t *testing.T) {
+	_, err := (*TestWorkflowExecutionTriage)(nil).Apply(TestWorkflowExecutionTriageRequest{Category: common.Ptr("unknown")}, "", time.Now())
+	assert.ErrorContains(t, err, "unknown triage category")
+
+	_, err = (*TestWorkflowExecutionTriage)(nil).Apply(TestWorkflowExecutionTriageRequest{AddIssues: []string{"javascript:alert(1)"}}, "", time.Now())
+	assert.ErrorContains(t, err, "invalid issue URL")
+}
@@ -0,0 +1,23 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+type TestWorkflowExecutionTriageNote struct {
+	// who has written the note
+	Author string `json:"author,omitempty"`
+	// content of the note
+	Text string `json:"text"`
+	// when the note has been written
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+}
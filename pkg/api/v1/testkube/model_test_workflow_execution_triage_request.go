@@ -0,0 +1,23 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowExecutionTriageRequest struct {
+	// new failure category, empty value clears it
+	Category *string `json:"category,omitempty"`
+	// new assignee, empty value clears it
+	Assignee *string `json:"assignee,omitempty"`
+	// note to append to the thread
+	Note string `json:"note,omitempty"`
+	// issue URLs to link
+	AddIssues []string `json:"addIssues,omitempty"`
+	// issue URLs to unlink
+	RemoveIssues []string `json:"removeIssues,omitempty"`
+}
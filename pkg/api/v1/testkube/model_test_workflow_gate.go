@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowGate struct {
+	// unique name of the gate
+	Name string `json:"name"`
+	// gate description to display
+	Description string `json:"description,omitempty"`
+	// expression that has to be truthy for the gate to pass
+	Condition string                  `json:"condition"`
+	OnFailure *TestWorkflowGateAction `json:"onFailure,omitempty"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// TestWorkflowGateAction : what should happen when the gate condition is not satisfied
+type TestWorkflowGateAction string
+
+// List of TestWorkflowGateAction
+const (
+	FAIL_TestWorkflowGateAction TestWorkflowGateAction = "fail"
+	WARN_TestWorkflowGateAction TestWorkflowGateAction = "warn"
+)
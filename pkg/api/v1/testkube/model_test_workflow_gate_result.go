@@ -0,0 +1,21 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowGateResult struct {
+	// gate name
+	Name string `json:"name"`
+	// condition that has been evaluated
+	Condition string                  `json:"condition,omitempty"`
+	Status    *TestWorkflowGateStatus `json:"status"`
+	OnFailure *TestWorkflowGateAction `json:"onFailure,omitempty"`
+	// details about the failure or evaluation error
+	Message string `json:"message,omitempty"`
+}
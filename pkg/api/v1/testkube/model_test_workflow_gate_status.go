@@ -0,0 +1,19 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowGateStatus string
+
+// List of TestWorkflowGateStatus
+const (
+	PASSED_TestWorkflowGateStatus TestWorkflowGateStatus = "passed"
+	FAILED_TestWorkflowGateStatus TestWorkflowGateStatus = "failed"
+	ERROR_TestWorkflowGateStatus  TestWorkflowGateStatus = "error"
+)
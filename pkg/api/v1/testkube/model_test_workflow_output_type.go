@@ -0,0 +1,22 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// TestWorkflowOutputType : how the output value should be interpreted
+type TestWorkflowOutputType string
+
+// List of TestWorkflowOutputType
+const (
+	STRING_TestWorkflowOutputType  TestWorkflowOutputType = "string"
+	INTEGER_TestWorkflowOutputType TestWorkflowOutputType = "integer"
+	NUMBER_TestWorkflowOutputType  TestWorkflowOutputType = "number"
+	BOOLEAN_TestWorkflowOutputType TestWorkflowOutputType = "boolean"
+	JSON_TestWorkflowOutputType    TestWorkflowOutputType = "json"
+)
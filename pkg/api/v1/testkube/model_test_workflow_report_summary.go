@@ -22,4 +22,6 @@ type TestWorkflowReportSummary struct {
 	Errored int32 `json:"errored,omitempty"`
 	// total duration of all test cases in milliseconds
 	Duration int64 `json:"duration,omitempty"`
+	// numeric metrics extracted from the report, like \"http_req_duration.p(95)\" for k6
+	Metrics map[string]float64 `json:"metrics,omitempty"`
 }
@@ -35,4 +35,6 @@ type TestWorkflowResult struct {
 	Pauses          []TestWorkflowPause               `json:"pauses,omitempty"`
 	Initialization  *TestWorkflowStepResult           `json:"initialization,omitempty"`
 	Steps           map[string]TestWorkflowStepResult `json:"steps,omitempty"`
+	// outcomes of the result gates
+	Gates []TestWorkflowGateResult `json:"gates,omitempty"`
 }
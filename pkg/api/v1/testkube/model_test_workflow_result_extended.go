@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/gookit/color"
@@ -183,6 +184,48 @@ func (r *TestWorkflowResult) IsAnyRequiredStepFailed(sigSequence []TestWorkflowS
 	return false
 }
 
+// InfrastructureFailureReasons are the reasons of the execution termination caused by the cluster, not by the workload.
+var InfrastructureFailureReasons = []string{
+	"Evicted",
+	"ExceededGracePeriod",
+	"Preempted",
+	"Preempting",
+	"TaintManagerEviction",
+	"PreemptionByScheduler",
+	"DeletionByTaintManager",
+	"EvictionByEvictionAPI",
+	"DeletionByPodGC",
+	"TerminationByKubelet",
+	"FailedScheduling",
+	"ImagePullBackOff",
+}
+
+// InfrastructureFailureReason returns the reason why the infrastructure has terminated the aborted execution,
+// or empty string when it has not been caused by the infrastructure.
+func (r *TestWorkflowResult) InfrastructureFailureReason() string {
+	if r == nil || !r.IsAborted() {
+		return ""
+	}
+	messages := make([]string, 0, len(r.Steps)+1)
+	if r.Initialization != nil && r.Initialization.Status.Aborted() {
+		messages = append(messages, r.Initialization.ErrorMessage)
+	}
+	for _, step := range r.Steps {
+		if step.Status.Aborted() {
+			messages = append(messages, step.ErrorMessage)
+		}
+	}
+	for _, reason := range InfrastructureFailureReasons {
+		for _, message := range messages {
+			// The termination reason is appended to the message as "(Reason: details)" or "(Reason)"
+			if strings.Contains(message, "("+reason+":") || strings.Contains(message, "("+reason+")") {
+				return reason
+			}
+		}
+	}
+	return ""
+}
+
 func (r *TestWorkflowResult) IsKnownStep(ref string) bool {
 	if ref == constants.InitStepName {
 		return true
@@ -297,6 +340,7 @@ func (r *TestWorkflowResult) Clone() *TestWorkflowResult {
 		TotalDurationMs: r.DurationMs + r.PausedMs,
 		Initialization:  r.Initialization.Clone(),
 		Steps:           steps,
+		Gates:           slices.Clone(r.Gates),
 	}
 }
 
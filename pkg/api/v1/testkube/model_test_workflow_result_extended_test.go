@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/internal/common"
 )
 
 func TestHealDuration(t *testing.T) {
@@ -120,3 +122,53 @@ func TestTestWorkflowResult_Fatal(t *testing.T) {
 		})
 	}
 }
+
+func TestTestWorkflowResult_InfrastructureFailureReason(t *testing.T) {
+	sig := []TestWorkflowSignature{{Ref: "step1"}, {Ref: "step2"}}
+	heal := func(status TestWorkflowStatus, errorStr string) *TestWorkflowResult {
+		r := &TestWorkflowResult{
+			Status:         common.Ptr(status),
+			Initialization: &TestWorkflowStepResult{Status: common.Ptr(PASSED_TestWorkflowStepStatus)},
+			Steps: map[string]TestWorkflowStepResult{
+				"step1": {Status: common.Ptr(PASSED_TestWorkflowStepStatus)},
+				"step2": {Status: common.Ptr(RUNNING_TestWorkflowStepStatus)},
+			},
+		}
+		r.HealAbortedOrCanceled(sig, errorStr, "Job was aborted", string(status))
+		return r
+	}
+
+	tests := map[string]struct {
+		result *TestWorkflowResult
+		want   string
+	}{
+		"eviction": {
+			result: heal(ABORTED_TestWorkflowStatus, "Evicted: The node was low on resource: ephemeral-storage"),
+			want:   "Evicted",
+		},
+		"preemption by the disruption condition": {
+			result: heal(ABORTED_TestWorkflowStatus, "PreemptionByScheduler: Kubernetes Scheduler preempted this pod"),
+			want:   "PreemptionByScheduler",
+		},
+		"scheduling timeout": {
+			result: heal(ABORTED_TestWorkflowStatus, "FailedScheduling: 0/3 nodes are available (Job timed out after 600 seconds)"),
+			want:   "FailedScheduling",
+		},
+		"timeout of the running test": {
+			result: heal(ABORTED_TestWorkflowStatus, "Job timed out after 600 seconds"),
+		},
+		"canceled by the user": {
+			result: heal(CANCELED_TestWorkflowStatus, "Evicted: The node was low on resource: memory"),
+		},
+		"failed test": {
+			result: &TestWorkflowResult{Status: common.Ptr(FAILED_TestWorkflowStatus)},
+		},
+		"no result": {},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.result.InfrastructureFailureReason())
+		})
+	}
+}
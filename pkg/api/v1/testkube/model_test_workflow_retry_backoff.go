@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// TestWorkflowRetryBackoff : how the delay grows with the next attempts
+type TestWorkflowRetryBackoff string
+
+// List of TestWorkflowRetryBackoff
+const (
+	CONSTANT_TestWorkflowRetryBackoff    TestWorkflowRetryBackoff = "constant"
+	LINEAR_TestWorkflowRetryBackoff      TestWorkflowRetryBackoff = "linear"
+	EXPONENTIAL_TestWorkflowRetryBackoff TestWorkflowRetryBackoff = "exponential"
+)
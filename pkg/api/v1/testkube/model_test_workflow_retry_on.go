@@ -0,0 +1,18 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// retry only when the failure matches any of the conditions
+type TestWorkflowRetryOn struct {
+	// exit codes of the step that should be retried
+	ExitCodes []int32 `json:"exitCodes,omitempty"`
+	// regular expression matching the step logs that should be retried
+	Logs string `json:"logs,omitempty"`
+}
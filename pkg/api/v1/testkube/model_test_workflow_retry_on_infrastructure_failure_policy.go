@@ -0,0 +1,16 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// policy to automatically re-queue the execution terminated by the infrastructure
+type TestWorkflowRetryOnInfrastructureFailurePolicy struct {
+	// maximum number of attempts, including the original execution
+	MaxAttempts int32 `json:"maxAttempts"`
+}
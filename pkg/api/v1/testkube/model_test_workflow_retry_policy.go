@@ -14,4 +14,12 @@ type TestWorkflowRetryPolicy struct {
 	Count int32 `json:"count"`
 	// until when it should retry (defaults to \"passed\")
 	Until string `json:"until,omitempty"`
+	// delay before the next attempt (e.g. \"30s\"), retries immediately when not provided
+	Delay   string                    `json:"delay,omitempty"`
+	Backoff *TestWorkflowRetryBackoff `json:"backoff,omitempty"`
+	// the upper bound for the delay growing with the backoff (e.g. \"5m\")
+	MaxDelay string `json:"maxDelay,omitempty"`
+	// percentage of the delay to randomly add or subtract, to avoid retrying at the same time
+	Jitter  int32                `json:"jitter,omitempty"`
+	RetryOn *TestWorkflowRetryOn `json:"retryOn,omitempty"`
 }
@@ -15,6 +15,7 @@ type TestWorkflowSpec struct {
 	Config      map[string]TestWorkflowParameterSchema `json:"config,omitempty"`
 	System      *TestWorkflowSystem                    `json:"system,omitempty"`
 	Content     *TestWorkflowContent                   `json:"content,omitempty"`
+	Environment *TestWorkflowEnvironmentSpec           `json:"environment,omitempty"`
 	Services    map[string]TestWorkflowServiceSpec     `json:"services,omitempty"`
 	Container   *TestWorkflowContainerConfig           `json:"container,omitempty"`
 	Job         *TestWorkflowJobConfig                 `json:"job,omitempty"`
@@ -22,6 +23,8 @@ type TestWorkflowSpec struct {
 	Setup       []TestWorkflowStep                     `json:"setup,omitempty"`
 	Steps       []TestWorkflowStep                     `json:"steps,omitempty"`
 	After       []TestWorkflowStep                     `json:"after,omitempty"`
+	Gates       []TestWorkflowGate                     `json:"gates,omitempty"`
+	Outputs     []TestWorkflowSpecOutput               `json:"outputs,omitempty"`
 	Events      []TestWorkflowEvent                    `json:"events,omitempty"`
 	Execution   *TestWorkflowExecutionSchema           `json:"execution,omitempty"`
 	Timeouts    *TestWorkflowTimeouts                  `json:"timeouts,omitempty"`
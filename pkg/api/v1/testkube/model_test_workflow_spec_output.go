@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowSpecOutput struct {
+	// name of the output returned by the execution
+	Name string                  `json:"name"`
+	Type *TestWorkflowOutputType `json:"type,omitempty"`
+	// output description to display
+	Description string `json:"description,omitempty"`
+	// expression computing the value out of the steps outputs, defaults to the output with the same name declared by any of the steps
+	Value string `json:"value,omitempty"`
+}
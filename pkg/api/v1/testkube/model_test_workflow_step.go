@@ -17,6 +17,8 @@ type TestWorkflowStep struct {
 	// expression to declare under which conditions the step should be run; defaults to \"passed\", except artifacts where it defaults to \"always\"
 	Condition string        `json:"condition,omitempty"`
 	Pure      *BoxedBoolean `json:"pure,omitempty"`
+	// outputs produced by the step
+	Outputs []TestWorkflowStepOutput `json:"outputs,omitempty"`
 	// should the step be paused initially
 	Paused bool `json:"paused,omitempty"`
 	// is the step expected to fail
@@ -40,6 +42,7 @@ type TestWorkflowStep struct {
 	Container  *TestWorkflowContainerConfig `json:"container,omitempty"`
 	Execute    *TestWorkflowStepExecute     `json:"execute,omitempty"`
 	Artifacts  *TestWorkflowStepArtifacts   `json:"artifacts,omitempty"`
+	Approval   *TestWorkflowStepApproval    `json:"approval,omitempty"`
 	Parallel   *TestWorkflowStepParallel    `json:"parallel,omitempty"`
 	// nested setup steps to run
 	Setup []TestWorkflowStep `json:"setup,omitempty"`
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowStepApproval struct {
+	// message displayed to the reviewers
+	Message string `json:"message,omitempty"`
+	// list of people allowed to approve or reject, anyone may decide when empty
+	Approvers []string `json:"approvers,omitempty"`
+	// maximum time to wait for the decision
+	Timeout string                        `json:"timeout,omitempty"`
+	Default *TestWorkflowApprovalDecision `json:"default,omitempty"`
+}
@@ -0,0 +1,28 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// single attempt of the retried step
+type TestWorkflowStepAttempt struct {
+	// attempt number, starting from 1
+	Number   int32                   `json:"number"`
+	Status   *TestWorkflowStepStatus `json:"status,omitempty"`
+	ExitCode float64                 `json:"exitCode,omitempty"`
+	// when the attempt was started
+	StartedAt time.Time `json:"startedAt,omitempty"`
+	// when the attempt was finished
+	FinishedAt time.Time `json:"finishedAt,omitempty"`
+	// how long it has waited before the next attempt
+	DelayMs int32 `json:"delayMs,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowStepOutput struct {
+	// name of the output, available for the next steps as steps.<id>.outputs.<name>
+	Name string                  `json:"name"`
+	Type *TestWorkflowOutputType `json:"type,omitempty"`
+	// output description to display
+	Description string `json:"description,omitempty"`
+}
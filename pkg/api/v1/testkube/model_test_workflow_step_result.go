@@ -22,5 +22,8 @@ type TestWorkflowStepResult struct {
 	// when the container was started
 	StartedAt time.Time `json:"startedAt,omitempty"`
 	// when the container was finished
-	FinishedAt time.Time `json:"finishedAt,omitempty"`
+	FinishedAt time.Time             `json:"finishedAt,omitempty"`
+	Approval   *TestWorkflowApproval `json:"approval,omitempty"`
+	// attempts of the retried step
+	Attempts []TestWorkflowStepAttempt `json:"attempts,omitempty"`
 }
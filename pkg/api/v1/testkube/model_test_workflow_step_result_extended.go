@@ -1,5 +1,7 @@
 package testkube
 
+import "slices"
+
 func (r *TestWorkflowStepResult) Clone() *TestWorkflowStepResult {
 	if r == nil {
 		return nil
@@ -11,5 +13,7 @@ func (r *TestWorkflowStepResult) Clone() *TestWorkflowStepResult {
 		QueuedAt:     r.QueuedAt,
 		StartedAt:    r.StartedAt,
 		FinishedAt:   r.FinishedAt,
+		Approval:     r.Approval.Clone(),
+		Attempts:     slices.Clone(r.Attempts),
 	}
 }
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowTriageCategory string
+
+// List of TestWorkflowTriageCategory
+const (
+	PRODUCT_BUG_TestWorkflowTriageCategory TestWorkflowTriageCategory = "product-bug"
+	TEST_BUG_TestWorkflowTriageCategory    TestWorkflowTriageCategory = "test-bug"
+	INFRA_TestWorkflowTriageCategory       TestWorkflowTriageCategory = "infra"
+	FLAKY_TestWorkflowTriageCategory       TestWorkflowTriageCategory = "flaky"
+)
@@ -0,0 +1,18 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowTriageCategoryStats struct {
+	Category TestWorkflowTriageCategory `json:"category"`
+	// number of failed executions in the category
+	Count int32 `json:"count"`
+	// share of all failures of the workflow, in percents
+	Share float64 `json:"share"`
+}
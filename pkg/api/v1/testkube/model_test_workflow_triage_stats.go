@@ -0,0 +1,21 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: contact@testkube.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// share of the failures per triage category for the workflow
+type TestWorkflowTriageStats struct {
+	// test workflow name
+	Workflow string `json:"workflow"`
+	// number of failed executions
+	Failures int32 `json:"failures"`
+	// number of failed executions without the category
+	Untriaged  int32                             `json:"untriaged"`
+	Categories []TestWorkflowTriageCategoryStats `json:"categories,omitempty"`
+}
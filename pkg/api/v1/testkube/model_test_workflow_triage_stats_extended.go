@@ -0,0 +1,27 @@
+package testkube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TestWorkflowTriageStatsList []TestWorkflowTriageStats
+
+func (list TestWorkflowTriageStatsList) Table() (header []string, output [][]string) {
+	header = []string{"Test Workflow Name", "Failures", "Untriaged", "Categories"}
+
+	for _, s := range list {
+		categories := make([]string, len(s.Categories))
+		for i, c := range s.Categories {
+			categories[i] = fmt.Sprintf("%s=%d (%.0f%%)", c.Category, c.Count, c.Share)
+		}
+		output = append(output, []string{
+			s.Workflow,
+			fmt.Sprintf("%d", s.Failures),
+			fmt.Sprintf("%d", s.Untriaged),
+			strings.Join(categories, ", "),
+		})
+	}
+
+	return
+}
@@ -0,0 +1,185 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	executorv1 "github.com/kubeshop/testkube/api/executor/v1"
+	testtriggersv1 "github.com/kubeshop/testkube/api/testtriggers/v1"
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	workflowtriggersv1 "github.com/kubeshop/testkube/api/workflowtriggers/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	apiclientv1 "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+	testworkflowsmapper "github.com/kubeshop/testkube/pkg/mapper/testworkflows"
+	webhooksmapper "github.com/kubeshop/testkube/pkg/mapper/webhooks"
+	webhooktemplatesmapper "github.com/kubeshop/testkube/pkg/mapper/webhooktemplates"
+	workflowtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/workflowtriggers"
+)
+
+// APIBackend applies the resources through the Testkube API
+type APIBackend struct {
+	client    apiclientv1.Client
+	namespace string
+}
+
+func NewAPIBackend(client apiclientv1.Client, namespace string) *APIBackend {
+	return &APIBackend{client: client, namespace: namespace}
+}
+
+func (b *APIBackend) List(_ context.Context, kind *Kind) ([]map[string]interface{}, error) {
+	var items []interface{}
+	switch kind {
+	case TestWorkflowKind:
+		list, err := b.client.ListTestWorkflows("")
+		if err != nil {
+			return nil, err
+		}
+		items = common.MapSlice(list, func(v testkube.TestWorkflow) interface{} {
+			return testworkflowsmapper.MapTestWorkflowAPIToKube(v)
+		})
+	case TestWorkflowTemplateKind:
+		list, err := b.client.ListTestWorkflowTemplates("")
+		if err != nil {
+			return nil, err
+		}
+		items = common.MapSlice(list, func(v testkube.TestWorkflowTemplate) interface{} {
+			return testworkflowsmapper.MapTestWorkflowTemplateAPIToKube(v)
+		})
+	case WebhookKind:
+		list, err := b.client.ListWebhooks("")
+		if err != nil {
+			return nil, err
+		}
+		items = common.MapSlice(list, func(v testkube.Webhook) interface{} {
+			return webhooksmapper.MapAPIToCRD(v)
+		})
+	case WebhookTemplateKind:
+		list, err := b.client.ListWebhookTemplates("")
+		if err != nil {
+			return nil, err
+		}
+		items = common.MapSlice(list, func(v testkube.WebhookTemplate) interface{} {
+			return webhooktemplatesmapper.MapAPIToCRD(testkube.WebhookTemplateCreateRequest(v))
+		})
+	case TestTriggerKind:
+		list, err := b.client.ListTestTriggers("")
+		if err != nil {
+			return nil, err
+		}
+		items = common.MapSlice(list, func(v testkube.TestTrigger) interface{} {
+			return testtriggersmapper.MapTestTriggerUpsertRequestToTestTriggerCRD(testtriggersmapper.MapAPIToTestTriggerUpsertRequest(v))
+		})
+	case WorkflowTriggerKind:
+		list, err := b.client.ListWorkflowTriggers("")
+		if err != nil {
+			return nil, err
+		}
+		items = common.MapSlice(list, func(v testkube.WorkflowTrigger) interface{} {
+			return workflowtriggersmapper.MapAPIToCRD(v)
+		})
+	default:
+		return nil, fmt.Errorf("unsupported kind: %s", kind.Name)
+	}
+
+	result := make([]map[string]interface{}, len(items))
+	for i := range items {
+		data, err := json.Marshal(items[i])
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, &result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (b *APIBackend) Create(_ context.Context, kind *Kind, obj map[string]interface{}) error {
+	return b.save(kind, obj, false)
+}
+
+// Update replaces the resource with the expected object, as the Testkube API doesn't support the merge patches
+func (b *APIBackend) Update(_ context.Context, kind *Kind, _ string, obj map[string]interface{}, _ []byte) error {
+	return b.save(kind, obj, true)
+}
+
+func (b *APIBackend) save(kind *Kind, obj map[string]interface{}, update bool) (err error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	typed := kind.new()
+	if err = json.Unmarshal(data, typed); err != nil {
+		return err
+	}
+
+	switch v := typed.(type) {
+	case *testworkflowsv1.TestWorkflow:
+		v.Namespace = b.namespace
+		if update {
+			_, err = b.client.UpdateTestWorkflow(testworkflowsmapper.MapTestWorkflowKubeToAPI(*v))
+		} else {
+			_, err = b.client.CreateTestWorkflow(testworkflowsmapper.MapTestWorkflowKubeToAPI(*v))
+		}
+	case *testworkflowsv1.TestWorkflowTemplate:
+		v.Namespace = b.namespace
+		if update {
+			_, err = b.client.UpdateTestWorkflowTemplate(testworkflowsmapper.MapTestWorkflowTemplateKubeToAPI(*v))
+		} else {
+			_, err = b.client.CreateTestWorkflowTemplate(testworkflowsmapper.MapTestWorkflowTemplateKubeToAPI(*v))
+		}
+	case *executorv1.Webhook:
+		v.Namespace = b.namespace
+		if update {
+			_, err = b.client.UpdateWebhook(apiclientv1.UpdateWebhookOptions(webhooksmapper.MapSpecToUpdate(v)))
+		} else {
+			_, err = b.client.CreateWebhook(apiclientv1.CreateWebhookOptions(webhooksmapper.MapCRDToAPI(*v)))
+		}
+	case *executorv1.WebhookTemplate:
+		v.Namespace = b.namespace
+		if update {
+			_, err = b.client.UpdateWebhookTemplate(apiclientv1.UpdateWebhookTemplateOptions(webhooktemplatesmapper.MapSpecToUpdate(v)))
+		} else {
+			_, err = b.client.CreateWebhookTemplate(apiclientv1.CreateWebhookTemplateOptions(webhooktemplatesmapper.MapCRDToAPI(*v)))
+		}
+	case *testtriggersv1.TestTrigger:
+		v.Namespace = b.namespace
+		request := testtriggersmapper.MapTestTriggerCRDToTestTriggerUpsertRequest(*v)
+		if update {
+			_, err = b.client.UpdateTestTriggerWithReplaceMode(apiclientv1.UpdateTestTriggerOptions(request))
+		} else {
+			_, err = b.client.CreateTestTrigger(apiclientv1.CreateTestTriggerOptions(request))
+		}
+	case *workflowtriggersv1.WorkflowTrigger:
+		v.Namespace = b.namespace
+		if update {
+			_, err = b.client.UpdateWorkflowTrigger(workflowtriggersmapper.MapCRDToAPI(v))
+		} else {
+			_, err = b.client.CreateWorkflowTrigger(workflowtriggersmapper.MapCRDToAPI(v))
+		}
+	default:
+		return fmt.Errorf("unsupported kind: %s", kind.Name)
+	}
+	return err
+}
+
+func (b *APIBackend) Delete(_ context.Context, kind *Kind, name string) error {
+	switch kind {
+	case TestWorkflowKind:
+		return b.client.DeleteTestWorkflow(name)
+	case TestWorkflowTemplateKind:
+		return b.client.DeleteTestWorkflowTemplate(name)
+	case WebhookKind:
+		return b.client.DeleteWebhook(name)
+	case WebhookTemplateKind:
+		return b.client.DeleteWebhookTemplate(name)
+	case TestTriggerKind:
+		return b.client.DeleteTestTrigger(name)
+	case WorkflowTriggerKind:
+		return b.client.DeleteWorkflowTrigger(name)
+	}
+	return fmt.Errorf("unsupported kind: %s", kind.Name)
+}
@@ -0,0 +1,248 @@
+// Package apply synchronizes the Testkube resources with the declarative manifests,
+// similarly to `kubectl apply`: the changes are computed with the three-way merge
+// between the last applied manifest, the new manifest and the live resource.
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// LastAppliedAnnotationName holds the manifest applied most recently.
+	// It's used to detect the fields removed from the manifest, and it marks the resource as managed by the apply.
+	LastAppliedAnnotationName = "testkube.io/last-applied-configuration"
+)
+
+// Backend provides access to the live resources, either through the Kubernetes API or the Testkube API
+type Backend interface {
+	// List returns all the live resources of the kind in the Kubernetes format
+	List(ctx context.Context, kind *Kind) ([]map[string]interface{}, error)
+	// Create creates the resource
+	Create(ctx context.Context, kind *Kind, obj map[string]interface{}) error
+	// Update updates the resource, either by applying the JSON merge patch or replacing it with the expected object
+	Update(ctx context.Context, kind *Kind, name string, obj map[string]interface{}, patch []byte) error
+	// Delete deletes the resource
+	Delete(ctx context.Context, kind *Kind, name string) error
+}
+
+type Action string
+
+const (
+	ActionCreate    Action = "created"
+	ActionConfigure Action = "configured"
+	ActionUnchanged Action = "unchanged"
+	ActionPrune     Action = "pruned"
+)
+
+// Change is a single planned operation
+type Change struct {
+	Kind   *Kind
+	Name   string
+	File   string
+	Action Action
+	// Live is the normalized live resource, nil for created resources
+	Live map[string]interface{}
+	// Result is the expected resource after the change, nil for pruned resources
+	Result map[string]interface{}
+	// Patch is the JSON merge patch for the configured resources
+	Patch []byte
+}
+
+// Options configures the plan
+type Options struct {
+	// Prune deletes the managed resources that are not in the manifests anymore
+	Prune bool
+	// Selector limits the pruned resources
+	Selector labels.Selector
+}
+
+// Plan computes the changes required to apply the resources, in the order they should be executed.
+func Plan(ctx context.Context, backend Backend, resources []Resource, opts Options) ([]Change, error) {
+	resources = slices.Clone(resources)
+	slices.SortStableFunc(resources, func(a, b Resource) int {
+		return kindOrder(a.Kind) - kindOrder(b.Kind)
+	})
+
+	kinds := make([]*Kind, 0, len(Kinds))
+	for _, kind := range Kinds {
+		if opts.Prune || slices.ContainsFunc(resources, func(r Resource) bool { return r.Kind == kind }) {
+			kinds = append(kinds, kind)
+		}
+	}
+	live := make(map[*Kind]map[string]map[string]interface{}, len(kinds))
+	for _, kind := range kinds {
+		items, err := backend.List(ctx, kind)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing %s resources", kind.Name)
+		}
+		live[kind] = make(map[string]map[string]interface{}, len(items))
+		for _, item := range items {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return nil, err
+			}
+			obj, err := normalize(kind, data, false)
+			if err != nil {
+				return nil, errors.Wrapf(err, "reading live %s resource", kind.Name)
+			}
+			live[kind][objectName(obj)] = obj
+		}
+	}
+
+	changes := make([]Change, 0, len(resources))
+	desired := make(map[string]struct{}, len(resources))
+	for _, r := range resources {
+		desired[r.Key()] = struct{}{}
+		change, err := planResource(r, live[r.Kind][r.Name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s/%s", r.Kind.DisplayName(), r.Name)
+		}
+		changes = append(changes, change)
+	}
+
+	if !opts.Prune {
+		return changes, nil
+	}
+	selector := opts.Selector
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	for i := len(kinds) - 1; i >= 0; i-- {
+		kind := kinds[i]
+		names := make([]string, 0, len(live[kind]))
+		for name := range live[kind] {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			obj := live[kind][name]
+			if _, ok := desired[kind.Name+"/"+name]; ok {
+				continue
+			}
+			if _, managed := objectAnnotation(obj, LastAppliedAnnotationName); !managed {
+				continue
+			}
+			if !selector.Matches(labels.Set(objectLabels(obj))) {
+				continue
+			}
+			changes = append(changes, Change{Kind: kind, Name: name, Action: ActionPrune, Live: obj})
+		}
+	}
+	return changes, nil
+}
+
+func planResource(r Resource, live map[string]interface{}) (Change, error) {
+	change := Change{Kind: r.Kind, Name: r.Name, File: r.File, Live: live}
+
+	// Store the manifest itself, so the next apply may detect the removed fields
+	lastApplied, err := json.Marshal(withAnnotation(r.Object, LastAppliedAnnotationName, ""))
+	if err != nil {
+		return change, err
+	}
+	modified := withAnnotation(r.Object, LastAppliedAnnotationName, string(lastApplied))
+
+	if live == nil {
+		change.Action = ActionCreate
+		change.Result = modified
+		return change, nil
+	}
+
+	modifiedJSON, err := json.Marshal(modified)
+	if err != nil {
+		return change, err
+	}
+	currentJSON, err := json.Marshal(live)
+	if err != nil {
+		return change, err
+	}
+	original, _ := objectAnnotation(live, LastAppliedAnnotationName)
+	patch, err := ThreeWayMerge([]byte(original), modifiedJSON, currentJSON)
+	if err != nil {
+		return change, err
+	}
+	if string(patch) == "{}" {
+		change.Action = ActionUnchanged
+		change.Result = live
+		return change, nil
+	}
+
+	resultJSON, err := jsonpatch.MergePatch(currentJSON, patch)
+	if err != nil {
+		return change, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(resultJSON))
+	decoder.UseNumber()
+	if err = decoder.Decode(&change.Result); err != nil {
+		return change, err
+	}
+	change.Action = ActionConfigure
+	change.Patch = patch
+	return change, nil
+}
+
+// ThreeWayMerge computes the JSON merge patch for the live resource, based on the last applied and the new manifest.
+// The fields removed from the manifest since the last apply are deleted,
+// while the fields that were never part of the manifest are kept untouched.
+func ThreeWayMerge(lastApplied, modified, current []byte) ([]byte, error) {
+	return jsonmergepatch.CreateThreeWayJSONMergePatch(lastApplied, modified, current,
+		mergepatch.RequireKeyUnchanged("apiVersion"),
+		mergepatch.RequireKeyUnchanged("kind"),
+		mergepatch.RequireMetadataKeyUnchanged("name"))
+}
+
+// Execute performs the change against the backend
+func Execute(ctx context.Context, backend Backend, change Change) error {
+	switch change.Action {
+	case ActionCreate:
+		return backend.Create(ctx, change.Kind, change.Result)
+	case ActionConfigure:
+		return backend.Update(ctx, change.Kind, change.Name, change.Result, change.Patch)
+	case ActionPrune:
+		return backend.Delete(ctx, change.Kind, change.Name)
+	}
+	return nil
+}
+
+// Diff returns the unified diff between the live and the expected resource, in the YAML format.
+// The last applied annotation is omitted, as it's only duplicating the content.
+func (c *Change) Diff() (string, error) {
+	before, err := diffYAML(c.Live)
+	if err != nil {
+		return "", err
+	}
+	after, err := diffYAML(c.Result)
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s/%s", c.Kind.DisplayName(), c.Name)
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(before),
+		B:        difflib.SplitLines(after),
+		FromFile: "live/" + name,
+		ToFile:   "applied/" + name,
+		Context:  3,
+	})
+}
+
+func diffYAML(obj map[string]interface{}) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	data, err := yaml.Marshal(withAnnotation(obj, LastAppliedAnnotationName, ""))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,247 @@
+package apply
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	apiclientv1 "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	templateManifest = `
+apiVersion: testworkflows.testkube.io/v1
+kind: TestWorkflowTemplate
+metadata:
+  name: setup
+  labels:
+    owner: team-a
+spec:
+  steps:
+  - shell: echo setup
+`
+	workflowManifest = `
+apiVersion: testworkflows.testkube.io/v1
+kind: TestWorkflow
+metadata:
+  name: e2e
+  labels:
+    owner: team-a
+description: End-to-end tests
+spec:
+  use:
+  - name: setup
+  steps:
+  - shell: npm test
+`
+)
+
+func newFakeBackend(objects ...runtime.Object) (*KubernetesBackend, *dynamicfake.FakeDynamicClient) {
+	listKinds := make(map[schema.GroupVersionResource]string, len(Kinds))
+	for _, kind := range Kinds {
+		listKinds[kind.Resource] = kind.Name + "List"
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+	return NewKubernetesBackend(client, "testkube"), client
+}
+
+func mustParse(t *testing.T, manifests ...string) []Resource {
+	var resources []Resource
+	for _, manifest := range manifests {
+		v, err := Parse("test.yaml", []byte(manifest))
+		require.NoError(t, err)
+		resources = append(resources, v...)
+	}
+	return resources
+}
+
+func applyAll(t *testing.T, backend Backend, changes []Change) {
+	for _, change := range changes {
+		require.NoError(t, Execute(context.Background(), backend, change))
+	}
+}
+
+func actions(changes []Change) []string {
+	result := make([]string, len(changes))
+	for i, c := range changes {
+		result[i] = c.Kind.Name + "/" + c.Name + " " + string(c.Action)
+	}
+	return result
+}
+
+func TestParse(t *testing.T) {
+	resources, err := Parse("test.yaml", []byte(workflowManifest+"---\n"+templateManifest))
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, TestWorkflowKind, resources[0].Kind)
+	assert.Equal(t, "e2e", resources[0].Name)
+	assert.Equal(t, TestWorkflowTemplateKind, resources[1].Kind)
+
+	_, err = Parse("test.yaml", []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: x\n"))
+	assert.EqualError(t, err, "unsupported kind: ConfigMap (v1)")
+
+	_, err = Parse("test.yaml", []byte("apiVersion: testworkflows.testkube.io/v1\nkind: TestWorkflow\nmetadata:\n  name: x\nspec:\n  stpes: []\n"))
+	assert.ErrorContains(t, err, `unknown field "stpes"`)
+
+	_, err = Parse("test.yaml", []byte("apiVersion: testworkflows.testkube.io/v1\nkind: TestWorkflow\nspec: {}\n"))
+	assert.EqualError(t, err, "invalid TestWorkflow: metadata.name is required")
+}
+
+func TestPlan_CreatesInDependencyOrder(t *testing.T) {
+	backend, _ := newFakeBackend()
+	changes, err := Plan(context.Background(), backend, mustParse(t, workflowManifest, templateManifest), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TestWorkflowTemplate/setup created", "TestWorkflow/e2e created"}, actions(changes))
+
+	applyAll(t, backend, changes)
+	changes, err = Plan(context.Background(), backend, mustParse(t, workflowManifest, templateManifest), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TestWorkflowTemplate/setup unchanged", "TestWorkflow/e2e unchanged"}, actions(changes))
+}
+
+func TestPlan_ThreeWayMerge(t *testing.T) {
+	backend, client := newFakeBackend()
+	changes, err := Plan(context.Background(), backend, mustParse(t, workflowManifest), Options{})
+	require.NoError(t, err)
+	applyAll(t, backend, changes)
+
+	// Label the resource outside of the manifest
+	workflows := client.Resource(TestWorkflowKind.Resource).Namespace("testkube")
+	live, err := workflows.Get(context.Background(), "e2e", metav1.GetOptions{})
+	require.NoError(t, err)
+	live.SetLabels(map[string]string{"owner": "team-a", "external": "true"})
+	_, err = workflows.Update(context.Background(), live, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// Remove the description from the manifest
+	changed := mustParse(t, workflowManifest)
+	delete(changed[0].Object, "description")
+	changes, err = Plan(context.Background(), backend, changed, Options{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"TestWorkflow/e2e configured"}, actions(changes))
+
+	diff, err := changes[0].Diff()
+	require.NoError(t, err)
+	assert.Contains(t, diff, "-description: End-to-end tests\n")
+	assert.NotContains(t, diff, "external")
+	assert.NotContains(t, diff, LastAppliedAnnotationName)
+
+	applyAll(t, backend, changes)
+	live, err = workflows.Get(context.Background(), "e2e", metav1.GetOptions{})
+	require.NoError(t, err)
+	_, found, _ := unstructured.NestedString(live.Object, "description")
+	assert.False(t, found)
+	assert.Equal(t, map[string]string{"owner": "team-a", "external": "true"}, live.GetLabels())
+}
+
+func TestPlan_Prune(t *testing.T) {
+	unmanaged := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": TestWorkflowKind.APIVersion(),
+		"kind":       TestWorkflowKind.Name,
+		"metadata": map[string]interface{}{
+			"name":      "manual",
+			"namespace": "testkube",
+			"labels":    map[string]interface{}{"owner": "team-a"},
+		},
+	}}
+	backend, _ := newFakeBackend(unmanaged)
+	otherTeam := `
+apiVersion: testworkflows.testkube.io/v1
+kind: TestWorkflow
+metadata:
+  name: other
+  labels:
+    owner: team-b
+`
+	changes, err := Plan(context.Background(), backend, mustParse(t, workflowManifest, templateManifest, otherTeam), Options{})
+	require.NoError(t, err)
+	applyAll(t, backend, changes)
+
+	selector, err := labels.Parse("owner=team-a")
+	require.NoError(t, err)
+	changes, err = Plan(context.Background(), backend, mustParse(t, templateManifest), Options{Prune: true, Selector: selector})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TestWorkflowTemplate/setup unchanged", "TestWorkflow/e2e pruned"}, actions(changes))
+
+	diff, err := changes[1].Diff()
+	require.NoError(t, err)
+	assert.Contains(t, diff, "-  name: e2e\n")
+
+	applyAll(t, backend, changes)
+	items, err := backend.List(context.Background(), TestWorkflowKind)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+}
+
+func TestLoad_Duplicates(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(workflowManifest), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte(workflowManifest), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0644))
+
+	_, err := Load(dir)
+	assert.ErrorContains(t, err, "TestWorkflow/e2e is defined both in")
+}
+
+// fakeAPIClient keeps the workflows and webhooks in memory, as the Testkube API would
+type fakeAPIClient struct {
+	apiclientv1.Client
+	workflows map[string]testkube.TestWorkflow
+	webhooks  map[string]testkube.Webhook
+}
+
+func (c *fakeAPIClient) ListTestWorkflows(string) (testkube.TestWorkflows, error) {
+	return slices.Collect(maps.Values(c.workflows)), nil
+}
+
+func (c *fakeAPIClient) CreateTestWorkflow(w testkube.TestWorkflow) (testkube.TestWorkflow, error) {
+	c.workflows[w.Name] = w
+	return w, nil
+}
+
+func (c *fakeAPIClient) ListWebhooks(string) (testkube.Webhooks, error) {
+	return slices.Collect(maps.Values(c.webhooks)), nil
+}
+
+func (c *fakeAPIClient) CreateWebhook(options apiclientv1.CreateWebhookOptions) (testkube.Webhook, error) {
+	c.webhooks[options.Name] = testkube.Webhook(options)
+	return testkube.Webhook(options), nil
+}
+
+func TestPlan_APIBackendRoundTrip(t *testing.T) {
+	client := &fakeAPIClient{workflows: map[string]testkube.TestWorkflow{}, webhooks: map[string]testkube.Webhook{}}
+	backend := NewAPIBackend(client, "testkube")
+	webhookManifest := `
+apiVersion: executor.testkube.io/v1
+kind: Webhook
+metadata:
+  name: slack
+spec:
+  uri: https://hooks.example.com
+  events:
+  - end-testworkflow-failed
+`
+	changes, err := Plan(context.Background(), backend, mustParse(t, workflowManifest, webhookManifest), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TestWorkflow/e2e created", "Webhook/slack created"}, actions(changes))
+	applyAll(t, backend, changes)
+	assert.Equal(t, "testkube", client.workflows["e2e"].Namespace)
+	assert.Contains(t, client.webhooks["slack"].Annotations, LastAppliedAnnotationName)
+
+	changes, err = Plan(context.Background(), backend, mustParse(t, workflowManifest, webhookManifest), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TestWorkflow/e2e unchanged", "Webhook/slack unchanged"}, actions(changes))
+}
@@ -0,0 +1,93 @@
+package apply
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	executorv1 "github.com/kubeshop/testkube/api/executor/v1"
+	testtriggersv1 "github.com/kubeshop/testkube/api/testtriggers/v1"
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	workflowtriggersv1 "github.com/kubeshop/testkube/api/workflowtriggers/v1"
+)
+
+// Kind describes the resource kind that may be applied declaratively
+type Kind struct {
+	Name     string
+	Resource schema.GroupVersionResource
+	new      func() interface{}
+}
+
+// APIVersion returns the API version of the kind, as expected in the manifests
+func (k *Kind) APIVersion() string {
+	return k.Resource.GroupVersion().String()
+}
+
+// DisplayName returns the lower-case name of the kind, used in the output
+func (k *Kind) DisplayName() string {
+	return strings.ToLower(k.Name)
+}
+
+var (
+	WebhookTemplateKind = &Kind{
+		Name:     executorv1.WebhookTemplateResource,
+		Resource: executorv1.GroupVersion.WithResource("webhooktemplates"),
+		new:      func() interface{} { return &executorv1.WebhookTemplate{} },
+	}
+	TestWorkflowTemplateKind = &Kind{
+		Name:     testworkflowsv1.ResourceTemplate,
+		Resource: testworkflowsv1.GroupVersion.WithResource("testworkflowtemplates"),
+		new:      func() interface{} { return &testworkflowsv1.TestWorkflowTemplate{} },
+	}
+	TestWorkflowKind = &Kind{
+		Name:     testworkflowsv1.Resource,
+		Resource: testworkflowsv1.GroupVersion.WithResource("testworkflows"),
+		new:      func() interface{} { return &testworkflowsv1.TestWorkflow{} },
+	}
+	WebhookKind = &Kind{
+		Name:     executorv1.WebhookResource,
+		Resource: executorv1.GroupVersion.WithResource("webhooks"),
+		new:      func() interface{} { return &executorv1.Webhook{} },
+	}
+	TestTriggerKind = &Kind{
+		Name:     testtriggersv1.Resource,
+		Resource: testtriggersv1.GroupVersion.WithResource("testtriggers"),
+		new:      func() interface{} { return &testtriggersv1.TestTrigger{} },
+	}
+	WorkflowTriggerKind = &Kind{
+		Name:     workflowtriggersv1.Kind,
+		Resource: workflowtriggersv1.GroupVersionResource,
+		new:      func() interface{} { return &workflowtriggersv1.WorkflowTrigger{} },
+	}
+
+	// Kinds are all the supported kinds in the dependency order,
+	// so the templates are applied before the resources using them,
+	// and the workflows are applied before the triggers running them.
+	Kinds = []*Kind{
+		WebhookTemplateKind,
+		TestWorkflowTemplateKind,
+		TestWorkflowKind,
+		WebhookKind,
+		TestTriggerKind,
+		WorkflowTriggerKind,
+	}
+)
+
+// FindKind returns the supported kind for the manifest type, or nil
+func FindKind(apiVersion, kind string) *Kind {
+	for _, k := range Kinds {
+		if k.Name == kind && k.APIVersion() == apiVersion {
+			return k
+		}
+	}
+	return nil
+}
+
+func kindOrder(kind *Kind) int {
+	for i, k := range Kinds {
+		if k == kind {
+			return i
+		}
+	}
+	return len(Kinds)
+}
@@ -0,0 +1,62 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// KubernetesBackend applies the resources directly as the Kubernetes custom resources
+type KubernetesBackend struct {
+	client    dynamic.Interface
+	namespace string
+}
+
+func NewKubernetesBackend(client dynamic.Interface, namespace string) *KubernetesBackend {
+	return &KubernetesBackend{client: client, namespace: namespace}
+}
+
+func (b *KubernetesBackend) List(ctx context.Context, kind *Kind) ([]map[string]interface{}, error) {
+	list, err := b.client.Resource(kind.Resource).Namespace(b.namespace).List(ctx, metav1.ListOptions{})
+	if k8serrors.IsNotFound(err) {
+		// The CRD is not installed, so there are no resources of that kind
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	items := make([]map[string]interface{}, len(list.Items))
+	for i := range list.Items {
+		items[i] = list.Items[i].Object
+	}
+	return items, nil
+}
+
+func (b *KubernetesBackend) Create(ctx context.Context, kind *Kind, obj map[string]interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var u unstructured.Unstructured
+	if err = u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	u.SetNamespace(b.namespace)
+	_, err = b.client.Resource(kind.Resource).Namespace(b.namespace).Create(ctx, &u, metav1.CreateOptions{})
+	return err
+}
+
+// Update patches the resource, so the fields unknown to this client are preserved
+func (b *KubernetesBackend) Update(ctx context.Context, kind *Kind, name string, _ map[string]interface{}, patch []byte) error {
+	_, err := b.client.Resource(kind.Resource).Namespace(b.namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+func (b *KubernetesBackend) Delete(ctx context.Context, kind *Kind, name string) error {
+	return b.client.Resource(kind.Resource).Namespace(b.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,202 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Resource is a single manifest to apply
+type Resource struct {
+	Kind *Kind
+	Name string
+	File string
+	// Object is the normalized manifest
+	Object map[string]interface{}
+}
+
+// Key returns the unique identifier of the resource
+func (r *Resource) Key() string {
+	return r.Kind.Name + "/" + r.Name
+}
+
+// Load reads the resources from the YAML files, recursively for directories
+func Load(paths ...string) ([]Resource, error) {
+	resources := make([]Resource, 0)
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != root && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			ext := filepath.Ext(path)
+			if path != root && ext != ".yaml" && ext != ".yml" && ext != ".json" {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			v, err := Parse(path, content)
+			if err != nil {
+				return errors.Wrap(err, path)
+			}
+			resources = append(resources, v...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	seen := make(map[string]string, len(resources))
+	for _, r := range resources {
+		if file, ok := seen[r.Key()]; ok {
+			return nil, fmt.Errorf("%s is defined both in %s and %s", r.Key(), file, r.File)
+		}
+		seen[r.Key()] = r.File
+	}
+	return resources, nil
+}
+
+// Parse reads the resources from the YAML or JSON content.
+// It fails for the unsupported kinds and unknown fields, so no part of the manifest is silently ignored.
+func Parse(file string, content []byte) ([]Resource, error) {
+	resources := make([]Resource, 0)
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBuffer(content), len(content))
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		var meta metav1.TypeMeta
+		if err = json.Unmarshal(raw, &meta); err != nil {
+			return nil, err
+		}
+		kind := FindKind(meta.APIVersion, meta.Kind)
+		if kind == nil {
+			return nil, fmt.Errorf("unsupported kind: %s (%s)", meta.Kind, meta.APIVersion)
+		}
+		obj, err := normalize(kind, raw, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", kind.Name, err)
+		}
+		name := objectName(obj)
+		if name == "" {
+			return nil, fmt.Errorf("invalid %s: metadata.name is required", kind.Name)
+		}
+		resources = append(resources, Resource{Kind: kind, Name: name, File: file, Object: obj})
+	}
+}
+
+// normalize decodes the object into its typed representation and back,
+// to have the same format for the manifests and the live objects.
+// Only the user-controlled metadata is kept, and the status is dropped.
+func normalize(kind *Kind, data []byte, strict bool) (map[string]interface{}, error) {
+	typed := kind.new()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(typed); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(typed)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+
+	meta, _ := obj["metadata"].(map[string]interface{})
+	cleanMeta := map[string]interface{}{"name": meta["name"]}
+	for _, key := range []string{"labels", "annotations"} {
+		if v, ok := meta[key].(map[string]interface{}); ok && len(v) > 0 {
+			cleanMeta[key] = v
+		}
+	}
+	obj["metadata"] = cleanMeta
+	obj["apiVersion"] = kind.APIVersion()
+	obj["kind"] = kind.Name
+	delete(obj, "status")
+	return obj, nil
+}
+
+func objectName(obj map[string]interface{}) string {
+	meta, _ := obj["metadata"].(map[string]interface{})
+	name, _ := meta["name"].(string)
+	return name
+}
+
+func objectLabels(obj map[string]interface{}) map[string]string {
+	meta, _ := obj["metadata"].(map[string]interface{})
+	labels, _ := meta["labels"].(map[string]interface{})
+	result := make(map[string]string, len(labels))
+	for k, v := range labels {
+		result[k], _ = v.(string)
+	}
+	return result
+}
+
+func objectAnnotation(obj map[string]interface{}, name string) (string, bool) {
+	meta, _ := obj["metadata"].(map[string]interface{})
+	annotations, _ := meta["annotations"].(map[string]interface{})
+	v, ok := annotations[name].(string)
+	return v, ok
+}
+
+// withAnnotation returns the copy of the object with the annotation set, or removed when the value is empty
+func withAnnotation(obj map[string]interface{}, name, value string) map[string]interface{} {
+	result := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		result[k] = v
+	}
+	meta := make(map[string]interface{})
+	for k, v := range obj["metadata"].(map[string]interface{}) {
+		meta[k] = v
+	}
+	annotations := make(map[string]interface{})
+	if current, ok := meta["annotations"].(map[string]interface{}); ok {
+		for k, v := range current {
+			annotations[k] = v
+		}
+	}
+	if value == "" {
+		delete(annotations, name)
+	} else {
+		annotations[name] = value
+	}
+	if len(annotations) == 0 {
+		delete(meta, "annotations")
+	} else {
+		meta["annotations"] = annotations
+	}
+	result["metadata"] = meta
+	return result
+}
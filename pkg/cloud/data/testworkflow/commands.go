@@ -25,9 +25,13 @@ const (
 	CmdTestWorkflowExecutionGetNextExecutionNumber   executor.Command = "workflow_execution_get_next_execution_number"
 	CmdTestWorkflowExecutionGetExecutionTags         executor.Command = "workflow_execution_get_execution_tags"
 	CmdTestWorkflowExecutionUpdateTags               executor.Command = "workflow_execution_update_tags"
+	CmdTestWorkflowExecutionAddApproval              executor.Command = "workflow_execution_add_approval"
+	CmdTestWorkflowExecutionUpdateTriage             executor.Command = "workflow_execution_update_triage"
+	CmdTestWorkflowExecutionGetTriageStats           executor.Command = "workflow_execution_get_triage_stats"
 
 	CmdTestWorkflowOutputPresignSaveLog         executor.Command = "workflow_output_presign_save_log"
 	CmdTestWorkflowOutputPresignReadLog         executor.Command = "workflow_output_presign_read_log"
+	CmdTestWorkflowOutputPresignReadLogChunks   executor.Command = "workflow_output_presign_read_log_chunks"
 	CmdTestWorkflowOutputHasLog                 executor.Command = "workflow_output_has_log"
 	CmdTestWorkflowOutputDeleteByTestWorkflow   executor.Command = "workflow_output_delete_by_test_workflow"
 	CmdTestworkflowOutputDeleteForTestWorkflows executor.Command = "workflow_output_delete_for_test_workflows"
@@ -80,11 +84,19 @@ func command(v interface{}) executor.Command {
 		return CmdTestWorkflowExecutionGetExecutionTags
 	case ExecutionUpdateTagsRequest:
 		return CmdTestWorkflowExecutionUpdateTags
+	case ExecutionAddApprovalRequest:
+		return CmdTestWorkflowExecutionAddApproval
+	case ExecutionUpdateTriageRequest:
+		return CmdTestWorkflowExecutionUpdateTriage
+	case ExecutionGetTriageStatsRequest:
+		return CmdTestWorkflowExecutionGetTriageStats
 
 	case OutputPresignSaveLogRequest:
 		return CmdTestWorkflowOutputPresignSaveLog
 	case OutputPresignReadLogRequest:
 		return CmdTestWorkflowOutputPresignReadLog
+	case OutputPresignReadLogChunksRequest:
+		return CmdTestWorkflowOutputPresignReadLogChunks
 	case OutputHasLogRequest:
 		return CmdTestWorkflowOutputHasLog
 	case ExecutionDeleteOutputByWorkflowRequest:
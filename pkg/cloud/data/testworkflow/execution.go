@@ -254,6 +254,30 @@ func (r *CloudRepository) UpdateTags(ctx context.Context, id string, tags map[st
 	return passNoContent(r.executor, ctx, req)
 }
 
+func (r *CloudRepository) AddApproval(ctx context.Context, id string, approval testkube.TestWorkflowApproval) (bool, error) {
+	req := ExecutionAddApprovalRequest{ID: id, Approval: approval}
+	process := func(v ExecutionAddApprovalResponse) bool {
+		return v.Added
+	}
+	return pass(r.executor, ctx, req, process)
+}
+
+func (r *CloudRepository) UpdateTriage(ctx context.Context, id string, previous, triage *testkube.TestWorkflowExecutionTriage) (bool, error) {
+	req := ExecutionUpdateTriageRequest{ID: id, Previous: previous, Triage: triage}
+	process := func(v ExecutionUpdateTriageResponse) bool {
+		return v.Updated
+	}
+	return pass(r.executor, ctx, req, process)
+}
+
+func (r *CloudRepository) GetTriageStats(ctx context.Context, filter testworkflow2.Filter) ([]testworkflow2.TriageCount, error) {
+	req := ExecutionGetTriageStatsRequest{Filter: filter.(*testworkflow2.FilterImpl)}
+	process := func(v ExecutionGetTriageStatsResponse) []testworkflow2.TriageCount {
+		return v.Counts
+	}
+	return pass(r.executor, ctx, req, process)
+}
+
 func (r *CloudRepository) UpdateResourceAggregations(ctx context.Context, id string, resourceAggregations *testkube.TestWorkflowExecutionResourceAggregationsReport) error {
 	return errors.New("not supported")
 }
@@ -216,6 +216,33 @@ type ExecutionUpdateTagsRequest struct {
 	Tags map[string]string `json:"tags"`
 }
 
+type ExecutionAddApprovalRequest struct {
+	ID       string                        `json:"id"`
+	Approval testkube.TestWorkflowApproval `json:"approval"`
+}
+
+type ExecutionAddApprovalResponse struct {
+	Added bool `json:"added"`
+}
+
+type ExecutionUpdateTriageRequest struct {
+	ID       string                                `json:"id"`
+	Previous *testkube.TestWorkflowExecutionTriage `json:"previous"`
+	Triage   *testkube.TestWorkflowExecutionTriage `json:"triage"`
+}
+
+type ExecutionUpdateTriageResponse struct {
+	Updated bool `json:"updated"`
+}
+
+type ExecutionGetTriageStatsRequest struct {
+	Filter *testworkflow.FilterImpl `json:"filter"`
+}
+
+type ExecutionGetTriageStatsResponse struct {
+	Counts []testworkflow.TriageCount `json:"counts"`
+}
+
 type TestWorkflowListRequest struct {
 	Selector string `json:"selector"`
 }
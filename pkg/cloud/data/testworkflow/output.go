@@ -10,6 +10,7 @@ import (
 
 	"github.com/kubeshop/testkube/pkg/bufferedstream"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
 
 	intconfig "github.com/kubeshop/testkube/internal/config"
 	"github.com/kubeshop/testkube/pkg/cloud"
@@ -96,6 +97,28 @@ func (r *CloudOutputRepository) SaveLog(ctx context.Context, id, workflowName st
 // ReadLog streams the output from Cloud.
 // The caller is responsible for closing the stream.
 func (r *CloudOutputRepository) ReadLog(ctx context.Context, id, workflowName string) (io.ReadCloser, error) {
+	return r.ReadLogWithOptions(ctx, id, workflowName, testworkflowlogs.ReadOptions{})
+}
+
+// ReadLogWithOptions streams the part of the output from Cloud, downloading only the chunks it needs.
+// The caller is responsible for closing the stream.
+func (r *CloudOutputRepository) ReadLogWithOptions(ctx context.Context, id, workflowName string, options testworkflowlogs.ReadOptions) (io.ReadCloser, error) {
+	// The Control Plane without the chunks support fails the request, so fall back to the whole output then
+	req := OutputPresignReadLogChunksRequest{ID: id, WorkflowName: workflowName}
+	chunks, err := pass(r.executor, ctx, req, func(v OutputPresignReadLogChunksResponse) OutputPresignReadLogChunksResponse {
+		return v
+	})
+	if err == nil && chunks.Index != nil && len(chunks.URLs) == len(chunks.Index.Chunks) {
+		segments := testworkflowlogs.ChunkSegments(chunks.Index.Select(options), func(ctx context.Context, chunk testworkflowlogs.Chunk) (io.ReadCloser, error) {
+			body, err := r.download(ctx, chunks.URLs[chunk.Index])
+			if err != nil {
+				return nil, err
+			}
+			return testworkflowlogs.Decompress(body)
+		})
+		return testworkflowlogs.Read(ctx, segments, options), nil
+	}
+
 	url, err := r.PresignReadLog(ctx, id, workflowName)
 	if err != nil {
 		return nil, err
@@ -106,6 +129,18 @@ func (r *CloudOutputRepository) ReadLog(ctx context.Context, id, workflowName st
 		return io.NopCloser(http.NoBody), nil
 	}
 
+	body, err := r.download(ctx, url)
+	if err != nil || options.IsZero() {
+		return body, err
+	}
+	return testworkflowlogs.Read(ctx, []testworkflowlogs.Segment{{Open: func(_ context.Context) (io.ReadCloser, error) {
+		return body, nil
+	}}}, options), nil
+}
+
+// download streams the file from the presigned URL.
+// The caller is responsible for closing the stream.
+func (r *CloudOutputRepository) download(ctx context.Context, url string) (io.ReadCloser, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
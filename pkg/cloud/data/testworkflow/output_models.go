@@ -1,5 +1,9 @@
 package testworkflow
 
+import (
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
+)
+
 type OutputPresignSaveLogRequest struct {
 	ID           string `json:"id"`
 	WorkflowName string `json:"workflowName"`
@@ -18,6 +22,17 @@ type OutputPresignReadLogResponse struct {
 	URL string `json:"url"`
 }
 
+type OutputPresignReadLogChunksRequest struct {
+	ID           string `json:"id"`
+	WorkflowName string `json:"workflowName"`
+}
+
+type OutputPresignReadLogChunksResponse struct {
+	// Index is empty when the output is not stored in chunks
+	Index *testworkflowlogs.Index `json:"index,omitempty"`
+	URLs  []string                `json:"urls,omitempty"`
+}
+
 type OutputHasLogRequest struct {
 	ID           string `json:"id"`
 	WorkflowName string `json:"workflowName"`
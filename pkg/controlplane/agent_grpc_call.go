@@ -128,6 +128,18 @@ func CreateCommands(storageBucket string, storageClient domainstorage.Client, te
 		cloudtestworkflow.CmdTestWorkflowExecutionUpdateTags: Handler(func(ctx context.Context, data cloudtestworkflow.ExecutionUpdateTagsRequest) (r interface{}, err error) {
 			return r, testWorkflowResultsRepository.UpdateTags(ctx, data.ID, data.Tags)
 		}),
+		cloudtestworkflow.CmdTestWorkflowExecutionAddApproval: Handler(func(ctx context.Context, data cloudtestworkflow.ExecutionAddApprovalRequest) (r cloudtestworkflow.ExecutionAddApprovalResponse, err error) {
+			r.Added, err = testWorkflowResultsRepository.AddApproval(ctx, data.ID, data.Approval)
+			return
+		}),
+		cloudtestworkflow.CmdTestWorkflowExecutionUpdateTriage: Handler(func(ctx context.Context, data cloudtestworkflow.ExecutionUpdateTriageRequest) (r cloudtestworkflow.ExecutionUpdateTriageResponse, err error) {
+			r.Updated, err = testWorkflowResultsRepository.UpdateTriage(ctx, data.ID, data.Previous, data.Triage)
+			return
+		}),
+		cloudtestworkflow.CmdTestWorkflowExecutionGetTriageStats: Handler(func(ctx context.Context, data cloudtestworkflow.ExecutionGetTriageStatsRequest) (r cloudtestworkflow.ExecutionGetTriageStatsResponse, err error) {
+			r.Counts, err = testWorkflowResultsRepository.GetTriageStats(ctx, data.Filter)
+			return
+		}),
 	}
 
 	// Set up "Test Workflows - Output" commands
@@ -140,6 +152,12 @@ func CreateCommands(storageBucket string, storageClient domainstorage.Client, te
 			r.URL, err = testWorkflowOutputRepository.PresignReadLog(ctx, data.ID, data.WorkflowName)
 			return
 		}),
+		cloudtestworkflow.CmdTestWorkflowOutputPresignReadLogChunks: Handler(func(ctx context.Context, data cloudtestworkflow.OutputPresignReadLogChunksRequest) (r cloudtestworkflow.OutputPresignReadLogChunksResponse, err error) {
+			if chunked, ok := testWorkflowOutputRepository.(testworkflow.ChunkedOutputRepository); ok {
+				r.Index, r.URLs, err = chunked.PresignReadLogChunks(ctx, data.ID, data.WorkflowName)
+			}
+			return
+		}),
 		cloudtestworkflow.CmdTestWorkflowOutputHasLog: Handler(func(ctx context.Context, data cloudtestworkflow.OutputHasLogRequest) (r cloudtestworkflow.OutputHasLogResponse, err error) {
 			r.Has, err = testWorkflowOutputRepository.HasLog(ctx, data.ID, data.WorkflowName)
 			return
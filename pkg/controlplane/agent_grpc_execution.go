@@ -2,12 +2,15 @@ package controlplane
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/cloud"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowgates"
 )
 
 func (s *Server) SaveExecutionArtifactPresigned(ctx context.Context, req *cloud.SaveExecutionArtifactPresignedRequest) (*cloud.SaveExecutionArtifactPresignedResponse, error) {
@@ -18,7 +21,21 @@ func (s *Server) SaveExecutionArtifactPresigned(ctx context.Context, req *cloud.
 	return &cloud.SaveExecutionArtifactPresignedResponse{Url: url}, nil
 }
 
-func (s *Server) AppendExecutionReport(_ context.Context, _ *cloud.AppendExecutionReportRequest) (*cloud.AppendExecutionReportResponse, error) {
-	// This is currently only used for CapabilityJUnitReports which is unsupported by OSS.
-	return nil, status.Error(codes.Unimplemented, "not supported in the standalone version")
+func (s *Server) AppendExecutionReport(ctx context.Context, req *cloud.AppendExecutionReportRequest) (*cloud.AppendExecutionReportResponse, error) {
+	// The standalone version keeps only the report summary, so it may be used by the result gates.
+	// The full report is available in the artifacts.
+	kind, summary, err := testworkflowgates.SummarizeReport(req.FilePath, req.Report)
+	if err != nil {
+		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("report not supported in the standalone version: %s", err.Error()))
+	}
+	err = s.resultsRepository.UpdateReport(ctx, req.Id, &testkube.TestWorkflowReport{
+		Ref:     req.Step,
+		Kind:    kind,
+		File:    req.FilePath,
+		Summary: summary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cloud.AppendExecutionReportResponse{}, nil
 }
@@ -21,6 +21,8 @@ import (
 	signaturev1 "github.com/kubeshop/testkube/pkg/proto/testkube/testworkflow/signature/v1"
 	testworkflowv1 "github.com/kubeshop/testkube/pkg/proto/testkube/testworkflow/v1"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowgates"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowoutputs"
 	"github.com/kubeshop/testkube/pkg/utils"
 )
 
@@ -210,13 +212,47 @@ func (s *Server) UpdateExecutionResult(ctx context.Context, req *cloud.UpdateExe
 	if err != nil {
 		return nil, err
 	}
+	// Detect approval steps that have just started waiting for the decision
+	var requested *testkube.TestWorkflowExecution
+	if hasPendingApproval(&result) {
+		execution, err := s.resultsRepository.Get(ctx, req.Id)
+		if err == nil && hasNewPendingApproval(execution.Result, &result) {
+			execution.Result = &result
+			requested = &execution
+		}
+	}
 	err = s.resultsRepository.UpdateResult(ctx, req.Id, &result)
 	if err != nil {
 		return nil, err
 	}
+	if requested != nil {
+		s.emitter.Notify(testkube.NewEventApprovalRequestedTestWorkflow(requested, s.envID))
+	}
 	return &cloud.UpdateExecutionResultResponse{}, nil
 }
 
+func hasPendingApproval(result *testkube.TestWorkflowResult) bool {
+	for _, step := range result.Steps {
+		if step.Approval.IsPending() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNewPendingApproval determines if the result contains approval requests that were not known before
+func hasNewPendingApproval(prev, next *testkube.TestWorkflowResult) bool {
+	for ref, step := range next.Steps {
+		if !step.Approval.IsPending() {
+			continue
+		}
+		if prev == nil || prev.Steps[ref].Approval == nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Server) UpdateExecutionOutput(ctx context.Context, req *cloud.UpdateExecutionOutputRequest) (*cloud.UpdateExecutionOutputResponse, error) {
 	err := s.resultsRepository.UpdateOutput(ctx, req.Id, common.MapSlice(req.Output, func(t *cloud.ExecutionOutput) testkube.TestWorkflowOutput {
 		var v map[string]interface{}
@@ -262,6 +298,19 @@ func (s *Server) FinishExecution(ctx context.Context, req *cloud.FinishExecution
 		return nil, status.Error(codes.NotFound, "execution not found")
 	}
 
+	// Evaluate the result gates against the aggregated execution data
+	testworkflowgates.Apply(&execution, &result)
+
+	// Compute the outputs returned by the execution
+	if applied, err := testworkflowoutputs.Apply(&execution); applied {
+		if err != nil {
+			log.Warnw("FinishExecution: some of the workflow outputs could not be computed", "id", req.Id, "error", err)
+		}
+		if err = s.resultsRepository.UpdateOutput(ctx, req.Id, execution.Output); err != nil {
+			log.Errorw("FinishExecution: failed to save the workflow outputs", "id", req.Id, "error", err)
+		}
+	}
+
 	updated, err := s.resultsRepository.FinishResultStrict(ctx, req.Id, common.StandaloneRunner, &result)
 	switch {
 	case utils.IsNotFound(err):
@@ -278,6 +327,14 @@ func (s *Server) FinishExecution(ctx context.Context, req *cloud.FinishExecution
 	execution.StatusAt = result.FinishedAt
 	execution.Result = &result
 
+	// The runner uploads the whole log at once, so split it into the chunks for the partial reads.
+	// It's done before emitting the events, so the listeners don't read the original log while it's deleted.
+	if chunked, ok := s.outputRepository.(testworkflow.ChunkedOutputRepository); ok {
+		if err := chunked.CompactLog(context.WithoutCancel(ctx), execution.Id, execution.Workflow.Name); err != nil {
+			log.Errorw("FinishExecution: failed to compact the execution log", "id", execution.Id, "error", err)
+		}
+	}
+
 	switch {
 	case execution.Result.IsPassed():
 		s.emitter.Notify(testkube.NewEventEndTestWorkflowSuccess(&execution, s.envID))
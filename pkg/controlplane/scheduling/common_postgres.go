@@ -90,6 +90,7 @@ func mapPgTestWorkflowResult(r sqlc.TestWorkflowResult) testkube.TestWorkflowRes
 		Pauses:          r.Pauses,
 		Initialization:  r.Initialization,
 		Steps:           r.Steps,
+		Gates:           r.Gates,
 	}
 }
 
@@ -0,0 +1,187 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+type SQLiteExecutionController struct {
+	db *sqlitedb.DB
+}
+
+func NewSQLiteExecutionController(db *sqlitedb.DB) Controller {
+	return &SQLiteExecutionController{db: db}
+}
+
+// transition moves the execution from one of the passed statuses with the update function.
+// It returns false when no execution matches.
+func (a SQLiteExecutionController) transition(ctx context.Context, executionId string, from []testkube.TestWorkflowStatus, update func(execution *testkube.TestWorkflowExecution)) (bool, error) {
+	placeholders, args := sqlitedb.Placeholders(from)
+	_, _, err := a.db.UpdateExecution(ctx, sqlitedb.Query{
+		Where: "id = ? AND status IN (" + placeholders + ")",
+		Args:  append([]any{executionId}, args...),
+	}, func(execution *testkube.TestWorkflowExecution) bool {
+		if execution.Result == nil {
+			execution.Result = &testkube.TestWorkflowResult{}
+		}
+		update(execution)
+		return true
+	})
+	switch {
+	case utils.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("unable to update test workflow status: %s", err)
+	}
+	return true, nil
+}
+
+func setStatus(now time.Time, status testkube.TestWorkflowStatus) func(execution *testkube.TestWorkflowExecution) {
+	return func(execution *testkube.TestWorkflowExecution) {
+		execution.StatusAt = now
+		execution.Result.Status = common.Ptr(status)
+	}
+}
+
+func setStopping(now time.Time, predicted testkube.TestWorkflowStatus) func(execution *testkube.TestWorkflowExecution) {
+	return func(execution *testkube.TestWorkflowExecution) {
+		execution.StatusAt = now
+		execution.Result.Status = common.Ptr(testkube.STOPPING_TestWorkflowStatus)
+		execution.Result.PredictedStatus = common.Ptr(predicted)
+	}
+}
+
+func setFinished(now time.Time, status testkube.TestWorkflowStatus) func(execution *testkube.TestWorkflowExecution) {
+	return func(execution *testkube.TestWorkflowExecution) {
+		execution.StatusAt = now
+		execution.Result.FinishedAt = now
+		execution.Result.Status = common.Ptr(status)
+		execution.Result.PredictedStatus = common.Ptr(status)
+	}
+}
+
+// cancelStep cancels the step if it's not terminated (i.e. not passed or failed),
+// and sets the queuedat, startedat and finishedat to `t` if missing.
+func cancelStep(step testkube.TestWorkflowStepResult, t time.Time) testkube.TestWorkflowStepResult {
+	if step.Status == nil || !slices.Contains([]testkube.TestWorkflowStepStatus{testkube.PASSED_TestWorkflowStepStatus, testkube.FAILED_TestWorkflowStepStatus}, *step.Status) {
+		step.Status = common.Ptr(testkube.CANCELED_TestWorkflowStepStatus)
+	}
+	if step.QueuedAt.IsZero() {
+		step.QueuedAt = t
+	}
+	if step.StartedAt.IsZero() {
+		step.StartedAt = t
+	}
+	if step.FinishedAt.IsZero() {
+		step.FinishedAt = t
+	}
+	return step
+}
+
+var (
+	stoppableStatuses = []testkube.TestWorkflowStatus{
+		testkube.STARTING_TestWorkflowStatus,
+		testkube.SCHEDULING_TestWorkflowStatus,
+		testkube.RUNNING_TestWorkflowStatus,
+		testkube.PAUSED_TestWorkflowStatus,
+		testkube.RESUMING_TestWorkflowStatus,
+	}
+	notStartedStatuses = []testkube.TestWorkflowStatus{
+		testkube.QUEUED_TestWorkflowStatus,
+		testkube.ASSIGNED_TestWorkflowStatus,
+	}
+	forceCancellableStatuses = []testkube.TestWorkflowStatus{
+		testkube.QUEUED_TestWorkflowStatus,
+		testkube.ASSIGNED_TestWorkflowStatus,
+		testkube.STARTING_TestWorkflowStatus,
+		testkube.SCHEDULING_TestWorkflowStatus,
+		testkube.RUNNING_TestWorkflowStatus,
+		testkube.PAUSING_TestWorkflowStatus,
+		testkube.PAUSED_TestWorkflowStatus,
+		testkube.RESUMING_TestWorkflowStatus,
+		testkube.STOPPING_TestWorkflowStatus,
+	}
+)
+
+// StartExecution marks an execution that is currently assigned that it should be started.
+// If no execution can be found that matches the passed ID, and is assigned,
+// then no error will be emitted and no action will have been taken.
+func (a SQLiteExecutionController) StartExecution(ctx context.Context, executionId string) error {
+	_, err := a.transition(ctx, executionId, []testkube.TestWorkflowStatus{testkube.ASSIGNED_TestWorkflowStatus},
+		setStatus(time.Now(), testkube.STARTING_TestWorkflowStatus))
+	return err
+}
+
+// PauseExecution marks an execution that is currently running that it should be paused.
+// If no execution can be found that matches the passed ID, and is currently running,
+// then no error will be emitted and no action will have been taken.
+func (a SQLiteExecutionController) PauseExecution(ctx context.Context, executionId string) error {
+	_, err := a.transition(ctx, executionId, []testkube.TestWorkflowStatus{testkube.RUNNING_TestWorkflowStatus},
+		setStatus(time.Now(), testkube.PAUSING_TestWorkflowStatus))
+	return err
+}
+
+// ResumeExecution marks an execution that is currently paused that it should be resumed.
+// If no execution can be found that matches the passed ID, and is currently paused,
+// then no error will be emitted and no action will have been taken.
+func (a SQLiteExecutionController) ResumeExecution(ctx context.Context, executionId string) error {
+	_, err := a.transition(ctx, executionId, []testkube.TestWorkflowStatus{testkube.PAUSED_TestWorkflowStatus},
+		setStatus(time.Now(), testkube.RESUMING_TestWorkflowStatus))
+	return err
+}
+
+// AbortExecution marks an execution that is currently in an executing state that it
+// should be aborted. Queued or assigned executions are aborted immediately.
+// If no execution can be found that matches the passed ID, and is in an appropriate state,
+// then no error will be emitted and no action will have been taken.
+func (a SQLiteExecutionController) AbortExecution(ctx context.Context, executionId string) error {
+	return a.stop(ctx, executionId, testkube.ABORTED_TestWorkflowStatus)
+}
+
+// CancelExecution marks an execution that is currently in an executing state that it
+// should be cancelled. Queued or assigned executions are cancelled immediately.
+// If no execution can be found that matches the passed ID, and is in an appropriate state,
+// then no error will be emitted and no action will have been taken.
+func (a SQLiteExecutionController) CancelExecution(ctx context.Context, executionId string) error {
+	return a.stop(ctx, executionId, testkube.CANCELED_TestWorkflowStatus)
+}
+
+func (a SQLiteExecutionController) stop(ctx context.Context, executionId string, status testkube.TestWorkflowStatus) error {
+	now := time.Now()
+	found, err := a.transition(ctx, executionId, stoppableStatuses, setStopping(now, status))
+	if err != nil || found {
+		return err
+	}
+	// It is possible to jump directly to the final state in some circumstances.
+	_, err = a.transition(ctx, executionId, notStartedStatuses, setFinished(now, status))
+	return err
+}
+
+// ForceCancelExecution marks an execution that is currently in any non-terminal state as
+// immediately cancelled, together with all its unfinished steps.
+func (a SQLiteExecutionController) ForceCancelExecution(ctx context.Context, executionId string) error {
+	now := time.Now()
+	found, err := a.transition(ctx, executionId, forceCancellableStatuses, func(execution *testkube.TestWorkflowExecution) {
+		setFinished(now, testkube.CANCELED_TestWorkflowStatus)(execution)
+		if execution.Result.Initialization != nil {
+			execution.Result.Initialization = common.Ptr(cancelStep(*execution.Result.Initialization, now))
+		}
+		for ref, step := range execution.Result.Steps {
+			execution.Result.Steps[ref] = cancelStep(step, now)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("unable to update test workflow status: execution not found or not in cancellable state")
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
+)
+
+type SQLiteExecutionQuerier struct {
+	db *sqlitedb.DB
+}
+
+func NewSQLiteExecutionQuerier(db *sqlitedb.DB) *SQLiteExecutionQuerier {
+	return &SQLiteExecutionQuerier{db: db}
+}
+
+// Pausing yields an iterator returning all executions that should be paused by the runner.
+func (a SQLiteExecutionQuerier) Pausing(ctx context.Context) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	return a.executionIterator(ctx, sqlitedb.Query{Where: "status = ?", Args: []any{testkube.PAUSING_TestWorkflowStatus}})
+}
+
+// Resuming yields an iterator returning all executions that should be resumed by the runner.
+func (a SQLiteExecutionQuerier) Resuming(ctx context.Context) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	return a.executionIterator(ctx, sqlitedb.Query{Where: "status = ?", Args: []any{testkube.RESUMING_TestWorkflowStatus}})
+}
+
+// Aborting yields an iterator returning all executions that should be aborted by the runner.
+func (a SQLiteExecutionQuerier) Aborting(ctx context.Context) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	return a.executionIterator(ctx, sqlitedb.Query{
+		Where: "status = ? AND predicted_status != ?",
+		Args:  []any{testkube.STOPPING_TestWorkflowStatus, testkube.CANCELED_TestWorkflowStatus},
+	})
+}
+
+// Cancelling yields an iterator returning all executions that should be cancelled by the runner.
+func (a SQLiteExecutionQuerier) Cancelling(ctx context.Context) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	return a.executionIterator(ctx, sqlitedb.Query{
+		Where: "status = ? AND predicted_status = ?",
+		Args:  []any{testkube.STOPPING_TestWorkflowStatus, testkube.CANCELED_TestWorkflowStatus},
+	})
+}
+
+// Assigned yields an iterator returning all executions that are assigned to the runner.
+func (a SQLiteExecutionQuerier) Assigned(ctx context.Context) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	return a.executionIterator(ctx, sqlitedb.Query{Where: "status = ?", Args: []any{testkube.ASSIGNED_TestWorkflowStatus}})
+}
+
+// Starting yields an iterator returning all executions that should be started by the runner.
+func (a SQLiteExecutionQuerier) Starting(ctx context.Context) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	return a.executionIterator(ctx, sqlitedb.Query{Where: "status = ?", Args: []any{testkube.STARTING_TestWorkflowStatus}})
+}
+
+// ByStatus yields an iterator returning all executions that match one of the given statuses.
+func (a SQLiteExecutionQuerier) ByStatus(ctx context.Context, statuses []testkube.TestWorkflowStatus) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	placeholders, args := sqlitedb.Placeholders(statuses)
+	return a.executionIterator(ctx, sqlitedb.Query{Where: "status IN (" + placeholders + ")", Args: args})
+}
+
+// executionIterator reads all the matching executions before yielding them,
+// as the database has a single connection, and the caller may update the executions in the loop.
+func (a SQLiteExecutionQuerier) executionIterator(ctx context.Context, q sqlitedb.Query) func(yield func(testkube.TestWorkflowExecution, error) bool) {
+	return func(yield func(testkube.TestWorkflowExecution, error) bool) {
+		executions, err := a.db.FindExecutions(ctx, q)
+		if err != nil {
+			yield(testkube.TestWorkflowExecution{}, fmt.Errorf("find executions with ExecutionQuerier statuses: %w", err))
+			return
+		}
+		for _, exe := range executions {
+			if !yield(exe, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+type SQLiteScheduler struct {
+	db *sqlitedb.DB
+}
+
+func NewSQLiteScheduler(db *sqlitedb.DB) Scheduler {
+	return &SQLiteScheduler{db: db}
+}
+
+func (s *SQLiteScheduler) ScheduleExecution(ctx context.Context, info RunnerInfo) (testkube.TestWorkflowExecution, bool, error) {
+	// Note: Standalone Control Plane does not support policies.
+	// Note: Standalone Control Plane does not support label matches, excludes, etc. It always targets the sole standalone runner.
+
+	now := time.Now()
+	execution, _, err := s.db.UpdateExecution(ctx, sqlitedb.Query{
+		Where: "status IN (?, ?, ?, '') AND (runner_id = ? OR runner_id = '')",
+		Args: []any{
+			testkube.QUEUED_TestWorkflowStatus,
+			testkube.ASSIGNED_TestWorkflowStatus,
+			testkube.STARTING_TestWorkflowStatus,
+			info.Id,
+		},
+		OrderBy: "scheduled_at ASC", // Choose the oldest scheduled match first.
+	}, func(execution *testkube.TestWorkflowExecution) bool {
+		// Only modify the assigned time if we are actually assigning this to a new runner.
+		if execution.RunnerId != info.Id {
+			execution.AssignedAt = now
+		}
+		// Only transition the status if it was QUEUED or nothing. Otherwise leave it alone,
+		// i.e. a STARTING execution that is being retrieved again to be retried.
+		if execution.Result == nil {
+			execution.Result = &testkube.TestWorkflowResult{}
+		}
+		if execution.Result.Status == nil || *execution.Result.Status == "" || *execution.Result.Status == testkube.QUEUED_TestWorkflowStatus {
+			execution.StatusAt = now
+			execution.Result.Status = common.Ptr(testkube.ASSIGNED_TestWorkflowStatus)
+		}
+		execution.RunnerId = info.Id
+		return true
+	})
+	switch {
+	case utils.IsNotFound(err):
+		return testkube.TestWorkflowExecution{}, false, nil
+	case err != nil:
+		return testkube.TestWorkflowExecution{}, false, fmt.Errorf("failed to schedule execution: %w", err)
+	}
+	return execution, true, nil
+}
@@ -118,6 +118,8 @@ type TestWorkflowResult struct {
 	FinishedAt      pgtype.Timestamptz                         `db:"finished_at" json:"finished_at"`
 	CreatedAt       pgtype.Timestamptz                         `db:"created_at" json:"created_at"`
 	UpdatedAt       pgtype.Timestamptz                         `db:"updated_at" json:"updated_at"`
+	Gates           []testkube.TestWorkflowGateResult          `db:"gates" json:"gates"`
+	Approvals       []testkube.TestWorkflowApproval            `db:"approvals" json:"approvals"`
 }
 
 type TestWorkflowSignature struct {
@@ -0,0 +1,136 @@
+package scheduling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
+)
+
+func newTestSQLiteDatabase(t *testing.T) *sqlitedb.DB {
+	ctx := context.Background()
+	db, err := sqlitedb.Open(ctx, sqlitedb.MemoryPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Migrate(ctx)
+	require.NoError(t, err)
+	return db
+}
+
+func insertSQLiteExecution(t *testing.T, db *sqlitedb.DB, id string, status testkube.TestWorkflowStatus, scheduledAt time.Time) {
+	err := db.InsertExecution(context.Background(), testkube.TestWorkflowExecution{
+		Id:          id,
+		Name:        id,
+		ScheduledAt: scheduledAt,
+		Workflow:    &testkube.TestWorkflow{Name: "workflow"},
+		Result: &testkube.TestWorkflowResult{
+			Status: common.Ptr(status),
+			Steps: map[string]testkube.TestWorkflowStepResult{
+				"passed":  {Status: common.Ptr(testkube.PASSED_TestWorkflowStepStatus), QueuedAt: scheduledAt, StartedAt: scheduledAt, FinishedAt: scheduledAt},
+				"running": {Status: common.Ptr(testkube.RUNNING_TestWorkflowStepStatus), QueuedAt: scheduledAt},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func getSQLiteExecution(t *testing.T, db *sqlitedb.DB, id string) testkube.TestWorkflowExecution {
+	execution, err := db.FindExecution(context.Background(), sqlitedb.Query{Where: "id = ?", Args: []any{id}})
+	require.NoError(t, err)
+	return execution
+}
+
+func TestSQLiteScheduler_ScheduleExecution(t *testing.T) {
+	ctx := context.Background()
+	db := newTestSQLiteDatabase(t)
+	scheduler := NewSQLiteScheduler(db)
+	now := time.Now()
+	insertSQLiteExecution(t, db, "newer", testkube.QUEUED_TestWorkflowStatus, now.Add(-time.Minute))
+	insertSQLiteExecution(t, db, "older", testkube.QUEUED_TestWorkflowStatus, now.Add(-time.Hour))
+	insertSQLiteExecution(t, db, "running", testkube.RUNNING_TestWorkflowStatus, now.Add(-2*time.Hour))
+
+	execution, found, err := scheduler.ScheduleExecution(ctx, RunnerInfo{Id: "runner"})
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "older", execution.Id)
+	assert.Equal(t, "runner", execution.RunnerId)
+	assert.Equal(t, testkube.ASSIGNED_TestWorkflowStatus, *execution.Result.Status)
+	assert.False(t, execution.AssignedAt.IsZero())
+
+	// The assigned execution is still matched until it is started, so it may be retried
+	execution, found, err = scheduler.ScheduleExecution(ctx, RunnerInfo{Id: "runner"})
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "older", execution.Id)
+
+	// It is not available for other runners though
+	execution, found, err = scheduler.ScheduleExecution(ctx, RunnerInfo{Id: "other"})
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "newer", execution.Id)
+
+	_, found, err = scheduler.ScheduleExecution(ctx, RunnerInfo{Id: "third"})
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestSQLiteExecutionController(t *testing.T) {
+	ctx := context.Background()
+	db := newTestSQLiteDatabase(t)
+	controller := NewSQLiteExecutionController(db)
+	querier := NewSQLiteExecutionQuerier(db)
+	now := time.Now()
+	insertSQLiteExecution(t, db, "assigned", testkube.ASSIGNED_TestWorkflowStatus, now)
+	insertSQLiteExecution(t, db, "running", testkube.RUNNING_TestWorkflowStatus, now)
+	insertSQLiteExecution(t, db, "queued", testkube.QUEUED_TestWorkflowStatus, now)
+	insertSQLiteExecution(t, db, "passed", testkube.PASSED_TestWorkflowStatus, now)
+
+	require.NoError(t, controller.StartExecution(ctx, "assigned"))
+	assert.Equal(t, testkube.STARTING_TestWorkflowStatus, *getSQLiteExecution(t, db, "assigned").Result.Status)
+	require.NoError(t, controller.StartExecution(ctx, "missing"))
+
+	require.NoError(t, controller.PauseExecution(ctx, "running"))
+	assert.Equal(t, testkube.PAUSING_TestWorkflowStatus, *getSQLiteExecution(t, db, "running").Result.Status)
+	assert.Equal(t, []string{"running"}, collectSQLiteIds(t, querier.Pausing(ctx)))
+
+	require.NoError(t, controller.CancelExecution(ctx, "assigned"))
+	execution := getSQLiteExecution(t, db, "assigned")
+	assert.Equal(t, testkube.STOPPING_TestWorkflowStatus, *execution.Result.Status)
+	assert.Equal(t, testkube.CANCELED_TestWorkflowStatus, *execution.Result.PredictedStatus)
+	assert.Equal(t, []string{"assigned"}, collectSQLiteIds(t, querier.Cancelling(ctx)))
+	assert.Empty(t, collectSQLiteIds(t, querier.Aborting(ctx)))
+
+	require.NoError(t, controller.AbortExecution(ctx, "queued"))
+	execution = getSQLiteExecution(t, db, "queued")
+	assert.Equal(t, testkube.ABORTED_TestWorkflowStatus, *execution.Result.Status)
+	assert.False(t, execution.Result.FinishedAt.IsZero())
+
+	require.NoError(t, controller.ForceCancelExecution(ctx, "running"))
+	execution = getSQLiteExecution(t, db, "running")
+	assert.Equal(t, testkube.CANCELED_TestWorkflowStatus, *execution.Result.Status)
+	assert.Equal(t, testkube.PASSED_TestWorkflowStepStatus, *execution.Result.Steps["passed"].Status)
+	assert.Equal(t, testkube.CANCELED_TestWorkflowStepStatus, *execution.Result.Steps["running"].Status)
+	assert.False(t, execution.Result.Steps["running"].FinishedAt.IsZero())
+
+	assert.Error(t, controller.ForceCancelExecution(ctx, "passed"))
+
+	assert.ElementsMatch(t, []string{"queued", "running"}, collectSQLiteIds(t, querier.ByStatus(ctx, []testkube.TestWorkflowStatus{
+		testkube.ABORTED_TestWorkflowStatus,
+		testkube.CANCELED_TestWorkflowStatus,
+	})))
+}
+
+func collectSQLiteIds(t *testing.T, iterator func(yield func(testkube.TestWorkflowExecution, error) bool)) []string {
+	ids := make([]string, 0)
+	for execution, err := range iterator {
+		require.NoError(t, err)
+		ids = append(ids, execution.Id)
+	}
+	return ids
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/repository"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
 	domainstorage "github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionarchive"
 )
 
 const (
@@ -109,6 +110,11 @@ func (s *Server) GetRepositoryManager() repository.DatabaseRepository {
 	return s.repositoryManager
 }
 
+// GetExecutionImporter builds the importer to recreate archived executions in this control plane
+func (s *Server) GetExecutionImporter() *executionarchive.Importer {
+	return executionarchive.NewImporter(s.resultsRepository, s.outputRepository, s.storageClient, s.cfg.StorageBucket)
+}
+
 func (s *Server) Start(ctx context.Context, ln net.Listener) error {
 	var opts []grpc.ServerOption
 
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE test_workflow_results
+    ADD COLUMN approvals JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE test_workflow_results
+    DROP COLUMN approvals;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Logs of the finished executions, per step, indexed for the full-text search.
+-- The terms are tokenized by the application, so they match the same way in every database.
+CREATE TABLE test_workflow_execution_logs (
+    id BIGSERIAL PRIMARY KEY,
+    execution_id VARCHAR(255) NOT NULL,
+    execution_name VARCHAR(255) NOT NULL DEFAULT '',
+    workflow_name VARCHAR(255) NOT NULL DEFAULT '',
+    step_ref VARCHAR(255) NOT NULL DEFAULT '',
+    step_order INTEGER NOT NULL DEFAULT 0,
+    scheduled_at TIMESTAMPTZ,
+    first_line INTEGER NOT NULL DEFAULT 1,
+    content TEXT NOT NULL,
+    terms TSVECTOR NOT NULL,
+    organization_id VARCHAR(255) DEFAULT '' NOT NULL,
+    environment_id VARCHAR(255) DEFAULT '' NOT NULL
+);
+
+CREATE INDEX idx_test_workflow_execution_logs_terms ON test_workflow_execution_logs USING GIN (terms);
+CREATE INDEX idx_test_workflow_execution_logs_execution_id ON test_workflow_execution_logs(execution_id);
+CREATE INDEX idx_test_workflow_execution_logs_org_env_workflow ON test_workflow_execution_logs(organization_id, environment_id, workflow_name, scheduled_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS test_workflow_execution_logs;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Triage of the finished executions. Category and assignee are denormalized for filtering.
+CREATE TABLE test_workflow_execution_triages (
+    execution_id VARCHAR(255) PRIMARY KEY REFERENCES test_workflow_executions(id) ON DELETE CASCADE,
+    category VARCHAR(64) NOT NULL DEFAULT '',
+    assignee VARCHAR(255) NOT NULL DEFAULT '',
+    triage JSONB NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_test_workflow_execution_triages_category ON test_workflow_execution_triages(category);
+CREATE INDEX idx_test_workflow_execution_triages_assignee ON test_workflow_execution_triages(assignee);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS test_workflow_execution_triages;
+-- +goose StatementEnd
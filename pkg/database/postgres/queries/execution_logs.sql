@@ -0,0 +1,30 @@
+-- name: DeleteTestWorkflowExecutionLogs :exec
+DELETE FROM test_workflow_execution_logs
+WHERE execution_id = @execution_id AND (organization_id = @organization_id AND environment_id = @environment_id);
+
+-- name: InsertTestWorkflowExecutionLog :exec
+INSERT INTO test_workflow_execution_logs (
+    execution_id, execution_name, workflow_name, step_ref, step_order, scheduled_at, first_line, content, terms,
+    organization_id, environment_id
+) VALUES (
+    @execution_id, @execution_name, @workflow_name, @step_ref, @step_order, @scheduled_at, @first_line, @content, to_tsvector('simple', @terms::text),
+    @organization_id, @environment_id
+);
+
+-- name: SearchTestWorkflowExecutionLogs :many
+SELECT execution_id, execution_name, workflow_name, step_ref, scheduled_at, first_line, content
+FROM test_workflow_execution_logs
+WHERE terms @@ plainto_tsquery('simple', @query::text)
+    AND (organization_id = @organization_id AND environment_id = @environment_id)
+    AND (COALESCE(array_length(@workflow_names::text[], 1), 0) = 0 OR workflow_name = ANY(@workflow_names::text[]))
+    AND (sqlc.narg('since')::timestamptz IS NULL OR scheduled_at >= sqlc.narg('since')::timestamptz)
+ORDER BY scheduled_at DESC, execution_id, step_order
+LIMIT NULLIF(@lmt, 0);
+
+-- name: DeleteTestWorkflowExecutionLogsByTestWorkflows :exec
+DELETE FROM test_workflow_execution_logs
+WHERE workflow_name = ANY(@workflow_names::text[]) AND (organization_id = @organization_id AND environment_id = @environment_id);
+
+-- name: DeleteAllTestWorkflowExecutionLogs :exec
+DELETE FROM test_workflow_execution_logs
+WHERE organization_id = @organization_id AND environment_id = @environment_id;
@@ -0,0 +1,36 @@
+-- name: UpsertTestWorkflowExecutionTriage :execrows
+INSERT INTO test_workflow_execution_triages (execution_id, category, assignee, triage)
+SELECT e.id, @category::text, @assignee::text, @triage::jsonb
+FROM test_workflow_executions e
+WHERE e.id = @execution_id AND (e.organization_id = @organization_id AND e.environment_id = @environment_id)
+ON CONFLICT (execution_id) DO UPDATE SET
+    category = EXCLUDED.category,
+    assignee = EXCLUDED.assignee,
+    triage = EXCLUDED.triage,
+    updated_at = NOW();
+
+-- name: UpdateTestWorkflowExecutionTriageStrict :execrows
+INSERT INTO test_workflow_execution_triages (execution_id, category, assignee, triage)
+SELECT e.id, @category::text, @assignee::text, @triage::jsonb
+FROM test_workflow_executions e
+WHERE e.id = @execution_id AND (e.organization_id = @organization_id AND e.environment_id = @environment_id)
+ON CONFLICT (execution_id) DO UPDATE SET
+    category = EXCLUDED.category,
+    assignee = EXCLUDED.assignee,
+    triage = EXCLUDED.triage,
+    updated_at = NOW()
+WHERE COALESCE(test_workflow_execution_triages.triage->>'updatedAt', '') = @previous_updated_at::text;
+
+-- name: GetTestWorkflowTriageStats :many
+SELECT e.workflow_name, COALESCE(t.category, '')::text AS category, COUNT(*) AS count
+FROM test_workflow_executions e
+LEFT JOIN test_workflow_execution_triages t ON t.execution_id = e.id
+WHERE (e.organization_id = @organization_id AND e.environment_id = @environment_id)
+    AND (COALESCE(@workflow_name::text, '') = '' OR e.workflow_name = @workflow_name::text)
+    AND (COALESCE(@workflow_names::text[], ARRAY[]::text[]) = ARRAY[]::text[] OR e.workflow_name = ANY(@workflow_names::text[]))
+    AND (COALESCE(@start_date::timestamptz, '1900-01-01'::timestamptz) = '1900-01-01'::timestamptz OR e.scheduled_at >= @start_date::timestamptz)
+    AND (COALESCE(@end_date::timestamptz, '2100-01-01'::timestamptz) = '2100-01-01'::timestamptz OR e.scheduled_at <= @end_date::timestamptz)
+    AND (COALESCE(@last_n_days::integer, 0) = 0 OR e.scheduled_at >= NOW() - (COALESCE(@last_n_days::integer, 0) || ' days')::interval)
+    AND (COALESCE(@statuses::text[], ARRAY[]::text[]) = ARRAY[]::text[] OR e.status = ANY(@statuses::text[]))
+GROUP BY e.workflow_name, COALESCE(t.category, '')
+ORDER BY e.workflow_name;
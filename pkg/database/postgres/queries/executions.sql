@@ -3,7 +3,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -51,7 +51,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -64,7 +65,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -112,7 +113,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -155,7 +157,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -203,7 +205,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -216,7 +219,7 @@ SELECT DISTINCT ON (e.workflow_name)
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -264,7 +267,8 @@ SELECT DISTINCT ON (e.workflow_name)
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -278,7 +282,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -326,7 +330,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -340,7 +345,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -388,7 +393,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -478,6 +484,14 @@ WHERE e.status IN ('passed', 'failed', 'aborted') AND (e.organization_id = @orga
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest(@selector_conditions::text[]) AS cond)
         )
     )
+    AND (COALESCE(@triage_category::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = @triage_category::text
+    ))
+    AND (COALESCE(@triage_assignee::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = @triage_assignee::text
+    ))
+    AND (COALESCE(@triaged, NULL) IS NULL OR
+         @triaged::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
 ORDER BY e.scheduled_at DESC
 LIMIT NULLIF(@lmt, 0) OFFSET @fst;
 
@@ -491,7 +505,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -540,7 +554,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -645,6 +660,14 @@ WHERE (e.organization_id = @organization_id AND e.environment_id = @environment_
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest(@selector_conditions::text[]) AS cond)
         )
     )
+    AND (COALESCE(@triage_category::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = @triage_category::text
+    ))
+    AND (COALESCE(@triage_assignee::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = @triage_assignee::text
+    ))
+    AND (COALESCE(@triaged, NULL) IS NULL OR
+         @triaged::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
 GROUP BY e.status;
 
 -- name: GetTestWorkflowExecutionsTotalsByWorkflow :many
@@ -662,7 +685,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -710,7 +733,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -799,6 +823,14 @@ WHERE (e.organization_id = @organization_id AND e.environment_id = @environment_
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest(@selector_conditions::text[]) AS cond)
         )
     )
+    AND (COALESCE(@triage_category::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = @triage_category::text
+    ))
+    AND (COALESCE(@triage_assignee::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = @triage_assignee::text
+    ))
+    AND (COALESCE(@triaged, NULL) IS NULL OR
+         @triaged::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
 ORDER BY e.organization_id, e.environment_id, e.scheduled_at DESC
 LIMIT NULLIF(@lmt, 0) OFFSET @fst;
 
@@ -1032,7 +1064,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1080,7 +1112,8 @@ SELECT
         '[]'::json
     )::json  as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -1143,7 +1176,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1191,7 +1224,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM (
     SELECT e.*
     FROM test_workflow_executions e
@@ -1280,6 +1314,14 @@ FROM (
                 ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest(@selector_conditions::text[]) AS cond)
             )
         )
+        AND (COALESCE(@triage_category::text, '') = '' OR EXISTS (
+            SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = @triage_category::text
+        ))
+        AND (COALESCE(@triage_assignee::text, '') = '' OR EXISTS (
+            SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = @triage_assignee::text
+        ))
+        AND (COALESCE(@triaged, NULL) IS NULL OR
+             @triaged::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
     ORDER BY e.scheduled_at DESC
     LIMIT NULLIF(@lmt, 0) OFFSET @fst
 ) e
@@ -1299,7 +1341,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1347,7 +1389,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -1448,14 +1491,22 @@ WHERE (e.organization_id = @organization_id AND e.environment_id = @environment_
                 WHERE w.labels->>split_part(cond, '=', 1) = split_part(cond, '=', 2)
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest(@selector_conditions::text[]) AS cond)
         )
-    );
+    )
+    AND (COALESCE(@triage_category::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = @triage_category::text
+    ))
+    AND (COALESCE(@triage_assignee::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = @triage_assignee::text
+    ))
+    AND (COALESCE(@triaged, NULL) IS NULL OR
+         @triaged::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''));
 
 -- name: GetTestWorkflowExecutionWithRunner :one
 SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1503,7 +1554,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -1577,6 +1629,17 @@ WHERE test_workflow_results.execution_id = @execution_id
     )
 RETURNING test_workflow_results.execution_id;
 
+-- name: UpdateTestWorkflowResultGates :exec
+UPDATE test_workflow_results
+SET gates = @gates
+WHERE execution_id = @execution_id;
+
+-- name: AddTestWorkflowResultApproval :execrows
+UPDATE test_workflow_results
+SET approvals = COALESCE(approvals, '[]'::jsonb) || jsonb_build_array(@approval::jsonb)
+WHERE execution_id = @execution_id
+    AND NOT COALESCE(approvals, '[]'::jsonb) @> jsonb_build_array(jsonb_build_object('ref', @ref::text));
+
 -- name: FinishExecutionStatusAtStrict :exec
 UPDATE test_workflow_executions
 SET status_at = @finished_at
@@ -14,3 +14,10 @@ DELETE FROM execution_sequences WHERE name = ANY(@names::text[]) AND (organizati
 
 -- name: DeleteAllExecutionSequences :exec
 DELETE FROM execution_sequences WHERE organization_id = @organization_id AND environment_id = @environment_id;
+
+-- name: UpsertExecutionSequenceAtLeast :exec
+INSERT INTO execution_sequences (name, number, organization_id, environment_id)
+VALUES (@name, @number, @organization_id, @environment_id)
+ON CONFLICT (name, organization_id, environment_id) DO UPDATE SET
+    number = GREATEST(execution_sequences.number, EXCLUDED.number),
+    updated_at = NOW();
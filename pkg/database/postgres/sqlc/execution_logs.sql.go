@@ -0,0 +1,165 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: execution_logs.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteAllTestWorkflowExecutionLogs = `-- name: DeleteAllTestWorkflowExecutionLogs :exec
+DELETE FROM test_workflow_execution_logs
+WHERE organization_id = $1 AND environment_id = $2
+`
+
+type DeleteAllTestWorkflowExecutionLogsParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	EnvironmentID  string `db:"environment_id" json:"environment_id"`
+}
+
+func (q *Queries) DeleteAllTestWorkflowExecutionLogs(ctx context.Context, arg DeleteAllTestWorkflowExecutionLogsParams) error {
+	_, err := q.db.Exec(ctx, deleteAllTestWorkflowExecutionLogs, arg.OrganizationID, arg.EnvironmentID)
+	return err
+}
+
+const deleteTestWorkflowExecutionLogs = `-- name: DeleteTestWorkflowExecutionLogs :exec
+DELETE FROM test_workflow_execution_logs
+WHERE execution_id = $1 AND (organization_id = $2 AND environment_id = $3)
+`
+
+type DeleteTestWorkflowExecutionLogsParams struct {
+	ExecutionID    string `db:"execution_id" json:"execution_id"`
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	EnvironmentID  string `db:"environment_id" json:"environment_id"`
+}
+
+func (q *Queries) DeleteTestWorkflowExecutionLogs(ctx context.Context, arg DeleteTestWorkflowExecutionLogsParams) error {
+	_, err := q.db.Exec(ctx, deleteTestWorkflowExecutionLogs, arg.ExecutionID, arg.OrganizationID, arg.EnvironmentID)
+	return err
+}
+
+const deleteTestWorkflowExecutionLogsByTestWorkflows = `-- name: DeleteTestWorkflowExecutionLogsByTestWorkflows :exec
+DELETE FROM test_workflow_execution_logs
+WHERE workflow_name = ANY($1::text[]) AND (organization_id = $2 AND environment_id = $3)
+`
+
+type DeleteTestWorkflowExecutionLogsByTestWorkflowsParams struct {
+	WorkflowNames  []string `db:"workflow_names" json:"workflow_names"`
+	OrganizationID string   `db:"organization_id" json:"organization_id"`
+	EnvironmentID  string   `db:"environment_id" json:"environment_id"`
+}
+
+func (q *Queries) DeleteTestWorkflowExecutionLogsByTestWorkflows(ctx context.Context, arg DeleteTestWorkflowExecutionLogsByTestWorkflowsParams) error {
+	_, err := q.db.Exec(ctx, deleteTestWorkflowExecutionLogsByTestWorkflows, arg.WorkflowNames, arg.OrganizationID, arg.EnvironmentID)
+	return err
+}
+
+const insertTestWorkflowExecutionLog = `-- name: InsertTestWorkflowExecutionLog :exec
+INSERT INTO test_workflow_execution_logs (
+    execution_id, execution_name, workflow_name, step_ref, step_order, scheduled_at, first_line, content, terms,
+    organization_id, environment_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, to_tsvector('simple', $9::text),
+    $10, $11
+)
+`
+
+type InsertTestWorkflowExecutionLogParams struct {
+	ExecutionID    string             `db:"execution_id" json:"execution_id"`
+	ExecutionName  string             `db:"execution_name" json:"execution_name"`
+	WorkflowName   string             `db:"workflow_name" json:"workflow_name"`
+	StepRef        string             `db:"step_ref" json:"step_ref"`
+	StepOrder      int32              `db:"step_order" json:"step_order"`
+	ScheduledAt    pgtype.Timestamptz `db:"scheduled_at" json:"scheduled_at"`
+	FirstLine      int32              `db:"first_line" json:"first_line"`
+	Content        string             `db:"content" json:"content"`
+	Terms          string             `db:"terms" json:"terms"`
+	OrganizationID string             `db:"organization_id" json:"organization_id"`
+	EnvironmentID  string             `db:"environment_id" json:"environment_id"`
+}
+
+func (q *Queries) InsertTestWorkflowExecutionLog(ctx context.Context, arg InsertTestWorkflowExecutionLogParams) error {
+	_, err := q.db.Exec(ctx, insertTestWorkflowExecutionLog,
+		arg.ExecutionID,
+		arg.ExecutionName,
+		arg.WorkflowName,
+		arg.StepRef,
+		arg.StepOrder,
+		arg.ScheduledAt,
+		arg.FirstLine,
+		arg.Content,
+		arg.Terms,
+		arg.OrganizationID,
+		arg.EnvironmentID,
+	)
+	return err
+}
+
+const searchTestWorkflowExecutionLogs = `-- name: SearchTestWorkflowExecutionLogs :many
+SELECT execution_id, execution_name, workflow_name, step_ref, scheduled_at, first_line, content
+FROM test_workflow_execution_logs
+WHERE terms @@ plainto_tsquery('simple', $1::text)
+    AND (organization_id = $2 AND environment_id = $3)
+    AND (COALESCE(array_length($4::text[], 1), 0) = 0 OR workflow_name = ANY($4::text[]))
+    AND ($5::timestamptz IS NULL OR scheduled_at >= $5::timestamptz)
+ORDER BY scheduled_at DESC, execution_id, step_order
+LIMIT NULLIF($6, 0)
+`
+
+type SearchTestWorkflowExecutionLogsParams struct {
+	Query          string             `db:"query" json:"query"`
+	OrganizationID string             `db:"organization_id" json:"organization_id"`
+	EnvironmentID  string             `db:"environment_id" json:"environment_id"`
+	WorkflowNames  []string           `db:"workflow_names" json:"workflow_names"`
+	Since          pgtype.Timestamptz `db:"since" json:"since"`
+	Lmt            interface{}        `db:"lmt" json:"lmt"`
+}
+
+type SearchTestWorkflowExecutionLogsRow struct {
+	ExecutionID   string             `db:"execution_id" json:"execution_id"`
+	ExecutionName string             `db:"execution_name" json:"execution_name"`
+	WorkflowName  string             `db:"workflow_name" json:"workflow_name"`
+	StepRef       string             `db:"step_ref" json:"step_ref"`
+	ScheduledAt   pgtype.Timestamptz `db:"scheduled_at" json:"scheduled_at"`
+	FirstLine     int32              `db:"first_line" json:"first_line"`
+	Content       string             `db:"content" json:"content"`
+}
+
+func (q *Queries) SearchTestWorkflowExecutionLogs(ctx context.Context, arg SearchTestWorkflowExecutionLogsParams) ([]SearchTestWorkflowExecutionLogsRow, error) {
+	rows, err := q.db.Query(ctx, searchTestWorkflowExecutionLogs,
+		arg.Query,
+		arg.OrganizationID,
+		arg.EnvironmentID,
+		arg.WorkflowNames,
+		arg.Since,
+		arg.Lmt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchTestWorkflowExecutionLogsRow
+	for rows.Next() {
+		var i SearchTestWorkflowExecutionLogsRow
+		if err := rows.Scan(
+			&i.ExecutionID,
+			&i.ExecutionName,
+			&i.WorkflowName,
+			&i.StepRef,
+			&i.ScheduledAt,
+			&i.FirstLine,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
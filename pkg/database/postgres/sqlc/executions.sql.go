@@ -196,6 +196,14 @@ WHERE (e.organization_id = $1 AND e.environment_id = $2)
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest($22::text[]) AS cond)
         )
     )
+    AND (COALESCE($23::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = $23::text
+    ))
+    AND (COALESCE($24::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = $24::text
+    ))
+    AND (COALESCE($25, NULL) IS NULL OR
+         $25::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
 `
 
 type CountTestWorkflowExecutionsParams struct {
@@ -221,6 +229,9 @@ type CountTestWorkflowExecutionsParams struct {
 	LabelConditions    []string           `db:"label_conditions" json:"label_conditions"`
 	SelectorKeys       []string           `db:"selector_keys" json:"selector_keys"`
 	SelectorConditions []string           `db:"selector_conditions" json:"selector_conditions"`
+	TriageCategory     string             `db:"triage_category" json:"triage_category"`
+	TriageAssignee     string             `db:"triage_assignee" json:"triage_assignee"`
+	Triaged            interface{}        `db:"triaged" json:"triaged"`
 }
 
 func (q *Queries) CountTestWorkflowExecutions(ctx context.Context, arg CountTestWorkflowExecutionsParams) (int64, error) {
@@ -247,6 +258,9 @@ func (q *Queries) CountTestWorkflowExecutions(ctx context.Context, arg CountTest
 		arg.LabelConditions,
 		arg.SelectorKeys,
 		arg.SelectorConditions,
+		arg.TriageCategory,
+		arg.TriageAssignee,
+		arg.Triaged,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -456,7 +470,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -504,7 +518,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -594,8 +609,16 @@ WHERE e.status IN ('passed', 'failed', 'aborted') AND (e.organization_id = $1 AN
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest($22::text[]) AS cond)
         )
     )
+    AND (COALESCE($23::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = $23::text
+    ))
+    AND (COALESCE($24::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = $24::text
+    ))
+    AND (COALESCE($25, NULL) IS NULL OR
+         $25::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
 ORDER BY e.scheduled_at DESC
-LIMIT NULLIF($24, 0) OFFSET $23
+LIMIT NULLIF($27, 0) OFFSET $26
 `
 
 type GetFinishedTestWorkflowExecutionsParams struct {
@@ -621,6 +644,9 @@ type GetFinishedTestWorkflowExecutionsParams struct {
 	LabelConditions    []string           `db:"label_conditions" json:"label_conditions"`
 	SelectorKeys       []string           `db:"selector_keys" json:"selector_keys"`
 	SelectorConditions []string           `db:"selector_conditions" json:"selector_conditions"`
+	TriageCategory     string             `db:"triage_category" json:"triage_category"`
+	TriageAssignee     string             `db:"triage_assignee" json:"triage_assignee"`
+	Triaged            interface{}        `db:"triaged" json:"triaged"`
 	Fst                int32              `db:"fst" json:"fst"`
 	Lmt                interface{}        `db:"lmt" json:"lmt"`
 }
@@ -659,6 +685,8 @@ type GetFinishedTestWorkflowExecutionsRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -684,6 +712,7 @@ type GetFinishedTestWorkflowExecutionsRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetFinishedTestWorkflowExecutions(ctx context.Context, arg GetFinishedTestWorkflowExecutionsParams) ([]GetFinishedTestWorkflowExecutionsRow, error) {
@@ -710,6 +739,9 @@ func (q *Queries) GetFinishedTestWorkflowExecutions(ctx context.Context, arg Get
 		arg.LabelConditions,
 		arg.SelectorKeys,
 		arg.SelectorConditions,
+		arg.TriageCategory,
+		arg.TriageAssignee,
+		arg.Triaged,
 		arg.Fst,
 		arg.Lmt,
 	)
@@ -754,6 +786,8 @@ func (q *Queries) GetFinishedTestWorkflowExecutions(ctx context.Context, arg Get
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -779,6 +813,7 @@ func (q *Queries) GetFinishedTestWorkflowExecutions(ctx context.Context, arg Get
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -795,7 +830,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -844,7 +879,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -903,6 +939,8 @@ type GetFinishedTestWorkflowExecutionsByWorkflowRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -928,6 +966,7 @@ type GetFinishedTestWorkflowExecutionsByWorkflowRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 // Fast-path finished query when filtering by a single denormalized workflow_name
@@ -985,6 +1024,8 @@ func (q *Queries) GetFinishedTestWorkflowExecutionsByWorkflow(ctx context.Contex
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -1010,6 +1051,7 @@ func (q *Queries) GetFinishedTestWorkflowExecutionsByWorkflow(ctx context.Contex
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -1056,7 +1098,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1104,7 +1146,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -1155,6 +1198,8 @@ type GetLatestTestWorkflowExecutionByTestWorkflowRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -1180,6 +1225,7 @@ type GetLatestTestWorkflowExecutionByTestWorkflowRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetLatestTestWorkflowExecutionByTestWorkflow(ctx context.Context, arg GetLatestTestWorkflowExecutionByTestWorkflowParams) (GetLatestTestWorkflowExecutionByTestWorkflowRow, error) {
@@ -1225,6 +1271,8 @@ func (q *Queries) GetLatestTestWorkflowExecutionByTestWorkflow(ctx context.Conte
 		&i.Pauses,
 		&i.Initialization,
 		&i.Steps,
+		&i.Gates,
+		&i.Approvals,
 		&i.WorkflowName,
 		&i.WorkflowNamespace,
 		&i.WorkflowDescription,
@@ -1250,6 +1298,7 @@ func (q *Queries) GetLatestTestWorkflowExecutionByTestWorkflow(ctx context.Conte
 		&i.ReportsJson,
 		&i.ResourceAggregationsGlobal,
 		&i.ResourceAggregationsStep,
+		&i.Triage,
 	)
 	return i, err
 }
@@ -1259,7 +1308,7 @@ SELECT DISTINCT ON (e.workflow_name)
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1307,7 +1356,8 @@ SELECT DISTINCT ON (e.workflow_name)
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -1357,6 +1407,8 @@ type GetLatestTestWorkflowExecutionsByTestWorkflowsRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -1382,6 +1434,7 @@ type GetLatestTestWorkflowExecutionsByTestWorkflowsRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetLatestTestWorkflowExecutionsByTestWorkflows(ctx context.Context, arg GetLatestTestWorkflowExecutionsByTestWorkflowsParams) ([]GetLatestTestWorkflowExecutionsByTestWorkflowsRow, error) {
@@ -1427,6 +1480,8 @@ func (q *Queries) GetLatestTestWorkflowExecutionsByTestWorkflows(ctx context.Con
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -1452,6 +1507,7 @@ func (q *Queries) GetLatestTestWorkflowExecutionsByTestWorkflows(ctx context.Con
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -1518,7 +1574,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1566,7 +1622,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -1615,6 +1672,8 @@ type GetRunningTestWorkflowExecutionsRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -1640,6 +1699,7 @@ type GetRunningTestWorkflowExecutionsRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetRunningTestWorkflowExecutions(ctx context.Context, arg GetRunningTestWorkflowExecutionsParams) ([]GetRunningTestWorkflowExecutionsRow, error) {
@@ -1685,6 +1745,8 @@ func (q *Queries) GetRunningTestWorkflowExecutions(ctx context.Context, arg GetR
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -1710,6 +1772,7 @@ func (q *Queries) GetRunningTestWorkflowExecutions(ctx context.Context, arg GetR
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -1726,7 +1789,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1774,7 +1837,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -1823,6 +1887,8 @@ type GetTestWorkflowExecutionRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -1848,6 +1914,7 @@ type GetTestWorkflowExecutionRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetTestWorkflowExecution(ctx context.Context, arg GetTestWorkflowExecutionParams) (GetTestWorkflowExecutionRow, error) {
@@ -1887,6 +1954,8 @@ func (q *Queries) GetTestWorkflowExecution(ctx context.Context, arg GetTestWorkf
 		&i.Pauses,
 		&i.Initialization,
 		&i.Steps,
+		&i.Gates,
+		&i.Approvals,
 		&i.WorkflowName,
 		&i.WorkflowNamespace,
 		&i.WorkflowDescription,
@@ -1912,6 +1981,7 @@ func (q *Queries) GetTestWorkflowExecution(ctx context.Context, arg GetTestWorkf
 		&i.ReportsJson,
 		&i.ResourceAggregationsGlobal,
 		&i.ResourceAggregationsStep,
+		&i.Triage,
 	)
 	return i, err
 }
@@ -1921,7 +1991,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -1969,7 +2039,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -2019,6 +2090,8 @@ type GetTestWorkflowExecutionByNameAndTestWorkflowRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -2044,6 +2117,7 @@ type GetTestWorkflowExecutionByNameAndTestWorkflowRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetTestWorkflowExecutionByNameAndTestWorkflow(ctx context.Context, arg GetTestWorkflowExecutionByNameAndTestWorkflowParams) (GetTestWorkflowExecutionByNameAndTestWorkflowRow, error) {
@@ -2088,6 +2162,8 @@ func (q *Queries) GetTestWorkflowExecutionByNameAndTestWorkflow(ctx context.Cont
 		&i.Pauses,
 		&i.Initialization,
 		&i.Steps,
+		&i.Gates,
+		&i.Approvals,
 		&i.WorkflowName,
 		&i.WorkflowNamespace,
 		&i.WorkflowDescription,
@@ -2113,6 +2189,7 @@ func (q *Queries) GetTestWorkflowExecutionByNameAndTestWorkflow(ctx context.Cont
 		&i.ReportsJson,
 		&i.ResourceAggregationsGlobal,
 		&i.ResourceAggregationsStep,
+		&i.Triage,
 	)
 	return i, err
 }
@@ -2175,7 +2252,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -2223,7 +2300,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -2273,6 +2351,8 @@ type GetTestWorkflowExecutionWithRunnerRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -2298,6 +2378,7 @@ type GetTestWorkflowExecutionWithRunnerRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetTestWorkflowExecutionWithRunner(ctx context.Context, arg GetTestWorkflowExecutionWithRunnerParams) (GetTestWorkflowExecutionWithRunnerRow, error) {
@@ -2342,6 +2423,8 @@ func (q *Queries) GetTestWorkflowExecutionWithRunner(ctx context.Context, arg Ge
 		&i.Pauses,
 		&i.Initialization,
 		&i.Steps,
+		&i.Gates,
+		&i.Approvals,
 		&i.WorkflowName,
 		&i.WorkflowNamespace,
 		&i.WorkflowDescription,
@@ -2367,6 +2450,7 @@ func (q *Queries) GetTestWorkflowExecutionWithRunner(ctx context.Context, arg Ge
 		&i.ReportsJson,
 		&i.ResourceAggregationsGlobal,
 		&i.ResourceAggregationsStep,
+		&i.Triage,
 	)
 	return i, err
 }
@@ -2376,7 +2460,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -2424,7 +2508,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -2513,8 +2598,16 @@ WHERE (e.organization_id = $1 AND e.environment_id = $2)
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest($22::text[]) AS cond)
         )
     )
+    AND (COALESCE($23::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = $23::text
+    ))
+    AND (COALESCE($24::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = $24::text
+    ))
+    AND (COALESCE($25, NULL) IS NULL OR
+         $25::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
 ORDER BY e.organization_id, e.environment_id, e.scheduled_at DESC
-LIMIT NULLIF($24, 0) OFFSET $23
+LIMIT NULLIF($27, 0) OFFSET $26
 `
 
 type GetTestWorkflowExecutionsParams struct {
@@ -2540,6 +2633,9 @@ type GetTestWorkflowExecutionsParams struct {
 	LabelConditions    []string           `db:"label_conditions" json:"label_conditions"`
 	SelectorKeys       []string           `db:"selector_keys" json:"selector_keys"`
 	SelectorConditions []string           `db:"selector_conditions" json:"selector_conditions"`
+	TriageCategory     string             `db:"triage_category" json:"triage_category"`
+	TriageAssignee     string             `db:"triage_assignee" json:"triage_assignee"`
+	Triaged            interface{}        `db:"triaged" json:"triaged"`
 	Fst                int32              `db:"fst" json:"fst"`
 	Lmt                interface{}        `db:"lmt" json:"lmt"`
 }
@@ -2578,6 +2674,8 @@ type GetTestWorkflowExecutionsRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -2603,6 +2701,7 @@ type GetTestWorkflowExecutionsRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetTestWorkflowExecutions(ctx context.Context, arg GetTestWorkflowExecutionsParams) ([]GetTestWorkflowExecutionsRow, error) {
@@ -2629,6 +2728,9 @@ func (q *Queries) GetTestWorkflowExecutions(ctx context.Context, arg GetTestWork
 		arg.LabelConditions,
 		arg.SelectorKeys,
 		arg.SelectorConditions,
+		arg.TriageCategory,
+		arg.TriageAssignee,
+		arg.Triaged,
 		arg.Fst,
 		arg.Lmt,
 	)
@@ -2673,6 +2775,8 @@ func (q *Queries) GetTestWorkflowExecutions(ctx context.Context, arg GetTestWork
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -2698,6 +2802,7 @@ func (q *Queries) GetTestWorkflowExecutions(ctx context.Context, arg GetTestWork
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -2714,7 +2819,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -2762,7 +2867,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM (
     SELECT e.id, e.name, e.namespace, e.number, e.test_workflow_execution_name, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.scheduled_at, e.assigned_at, e.status_at, e.created_at, e.updated_at, e.organization_id, e.environment_id, e.runtime, e.silent_mode, e.workflow_name, e.status
     FROM test_workflow_executions e
@@ -2851,8 +2957,16 @@ FROM (
                 ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest($22::text[]) AS cond)
             )
         )
+        AND (COALESCE($23::text, '') = '' OR EXISTS (
+            SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = $23::text
+        ))
+        AND (COALESCE($24::text, '') = '' OR EXISTS (
+            SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = $24::text
+        ))
+        AND (COALESCE($25, NULL) IS NULL OR
+             $25::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
     ORDER BY e.scheduled_at DESC
-    LIMIT NULLIF($24, 0) OFFSET $23
+    LIMIT NULLIF($27, 0) OFFSET $26
 ) e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -2884,6 +2998,9 @@ type GetTestWorkflowExecutionsSummaryParams struct {
 	LabelConditions    []string           `db:"label_conditions" json:"label_conditions"`
 	SelectorKeys       []string           `db:"selector_keys" json:"selector_keys"`
 	SelectorConditions []string           `db:"selector_conditions" json:"selector_conditions"`
+	TriageCategory     string             `db:"triage_category" json:"triage_category"`
+	TriageAssignee     string             `db:"triage_assignee" json:"triage_assignee"`
+	Triaged            interface{}        `db:"triaged" json:"triaged"`
 	Fst                int32              `db:"fst" json:"fst"`
 	Lmt                interface{}        `db:"lmt" json:"lmt"`
 }
@@ -2922,6 +3039,8 @@ type GetTestWorkflowExecutionsSummaryRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -2947,6 +3066,7 @@ type GetTestWorkflowExecutionsSummaryRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetTestWorkflowExecutionsSummary(ctx context.Context, arg GetTestWorkflowExecutionsSummaryParams) ([]GetTestWorkflowExecutionsSummaryRow, error) {
@@ -2973,6 +3093,9 @@ func (q *Queries) GetTestWorkflowExecutionsSummary(ctx context.Context, arg GetT
 		arg.LabelConditions,
 		arg.SelectorKeys,
 		arg.SelectorConditions,
+		arg.TriageCategory,
+		arg.TriageAssignee,
+		arg.Triaged,
 		arg.Fst,
 		arg.Lmt,
 	)
@@ -3017,6 +3140,8 @@ func (q *Queries) GetTestWorkflowExecutionsSummary(ctx context.Context, arg GetT
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -3042,6 +3167,7 @@ func (q *Queries) GetTestWorkflowExecutionsSummary(ctx context.Context, arg GetT
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -3058,7 +3184,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -3106,7 +3232,8 @@ SELECT
         '[]'::json
     )::json as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -3161,6 +3288,8 @@ type GetTestWorkflowExecutionsSummaryByWorkflowRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -3186,6 +3315,7 @@ type GetTestWorkflowExecutionsSummaryByWorkflowRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 // Fast-path summary query used when the only filter is a single workflow name
@@ -3242,6 +3372,8 @@ func (q *Queries) GetTestWorkflowExecutionsSummaryByWorkflow(ctx context.Context
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -3267,6 +3399,7 @@ func (q *Queries) GetTestWorkflowExecutionsSummaryByWorkflow(ctx context.Context
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -3368,6 +3501,14 @@ WHERE (e.organization_id = $1 AND e.environment_id = $2)
             ) = (SELECT COUNT(DISTINCT split_part(cond, '=', 1)) FROM unnest($22::text[]) AS cond)
         )
     )
+    AND (COALESCE($23::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category = $23::text
+    ))
+    AND (COALESCE($24::text, '') = '' OR EXISTS (
+        SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.assignee = $24::text
+    ))
+    AND (COALESCE($25, NULL) IS NULL OR
+         $25::boolean = EXISTS (SELECT 1 FROM test_workflow_execution_triages t WHERE t.execution_id = e.id AND t.category != ''))
 GROUP BY e.status
 `
 
@@ -3394,6 +3535,9 @@ type GetTestWorkflowExecutionsTotalsParams struct {
 	LabelConditions    []string           `db:"label_conditions" json:"label_conditions"`
 	SelectorKeys       []string           `db:"selector_keys" json:"selector_keys"`
 	SelectorConditions []string           `db:"selector_conditions" json:"selector_conditions"`
+	TriageCategory     string             `db:"triage_category" json:"triage_category"`
+	TriageAssignee     string             `db:"triage_assignee" json:"triage_assignee"`
+	Triaged            interface{}        `db:"triaged" json:"triaged"`
 }
 
 type GetTestWorkflowExecutionsTotalsRow struct {
@@ -3425,6 +3569,9 @@ func (q *Queries) GetTestWorkflowExecutionsTotals(ctx context.Context, arg GetTe
 		arg.LabelConditions,
 		arg.SelectorKeys,
 		arg.SelectorConditions,
+		arg.TriageCategory,
+		arg.TriageAssignee,
+		arg.Triaged,
 	)
 	if err != nil {
 		return nil, err
@@ -3566,7 +3713,7 @@ SELECT
     e.id, e.group_id, e.runner_id, e.runner_target, e.runner_original_target, e.name, e.namespace, e.number, e.scheduled_at, e.assigned_at, e.status_at, e.test_workflow_execution_name, e.disable_webhooks, e.tags, e.running_context, e.config_params, e.runtime, e.silent_mode, e.created_at, e.updated_at,
     r.status, r.predicted_status, r.queued_at, r.started_at, r.finished_at,
     r.duration, r.total_duration, r.duration_ms, r.paused_ms, r.total_duration_ms,
-    r.pauses, r.initialization, r.steps,
+    r.pauses, r.initialization, r.steps, r.gates, r.approvals,
     w.name as workflow_name, w.namespace as workflow_namespace, w.description as workflow_description,
     w.labels as workflow_labels, w.annotations as workflow_annotations, w.created as workflow_created,
     w.updated as workflow_updated, w.spec as workflow_spec, w.read_only as workflow_read_only,
@@ -3614,7 +3761,8 @@ SELECT
         '[]'::json
     )::json  as reports_json,
     ra.global as resource_aggregations_global,
-    ra.step as resource_aggregations_step
+    ra.step as resource_aggregations_step,
+    (SELECT t.triage FROM test_workflow_execution_triages t WHERE t.execution_id = e.id) as triage
 FROM test_workflow_executions e
 LEFT JOIN test_workflow_results r ON e.id = r.execution_id
 LEFT JOIN test_workflows w ON e.id = w.execution_id AND w.workflow_type = 'workflow'
@@ -3664,6 +3812,8 @@ type GetUnassignedTestWorkflowExecutionsRow struct {
 	Pauses                      []byte             `db:"pauses" json:"pauses"`
 	Initialization              []byte             `db:"initialization" json:"initialization"`
 	Steps                       []byte             `db:"steps" json:"steps"`
+	Gates                       []byte             `db:"gates" json:"gates"`
+	Approvals                   []byte             `db:"approvals" json:"approvals"`
 	WorkflowName                pgtype.Text        `db:"workflow_name" json:"workflow_name"`
 	WorkflowNamespace           pgtype.Text        `db:"workflow_namespace" json:"workflow_namespace"`
 	WorkflowDescription         pgtype.Text        `db:"workflow_description" json:"workflow_description"`
@@ -3689,6 +3839,7 @@ type GetUnassignedTestWorkflowExecutionsRow struct {
 	ReportsJson                 []byte             `db:"reports_json" json:"reports_json"`
 	ResourceAggregationsGlobal  []byte             `db:"resource_aggregations_global" json:"resource_aggregations_global"`
 	ResourceAggregationsStep    []byte             `db:"resource_aggregations_step" json:"resource_aggregations_step"`
+	Triage                      []byte             `db:"triage" json:"triage"`
 }
 
 func (q *Queries) GetUnassignedTestWorkflowExecutions(ctx context.Context, arg GetUnassignedTestWorkflowExecutionsParams) ([]GetUnassignedTestWorkflowExecutionsRow, error) {
@@ -3734,6 +3885,8 @@ func (q *Queries) GetUnassignedTestWorkflowExecutions(ctx context.Context, arg G
 			&i.Pauses,
 			&i.Initialization,
 			&i.Steps,
+			&i.Gates,
+			&i.Approvals,
 			&i.WorkflowName,
 			&i.WorkflowNamespace,
 			&i.WorkflowDescription,
@@ -3759,6 +3912,7 @@ func (q *Queries) GetUnassignedTestWorkflowExecutions(ctx context.Context, arg G
 			&i.ReportsJson,
 			&i.ResourceAggregationsGlobal,
 			&i.ResourceAggregationsStep,
+			&i.Triage,
 		); err != nil {
 			return nil, err
 		}
@@ -4360,6 +4514,43 @@ func (q *Queries) UpdateTestWorkflowExecutionTags(ctx context.Context, arg Updat
 	return result.RowsAffected(), nil
 }
 
+const updateTestWorkflowResultGates = `-- name: UpdateTestWorkflowResultGates :exec
+UPDATE test_workflow_results
+SET gates = $1
+WHERE execution_id = $2
+`
+
+type UpdateTestWorkflowResultGatesParams struct {
+	Gates       []byte `db:"gates" json:"gates"`
+	ExecutionID string `db:"execution_id" json:"execution_id"`
+}
+
+func (q *Queries) UpdateTestWorkflowResultGates(ctx context.Context, arg UpdateTestWorkflowResultGatesParams) error {
+	_, err := q.db.Exec(ctx, updateTestWorkflowResultGates, arg.Gates, arg.ExecutionID)
+	return err
+}
+
+const addTestWorkflowResultApproval = `-- name: AddTestWorkflowResultApproval :execrows
+UPDATE test_workflow_results
+SET approvals = COALESCE(approvals, '[]'::jsonb) || jsonb_build_array($1::jsonb)
+WHERE execution_id = $2
+    AND NOT COALESCE(approvals, '[]'::jsonb) @> jsonb_build_array(jsonb_build_object('ref', $3::text))
+`
+
+type AddTestWorkflowResultApprovalParams struct {
+	Approval    []byte `db:"approval" json:"approval"`
+	ExecutionID string `db:"execution_id" json:"execution_id"`
+	Ref         string `db:"ref" json:"ref"`
+}
+
+func (q *Queries) AddTestWorkflowResultApproval(ctx context.Context, arg AddTestWorkflowResultApprovalParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addTestWorkflowResultApproval, arg.Approval, arg.ExecutionID, arg.Ref)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const upsertTestWorkflowResourceAggregations = `-- name: UpsertTestWorkflowResourceAggregations :exec
 INSERT INTO test_workflow_resource_aggregations (execution_id, global, step)
 VALUES ($1, $2, $3)
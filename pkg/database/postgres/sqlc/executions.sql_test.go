@@ -46,6 +46,9 @@ func TestSQLCTestWorkflowExecutionQueries_GetTestWorkflowExecutionsTotals(t *tes
 		[]string{},           // label_conditions
 		[]string{},           // selector_keys
 		[]string{},           // selector_conditions
+		"",                   // triage_category
+		"",                   // triage_assignee
+		pgtype.Bool{},        // triaged
 	).WillReturnRows(rows)
 
 	// Execute query
@@ -72,6 +75,9 @@ func TestSQLCTestWorkflowExecutionQueries_GetTestWorkflowExecutionsTotals(t *tes
 		LabelConditions:    []string{},
 		SelectorKeys:       []string{},
 		SelectorConditions: []string{},
+		TriageCategory:     "",
+		TriageAssignee:     "",
+		Triaged:            pgtype.Bool{},
 	})
 
 	// Assertions
@@ -118,6 +124,9 @@ func TestSQLCTestWorkflowExecutionQueries_CountTestWorkflowExecutions(t *testing
 		[]string{},           // label_conditions
 		[]string{},           // selector_keys
 		[]string{},           // selector_conditions
+		"",                   // triage_category
+		"",                   // triage_assignee
+		pgtype.Bool{},        // triaged
 	).WillReturnRows(rows)
 
 	// Execute query
@@ -144,6 +153,9 @@ func TestSQLCTestWorkflowExecutionQueries_CountTestWorkflowExecutions(t *testing
 		LabelConditions:    []string{},
 		SelectorKeys:       []string{},
 		SelectorConditions: []string{},
+		TriageCategory:     "",
+		TriageAssignee:     "",
+		Triaged:            pgtype.Bool{},
 	})
 
 	// Assertions
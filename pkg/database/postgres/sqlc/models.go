@@ -71,6 +71,30 @@ type TestWorkflowExecution struct {
 	Status                    pgtype.Text        `db:"status" json:"status"`
 }
 
+type TestWorkflowExecutionLog struct {
+	ID             int64              `db:"id" json:"id"`
+	ExecutionID    string             `db:"execution_id" json:"execution_id"`
+	ExecutionName  string             `db:"execution_name" json:"execution_name"`
+	WorkflowName   string             `db:"workflow_name" json:"workflow_name"`
+	StepRef        string             `db:"step_ref" json:"step_ref"`
+	StepOrder      int32              `db:"step_order" json:"step_order"`
+	ScheduledAt    pgtype.Timestamptz `db:"scheduled_at" json:"scheduled_at"`
+	FirstLine      int32              `db:"first_line" json:"first_line"`
+	Content        string             `db:"content" json:"content"`
+	Terms          interface{}        `db:"terms" json:"terms"`
+	OrganizationID string             `db:"organization_id" json:"organization_id"`
+	EnvironmentID  string             `db:"environment_id" json:"environment_id"`
+}
+
+type TestWorkflowExecutionTriage struct {
+	ExecutionID string             `db:"execution_id" json:"execution_id"`
+	Category    string             `db:"category" json:"category"`
+	Assignee    string             `db:"assignee" json:"assignee"`
+	Triage      []byte             `db:"triage" json:"triage"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type TestWorkflowOutput struct {
 	ExecutionID string             `db:"execution_id" json:"execution_id"`
 	Ref         pgtype.Text        `db:"ref" json:"ref"`
@@ -117,6 +141,8 @@ type TestWorkflowResult struct {
 	FinishedAt      pgtype.Timestamptz `db:"finished_at" json:"finished_at"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Gates           []byte             `db:"gates" json:"gates"`
+	Approvals       []byte             `db:"approvals" json:"approvals"`
 }
 
 type TestWorkflowSignature struct {
@@ -50,6 +50,10 @@ type TestWorkflowExecutionQueriesInterface interface {
 	UpdateExecutionStatusAtStrict(ctx context.Context, arg UpdateExecutionStatusAtStrictParams) error
 	UpdateTestWorkflowExecutionResultStrict(ctx context.Context, arg UpdateTestWorkflowExecutionResultStrictParams) (string, error)
 	FinishTestWorkflowExecutionResultStrict(ctx context.Context, arg FinishTestWorkflowExecutionResultStrictParams) (string, error)
+	UpdateTestWorkflowResultGates(ctx context.Context, arg UpdateTestWorkflowResultGatesParams) error
+	AddTestWorkflowResultApproval(ctx context.Context, arg AddTestWorkflowResultApprovalParams) (int64, error)
+	UpsertTestWorkflowExecutionTriage(ctx context.Context, arg UpsertTestWorkflowExecutionTriageParams) (int64, error)
+	UpdateTestWorkflowExecutionTriageStrict(ctx context.Context, arg UpdateTestWorkflowExecutionTriageStrictParams) (int64, error)
 	UpdateExecutionStatus(ctx context.Context, arg UpdateExecutionStatusParams) error
 
 	// Delete operations
@@ -67,6 +71,7 @@ type TestWorkflowExecutionQueriesInterface interface {
 	GetTestWorkflowMetrics(ctx context.Context, arg GetTestWorkflowMetricsParams) ([]GetTestWorkflowMetricsRow, error)
 	GetPreviousFinishedState(ctx context.Context, arg GetPreviousFinishedStateParams) (pgtype.Text, error)
 	GetTestWorkflowExecutionTags(ctx context.Context, arg GetTestWorkflowExecutionTagsParams) ([]GetTestWorkflowExecutionTagsRow, error)
+	GetTestWorkflowTriageStats(ctx context.Context, arg GetTestWorkflowTriageStatsParams) ([]GetTestWorkflowTriageStatsRow, error)
 
 	// Execution management
 	InitTestWorkflowExecution(ctx context.Context, arg InitTestWorkflowExecutionParams) error
@@ -120,4 +125,14 @@ type ExecutionSequenceQueriesInterface interface {
 	DeleteExecutionSequence(ctx context.Context, arg DeleteExecutionSequenceParams) error
 	DeleteExecutionSequences(ctx context.Context, arg DeleteExecutionSequencesParams) error
 	DeleteAllExecutionSequences(ctx context.Context, arg DeleteAllExecutionSequencesParams) error
+	UpsertExecutionSequenceAtLeast(ctx context.Context, arg UpsertExecutionSequenceAtLeastParams) error
+}
+
+// ExecutionLogQueriesInterface defines the interface for sqlc generated queries
+type ExecutionLogQueriesInterface interface {
+	InsertTestWorkflowExecutionLog(ctx context.Context, arg InsertTestWorkflowExecutionLogParams) error
+	SearchTestWorkflowExecutionLogs(ctx context.Context, arg SearchTestWorkflowExecutionLogsParams) ([]SearchTestWorkflowExecutionLogsRow, error)
+	DeleteTestWorkflowExecutionLogs(ctx context.Context, arg DeleteTestWorkflowExecutionLogsParams) error
+	DeleteTestWorkflowExecutionLogsByTestWorkflows(ctx context.Context, arg DeleteTestWorkflowExecutionLogsByTestWorkflowsParams) error
+	DeleteAllTestWorkflowExecutionLogs(ctx context.Context, arg DeleteAllTestWorkflowExecutionLogsParams) error
 }
@@ -81,3 +81,28 @@ func (q *Queries) UpsertAndIncrementExecutionSequence(ctx context.Context, arg U
 	)
 	return i, err
 }
+
+const upsertExecutionSequenceAtLeast = `-- name: UpsertExecutionSequenceAtLeast :exec
+INSERT INTO execution_sequences (name, number, organization_id, environment_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (name, organization_id, environment_id) DO UPDATE SET
+    number = GREATEST(execution_sequences.number, EXCLUDED.number),
+    updated_at = NOW()
+`
+
+type UpsertExecutionSequenceAtLeastParams struct {
+	Name           string `db:"name" json:"name"`
+	Number         int32  `db:"number" json:"number"`
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	EnvironmentID  string `db:"environment_id" json:"environment_id"`
+}
+
+func (q *Queries) UpsertExecutionSequenceAtLeast(ctx context.Context, arg UpsertExecutionSequenceAtLeastParams) error {
+	_, err := q.db.Exec(ctx, upsertExecutionSequenceAtLeast,
+		arg.Name,
+		arg.Number,
+		arg.OrganizationID,
+		arg.EnvironmentID,
+	)
+	return err
+}
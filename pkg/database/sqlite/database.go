@@ -0,0 +1,98 @@
+// Package database provides the embedded SQLite database for the single-binary and edge installations,
+// so the Control Plane doesn't need MongoDB or PostgreSQL next to it.
+//
+// The PostgreSQL query set relies on JSONB operators, arrays and row locks, so it can't be shared as-is.
+// Instead, the executions are stored as JSON documents (like in MongoDB) with the filtered columns
+// denormalized next to them, and every write is done in a transaction on a single connection.
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/pressly/goose/v3"
+	_ "modernc.org/sqlite"
+
+	"github.com/kubeshop/testkube/pkg/database/sqlite/migrations"
+)
+
+const (
+	DriverName = "sqlite"
+
+	// MemoryPath opens the database without persisting it, i.e. for tests
+	MemoryPath = ":memory:"
+
+	busyTimeoutMs = 10000
+)
+
+type DB struct {
+	*sql.DB
+}
+
+// Open opens (or creates) the database file at the provided path
+func Open(ctx context.Context, path string) (*DB, error) {
+	if path == "" {
+		return nil, errors.New("database path is required")
+	}
+	if path != MemoryPath {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, fmt.Errorf("creating database directory: %w", err)
+		}
+	}
+
+	params := url.Values{}
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeoutMs))
+	params.Add("_pragma", "foreign_keys(1)")
+	if path != MemoryPath {
+		params.Add("_pragma", "journal_mode(WAL)")
+		params.Add("_pragma", "synchronous(NORMAL)")
+	}
+	db, err := sql.Open(DriverName, fmt.Sprintf("file:%s?%s", path, params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite has a single writer anyway, and using a single connection avoids SQLITE_BUSY errors
+	// for the read-modify-write transactions. It also keeps the in-memory database alive.
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("opening database: %w", err)
+	}
+	return &DB{DB: db}, nil
+}
+
+// Migrate applies the schema migrations
+func (db *DB) Migrate(ctx context.Context) ([]*goose.MigrationResult, error) {
+	provider, err := goose.NewProvider(goose.DialectSQLite3, db.DB, migrations.Fs, goose.WithAllowOutofOrder(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize SQLite migrations provider: %w", err)
+	}
+	results, err := provider.Up(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply SQLite migrations: %w", err)
+	}
+	return results, nil
+}
+
+// Tx runs the function in a transaction, and commits it when there is no error.
+// The queries inside must use the provided transaction, as the database has a single connection.
+func (db *DB) Tx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+	if err = fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
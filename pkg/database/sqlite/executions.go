@@ -0,0 +1,223 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const executionColumns = `id, name, number, workflow_name, group_id, runner_id, status, predicted_status,
+	scheduled_at, assigned_at, status_at, finished_at, silent_health, silent_webhooks, document`
+
+// querier is the common part of the database and the transaction
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Query selects the executions by the denormalized columns, or the document fields with json_extract()
+type Query struct {
+	Where   string
+	Args    []any
+	OrderBy string
+	Limit   int
+	Offset  int
+}
+
+func (q Query) String() string {
+	var b strings.Builder
+	if q.Where != "" {
+		b.WriteString(" WHERE ")
+		b.WriteString(q.Where)
+	}
+	if q.OrderBy != "" {
+		b.WriteString(" ORDER BY ")
+		b.WriteString(q.OrderBy)
+	}
+	if q.Limit > 0 {
+		fmt.Fprintf(&b, " LIMIT %d", q.Limit)
+		if q.Offset > 0 {
+			fmt.Fprintf(&b, " OFFSET %d", q.Offset)
+		}
+	} else if q.Offset > 0 {
+		fmt.Fprintf(&b, " LIMIT -1 OFFSET %d", q.Offset)
+	}
+	return b.String()
+}
+
+// Millis converts the time to the stored column value, where zero time is NULL
+func Millis(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UnixMilli()
+}
+
+// FromMillis converts the stored column value back to the time
+func FromMillis(v sql.NullInt64) time.Time {
+	if !v.Valid {
+		return time.Time{}
+	}
+	return time.UnixMilli(v.Int64)
+}
+
+func columnValues(execution *testkube.TestWorkflowExecution) ([]any, error) {
+	document, err := json.Marshal(execution)
+	if err != nil {
+		return nil, fmt.Errorf("encoding execution: %w", err)
+	}
+	var workflowName, status, predictedStatus string
+	var finishedAt time.Time
+	if execution.Workflow != nil {
+		workflowName = execution.Workflow.Name
+	}
+	if execution.Result != nil {
+		if execution.Result.Status != nil {
+			status = string(*execution.Result.Status)
+		}
+		if execution.Result.PredictedStatus != nil {
+			predictedStatus = string(*execution.Result.PredictedStatus)
+		}
+		finishedAt = execution.Result.FinishedAt
+	}
+	silentHealth := execution.SilentMode != nil && execution.SilentMode.Health
+	silentWebhooks := execution.DisableWebhooks || (execution.SilentMode != nil && execution.SilentMode.Webhooks)
+	return []any{
+		execution.Id, execution.Name, execution.Number, workflowName, execution.GroupId, execution.RunnerId, status, predictedStatus,
+		Millis(execution.ScheduledAt), Millis(execution.AssignedAt), Millis(execution.StatusAt), Millis(finishedAt),
+		silentHealth, silentWebhooks, string(document),
+	}, nil
+}
+
+func scanExecutions(rows *sql.Rows) ([]testkube.TestWorkflowExecution, error) {
+	defer rows.Close()
+	result := make([]testkube.TestWorkflowExecution, 0)
+	for rows.Next() {
+		var document string
+		if err := rows.Scan(&document); err != nil {
+			return nil, err
+		}
+		var execution testkube.TestWorkflowExecution
+		if err := json.Unmarshal([]byte(document), &execution); err != nil {
+			return nil, fmt.Errorf("decoding execution: %w", err)
+		}
+		result = append(result, execution)
+	}
+	return result, rows.Err()
+}
+
+func findExecutions(ctx context.Context, db querier, q Query) ([]testkube.TestWorkflowExecution, error) {
+	rows, err := db.QueryContext(ctx, "SELECT document FROM test_workflow_executions"+q.String(), q.Args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanExecutions(rows)
+}
+
+func replaceExecution(ctx context.Context, db querier, execution *testkube.TestWorkflowExecution) error {
+	values, err := columnValues(execution)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, `UPDATE test_workflow_executions SET
+		name = ?2, number = ?3, workflow_name = ?4, group_id = ?5, runner_id = ?6, status = ?7, predicted_status = ?8,
+		scheduled_at = ?9, assigned_at = ?10, status_at = ?11, finished_at = ?12, silent_health = ?13, silent_webhooks = ?14, document = ?15
+		WHERE id = ?1`, values...)
+	return err
+}
+
+// FindExecutions lists the executions matching the query
+func (db *DB) FindExecutions(ctx context.Context, q Query) ([]testkube.TestWorkflowExecution, error) {
+	return findExecutions(ctx, db, q)
+}
+
+// FindExecution gets the first execution matching the query, or sql.ErrNoRows
+func (db *DB) FindExecution(ctx context.Context, q Query) (testkube.TestWorkflowExecution, error) {
+	q.Limit = 1
+	result, err := findExecutions(ctx, db, q)
+	if err != nil {
+		return testkube.TestWorkflowExecution{}, err
+	}
+	if len(result) == 0 {
+		return testkube.TestWorkflowExecution{}, sql.ErrNoRows
+	}
+	return result[0], nil
+}
+
+// CountExecutions counts the executions matching the query, ignoring the sorting and paging
+func (db *DB) CountExecutions(ctx context.Context, q Query) (count int64, err error) {
+	err = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM test_workflow_executions"+Query{Where: q.Where}.String(), q.Args...).Scan(&count)
+	return count, err
+}
+
+// InsertExecution stores the new execution
+func (db *DB) InsertExecution(ctx context.Context, execution testkube.TestWorkflowExecution) error {
+	values, err := columnValues(&execution)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, "INSERT INTO test_workflow_executions ("+executionColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", values...)
+	return err
+}
+
+// ReplaceExecution overrides the whole stored execution
+func (db *DB) ReplaceExecution(ctx context.Context, execution testkube.TestWorkflowExecution) error {
+	return replaceExecution(ctx, db, &execution)
+}
+
+// UpdateExecution atomically modifies the first execution matching the query.
+// The update function may reject the change by returning false.
+// It returns the execution after the change, sql.ErrNoRows when nothing matched,
+// and whether the stored document has actually changed.
+func (db *DB) UpdateExecution(ctx context.Context, q Query, update func(execution *testkube.TestWorkflowExecution) bool) (result testkube.TestWorkflowExecution, modified bool, err error) {
+	q.Limit = 1
+	err = db.Tx(ctx, func(tx *sql.Tx) error {
+		executions, err := findExecutions(ctx, tx, q)
+		if err != nil {
+			return err
+		}
+		if len(executions) == 0 {
+			return sql.ErrNoRows
+		}
+		result = executions[0]
+		before, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		if !update(&result) {
+			return nil
+		}
+		after, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(before, after) {
+			return nil
+		}
+		modified = true
+		return replaceExecution(ctx, tx, &result)
+	})
+	return result, modified, err
+}
+
+// DeleteExecutions deletes the executions matching the query
+func (db *DB) DeleteExecutions(ctx context.Context, q Query) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM test_workflow_executions"+Query{Where: q.Where}.String(), q.Args...)
+	return err
+}
+
+// Placeholders builds the list of the SQL parameters for the IN clause
+func Placeholders[T any](values []T) (string, []any) {
+	args := make([]any, len(values))
+	for i := range values {
+		args[i] = values[i]
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", "), args
+}
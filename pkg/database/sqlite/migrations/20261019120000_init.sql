@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The executions are stored as JSON documents, with the columns used for filtering and sorting
+-- denormalized next to them. The document is the source of truth, the columns are rewritten on every write.
+CREATE TABLE test_workflow_executions (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    number INTEGER NOT NULL DEFAULT 0,
+    workflow_name TEXT NOT NULL DEFAULT '',
+    group_id TEXT NOT NULL DEFAULT '',
+    runner_id TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT '',
+    predicted_status TEXT NOT NULL DEFAULT '',
+    scheduled_at INTEGER,
+    assigned_at INTEGER,
+    status_at INTEGER,
+    finished_at INTEGER,
+    silent_health INTEGER NOT NULL DEFAULT 0,
+    silent_webhooks INTEGER NOT NULL DEFAULT 0,
+    document TEXT NOT NULL
+);
+
+CREATE INDEX idx_test_workflow_executions_name ON test_workflow_executions (name);
+CREATE INDEX idx_test_workflow_executions_scheduled_at ON test_workflow_executions (scheduled_at DESC);
+CREATE INDEX idx_test_workflow_executions_workflow_scheduled_at ON test_workflow_executions (workflow_name, scheduled_at DESC);
+CREATE INDEX idx_test_workflow_executions_status_scheduled_at ON test_workflow_executions (status, scheduled_at);
+CREATE INDEX idx_test_workflow_executions_status_at ON test_workflow_executions (status_at);
+CREATE INDEX idx_test_workflow_executions_group_id ON test_workflow_executions (group_id);
+
+CREATE TABLE execution_sequences (
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    number INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (name, type)
+);
+
+CREATE TABLE leases (
+    id TEXT PRIMARY KEY,
+    identifier TEXT NOT NULL,
+    cluster_id TEXT NOT NULL,
+    acquired_at INTEGER NOT NULL,
+    renewed_at INTEGER NOT NULL
+);
+
+CREATE TABLE config (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    cluster_id TEXT NOT NULL,
+    enable_telemetry INTEGER NOT NULL DEFAULT 0
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS config;
+DROP TABLE IF EXISTS leases;
+DROP TABLE IF EXISTS execution_sequences;
+DROP TABLE IF EXISTS test_workflow_executions;
+-- +goose StatementEnd
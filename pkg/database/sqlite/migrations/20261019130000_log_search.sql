@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE test_workflow_execution_logs (
+    id INTEGER PRIMARY KEY,
+    execution_id TEXT NOT NULL,
+    execution_name TEXT NOT NULL DEFAULT '',
+    workflow_name TEXT NOT NULL DEFAULT '',
+    step_ref TEXT NOT NULL DEFAULT '',
+    scheduled_at INTEGER,
+    first_line INTEGER NOT NULL DEFAULT 1,
+    content TEXT NOT NULL
+);
+
+CREATE INDEX idx_test_workflow_execution_logs_execution_id ON test_workflow_execution_logs(execution_id);
+CREATE INDEX idx_test_workflow_execution_logs_workflow_name ON test_workflow_execution_logs(workflow_name);
+
+-- The terms are tokenized by the application, and the rowid matches the id of the log
+CREATE VIRTUAL TABLE test_workflow_execution_log_terms USING fts5(terms);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS test_workflow_execution_log_terms;
+DROP TABLE IF EXISTS test_workflow_execution_logs;
+-- +goose StatementEnd
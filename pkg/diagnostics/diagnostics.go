@@ -2,6 +2,7 @@ package diagnostics
 
 import (
 	"errors"
+	"sort"
 	"sync"
 
 	"github.com/kubeshop/testkube/pkg/diagnostics/renderer"
@@ -32,8 +33,14 @@ type Diagnostics struct {
 // Run executes all validators in all groups and renders the results
 func (d Diagnostics) Run() error {
 
-	// for now we'll make validators concurrent
+	groupNames := make([]string, 0, len(d.Groups))
 	for groupName := range d.Groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	// for now we'll make validators concurrent
+	for _, groupName := range groupNames {
 		ch, err := d.RunGroup(groupName)
 		if err != nil {
 			return err
@@ -0,0 +1,223 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/testkube/pkg/k8sclient"
+)
+
+const DefaultAgentDeploymentName = "testkube-api-server"
+
+// Installation describes the Testkube Agent installed in the cluster, as configured in its Deployment
+type Installation struct {
+	Namespace        string
+	DeploymentName   string
+	ServiceAccount   string
+	Image            string
+	Env              map[string]string
+	ImagePullSecrets []corev1.Secret
+
+	Client     kubernetes.Interface
+	Dynamic    dynamic.Interface
+	Extensions apiextensionsclientset.Interface
+
+	// PortForward exposes the in-cluster Service on the local machine until the context is done,
+	// and returns the local address to connect to
+	PortForward func(ctx context.Context, namespace, service string, port int) (string, error)
+}
+
+// GetInstallation reads the Agent Deployment, and resolves its environment variables
+// including the ones sourced from Secrets and ConfigMaps
+func GetInstallation(ctx context.Context, namespace, deploymentName string) (inst Installation, err error) {
+	if deploymentName == "" {
+		deploymentName = DefaultAgentDeploymentName
+	}
+	config, err := k8sclient.GetK8sClientConfig()
+	if err != nil {
+		return inst, errors.Wrap(err, "getting kubernetes config")
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return inst, errors.Wrap(err, "creating kubernetes client")
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return inst, errors.Wrap(err, "creating kubernetes dynamic client")
+	}
+	extensionsClient, err := apiextensionsclientset.NewForConfig(config)
+	if err != nil {
+		return inst, errors.Wrap(err, "creating kubernetes api extensions client")
+	}
+
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return inst, errors.Wrapf(err, "getting '%s' deployment in '%s' namespace", deploymentName, namespace)
+	}
+	inst, err = NewInstallation(ctx, client, deployment)
+	if err != nil {
+		return inst, err
+	}
+	inst.Dynamic = dynamicClient
+	inst.Extensions = extensionsClient
+	inst.PortForward = portForward
+	return inst, nil
+}
+
+// NewInstallation builds the installation details from the already fetched Agent Deployment
+func NewInstallation(ctx context.Context, client kubernetes.Interface, deployment *appsv1.Deployment) (inst Installation, err error) {
+	spec := deployment.Spec.Template.Spec
+	inst = Installation{
+		Namespace:      deployment.Namespace,
+		DeploymentName: deployment.Name,
+		ServiceAccount: spec.ServiceAccountName,
+		Env:            make(map[string]string),
+		Client:         client,
+	}
+	if inst.ServiceAccount == "" {
+		inst.ServiceAccount = "default"
+	}
+	if len(spec.Containers) == 0 {
+		return inst, fmt.Errorf("deployment '%s' has no containers", deployment.Name)
+	}
+
+	container := spec.Containers[0]
+	inst.Image = container.Image
+	r := envResolver{ctx: ctx, client: client, namespace: deployment.Namespace}
+	for _, source := range container.EnvFrom {
+		values, err := r.source(source)
+		if err != nil {
+			return inst, err
+		}
+		for k, v := range values {
+			inst.Env[source.Prefix+k] = v
+		}
+	}
+	for _, env := range container.Env {
+		value, err := r.value(env)
+		if err != nil {
+			return inst, err
+		}
+		inst.Env[env.Name] = value
+	}
+
+	for _, ref := range spec.ImagePullSecrets {
+		secret, err := client.CoreV1().Secrets(deployment.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return inst, errors.Wrapf(err, "getting '%s' image pull secret", ref.Name)
+		}
+		inst.ImagePullSecrets = append(inst.ImagePullSecrets, *secret)
+	}
+	return inst, nil
+}
+
+type envResolver struct {
+	ctx       context.Context
+	client    kubernetes.Interface
+	namespace string
+}
+
+func (r envResolver) value(env corev1.EnvVar) (string, error) {
+	if env.ValueFrom == nil {
+		return env.Value, nil
+	}
+	switch {
+	case env.ValueFrom.SecretKeyRef != nil:
+		ref := env.ValueFrom.SecretKeyRef
+		secret, err := r.client.CoreV1().Secrets(r.namespace).Get(r.ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			if ref.Optional != nil && *ref.Optional {
+				return "", nil
+			}
+			return "", errors.Wrapf(err, "resolving '%s' environment variable", env.Name)
+		}
+		return string(secret.Data[ref.Key]), nil
+	case env.ValueFrom.ConfigMapKeyRef != nil:
+		ref := env.ValueFrom.ConfigMapKeyRef
+		configMap, err := r.client.CoreV1().ConfigMaps(r.namespace).Get(r.ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			if ref.Optional != nil && *ref.Optional {
+				return "", nil
+			}
+			return "", errors.Wrapf(err, "resolving '%s' environment variable", env.Name)
+		}
+		return configMap.Data[ref.Key], nil
+	case env.ValueFrom.FieldRef != nil && env.ValueFrom.FieldRef.FieldPath == "metadata.namespace":
+		return r.namespace, nil
+	}
+	// Other sources (i.e. resource fields or pod fields) are not relevant for the diagnostics
+	return "", nil
+}
+
+func (r envResolver) source(source corev1.EnvFromSource) (map[string]string, error) {
+	switch {
+	case source.SecretRef != nil:
+		secret, err := r.client.CoreV1().Secrets(r.namespace).Get(r.ctx, source.SecretRef.Name, metav1.GetOptions{})
+		if err != nil {
+			if source.SecretRef.Optional != nil && *source.SecretRef.Optional {
+				return nil, nil
+			}
+			return nil, errors.Wrapf(err, "reading environment from '%s' secret", source.SecretRef.Name)
+		}
+		values := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			values[k] = string(v)
+		}
+		return values, nil
+	case source.ConfigMapRef != nil:
+		configMap, err := r.client.CoreV1().ConfigMaps(r.namespace).Get(r.ctx, source.ConfigMapRef.Name, metav1.GetOptions{})
+		if err != nil {
+			if source.ConfigMapRef.Optional != nil && *source.ConfigMapRef.Optional {
+				return nil, nil
+			}
+			return nil, errors.Wrapf(err, "reading environment from '%s' config map", source.ConfigMapRef.Name)
+		}
+		return configMap.Data, nil
+	}
+	return nil, nil
+}
+
+// ExecutionNamespaces lists the namespaces where the Agent runs the executions
+func (i Installation) ExecutionNamespaces() []string {
+	namespaces := []string{i.Namespace}
+	if ns := i.Env["DEFAULT_EXECUTION_NAMESPACE"]; ns != "" {
+		namespaces[0] = ns
+	}
+	for _, item := range strings.Split(i.Env["TESTKUBE_EXECUTION_NAMESPACES"], ",") {
+		name, _, ok := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		if ok && name != "" && name != namespaces[0] {
+			namespaces = append(namespaces, name)
+		}
+	}
+	return namespaces
+}
+
+// IsStandalone checks if the Agent runs with the embedded Control Plane
+func (i Installation) IsStandalone() bool {
+	return i.Env["TESTKUBE_PRO_URL"] == "" && i.Env["TESTKUBE_CLOUD_URL"] == ""
+}
+
+func portForward(ctx context.Context, namespace, service string, port int) (string, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", errors.Wrap(err, "finding free local port")
+	}
+	localPort := ln.Addr().(*net.TCPAddr).Port
+	_ = ln.Close()
+
+	if err = k8sclient.PortForward(ctx, namespace, service, port, localPort, false); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("127.0.0.1:%d", localPort), nil
+}
@@ -51,6 +51,9 @@ func (r CLIRenderer) RenderResult(res validators.ValidationResult) {
 		}
 	} else {
 		ui.Printf("%s", ui.IconCheckMark)
+		if res.AdditionalInfo != "" {
+			ui.Printf(" %s", ui.LightGray(res.AdditionalInfo))
+		}
 	}
 	ui.NL()
 
@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+)
+
+const (
+	checkTimeout = 30 * time.Second
+	dialTimeout  = 5 * time.Second
+)
+
+// address is the way to connect from this machine to the host used by the Agent
+type address struct {
+	Address string
+	// Forwarded is set when the host is a Kubernetes Service exposed with port forwarding,
+	// so the TLS certificates won't match the local address
+	Forwarded bool
+}
+
+// reach resolves the address to connect from this machine to the host:port used by the Agent.
+// The cluster-local Services are not resolvable outside the cluster, so they are port-forwarded.
+// The forwarding lasts until the context is done.
+func reach(ctx context.Context, inst loader.Installation, hostport string, defaultPort int) (address, error) {
+	host, portStr, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host, portStr = hostport, strconv.Itoa(defaultPort)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return address{}, fmt.Errorf("invalid port in '%s'", hostport)
+	}
+
+	name, namespace, ok := serviceFromHost(host, inst.Namespace)
+	if ok && inst.Client != nil {
+		_, err = inst.Client.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if inst.PortForward == nil {
+				return address{}, fmt.Errorf("can't forward port of '%s/%s' service", namespace, name)
+			}
+			local, err := inst.PortForward(ctx, namespace, name, port)
+			if err != nil {
+				return address{}, fmt.Errorf("forwarding port of '%s/%s' service: %w", namespace, name, err)
+			}
+			return address{Address: local, Forwarded: true}, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return address{}, err
+		}
+	}
+	return address{Address: net.JoinHostPort(host, strconv.Itoa(port))}, nil
+}
+
+// serviceFromHost detects the Kubernetes Service name and namespace from the cluster-local host name,
+// i.e. 'minio', 'minio.testkube', 'minio.testkube.svc' or 'minio.testkube.svc.cluster.local'
+func serviceFromHost(host, namespace string) (string, string, bool) {
+	if host == "" || net.ParseIP(host) != nil || host == "localhost" {
+		return "", "", false
+	}
+	parts := strings.Split(strings.TrimSuffix(host, "."), ".")
+	switch {
+	case len(parts) == 1:
+		return parts[0], namespace, true
+	case len(parts) == 2:
+		return parts[0], parts[1], true
+	case parts[2] == "svc":
+		return parts[0], parts[1], true
+	}
+	return "", "", false
+}
+
+// dial checks if the TCP connection to the address is possible
+func dial(ctx context.Context, addr string) error {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// installation reads the installation details passed as the validation subject
+func installation(subject any) (loader.Installation, bool) {
+	switch inst := subject.(type) {
+	case loader.Installation:
+		return inst, true
+	case *loader.Installation:
+		if inst != nil {
+			return *inst, true
+		}
+	}
+	return loader.Installation{}, false
+}
@@ -0,0 +1,64 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+)
+
+func TestServiceFromHost(t *testing.T) {
+	tests := []struct {
+		host      string
+		name      string
+		namespace string
+		ok        bool
+	}{
+		{host: "minio", name: "minio", namespace: "testkube", ok: true},
+		{host: "minio.other", name: "minio", namespace: "other", ok: true},
+		{host: "minio.other.svc", name: "minio", namespace: "other", ok: true},
+		{host: "minio.other.svc.cluster.local", name: "minio", namespace: "other", ok: true},
+		{host: "s3.amazonaws.com.example"},
+		{host: "10.0.0.1"},
+		{host: "localhost"},
+		{host: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			name, namespace, ok := serviceFromHost(tt.host, "testkube")
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.namespace, namespace)
+		})
+	}
+}
+
+func TestReach(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "minio", Namespace: "testkube"}})
+	var forwarded []string
+	inst := loader.Installation{
+		Namespace: "testkube",
+		Client:    client,
+		PortForward: func(_ context.Context, namespace, service string, port int) (string, error) {
+			forwarded = append(forwarded, namespace+"/"+service)
+			assert.Equal(t, 9000, port)
+			return "127.0.0.1:12345", nil
+		},
+	}
+
+	addr, err := reach(context.Background(), inst, "minio.testkube.svc.cluster.local:9000", 80)
+	require.NoError(t, err)
+	assert.Equal(t, address{Address: "127.0.0.1:12345", Forwarded: true}, addr)
+
+	addr, err = reach(context.Background(), inst, "storage.example.com", 9000)
+	require.NoError(t, err)
+	assert.Equal(t, address{Address: "storage.example.com:9000"}, addr)
+
+	assert.Equal(t, []string{"testkube/minio"}, forwarded)
+}
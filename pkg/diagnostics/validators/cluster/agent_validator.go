@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	agentclient "github.com/kubeshop/testkube/pkg/agent/client"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+func NewControlPlaneConnectionValidator() ControlPlaneConnectionValidator {
+	return ControlPlaneConnectionValidator{}
+}
+
+// ControlPlaneConnectionValidator checks if the Control Plane gRPC API used by the Agent is reachable
+type ControlPlaneConnectionValidator struct{}
+
+func (v ControlPlaneConnectionValidator) Name() string {
+	return "Control Plane gRPC connection"
+}
+
+func (v ControlPlaneConnectionValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok {
+		return r.WithError(ErrInvalidSubject)
+	}
+	if inst.IsStandalone() {
+		return r.WithValidStatus().WithAdditionalInfo("embedded control plane")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	server := envOr(inst, "TESTKUBE_PRO_URL", inst.Env["TESTKUBE_CLOUD_URL"])
+	isInsecure, _ := strconv.ParseBool(inst.Env["TESTKUBE_PRO_TLS_INSECURE"])
+	skipVerify, _ := strconv.ParseBool(inst.Env["TESTKUBE_PRO_SKIP_VERIFY"])
+	// The custom CA file is mounted in the Agent pod only, so the system CAs are used there
+	conn, err := agentclient.NewGRPCConnection(ctx, isInsecure, skipVerify, server, "", zap.NewNop().Sugar())
+	if err != nil {
+		return r.WithError(ErrControlPlaneUnreachable.WithDetails(fmt.Sprintf("%s: %s", server, err)))
+	}
+	_ = conn.Close()
+	return r.WithValidStatus().WithAdditionalInfo(server)
+}
+
+func NewLeaseValidator() LeaseValidator {
+	return LeaseValidator{}
+}
+
+// LeaseValidator checks if the Agent holds and renews its Kubernetes leases
+type LeaseValidator struct{}
+
+func (v LeaseValidator) Name() string {
+	return "Agent lease"
+}
+
+func (v LeaseValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok || inst.Client == nil {
+		return r.WithError(ErrInvalidSubject)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	leases, err := inst.Client.CoordinationV1().Leases(inst.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return r.WithStdError(err)
+	}
+
+	// The lease names include the cluster and agent identifiers, so only the prefixes are known
+	override := inst.Env["TESTKUBE_LEASE_NAME"]
+	prefixes := []string{"testkube-core-", "testkube-agent-" + envOr(inst, "APISERVER_FULLNAME", "testkube-api-server") + "-"}
+	found := make([]string, 0)
+	for _, lease := range leases.Items {
+		matches := lease.Name == override
+		for _, prefix := range prefixes {
+			matches = matches || strings.HasPrefix(lease.Name, prefix)
+		}
+		if !matches {
+			continue
+		}
+		found = append(found, lease.Name)
+
+		spec := lease.Spec
+		if spec.HolderIdentity == nil || *spec.HolderIdentity == "" || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+			continue
+		}
+		expiresAt := spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second)
+		if time.Now().Before(expiresAt) {
+			return r.WithValidStatus().WithAdditionalInfo(fmt.Sprintf("%s held by %s", lease.Name, *spec.HolderIdentity))
+		}
+	}
+	if len(found) == 0 {
+		return r.WithError(ErrLeaseNotFound.WithDetails("namespace " + inst.Namespace))
+	}
+	return r.WithError(ErrLeaseExpired.WithDetails(strings.Join(found, ", ")))
+}
@@ -0,0 +1,72 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+func lease(name string, renewedAgo time.Duration) *coordinationv1.Lease {
+	holder := "testkube-api-server-abc"
+	duration := int32(15)
+	renewTime := metav1.NewMicroTime(time.Now().Add(-renewedAgo))
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "testkube"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &duration,
+			RenewTime:            &renewTime,
+		},
+	}
+}
+
+func TestLeaseValidator(t *testing.T) {
+	tests := map[string]struct {
+		leases []*coordinationv1.Lease
+		err    *validators.Error
+	}{
+		"renewed": {
+			leases: []*coordinationv1.Lease{lease("testkube-agent-testkube-api-server-events", time.Second)},
+		},
+		"expired": {
+			leases: []*coordinationv1.Lease{lease("testkube-core-cluster", time.Hour)},
+			err:    &ErrLeaseExpired,
+		},
+		"missing": {
+			leases: []*coordinationv1.Lease{lease("other-lease", time.Second)},
+			err:    &ErrLeaseNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			for _, l := range tt.leases {
+				_ = client.Tracker().Add(l)
+			}
+			inst := loader.Installation{Namespace: "testkube", Env: map[string]string{}, Client: client}
+
+			result := NewLeaseValidator().Validate(inst)
+
+			if tt.err == nil {
+				assert.Equal(t, validators.StatusValid, result.Status)
+				return
+			}
+			assert.Equal(t, validators.StatusInvalid, result.Status)
+			assert.Equal(t, tt.err.Message, result.Errors[0].Message)
+		})
+	}
+}
+
+func TestControlPlaneConnectionValidator_Standalone(t *testing.T) {
+	result := NewControlPlaneConnectionValidator().Validate(loader.Installation{Env: map[string]string{}})
+
+	assert.Equal(t, validators.StatusValid, result.Status)
+	assert.Equal(t, "embedded control plane", result.AdditionalInfo)
+}
@@ -0,0 +1,110 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/testkube/k8s"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+// AgentCRDs lists the Custom Resource Definitions used by the Agent, as named in the embedded CRD files
+var AgentCRDs = []string{
+	"testworkflows.testkube.io_testworkflows",
+	"testworkflows.testkube.io_testworkflowtemplates",
+	"testworkflows.testkube.io_testworkflowexecutions",
+	"testworkflows.testkube.io_workflowtriggers",
+	"tests.testkube.io_testtriggers",
+	"executor.testkube.io_webhooks",
+	"executor.testkube.io_webhooktemplates",
+}
+
+func NewCRDVersionsValidator() CRDVersionsValidator {
+	return CRDVersionsValidator{FS: k8s.SF, Names: AgentCRDs}
+}
+
+// CRDVersionsValidator compares the CRDs installed in the cluster with the ones shipped with this binary
+type CRDVersionsValidator struct {
+	FS    fs.FS
+	Names []string
+}
+
+func (v CRDVersionsValidator) Name() string {
+	return "CRD versions"
+}
+
+func (v CRDVersionsValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok || inst.Extensions == nil {
+		return r.WithError(ErrInvalidSubject)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	r = r.WithValidStatus()
+	for _, name := range v.Names {
+		expected, err := v.load(name)
+		if err != nil {
+			return r.WithStdError(err)
+		}
+		actual, err := inst.Extensions.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, expected.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			r = r.WithError(ErrCRDMissing.WithDetails(expected.Name))
+			continue
+		} else if err != nil {
+			return r.WithStdError(err)
+		}
+		if details := compareCRD(expected, actual); details != "" {
+			r = r.WithError(ErrCRDVersionMismatch.WithDetails(fmt.Sprintf("%s: %s", expected.Name, details)))
+		}
+	}
+	return r
+}
+
+func (v CRDVersionsValidator) load(name string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	content, err := fs.ReadFile(v.FS, path.Join("crd", name+".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("reading '%s' CRD shipped with the binary: %w", name, err)
+	}
+	var crd apiextensionsv1.CustomResourceDefinition
+	if err = yaml.Unmarshal(content, &crd); err != nil {
+		return nil, fmt.Errorf("parsing '%s' CRD shipped with the binary: %w", name, err)
+	}
+	return &crd, nil
+}
+
+// compareCRD describes the first difference between the expected and installed CRD versions
+func compareCRD(expected, actual *apiextensionsv1.CustomResourceDefinition) string {
+	installed := make(map[string]apiextensionsv1.CustomResourceDefinitionVersion, len(actual.Spec.Versions))
+	for _, version := range actual.Spec.Versions {
+		installed[version.Name] = version
+	}
+	for _, version := range expected.Spec.Versions {
+		current, ok := installed[version.Name]
+		switch {
+		case !ok:
+			return fmt.Sprintf("version %s is not installed", version.Name)
+		case version.Served && !current.Served:
+			return fmt.Sprintf("version %s is not served", version.Name)
+		case version.Storage && !current.Storage:
+			return fmt.Sprintf("version %s is not the storage version", version.Name)
+		case !sameSchema(version.Schema, current.Schema):
+			return fmt.Sprintf("version %s has outdated schema", version.Name)
+		}
+	}
+	return ""
+}
+
+func sameSchema(expected, actual *apiextensionsv1.CustomResourceValidation) bool {
+	expectedJSON, err1 := json.Marshal(expected)
+	actualJSON, err2 := json.Marshal(actual)
+	return err1 == nil && err2 == nil && string(expectedJSON) == string(actualJSON)
+}
@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+func TestCRDVersionsValidator(t *testing.T) {
+	v := CRDVersionsValidator{FS: NewCRDVersionsValidator().FS, Names: []string{"testworkflows.testkube.io_testworkflows", "executor.testkube.io_webhooks"}}
+	workflows, err := v.load("testworkflows.testkube.io_testworkflows")
+	require.NoError(t, err)
+	webhooks, err := v.load("executor.testkube.io_webhooks")
+	require.NoError(t, err)
+
+	client := fake.NewSimpleClientset(workflows, webhooks)
+	inst := loader.Installation{Extensions: client}
+
+	result := v.Validate(inst)
+	assert.Equal(t, validators.StatusValid, result.Status)
+
+	outdated := webhooks.DeepCopy()
+	outdated.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"].Properties["uri"] = outdated.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["status"]
+	_, err = client.ApiextensionsV1().CustomResourceDefinitions().Update(context.Background(), outdated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	err = client.ApiextensionsV1().CustomResourceDefinitions().Delete(context.Background(), workflows.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	result = v.Validate(inst)
+	assert.Equal(t, validators.StatusInvalid, result.Status)
+	require.Len(t, result.Errors, 2)
+	assert.Equal(t, ErrCRDMissing.Message, result.Errors[0].Message)
+	assert.Equal(t, ErrCRDVersionMismatch.Message, result.Errors[1].Message)
+	assert.Contains(t, result.Errors[1].Details, "has outdated schema")
+}
@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+
+	mongomigrations "github.com/kubeshop/testkube/internal/db-migrations"
+	postgresmigrations "github.com/kubeshop/testkube/pkg/database/postgres/migrations"
+	"github.com/kubeshop/testkube/pkg/dbmigrator"
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+)
+
+func NewDatabaseValidator() DatabaseValidator {
+	return DatabaseValidator{}
+}
+
+// DatabaseValidator checks if the MongoDB or PostgreSQL database is reachable, and has all the migrations applied
+type DatabaseValidator struct{}
+
+func (v DatabaseValidator) Name() string {
+	return "Database connection and migrations"
+}
+
+func (v DatabaseValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok {
+		return r.WithError(ErrInvalidSubject)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	switch {
+	case inst.Env["API_POSTGRES_DSN"] != "":
+		return v.validatePostgres(ctx, inst)
+	case inst.Env["API_MONGO_DSN"] != "":
+		return v.validateMongo(ctx, inst)
+	case !inst.IsStandalone():
+		return r.WithValidStatus().WithAdditionalInfo("data is stored by the Control Plane")
+	}
+	return r.WithError(ErrDatabaseNotConfigured)
+}
+
+func (v DatabaseValidator) validateMongo(ctx context.Context, inst loader.Installation) (r validators.ValidationResult) {
+	dsn, err := mongoDSN(ctx, inst, inst.Env["API_MONGO_DSN"])
+	if err != nil {
+		return r.WithError(ErrDatabaseUnreachable.WithDetails(err.Error()))
+	}
+	allowTLS, _ := strconv.ParseBool(inst.Env["API_MONGO_ALLOW_TLS"])
+	db, err := storage.GetMongoDatabase(dsn, envOr(inst, "API_MONGO_DB", "testkube"), inst.Env["API_MONGO_DB_TYPE"], allowTLS, nil)
+	if err != nil {
+		return r.WithError(ErrDatabaseUnreachable.WithDetails(err.Error()))
+	}
+	defer func() { _ = db.Client().Disconnect(context.Background()) }()
+
+	migrations, err := dbmigrator.GetDbMigrationsFromFs(mongomigrations.MongoMigrationsFs)
+	if err != nil {
+		return r.WithStdError(err)
+	}
+	plan, err := dbmigrator.NewDbMigrator(dbmigrator.NewDatabase(db, "__migrations"), migrations).Plan(ctx)
+	if err != nil {
+		return r.WithError(ErrDatabaseUnreachable.WithDetails(err.Error()))
+	}
+	if plan.Total > 0 {
+		return r.WithError(ErrDatabaseMigrationsPending.WithDetails(
+			fmt.Sprintf("MongoDB: %d to apply, %d to revert", len(plan.Ups), len(plan.Downs))))
+	}
+	return r.WithValidStatus().WithAdditionalInfo("MongoDB")
+}
+
+func (v DatabaseValidator) validatePostgres(ctx context.Context, inst loader.Installation) (r validators.ValidationResult) {
+	cfg, err := pgx.ParseConfig(inst.Env["API_POSTGRES_DSN"])
+	if err != nil {
+		return r.WithError(ErrDatabaseUnreachable.WithDetails(err.Error()))
+	}
+	addr, err := reach(ctx, inst, net.JoinHostPort(cfg.Host, strconv.Itoa(int(cfg.Port))), 5432)
+	if err != nil {
+		return r.WithError(ErrDatabaseUnreachable.WithDetails(err.Error()))
+	}
+	if addr.Forwarded {
+		host, port, _ := net.SplitHostPort(addr.Address)
+		portNumber, _ := strconv.Atoi(port)
+		cfg.Host, cfg.Port, cfg.Fallbacks = host, uint16(portNumber), nil
+		if cfg.TLSConfig != nil {
+			cfg.TLSConfig.InsecureSkipVerify = true
+		}
+	}
+
+	db := stdlib.OpenDB(*cfg)
+	defer db.Close()
+	if err = db.PingContext(ctx); err != nil {
+		return r.WithError(ErrDatabaseUnreachable.WithDetails(err.Error()))
+	}
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, postgresmigrations.Fs, goose.WithAllowOutofOrder(true))
+	if err != nil {
+		return r.WithStdError(err)
+	}
+	pending, err := provider.HasPending(ctx)
+	if err != nil {
+		return r.WithError(ErrDatabaseUnreachable.WithDetails(err.Error()))
+	}
+	if pending {
+		current, target, _ := provider.GetVersions(ctx)
+		return r.WithError(ErrDatabaseMigrationsPending.WithDetails(
+			fmt.Sprintf("PostgreSQL: at version %d, expected %d", current, target)))
+	}
+	return r.WithValidStatus().WithAdditionalInfo("PostgreSQL")
+}
+
+// mongoDSN points the standard MongoDB connection string to the reachable address.
+// The SRV and replica set connection strings are used as they are.
+func mongoDSN(ctx context.Context, inst loader.Installation, dsn string) (string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", fmt.Errorf("invalid MongoDB connection string")
+	}
+	if u.Scheme != "mongodb" || u.Host == "" || strings.Contains(u.Host, ",") {
+		return dsn, nil
+	}
+	addr, err := reach(ctx, inst, u.Host, 27017)
+	if err != nil || !addr.Forwarded {
+		return dsn, err
+	}
+	u.Host = addr.Address
+	query := u.Query()
+	// The forwarded port leads to a single replica set member, so the discovery must be avoided
+	query.Set("directConnection", "true")
+	if query.Get("tls") == "true" || query.Get("ssl") == "true" {
+		query.Set("tlsInsecure", "true")
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,61 @@
+package cluster
+
+import v "github.com/kubeshop/testkube/pkg/diagnostics/validators"
+
+const (
+	docsInstallationURI = "https://docs.testkube.io/articles/install/overview"
+	docsUpgradeURI      = "https://docs.testkube.io/articles/upgrade"
+)
+
+var (
+	ErrInvalidSubject = v.Err("Testkube installation details are not available", v.ErrorKindCustom).
+				WithSuggestion("Make sure the Testkube Agent is installed in the namespace passed with `--namespace`")
+
+	ErrCRDMissing = v.Err("Custom Resource Definition is not installed", v.ErrorKindCRDMismatch).
+			WithSuggestion("Install the Testkube CRDs matching your version").
+			WithDocsURI(docsUpgradeURI)
+	ErrCRDVersionMismatch = v.Err("Custom Resource Definition versions don't match the Testkube version", v.ErrorKindCRDMismatch).
+				WithSuggestion("Upgrade the Testkube CRDs together with the Agent, Helm doesn't upgrade CRDs automatically").
+				WithDocsURI(docsUpgradeURI)
+
+	ErrRBACMissingPermissions = v.Err("Agent service account is missing permissions required to run executions", v.ErrorKindRBAC).
+					WithSuggestion("Make sure the Helm chart was installed with `rbac.create` enabled").
+					WithSuggestion("Grant the missing verbs to the service account with a Role and RoleBinding in the execution namespace").
+					WithDocsURI(docsInstallationURI)
+	ErrRBACCheckFailed = v.Err("Can't check the Agent service account permissions", v.ErrorKindRBAC).
+				WithSuggestion("Make sure you are allowed to create SubjectAccessReviews in the cluster")
+
+	ErrStorageUnreachable = v.Err("Object storage (MinIO/S3) is not reachable", v.ErrorKindUnreachable).
+				WithSuggestion("Check STORAGE_ENDPOINT and the storage credentials of the Agent").
+				WithSuggestion("Make sure the MinIO pods are running, i.e. `kubectl get pods -l app.kubernetes.io/name=minio`")
+	ErrStorageBucketMissing = v.Err("Object storage bucket doesn't exist", v.ErrorKindUnreachable).
+				WithSuggestion("The Agent creates the bucket on startup, check its logs for storage errors")
+
+	ErrDatabaseNotConfigured = v.Err("Database is not configured", v.ErrorKindCustom).
+					WithSuggestion("Set API_MONGO_DSN or API_POSTGRES_DSN for the standalone Agent")
+	ErrDatabaseUnreachable = v.Err("Database is not reachable", v.ErrorKindUnreachable).
+				WithSuggestion("Check the database connection string and credentials of the Agent").
+				WithSuggestion("Make sure the database pods are running and ready")
+	ErrDatabaseMigrationsPending = v.Err("Database migrations are not at head", v.ErrorKindMigrations).
+					WithSuggestion("Restart the Agent to apply the migrations, and check its logs for migration errors").
+					WithSuggestion("Make sure DISABLE_MONGO_MIGRATIONS and DISABLE_POSTGRES_MIGRATIONS are not set")
+
+	ErrNATSUnreachable = v.Err("NATS is not reachable", v.ErrorKindUnreachable).
+				WithSuggestion("Check NATS_URI of the Agent, and make sure the NATS pods are running").
+				WithSuggestion("Consider using the embedded NATS with NATS_EMBEDDED=true")
+
+	ErrControlPlaneUnreachable = v.Err("Agent can't connect to the Control Plane gRPC API", v.ErrorKindUnreachable).
+					WithSuggestion("Check TESTKUBE_PRO_URL of the Agent, and that the Control Plane is reachable from the cluster").
+					WithSuggestion("For self-signed certificates, provide the CA or set TESTKUBE_PRO_SKIP_VERIFY")
+	ErrLeaseNotFound = v.Err("Agent lease not found", v.ErrorKindLease).
+				WithSuggestion("Make sure the Agent is running, and its service account can manage leases")
+	ErrLeaseExpired = v.Err("Agent lease has expired", v.ErrorKindLease).
+			WithSuggestion("The Agent is not renewing its lease, check that it's running and healthy")
+
+	ErrImageNotPullable = v.Err("Image can't be pulled", v.ErrorKindImagePull).
+				WithSuggestion("Check that the image exists, and that the image pull secrets allow accessing it").
+				WithSuggestion("For private registries, set TESTKUBE_REGISTRY and the image pull secrets of the Agent")
+
+	ErrWebhookUnreachable = v.Err("Webhook target is not reachable", v.ErrorKindUnreachable).
+				WithSuggestion("Check the webhook URI, and that the target is reachable from the cluster")
+)
@@ -0,0 +1,61 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+	"github.com/kubeshop/testkube/pkg/imageinspector"
+)
+
+func NewWorkerImagesValidator() WorkerImagesValidator {
+	return WorkerImagesValidator{Fetcher: imageinspector.NewCraneFetcher()}
+}
+
+// WorkerImagesValidator checks if the images used by every Test Workflow execution can be pulled
+type WorkerImagesValidator struct {
+	Fetcher imageinspector.InfoFetcher
+}
+
+func (v WorkerImagesValidator) Name() string {
+	return "Init and Toolkit images"
+}
+
+func (v WorkerImagesValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok {
+		return r.WithError(ErrInvalidSubject)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	images := WorkerImages(inst)
+	r = r.WithValidStatus()
+	for _, image := range images {
+		if _, err := v.Fetcher.Fetch(ctx, inst.Env["TESTKUBE_REGISTRY"], image, inst.ImagePullSecrets); err != nil {
+			r = r.WithError(ErrImageNotPullable.WithDetails(fmt.Sprintf("%s: %s", image, err)))
+		}
+	}
+	return r.WithAdditionalInfo(strings.Join(images, ", "))
+}
+
+// WorkerImages lists the Init and Toolkit images the Agent uses, which default to the Agent version
+func WorkerImages(inst loader.Installation) []string {
+	version := imageTag(inst.Image)
+	if version == "" || version == "dev" {
+		version = "latest"
+	}
+	return []string{
+		envOr(inst, "TESTKUBE_TW_INIT_IMAGE", "kubeshop/testkube-tw-init:"+version),
+		envOr(inst, "TESTKUBE_TW_TOOLKIT_IMAGE", "kubeshop/testkube-tw-toolkit:"+version),
+	}
+}
+
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	name := image[strings.LastIndex(image, "/")+1:]
+	_, tag, _ := strings.Cut(name, ":")
+	return tag
+}
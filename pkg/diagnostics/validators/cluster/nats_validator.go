@@ -0,0 +1,65 @@
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+func NewNATSValidator() NATSValidator {
+	return NATSValidator{}
+}
+
+// NATSValidator checks if the NATS server used for the events is reachable
+type NATSValidator struct{}
+
+func (v NATSValidator) Name() string {
+	return "NATS connection"
+}
+
+func (v NATSValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok {
+		return r.WithError(ErrInvalidSubject)
+	}
+	if embedded, _ := strconv.ParseBool(inst.Env["NATS_EMBEDDED"]); embedded {
+		return r.WithValidStatus().WithAdditionalInfo("embedded NATS server")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	uri := envOr(inst, "NATS_URI", "nats://localhost:4222")
+	// Connecting to any of the cluster members is enough to prove the connectivity
+	first, _, _ := strings.Cut(uri, ",")
+	u, err := url.Parse(first)
+	if err != nil || u.Host == "" {
+		return r.WithError(ErrNATSUnreachable.WithDetails("invalid NATS_URI: " + uri))
+	}
+	addr, err := reach(ctx, inst, u.Host, 4222)
+	if err != nil {
+		return r.WithError(ErrNATSUnreachable.WithDetails(err.Error()))
+	}
+	u.Host = addr.Address
+
+	opts := []nats.Option{nats.Timeout(dialTimeout), nats.NoReconnect()}
+	secure, _ := strconv.ParseBool(inst.Env["NATS_SECURE"])
+	skipVerify, _ := strconv.ParseBool(inst.Env["NATS_SKIP_VERIFY"])
+	if secure {
+		opts = append(opts, nats.Secure(&tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: skipVerify || addr.Forwarded}))
+	}
+	nc, err := nats.Connect(u.String(), opts...)
+	if err != nil {
+		return r.WithError(ErrNATSUnreachable.WithDetails(err.Error()))
+	}
+	defer nc.Close()
+	if err = nc.FlushWithContext(ctx); err != nil {
+		return r.WithError(ErrNATSUnreachable.WithDetails(err.Error()))
+	}
+	return r.WithValidStatus().WithAdditionalInfo(first)
+}
@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+// PolicyRule is the access to a resource required in the execution namespace
+type PolicyRule struct {
+	Group       string
+	Resource    string
+	Subresource string
+	Verbs       []string
+}
+
+// WorkerRules are the permissions the Kubernetes execution worker needs in every execution namespace
+var WorkerRules = []PolicyRule{
+	{Group: "batch", Resource: "jobs", Verbs: []string{"get", "list", "watch", "create", "patch", "delete", "deletecollection"}},
+	{Resource: "pods", Verbs: []string{"get", "list", "watch", "create", "delete", "deletecollection"}},
+	{Resource: "pods", Subresource: "log", Verbs: []string{"get"}},
+	{Resource: "configmaps", Verbs: []string{"get", "list", "create", "delete", "deletecollection"}},
+	{Resource: "secrets", Verbs: []string{"get", "list", "create", "delete", "deletecollection"}},
+	{Resource: "persistentvolumeclaims", Verbs: []string{"get", "list", "create", "delete", "deletecollection"}},
+	{Resource: "events", Verbs: []string{"get", "list", "watch"}},
+}
+
+func NewServiceAccountRBACValidator() ServiceAccountRBACValidator {
+	return ServiceAccountRBACValidator{Rules: WorkerRules}
+}
+
+// ServiceAccountRBACValidator checks if the Agent service account is allowed to run executions
+type ServiceAccountRBACValidator struct {
+	Rules []PolicyRule
+}
+
+func (v ServiceAccountRBACValidator) Name() string {
+	return "Service account permissions"
+}
+
+func (v ServiceAccountRBACValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok || inst.Client == nil {
+		return r.WithError(ErrInvalidSubject)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	user := fmt.Sprintf("system:serviceaccount:%s:%s", inst.Namespace, inst.ServiceAccount)
+	groups := []string{"system:serviceaccounts", "system:serviceaccounts:" + inst.Namespace, "system:authenticated"}
+
+	r = r.WithValidStatus()
+	for _, namespace := range inst.ExecutionNamespaces() {
+		missing := make([]string, 0)
+		for _, rule := range v.Rules {
+			for _, verb := range rule.Verbs {
+				review, err := inst.Client.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+					Spec: authorizationv1.SubjectAccessReviewSpec{
+						User:   user,
+						Groups: groups,
+						ResourceAttributes: &authorizationv1.ResourceAttributes{
+							Namespace:   namespace,
+							Verb:        verb,
+							Group:       rule.Group,
+							Resource:    rule.Resource,
+							Subresource: rule.Subresource,
+						},
+					},
+				}, metav1.CreateOptions{})
+				if err != nil {
+					return r.WithError(ErrRBACCheckFailed.WithDetails(err.Error()))
+				}
+				if !review.Status.Allowed {
+					missing = append(missing, fmt.Sprintf("%s %s", verb, rule.resource()))
+				}
+			}
+		}
+		if len(missing) > 0 {
+			r = r.WithError(ErrRBACMissingPermissions.WithDetails(
+				fmt.Sprintf("%s in '%s' namespace can't: %s", user, namespace, strings.Join(missing, ", "))))
+		}
+	}
+	return r
+}
+
+func (p PolicyRule) resource() string {
+	name := p.Resource
+	if p.Subresource != "" {
+		name += "/" + p.Subresource
+	}
+	if p.Group != "" {
+		name += "." + p.Group
+	}
+	return name
+}
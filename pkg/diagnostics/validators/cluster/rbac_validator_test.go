@@ -0,0 +1,48 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+func TestServiceAccountRBACValidator(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	var users []string
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		users = append(users, review.Spec.User)
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = !(attrs.Namespace == "runners" && attrs.Resource == "jobs" && attrs.Verb == "deletecollection")
+		return true, review, nil
+	})
+	inst := loader.Installation{
+		Namespace:      "testkube",
+		ServiceAccount: "testkube-api-server",
+		Env:            map[string]string{"TESTKUBE_EXECUTION_NAMESPACES": "runners=runner-sa"},
+		Client:         client,
+	}
+
+	result := NewServiceAccountRBACValidator().Validate(inst)
+
+	assert.Equal(t, validators.StatusInvalid, result.Status)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, validators.ErrorKindRBAC, result.Errors[0].Kind)
+	assert.Contains(t, result.Errors[0].Details, "'runners' namespace can't: deletecollection jobs.batch")
+	assert.Contains(t, users, "system:serviceaccount:testkube:testkube-api-server")
+}
+
+func TestServiceAccountRBACValidator_InvalidSubject(t *testing.T) {
+	result := NewServiceAccountRBACValidator().Validate(nil)
+
+	assert.Equal(t, validators.StatusInvalid, result.Status)
+	assert.Equal(t, ErrInvalidSubject.Message, result.Errors[0].Message)
+}
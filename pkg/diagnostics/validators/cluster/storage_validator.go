@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+	"github.com/kubeshop/testkube/pkg/storage/minio"
+)
+
+func NewStorageValidator() StorageValidator {
+	return StorageValidator{}
+}
+
+// StorageValidator checks if the object storage used for artifacts and logs is reachable
+type StorageValidator struct{}
+
+func (v StorageValidator) Name() string {
+	return "Object storage (MinIO/S3)"
+}
+
+func (v StorageValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok {
+		return r.WithError(ErrInvalidSubject)
+	}
+	if !inst.IsStandalone() {
+		return r.WithValidStatus().WithAdditionalInfo("artifacts are stored by the Control Plane")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	endpoint := envOr(inst, "STORAGE_ENDPOINT", "localhost:9000")
+	bucket := envOr(inst, "STORAGE_BUCKET", "testkube-logs")
+	addr, err := reach(ctx, inst, endpoint, 9000)
+	if err != nil {
+		return r.WithError(ErrStorageUnreachable.WithDetails(err.Error()))
+	}
+
+	ssl, _ := strconv.ParseBool(inst.Env["STORAGE_SSL"])
+	skipVerify, _ := strconv.ParseBool(inst.Env["STORAGE_SKIP_VERIFY"])
+	// The client certificates are mounted in the Agent pod only, so they can't be used there
+	client := minio.NewClient(addr.Address, inst.Env["STORAGE_ACCESSKEYID"], inst.Env["STORAGE_SECRETACCESSKEY"],
+		inst.Env["STORAGE_REGION"], inst.Env["STORAGE_TOKEN"], bucket,
+		minio.GetTLSOptions(ssl, skipVerify || addr.Forwarded, "", "", "")...)
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return r.WithError(ErrStorageUnreachable.WithDetails(err.Error()))
+	}
+	if !exists {
+		return r.WithError(ErrStorageBucketMissing.WithDetails(bucket))
+	}
+	return r.WithValidStatus().WithAdditionalInfo(endpoint + "/" + bucket)
+}
+
+// envOr reads the Agent environment variable, falling back to the Agent default
+func envOr(inst loader.Installation, name, defaultValue string) string {
+	if value := inst.Env[name]; value != "" {
+		return value
+	}
+	return defaultValue
+}
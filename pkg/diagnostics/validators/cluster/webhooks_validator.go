@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubeshop/testkube/pkg/diagnostics/loader"
+	"github.com/kubeshop/testkube/pkg/diagnostics/validators"
+)
+
+var webhookResource = schema.GroupVersionResource{Group: "executor.testkube.io", Version: "v1", Resource: "webhooks"}
+
+func NewWebhooksValidator() WebhooksValidator {
+	return WebhooksValidator{}
+}
+
+// WebhooksValidator checks if the targets of the enabled webhooks accept connections.
+// The targets are only dialed, so no webhook is triggered.
+type WebhooksValidator struct{}
+
+func (v WebhooksValidator) Name() string {
+	return "Webhook targets"
+}
+
+func (v WebhooksValidator) Validate(subject any) (r validators.ValidationResult) {
+	inst, ok := installation(subject)
+	if !ok || inst.Dynamic == nil {
+		return r.WithError(ErrInvalidSubject)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	list, err := inst.Dynamic.Resource(webhookResource).Namespace(inst.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return r.WithStdError(err)
+	}
+
+	r = r.WithValidStatus()
+	checked, skipped := 0, 0
+	for _, item := range list.Items {
+		uri, _, _ := unstructured.NestedString(item.Object, "spec", "uri")
+		disabled, _, _ := unstructured.NestedBool(item.Object, "spec", "disabled")
+		// The templated targets are known only for the specific event
+		if disabled || uri == "" || strings.Contains(uri, "{{") {
+			skipped++
+			continue
+		}
+		checked++
+		if err := v.dial(ctx, inst, uri); err != nil {
+			r = r.WithError(ErrWebhookUnreachable.WithDetails(fmt.Sprintf("%s (%s): %s", item.GetName(), uri, err)))
+		}
+	}
+	return r.WithAdditionalInfo(fmt.Sprintf("%d checked, %d skipped", checked, skipped))
+}
+
+func (v WebhooksValidator) dial(ctx context.Context, inst loader.Installation, uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid URI")
+	}
+	port := 80
+	if u.Scheme == "https" {
+		port = 443
+	}
+	addr, err := reach(ctx, inst, u.Host, port)
+	if err != nil {
+		return err
+	}
+	return dial(ctx, addr.Address)
+}
@@ -7,7 +7,7 @@ func IsError(err error) bool {
 }
 
 func Err(e string, kind ErrorKind, suggestions ...string) Error {
-	err := Error{Message: e, Suggestions: suggestions}
+	err := Error{Kind: kind, Message: e, Suggestions: suggestions}
 	return err
 }
 
@@ -11,6 +11,13 @@ const (
 
 	ErrorKindLicenseInvalid ErrorKind = "license invalid"
 	ErrorKindLicenseExpired ErrorKind = "license expired"
+
+	ErrorKindCRDMismatch ErrorKind = "crd mismatch"
+	ErrorKindRBAC        ErrorKind = "rbac"
+	ErrorKindUnreachable ErrorKind = "unreachable"
+	ErrorKindMigrations  ErrorKind = "migrations"
+	ErrorKindLease       ErrorKind = "lease"
+	ErrorKindImagePull   ErrorKind = "image pull"
 )
 
 var (
@@ -0,0 +1,84 @@
+package testworkflowexecutionlogs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+)
+
+var _ common.Listener = (*testWorkflowExecutionLogsListener)(nil)
+
+// Index the Test Workflow Execution logs for the search, once the execution is finished
+func NewListener(ctx context.Context, outputRepository testworkflow.OutputRepository, logSearchRepository logsearch.Repository) *testWorkflowExecutionLogsListener {
+	return &testWorkflowExecutionLogsListener{
+		ctx:                 ctx,
+		outputRepository:    outputRepository,
+		logSearchRepository: logSearchRepository,
+	}
+}
+
+type testWorkflowExecutionLogsListener struct {
+	ctx                 context.Context
+	outputRepository    testworkflow.OutputRepository
+	logSearchRepository logsearch.Repository
+}
+
+func (l *testWorkflowExecutionLogsListener) Name() string {
+	return "TestWorkflowExecutionLogs"
+}
+
+func (l *testWorkflowExecutionLogsListener) Selector() string {
+	return ""
+}
+
+func (l *testWorkflowExecutionLogsListener) Kind() string {
+	return "TestWorkflowExecutionLogs"
+}
+
+func (l *testWorkflowExecutionLogsListener) Group() string {
+	return ""
+}
+
+func (l *testWorkflowExecutionLogsListener) Events() []testkube.EventType {
+	return []testkube.EventType{
+		testkube.END_TESTWORKFLOW_SUCCESS_EventType,
+		testkube.END_TESTWORKFLOW_FAILED_EventType,
+		testkube.END_TESTWORKFLOW_ABORTED_EventType,
+		testkube.END_TESTWORKFLOW_CANCELED_EventType,
+	}
+}
+
+func (l *testWorkflowExecutionLogsListener) Metadata() map[string]string {
+	return map[string]string{
+		"name":     l.Name(),
+		"events":   fmt.Sprintf("%v", l.Events()),
+		"selector": l.Selector(),
+	}
+}
+
+func (l *testWorkflowExecutionLogsListener) Match(event testkube.Event) bool {
+	_, valid := event.Valid(l.Group(), l.Selector(), l.Events())
+	return valid
+}
+
+func (l *testWorkflowExecutionLogsListener) Notify(event testkube.Event) testkube.EventResult {
+	execution := event.TestWorkflowExecution
+	if execution == nil || execution.Workflow == nil {
+		return testkube.NewSuccessEventResult(event.Id, "ignored")
+	}
+
+	reader, err := l.outputRepository.ReadLog(l.ctx, execution.Id, execution.Workflow.Name)
+	if err != nil {
+		return testkube.NewFailedEventResult(event.Id, fmt.Errorf("reading logs: %w", err))
+	}
+	defer reader.Close()
+	// The documents are stored while the log is read, so it's never kept in memory as a whole
+	if err = l.logSearchRepository.Index(l.ctx, execution.Id, logsearch.ParseDocuments(reader, *execution)); err != nil {
+		return testkube.NewFailedEventResult(event.Id, err)
+	}
+	return testkube.NewSuccessEventResult(event.Id, "indexed")
+}
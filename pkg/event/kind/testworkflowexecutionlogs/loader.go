@@ -0,0 +1,29 @@
+package testworkflowexecutionlogs
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+)
+
+var _ common.ListenerLoader = (*testWorkflowExecutionLogsLoader)(nil)
+
+func NewLoader(ctx context.Context, outputRepository testworkflow.OutputRepository, logSearchRepository logsearch.Repository) *testWorkflowExecutionLogsLoader {
+	return &testWorkflowExecutionLogsLoader{
+		listener: NewListener(ctx, outputRepository, logSearchRepository),
+	}
+}
+
+type testWorkflowExecutionLogsLoader struct {
+	listener *testWorkflowExecutionLogsListener
+}
+
+func (r *testWorkflowExecutionLogsLoader) Kind() string {
+	return "TestWorkflowExecutionLogs"
+}
+
+func (r *testWorkflowExecutionLogsLoader) Load() (listeners common.Listeners, err error) {
+	return common.Listeners{r.listener}, nil
+}
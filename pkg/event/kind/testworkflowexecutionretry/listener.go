@@ -0,0 +1,85 @@
+package testworkflowexecutionretry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/testworkflows/executionretry"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowexecutor"
+)
+
+var _ common.Listener = (*testWorkflowExecutionRetryListener)(nil)
+
+// Re-queue the Test Workflow Execution terminated by the infrastructure, when the workflow allows that
+func NewListener(ctx context.Context, executor testworkflowexecutor.TestWorkflowExecutor) *testWorkflowExecutionRetryListener {
+	return &testWorkflowExecutionRetryListener{
+		ctx:      ctx,
+		executor: executor,
+	}
+}
+
+type testWorkflowExecutionRetryListener struct {
+	ctx      context.Context
+	executor testworkflowexecutor.TestWorkflowExecutor
+}
+
+func (l *testWorkflowExecutionRetryListener) Name() string {
+	return "TestWorkflowExecutionRetry"
+}
+
+func (l *testWorkflowExecutionRetryListener) Selector() string {
+	return ""
+}
+
+func (l *testWorkflowExecutionRetryListener) Kind() string {
+	return "TestWorkflowExecutionRetry"
+}
+
+func (l *testWorkflowExecutionRetryListener) Group() string {
+	return ""
+}
+
+func (l *testWorkflowExecutionRetryListener) Events() []testkube.EventType {
+	return []testkube.EventType{
+		testkube.END_TESTWORKFLOW_ABORTED_EventType,
+	}
+}
+
+func (l *testWorkflowExecutionRetryListener) Metadata() map[string]string {
+	return map[string]string{
+		"name":     l.Name(),
+		"events":   fmt.Sprintf("%v", l.Events()),
+		"selector": l.Selector(),
+	}
+}
+
+func (l *testWorkflowExecutionRetryListener) Match(event testkube.Event) bool {
+	_, valid := event.Valid(l.Group(), l.Selector(), l.Events())
+	return valid
+}
+
+func (l *testWorkflowExecutionRetryListener) Notify(event testkube.Event) testkube.EventResult {
+	execution := event.TestWorkflowExecution
+	request, err := executionretry.Next(execution)
+	if err != nil {
+		return testkube.NewFailedEventResult(event.Id, fmt.Errorf("preparing the next attempt: %w", err))
+	}
+	if request == nil {
+		return testkube.NewSuccessEventResult(event.Id, "ignored")
+	}
+	request.RunningContext, request.User = testworkflowexecutor.GetNewRunningContext(execution.RunningContext, nil)
+
+	attempts, err := l.executor.Execute(l.ctx, request)
+	if err != nil {
+		return testkube.NewFailedEventResult(event.Id, fmt.Errorf("scheduling the next attempt: %w", err))
+	}
+	for _, attempt := range attempts {
+		log.DefaultLogger.Infow("re-queued the execution terminated by the infrastructure",
+			"id", execution.Id, "attempt", executionretry.Attempt(&attempt), "attemptId", attempt.Id,
+			"reason", request.Tags[executionretry.RetryReasonTagKey])
+	}
+	return testkube.NewSuccessEventResult(event.Id, "retried")
+}
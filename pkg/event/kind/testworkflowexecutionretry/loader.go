@@ -0,0 +1,28 @@
+package testworkflowexecutionretry
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowexecutor"
+)
+
+var _ common.ListenerLoader = (*testWorkflowExecutionRetryLoader)(nil)
+
+func NewLoader(ctx context.Context, executor testworkflowexecutor.TestWorkflowExecutor) *testWorkflowExecutionRetryLoader {
+	return &testWorkflowExecutionRetryLoader{
+		listener: NewListener(ctx, executor),
+	}
+}
+
+type testWorkflowExecutionRetryLoader struct {
+	listener *testWorkflowExecutionRetryListener
+}
+
+func (r *testWorkflowExecutionRetryLoader) Kind() string {
+	return "TestWorkflowExecutionRetry"
+}
+
+func (r *testWorkflowExecutionRetryLoader) Load() (listeners common.Listeners, err error) {
+	return common.Listeners{r.listener}, nil
+}
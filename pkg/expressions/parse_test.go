@@ -492,3 +492,63 @@ abc
 def
 '`).String())
 }
+
+func TestCompileStandardLib_Strings(t *testing.T) {
+	assert.Equal(t, `"ABC-1"`, MustCompile(`upper("abc-1")`).String())
+	assert.Equal(t, `"abc-1"`, MustCompile(`lower("ABC-1")`).String())
+	assert.Equal(t, `"feature-abc-def"`, MustCompile(`replace("feature/abc/def", "/", "-")`).String())
+	assert.Equal(t, `true`, MustCompile(`regexmatch("release/1.2", "^release/[0-9.]+$")`).String())
+	assert.Equal(t, `false`, MustCompile(`regexmatch("main", "^release/")`).String())
+	assert.Equal(t, `"1.2"`, MustCompile(`regexfind("release/1.2", "[0-9.]+")`).String())
+	assert.Equal(t, `["1","22","333"]`, MustCompile(`regexfindall("a1b22c333", "[0-9]+")`).String())
+	assert.Equal(t, `[]`, MustCompile(`regexfindall("abc", "[0-9]+")`).String())
+	assert.Equal(t, `"1"`, MustCompile(`regexcapture("release/1.2", "(?P<major>[0-9]+)\\.([0-9]+)").major`).String())
+	assert.Equal(t, `"2"`, MustCompile(`at(regexcapture("release/1.2", "(?P<major>[0-9]+)\\.([0-9]+)"), "2")`).String())
+	assert.Equal(t, `null`, MustCompile(`regexcapture("main", "[0-9]+")`).String())
+	assert.Equal(t, `"v-1.-2"`, MustCompile(`regexreplace("v1.2", "([0-9]+)", "-$1")`).String())
+	_, err := Compile(`regexmatch("abc", "(")`)
+	assert.Error(t, err)
+}
+
+func TestCompileStandardLib_Encoding(t *testing.T) {
+	assert.Equal(t, `"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"`, MustCompile(`sha256("abc")`).String())
+	assert.Equal(t, `"900150983cd24fb0d6963f7d28e17f72"`, MustCompile(`md5("abc")`).String())
+	assert.Equal(t, `"aGVsbG8gd29ybGQ="`, MustCompile(`base64encode("hello world")`).String())
+	assert.Equal(t, `"hello world"`, MustCompile(`base64decode("aGVsbG8gd29ybGQ=")`).String())
+	assert.Equal(t, `"a+b%26c%3Dd%2Fe"`, MustCompile(`urlencode("a b&c=d/e")`).String())
+	assert.Equal(t, "a b&c=d/e", must(MustCompile(`urldecode("a+b%26c%3Dd%2Fe")`).Static().StringValue()))
+	_, err := Compile(`base64decode("not base64!")`)
+	assert.Error(t, err)
+}
+
+func TestCompileStandardLib_Semver(t *testing.T) {
+	assert.Equal(t, `-1`, MustCompile(`semvercompare("1.2.3", "v1.10.0")`).String())
+	assert.Equal(t, `0`, MustCompile(`semvercompare("1.2.3", "1.2.3+build")`).String())
+	assert.Equal(t, `1`, MustCompile(`semvercompare("2.0.0", "2.0.0-rc.1")`).String())
+	assert.Equal(t, `true`, MustCompile(`semvermatch("1.4.2", ">= 1.2, < 2")`).String())
+	assert.Equal(t, `false`, MustCompile(`semvermatch("2.0.0", "~1.4")`).String())
+	assert.Equal(t, `3`, MustCompile(`semver("v3.1.4-rc.1").major`).String())
+	assert.Equal(t, `"rc.1"`, MustCompile(`semver("v3.1.4-rc.1").prerelease`).String())
+	_, err := Compile(`semvercompare("latest", "1.0.0")`)
+	assert.Error(t, err)
+}
+
+func TestCompileStandardLib_DateMath(t *testing.T) {
+	assert.Equal(t, `"2026-03-02T12:00:00.000Z"`, MustCompile(`dateadd("2026-02-28T12:00:00Z", "2d")`).String())
+	assert.Equal(t, `"2026-02-27T00:30:00.000Z"`, MustCompile(`dateadd("2026-02-28T12:00:00Z", "-1d11h30m")`).String())
+	assert.Equal(t, `"20260228"`, MustCompile(`dateadd("2026-02-28", "90m", "20060102")`).String())
+	assert.Equal(t, `"2026-02-28 12:00"`, MustCompile(`dateformat("2026-02-28T14:00:00+02:00", "2006-01-02 15:04")`).String())
+	assert.Equal(t, `5400`, MustCompile(`datediff("2026-02-28T12:00:00Z", "2026-02-28T13:30:00Z")`).String())
+	assert.Equal(t, TypeString, MustCompile(`dateadd(date(), "1h")`).Type())
+	_, err := Compile(`dateadd("yesterday", "1h")`)
+	assert.Error(t, err)
+	_, err = Compile(`dateadd("2026-02-28", "1x")`)
+	assert.Error(t, err)
+}
+
+func TestCompileStandardLib_ReturnTypes(t *testing.T) {
+	assert.Equal(t, TypeBool, MustCompile(`regexmatch(branch, "^release/")`).Type())
+	assert.Equal(t, TypeString, MustCompile(`sha256(files)`).Type())
+	assert.Equal(t, TypeInt64, MustCompile(`semvercompare(a, b)`).Type())
+	assert.Equal(t, TypeFloat64, MustCompile(`datediff(a, b)`).Type())
+}
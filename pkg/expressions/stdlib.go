@@ -2,14 +2,23 @@ package expressions
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	math2 "math"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/itchyny/gojq"
 	"github.com/kballard/go-shellquote"
 	"github.com/pkg/errors"
@@ -654,6 +663,295 @@ var stdFunctions = map[string]StdFunction{
 			return nil, fmt.Errorf(`"date" function expects 0-1 arguments, %d provided`, len(value))
 		}),
 	},
+	"upper": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"upper" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(strings.ToUpper(str)), nil
+		}),
+	},
+	"lower": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"lower" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(strings.ToLower(str)), nil
+		}),
+	},
+	"replace": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 3 {
+				return nil, fmt.Errorf(`"replace" function expects 3 arguments, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			old, _ := value[1].StringValue()
+			replacement, _ := value[2].StringValue()
+			return NewValue(strings.ReplaceAll(str, old, replacement)), nil
+		}),
+	},
+	"regexmatch": {
+		ReturnType: TypeBool,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"regexmatch" function expects 2 arguments, %d provided`, len(value))
+			}
+			str, re, err := stringAndRegex("regexmatch", value[0], value[1])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(re.MatchString(str)), nil
+		}),
+	},
+	"regexfind": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"regexfind" function expects 2 arguments, %d provided`, len(value))
+			}
+			str, re, err := stringAndRegex("regexfind", value[0], value[1])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(re.FindString(str)), nil
+		}),
+	},
+	"regexfindall": {
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"regexfindall" function expects 2 arguments, %d provided`, len(value))
+			}
+			str, re, err := stringAndRegex("regexfindall", value[0], value[1])
+			if err != nil {
+				return nil, err
+			}
+			result := re.FindAllString(str, -1)
+			if result == nil {
+				result = []string{}
+			}
+			return NewValue(result), nil
+		}),
+	},
+	"regexcapture": {
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"regexcapture" function expects 2 arguments, %d provided`, len(value))
+			}
+			str, re, err := stringAndRegex("regexcapture", value[0], value[1])
+			if err != nil {
+				return nil, err
+			}
+			match := re.FindStringSubmatch(str)
+			if match == nil {
+				return None, nil
+			}
+
+			// Expose named groups by name, and all groups by their index (0 is the whole match)
+			result := make(map[string]interface{}, len(match))
+			for i, name := range re.SubexpNames() {
+				result[strconv.Itoa(i)] = match[i]
+				if name != "" {
+					result[name] = match[i]
+				}
+			}
+			return NewValue(result), nil
+		}),
+	},
+	"regexreplace": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 3 {
+				return nil, fmt.Errorf(`"regexreplace" function expects 3 arguments, %d provided`, len(value))
+			}
+			str, re, err := stringAndRegex("regexreplace", value[0], value[1])
+			if err != nil {
+				return nil, err
+			}
+			replacement, _ := value[2].StringValue()
+			return NewValue(re.ReplaceAllString(str, replacement)), nil
+		}),
+	},
+	"sha256": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"sha256" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			sum := sha256.Sum256([]byte(str))
+			return NewValue(hex.EncodeToString(sum[:])), nil
+		}),
+	},
+	"md5": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"md5" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			sum := md5.Sum([]byte(str))
+			return NewValue(hex.EncodeToString(sum[:])), nil
+		}),
+	},
+	"base64encode": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"base64encode" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(base64.StdEncoding.EncodeToString([]byte(str))), nil
+		}),
+	},
+	"base64decode": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"base64decode" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			v, err := base64.StdEncoding.DecodeString(str)
+			if err != nil {
+				return nil, fmt.Errorf(`"base64decode" function had problem decoding: %w`, err)
+			}
+			return NewValue(string(v)), nil
+		}),
+	},
+	"urlencode": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"urlencode" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(url.QueryEscape(str)), nil
+		}),
+	},
+	"urldecode": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"urldecode" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			v, err := url.QueryUnescape(str)
+			if err != nil {
+				return nil, fmt.Errorf(`"urldecode" function had problem decoding: %w`, err)
+			}
+			return NewValue(v), nil
+		}),
+	},
+	"semvercompare": {
+		ReturnType: TypeInt64,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"semvercompare" function expects 2 arguments, %d provided`, len(value))
+			}
+			v1, err := toSemver("semvercompare", value[0])
+			if err != nil {
+				return nil, err
+			}
+			v2, err := toSemver("semvercompare", value[1])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(int64(v1.Compare(v2))), nil
+		}),
+	},
+	"semvermatch": {
+		ReturnType: TypeBool,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"semvermatch" function expects 2 arguments, %d provided`, len(value))
+			}
+			v, err := toSemver("semvermatch", value[0])
+			if err != nil {
+				return nil, err
+			}
+			constraintStr, _ := value[1].StringValue()
+			constraint, err := semver.NewConstraint(constraintStr)
+			if err != nil {
+				return nil, fmt.Errorf(`"semvermatch" function expects 2nd argument to be a valid constraint, %s provided: %v`, value[1], err)
+			}
+			return NewValue(constraint.Check(v)), nil
+		}),
+	},
+	"semver": {
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"semver" function expects 1 argument, %d provided`, len(value))
+			}
+			v, err := toSemver("semver", value[0])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(map[string]interface{}{
+				"major":      int64(v.Major()),
+				"minor":      int64(v.Minor()),
+				"patch":      int64(v.Patch()),
+				"prerelease": v.Prerelease(),
+				"metadata":   v.Metadata(),
+			}), nil
+		}),
+	},
+	"dateadd": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 && len(value) != 3 {
+				return nil, fmt.Errorf(`"dateadd" function expects 2-3 arguments, %d provided`, len(value))
+			}
+			t, err := toDate("dateadd", value[0])
+			if err != nil {
+				return nil, err
+			}
+			durationStr, _ := value[1].StringValue()
+			duration, err := parseDateDuration(durationStr)
+			if err != nil {
+				return nil, fmt.Errorf(`"dateadd" function expects 2nd argument to be a duration, %s provided: %v`, value[1], err)
+			}
+			format := RFC3339Millis
+			if len(value) == 3 {
+				format, _ = value[2].StringValue()
+			}
+			return NewValue(t.Add(duration).Format(format)), nil
+		}),
+	},
+	"dateformat": {
+		ReturnType: TypeString,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"dateformat" function expects 2 arguments, %d provided`, len(value))
+			}
+			t, err := toDate("dateformat", value[0])
+			if err != nil {
+				return nil, err
+			}
+			format, _ := value[1].StringValue()
+			return NewValue(t.Format(format)), nil
+		}),
+	},
+	"datediff": {
+		ReturnType: TypeFloat64,
+		Handler: ToStdFunctionHandler(func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"datediff" function expects 2 arguments, %d provided`, len(value))
+			}
+			from, err := toDate("datediff", value[0])
+			if err != nil {
+				return nil, err
+			}
+			to, err := toDate("datediff", value[1])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(to.Sub(from).Seconds()), nil
+		}),
+	},
 	"any": {
 		Handler: func(args []CallArgument) (Expression, bool, error) {
 			resolved := true
@@ -717,6 +1015,67 @@ type MapEntry struct {
 	Value interface{} `json:"value"`
 }
 
+// dateLayouts are the layouts accepted by the date functions, tried in order.
+var dateLayouts = []string{RFC3339Millis, time.RFC3339Nano, time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+func toDate(fnName string, value StaticValue) (time.Time, error) {
+	str, _ := value.StringValue()
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf(`"%s" function expects a date in RFC 3339 format, %s provided`, fnName, value)
+}
+
+// parseDateDuration extends time.ParseDuration with the "d" unit for full days, i.e. "7d" or "-1d12h".
+func parseDateDuration(str string) (time.Duration, error) {
+	sign := time.Duration(1)
+	rest := strings.TrimSpace(str)
+	if strings.HasPrefix(rest, "-") {
+		sign = -1
+		rest = rest[1:]
+	} else if strings.HasPrefix(rest, "+") {
+		rest = rest[1:]
+	}
+	var days time.Duration
+	if index := strings.Index(rest, "d"); index != -1 {
+		v, err := strconv.ParseInt(rest[:index], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", str)
+		}
+		days = time.Duration(v) * 24 * time.Hour
+		rest = rest[index+1:]
+		if rest == "" {
+			return sign * days, nil
+		}
+	}
+	d, err := time.ParseDuration(rest)
+	if err != nil {
+		return 0, err
+	}
+	return sign * (days + d), nil
+}
+
+func toSemver(fnName string, value StaticValue) (*semver.Version, error) {
+	str, _ := value.StringValue()
+	v, err := semver.NewVersion(str)
+	if err != nil {
+		return nil, fmt.Errorf(`"%s" function expects a semantic version, %s provided: %v`, fnName, value, err)
+	}
+	return v, nil
+}
+
+func stringAndRegex(fnName string, str StaticValue, pattern StaticValue) (string, *regexp.Regexp, error) {
+	s, _ := str.StringValue()
+	p, _ := pattern.StringValue()
+	re, err := regexp.Compile(p)
+	if err != nil {
+		return "", nil, fmt.Errorf(`"%s" function expects 2nd argument to be a valid regular expression, %s provided: %v`, fnName, pattern, err)
+	}
+	return s, re, nil
+}
+
 func CastToString(v Expression) Expression {
 	if v.Static() != nil {
 		return NewStringValue(v.Static().Value())
@@ -752,6 +1111,18 @@ func IsStdFunction(name string) bool {
 	return ok
 }
 
+// StdFunctionNames lists the public functions of the standard library
+func StdFunctionNames() []string {
+	names := make([]string, 0, len(stdFunctions))
+	for name := range stdFunctions {
+		if !strings.HasPrefix(name, "_") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 func GetStdFunctionReturnType(name string) Type {
 	return stdFunctions[name].ReturnType
 }
@@ -130,6 +130,19 @@ func tokenize(exp string, index int) (tokens []token, i int, err error) {
 	return
 }
 
+// Tokens splits the expression into readable tokens, i.e. for debugging.
+func Tokens(exp string) ([]string, error) {
+	tokens, _, err := tokenize(exp, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, len(tokens))
+	for i, t := range tokens {
+		result[i] = t.String()
+	}
+	return result, nil
+}
+
 func mustTokenize(exp string) []token {
 	tokens, _, err := tokenize(exp, 0)
 	if err != nil {
@@ -70,3 +70,11 @@ func TestTokenizeInvalidJson(t *testing.T) {
 	assert.Error(t, err2)
 	assert.Equal(t, []token{}, tokens2)
 }
+
+func TestTokens(t *testing.T) {
+	tokens, err := Tokens(`!a.b(c, "d", null)...?e[0]:1.5`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"!", "a.b", "(", "c", ",", `"d"`, ",", "null", ")", "...", "?", "e", "[0]", ":", "1.5"}, tokens)
+	_, err = Tokens(`a # b`)
+	assert.Error(t, err)
+}
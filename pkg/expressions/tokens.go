@@ -1,5 +1,10 @@
 package expressions
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 type tokenType uint8
 
 const (
@@ -53,3 +58,35 @@ func tokenAccessor(value interface{}) token {
 func tokenPropertyAccessor(value interface{}) token {
 	return token{Type: tokenTypePropertyAccessor, Value: value}
 }
+
+func (t token) String() string {
+	switch t.Type {
+	case tokenTypeAccessor:
+		return fmt.Sprintf("%v", t.Value)
+	case tokenTypePropertyAccessor:
+		return fmt.Sprintf(".%v", t.Value)
+	case tokenTypeJson:
+		if isNone(t.Value) {
+			return "null"
+		}
+		b, _ := json.Marshal(t.Value)
+		return string(b)
+	case tokenTypeNot:
+		return "!"
+	case tokenTypeMath:
+		return fmt.Sprintf("%v", t.Value)
+	case tokenTypeOpen:
+		return "("
+	case tokenTypeClose:
+		return ")"
+	case tokenTypeTernary:
+		return "?"
+	case tokenTypeTernarySeparator:
+		return ":"
+	case tokenTypeComma:
+		return ","
+	case tokenTypeSpread:
+		return "..."
+	}
+	return fmt.Sprintf("%v", t.Value)
+}
@@ -0,0 +1,51 @@
+// Package junit parses the JUnit XML reports
+package junit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+// Problem is the failure or the error of the test case
+type Problem struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Case is a single test case of the report
+type Case struct {
+	Name      string    `xml:"name,attr"`
+	ClassName string    `xml:"classname,attr"`
+	Time      float64   `xml:"time,attr"`
+	Failure   *Problem  `xml:"failure"`
+	Error     *Problem  `xml:"error"`
+	Skipped   *struct{} `xml:"skipped"`
+}
+
+// Suite is the test suite with its test cases and the nested suites
+type Suite struct {
+	Name   string  `xml:"name,attr"`
+	Cases  []Case  `xml:"testcase"`
+	Suites []Suite `xml:"testsuite"`
+}
+
+// Parse reads the JUnit report.
+// Both <testsuites> and <testsuite> roots share the same nested structure, so they are read as the Suite.
+func Parse(data []byte) (*Suite, error) {
+	var root Suite
+	if err := xml.Unmarshal(bytes.TrimSpace(data), &root); err != nil {
+		return nil, fmt.Errorf("invalid junit report: %w", err)
+	}
+	return &root, nil
+}
+
+// Walk calls the function for each test case of the suite and the nested suites, along with its direct suite
+func (s *Suite) Walk(fn func(suite *Suite, c *Case)) {
+	for i := range s.Cases {
+		fn(s, &s.Cases[i])
+	}
+	for i := range s.Suites {
+		s.Suites[i].Walk(fn)
+	}
+}
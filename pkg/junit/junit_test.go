@@ -0,0 +1,36 @@
+package junit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	root, err := Parse([]byte(`
+<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="a">
+    <testcase name="1" classname="pkg.A" time="0.5"/>
+    <testsuite name="nested">
+      <testcase name="2"><failure message="boom">trace</failure></testcase>
+      <testcase name="3"><skipped/></testcase>
+    </testsuite>
+  </testsuite>
+</testsuites>`))
+	require.NoError(t, err)
+
+	var names []string
+	root.Walk(func(suite *Suite, c *Case) {
+		names = append(names, suite.Name+"/"+c.Name)
+	})
+	assert.Equal(t, []string{"a/1", "nested/2", "nested/3"}, names)
+	assert.Equal(t, 0.5, root.Suites[0].Cases[0].Time)
+	assert.Equal(t, "pkg.A", root.Suites[0].Cases[0].ClassName)
+	assert.Equal(t, &Problem{Message: "boom", Text: "trace"}, root.Suites[0].Suites[0].Cases[0].Failure)
+	assert.NotNil(t, root.Suites[0].Suites[0].Cases[1].Skipped)
+
+	_, err = Parse([]byte("<testsuite"))
+	assert.Error(t, err)
+}
@@ -51,6 +51,8 @@ func MapAPIToCRD(event testkube.Event, namespace string, eventTime time.Time) co
 			action = "aborted"
 		case *testkube.EventQueueTestWorkflow:
 			action = "queued"
+		case *testkube.EventApprovalRequestedTestWorkflow:
+			action = "approval-requested"
 		case *testkube.EventCreated:
 			action = "created"
 		case *testkube.EventUpdated:
@@ -55,6 +55,7 @@ func MapCRDToAPI(crd *testsv1.TestTrigger) testkube.TestTrigger {
 		Name:              crd.Name,
 		Namespace:         crd.Namespace,
 		Labels:            crd.Labels,
+		Annotations:       crd.Annotations,
 		Selector:          mapLabelSelectorFromCRD(crd.Spec.Selector),
 		Resource:          resource,
 		ResourceRef:       resourceRef,
@@ -280,3 +281,30 @@ func mapContentGitPullRequestFromCRD(pr *testsv1.TestTriggerContentGitPullReques
 		BranchesIgnore: pr.BranchesIgnore,
 	}
 }
+
+// MapAPIToTestTriggerUpsertRequest maps OpenAPI spec TestTrigger to TestTriggerUpsertRequest
+func MapAPIToTestTriggerUpsertRequest(trigger testkube.TestTrigger) testkube.TestTriggerUpsertRequest {
+	return testkube.TestTriggerUpsertRequest{
+		Namespace:         trigger.Namespace,
+		Name:              trigger.Name,
+		Labels:            trigger.Labels,
+		Annotations:       trigger.Annotations,
+		Selector:          trigger.Selector,
+		Resource:          trigger.Resource,
+		ResourceRef:       trigger.ResourceRef,
+		ResourceSelector:  trigger.ResourceSelector,
+		Event:             trigger.Event,
+		Match:             trigger.Match,
+		ConditionSpec:     trigger.ConditionSpec,
+		ProbeSpec:         trigger.ProbeSpec,
+		ContentSelector:   trigger.ContentSelector,
+		Action:            trigger.Action,
+		ActionParameters:  trigger.ActionParameters,
+		Execution:         trigger.Execution,
+		TestSelector:      trigger.TestSelector,
+		ConcurrencyPolicy: trigger.ConcurrencyPolicy,
+		Disabled:          trigger.Disabled,
+		Sync:              trigger.Sync,
+		Listener:          trigger.Listener,
+	}
+}
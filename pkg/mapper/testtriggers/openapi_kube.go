@@ -35,9 +35,10 @@ func MapTestTriggerUpsertRequestToTestTriggerCRD(request testkube.TestTriggerUps
 
 	return testsv1.TestTrigger{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      request.Name,
-			Namespace: request.Namespace,
-			Labels:    request.Labels,
+			Name:        request.Name,
+			Namespace:   request.Namespace,
+			Labels:      request.Labels,
+			Annotations: request.Annotations,
 		},
 		Spec: testsv1.TestTriggerSpec{
 			Selector:          mapLabelSelectorToCRD(request.Selector),
@@ -528,9 +528,43 @@ func MapContentGitKubeToAPI(v testworkflowsv1.ContentGit) testkube.TestWorkflowC
 
 func MapContentTarballKubeToAPI(v testworkflowsv1.ContentTarball) testkube.TestWorkflowContentTarball {
 	return testkube.TestWorkflowContentTarball{
-		Url:   v.Url,
-		Path:  v.Path,
-		Mount: MapBoolToBoxedBoolean(v.Mount),
+		Url:    v.Url,
+		Path:   v.Path,
+		Mount:  MapBoolToBoxedBoolean(v.Mount),
+		Sha256: v.Sha256,
+	}
+}
+
+func MapContentOciKubeToAPI(v testworkflowsv1.ContentOci) testkube.TestWorkflowContentOci {
+	return testkube.TestWorkflowContentOci{
+		Ref:          v.Ref,
+		Digest:       v.Digest,
+		Path:         v.Path,
+		Mount:        MapBoolToBoxedBoolean(v.Mount),
+		Username:     v.Username,
+		UsernameFrom: common.MapPtr(v.UsernameFrom, MapEnvVarSourceKubeToAPI),
+		Password:     v.Password,
+		PasswordFrom: common.MapPtr(v.PasswordFrom, MapEnvVarSourceKubeToAPI),
+		Insecure:     v.Insecure,
+	}
+}
+
+func MapContentObjectStorageKubeToAPI(v testworkflowsv1.ContentObjectStorage) testkube.TestWorkflowContentObjectStorage {
+	return testkube.TestWorkflowContentObjectStorage{
+		Endpoint:            v.Endpoint,
+		Region:              v.Region,
+		Bucket:              v.Bucket,
+		Key:                 v.Key,
+		Path:                v.Path,
+		Mount:               MapBoolToBoxedBoolean(v.Mount),
+		Unpack:              v.Unpack,
+		Sha256:              v.Sha256,
+		AccessKeyId:         v.AccessKeyId,
+		AccessKeyIdFrom:     common.MapPtr(v.AccessKeyIdFrom, MapEnvVarSourceKubeToAPI),
+		SecretAccessKey:     v.SecretAccessKey,
+		SecretAccessKeyFrom: common.MapPtr(v.SecretAccessKeyFrom, MapEnvVarSourceKubeToAPI),
+		DisableSsl:          v.DisableSsl,
+		SkipVerify:          v.SkipVerify,
 	}
 }
 
@@ -539,6 +573,9 @@ func MapContentKubeToAPI(v testworkflowsv1.Content) testkube.TestWorkflowContent
 		Git:     common.MapPtr(v.Git, MapContentGitKubeToAPI),
 		Files:   common.MapSlice(v.Files, MapContentFileKubeToAPI),
 		Tarball: common.MapSlice(v.Tarball, MapContentTarballKubeToAPI),
+		Oci:     common.MapSlice(v.Oci, MapContentOciKubeToAPI),
+		S3:      common.MapSlice(v.S3, MapContentObjectStorageKubeToAPI),
+		Gcs:     common.MapSlice(v.Gcs, MapContentObjectStorageKubeToAPI),
 	}
 }
 
@@ -1041,10 +1078,108 @@ func MapStepArtifactsKubeToAPI(v testworkflowsv1.StepArtifacts) testkube.TestWor
 	}
 }
 
+func MapApprovalDecisionKubeToAPI(v testworkflowsv1.ApprovalDecision) *testkube.TestWorkflowApprovalDecision {
+	if v == "" {
+		return nil
+	}
+	return common.Ptr(testkube.TestWorkflowApprovalDecision(v))
+}
+
+func MapStepApprovalKubeToAPI(v testworkflowsv1.StepApproval) testkube.TestWorkflowStepApproval {
+	return testkube.TestWorkflowStepApproval{
+		Message:   v.Message,
+		Approvers: v.Approvers,
+		Timeout:   v.Timeout,
+		Default:   MapApprovalDecisionKubeToAPI(v.Default),
+	}
+}
+
 func MapRetryPolicyKubeToAPI(v testworkflowsv1.RetryPolicy) testkube.TestWorkflowRetryPolicy {
 	return testkube.TestWorkflowRetryPolicy{
-		Count: v.Count,
-		Until: v.Until,
+		Count:    v.Count,
+		Until:    v.Until,
+		Delay:    v.Delay,
+		Backoff:  MapRetryBackoffKubeToAPI(v.Backoff),
+		MaxDelay: v.MaxDelay,
+		Jitter:   v.Jitter,
+		RetryOn:  common.MapPtr(v.RetryOn, MapRetryOnKubeToAPI),
+	}
+}
+
+func MapRetryBackoffKubeToAPI(v testworkflowsv1.RetryBackoff) *testkube.TestWorkflowRetryBackoff {
+	if v == "" {
+		return nil
+	}
+	return common.Ptr(testkube.TestWorkflowRetryBackoff(v))
+}
+
+func MapRetryOnKubeToAPI(v testworkflowsv1.RetryOn) testkube.TestWorkflowRetryOn {
+	return testkube.TestWorkflowRetryOn{
+		ExitCodes: v.ExitCodes,
+		Logs:      v.Logs,
+	}
+}
+
+func MapGateActionKubeToAPI(v testworkflowsv1.GateAction) *testkube.TestWorkflowGateAction {
+	if v == "" {
+		return nil
+	}
+	return common.Ptr(testkube.TestWorkflowGateAction(v))
+}
+
+func MapGateKubeToAPI(v testworkflowsv1.Gate) testkube.TestWorkflowGate {
+	return testkube.TestWorkflowGate{
+		Name:        v.Name,
+		Description: v.Description,
+		Condition:   v.Condition,
+		OnFailure:   MapGateActionKubeToAPI(v.OnFailure),
+	}
+}
+
+func MapOutputTypeKubeToAPI(v testworkflowsv1.OutputType) *testkube.TestWorkflowOutputType {
+	if v == "" {
+		return nil
+	}
+	return common.Ptr(testkube.TestWorkflowOutputType(v))
+}
+
+func MapStepOutputKubeToAPI(v testworkflowsv1.StepOutput) testkube.TestWorkflowStepOutput {
+	return testkube.TestWorkflowStepOutput{
+		Name:        v.Name,
+		Type:        MapOutputTypeKubeToAPI(v.Type),
+		Description: v.Description,
+	}
+}
+
+func MapWorkflowOutputKubeToAPI(v testworkflowsv1.WorkflowOutput) testkube.TestWorkflowSpecOutput {
+	return testkube.TestWorkflowSpecOutput{
+		Name:        v.Name,
+		Type:        MapOutputTypeKubeToAPI(v.Type),
+		Description: v.Description,
+		Value:       v.Value,
+	}
+}
+
+func MapEnvironmentHelmKubeToAPI(v testworkflowsv1.EnvironmentHelm) testkube.TestWorkflowEnvironmentHelm {
+	return testkube.TestWorkflowEnvironmentHelm{
+		Chart:       v.Chart,
+		Repository:  v.Repository,
+		Version:     v.Version,
+		Release:     v.Release,
+		ValuesFiles: v.ValuesFiles,
+		Values:      v.Values,
+	}
+}
+
+func MapEnvironmentSpecKubeToAPI(v testworkflowsv1.EnvironmentSpec) testkube.TestWorkflowEnvironmentSpec {
+	return testkube.TestWorkflowEnvironmentSpec{
+		Content:    common.MapPtr(v.Content, MapContentKubeToAPI),
+		WorkingDir: MapStringToBoxedString(v.WorkingDir),
+		Manifests:  v.Manifests,
+		Helm:       common.MapPtr(v.Helm, MapEnvironmentHelmKubeToAPI),
+		Labels:     v.Labels,
+		Image:      v.Image,
+		Timeout:    v.Timeout,
 	}
 }
 
@@ -1259,6 +1394,7 @@ func MapStepKubeToAPI(v testworkflowsv1.Step) testkube.TestWorkflowStep {
 		Negative:   v.Negative,
 		Optional:   v.Optional,
 		Pure:       MapBoolToBoxedBoolean(v.Pure),
+		Outputs:    common.MapSlice(v.Outputs, MapStepOutputKubeToAPI),
 		Use:        common.MapSlice(v.Use, MapTemplateRefKubeToAPI),
 		Template:   common.MapPtr(v.Template, MapTemplateRefKubeToAPI),
 		Retry:      common.MapPtr(v.Retry, MapRetryPolicyKubeToAPI),
@@ -1272,6 +1408,7 @@ func MapStepKubeToAPI(v testworkflowsv1.Step) testkube.TestWorkflowStep {
 		Container:  common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
 		Execute:    common.MapPtr(v.Execute, MapStepExecuteKubeToAPI),
 		Artifacts:  common.MapPtr(v.Artifacts, MapStepArtifactsKubeToAPI),
+		Approval:   common.MapPtr(v.Approval, MapStepApprovalKubeToAPI),
 		Setup:      common.MapSlice(v.Setup, MapStepKubeToAPI),
 		Steps:      common.MapSlice(v.Steps, MapStepKubeToAPI),
 		Parallel:   common.MapPtr(v.Parallel, MapStepParallelKubeToAPI),
@@ -1287,6 +1424,7 @@ func MapIndependentStepKubeToAPI(v testworkflowsv1.IndependentStep) testkube.Tes
 		Negative:   v.Negative,
 		Optional:   v.Optional,
 		Pure:       MapBoolToBoxedBoolean(v.Pure),
+		Outputs:    common.MapSlice(v.Outputs, MapStepOutputKubeToAPI),
 		Retry:      common.MapPtr(v.Retry, MapRetryPolicyKubeToAPI),
 		Timeout:    v.Timeout,
 		Delay:      v.Delay,
@@ -1298,6 +1436,7 @@ func MapIndependentStepKubeToAPI(v testworkflowsv1.IndependentStep) testkube.Tes
 		Container:  common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
 		Execute:    common.MapPtr(v.Execute, MapStepExecuteKubeToAPI),
 		Artifacts:  common.MapPtr(v.Artifacts, MapStepArtifactsKubeToAPI),
+		Approval:   common.MapPtr(v.Approval, MapStepApprovalKubeToAPI),
 		Setup:      common.MapSlice(v.Setup, MapIndependentStepKubeToAPI),
 		Steps:      common.MapSlice(v.Steps, MapIndependentStepKubeToAPI),
 		Parallel:   common.MapPtr(v.Parallel, MapIndependentStepParallelKubeToAPI),
@@ -1318,6 +1457,7 @@ func MapSpecKubeToAPI(v testworkflowsv1.TestWorkflowSpec) testkube.TestWorkflowS
 		Config:      common.MapMap(v.Config, MapParameterSchemaKubeToAPI),
 		System:      common.MapPtr(v.System, MapSystemKubeToAPI),
 		Content:     common.MapPtr(v.Content, MapContentKubeToAPI),
+		Environment: common.MapPtr(v.Environment, MapEnvironmentSpecKubeToAPI),
 		Services:    common.MapMap(v.Services, MapServiceSpecKubeToAPI),
 		Container:   common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
 		Job:         common.MapPtr(v.Job, MapJobConfigKubeToAPI),
@@ -1325,6 +1465,8 @@ func MapSpecKubeToAPI(v testworkflowsv1.TestWorkflowSpec) testkube.TestWorkflowS
 		Setup:       common.MapSlice(v.Setup, MapStepKubeToAPI),
 		Steps:       common.MapSlice(v.Steps, MapStepKubeToAPI),
 		After:       common.MapSlice(v.After, MapStepKubeToAPI),
+		Gates:       common.MapSlice(v.Gates, MapGateKubeToAPI),
+		Outputs:     common.MapSlice(v.Outputs, MapWorkflowOutputKubeToAPI),
 		Events:      common.MapSlice(v.Events, MapEventKubeToAPI),
 		Execution:   common.MapPtr(v.Execution, MapTestWorkflowTagSchemaKubeToAPI),
 		Timeouts:    common.MapPtr(v.Timeouts, MapTimeoutsKubeToAPI),
@@ -1427,9 +1569,16 @@ func MapTemplateListKubeToAPI(v *testworkflowsv1.TestWorkflowTemplateList) []tes
 
 func MapTestWorkflowTagSchemaKubeToAPI(v testworkflowsv1.TestWorkflowExecutionSchema) testkube.TestWorkflowExecutionSchema {
 	return testkube.TestWorkflowExecutionSchema{
-		Tags:   v.Tags,
-		Target: common.MapPtr(v.Target, commonmapper.MapTargetKubeToAPI),
-		Silent: common.ResolvePtr(v.Silent, false),
+		Tags:                         v.Tags,
+		Target:                       common.MapPtr(v.Target, commonmapper.MapTargetKubeToAPI),
+		Silent:                       common.ResolvePtr(v.Silent, false),
+		RetryOnInfrastructureFailure: common.MapPtr(v.RetryOnInfrastructureFailure, MapRetryOnInfrastructureFailurePolicyKubeToAPI),
+	}
+}
+
+func MapRetryOnInfrastructureFailurePolicyKubeToAPI(v testworkflowsv1.RetryOnInfrastructureFailurePolicy) testkube.TestWorkflowRetryOnInfrastructureFailurePolicy {
+	return testkube.TestWorkflowRetryOnInfrastructureFailurePolicy{
+		MaxAttempts: v.MaxAttempts,
 	}
 }
 
@@ -151,13 +151,25 @@ var (
 	stepBaseMeta = testworkflowsv1.StepMeta{
 		Name:      "some-name",
 		Condition: "some-condition",
+		Outputs: []testworkflowsv1.StepOutput{
+			{Name: "version", Description: "built version"},
+			{Name: "port", Type: testworkflowsv1.OutputTypeInteger},
+		},
 	}
 	stepBaseControl = testworkflowsv1.StepControl{
 		Negative: true,
 		Optional: false,
 		Retry: &testworkflowsv1.RetryPolicy{
-			Count: 444,
-			Until: "abc",
+			Count:    444,
+			Until:    "abc",
+			Delay:    "30s",
+			Backoff:  testworkflowsv1.RetryBackoffExponential,
+			MaxDelay: "5m",
+			Jitter:   20,
+			RetryOn: &testworkflowsv1.RetryOn{
+				ExitCodes: []int32{137, 143},
+				Logs:      "connection refused",
+			},
 		},
 		Timeout: "3h15m",
 	}
@@ -191,6 +203,30 @@ var (
 			Files: []testworkflowsv1.ContentFile{
 				{Path: "abc", Content: "some-content"},
 			},
+			Tarball: []testworkflowsv1.ContentTarball{
+				{Url: "https://example.com/tests.tar.gz", Path: "/data/tests", Sha256: "abcdef"},
+			},
+			Oci: []testworkflowsv1.ContentOci{
+				{
+					Ref:      "registry.example.com/tests/e2e:1.0.0",
+					Digest:   "sha256:abcdef",
+					Path:     "/data/e2e",
+					Mount:    common.Ptr(true),
+					Username: "some-user",
+					PasswordFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "registry"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+			S3: []testworkflowsv1.ContentObjectStorage{
+				{Endpoint: "minio:9000", Bucket: "tests", Key: "suites/", Path: "/data/suites", DisableSsl: true},
+			},
+			Gcs: []testworkflowsv1.ContentObjectStorage{
+				{Bucket: "tests", Key: "bundle.tar.gz", Path: "/data/bundle", Unpack: true, Sha256: "abcdef"},
+			},
 		},
 	}
 	stepBaseDefaults = testworkflowsv1.StepDefaults{
@@ -342,7 +378,8 @@ var (
 			},
 		},
 		Execution: &testworkflowsv1.TestWorkflowExecutionSchema{
-			Tags: map[string]string{"some-key": "some-value"},
+			Tags:                         map[string]string{"some-key": "some-value"},
+			RetryOnInfrastructureFailure: &testworkflowsv1.RetryOnInfrastructureFailurePolicy{MaxAttempts: 3},
 		},
 		Timeouts: &testworkflowsv1.TestWorkflowTimeouts{
 			Queue:          "30s",
@@ -375,6 +412,10 @@ func TestMapTestWorkflowBackAndForth(t *testing.T) {
 			Setup:                []testworkflowsv1.Step{step},
 			Steps:                []testworkflowsv1.Step{step, step},
 			After:                []testworkflowsv1.Step{step, step, step, step},
+			Outputs: []testworkflowsv1.WorkflowOutput{
+				{StepOutput: testworkflowsv1.StepOutput{Name: "url", Description: "deployed URL"}, Value: "steps.deploy.outputs.url"},
+				{StepOutput: testworkflowsv1.StepOutput{Name: "port", Type: testworkflowsv1.OutputTypeInteger}},
+			},
 		},
 	}
 	got := MapTestWorkflowAPIToKube(MapTestWorkflowKubeToAPI(*want.DeepCopy()))
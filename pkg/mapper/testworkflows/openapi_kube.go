@@ -352,9 +352,43 @@ func MapContentGitAPIToKube(v testkube.TestWorkflowContentGit) testworkflowsv1.C
 
 func MapContentTarballAPIToKube(v testkube.TestWorkflowContentTarball) testworkflowsv1.ContentTarball {
 	return testworkflowsv1.ContentTarball{
-		Url:   v.Url,
-		Path:  v.Path,
-		Mount: MapBoxedBooleanToBool(v.Mount),
+		Url:    v.Url,
+		Path:   v.Path,
+		Mount:  MapBoxedBooleanToBool(v.Mount),
+		Sha256: v.Sha256,
+	}
+}
+
+func MapContentOciAPIToKube(v testkube.TestWorkflowContentOci) testworkflowsv1.ContentOci {
+	return testworkflowsv1.ContentOci{
+		Ref:          v.Ref,
+		Digest:       v.Digest,
+		Path:         v.Path,
+		Mount:        MapBoxedBooleanToBool(v.Mount),
+		Username:     v.Username,
+		UsernameFrom: common.MapPtr(v.UsernameFrom, MapEnvVarSourceAPIToKube),
+		Password:     v.Password,
+		PasswordFrom: common.MapPtr(v.PasswordFrom, MapEnvVarSourceAPIToKube),
+		Insecure:     v.Insecure,
+	}
+}
+
+func MapContentObjectStorageAPIToKube(v testkube.TestWorkflowContentObjectStorage) testworkflowsv1.ContentObjectStorage {
+	return testworkflowsv1.ContentObjectStorage{
+		Endpoint:            v.Endpoint,
+		Region:              v.Region,
+		Bucket:              v.Bucket,
+		Key:                 v.Key,
+		Path:                v.Path,
+		Mount:               MapBoxedBooleanToBool(v.Mount),
+		Unpack:              v.Unpack,
+		Sha256:              v.Sha256,
+		AccessKeyId:         v.AccessKeyId,
+		AccessKeyIdFrom:     common.MapPtr(v.AccessKeyIdFrom, MapEnvVarSourceAPIToKube),
+		SecretAccessKey:     v.SecretAccessKey,
+		SecretAccessKeyFrom: common.MapPtr(v.SecretAccessKeyFrom, MapEnvVarSourceAPIToKube),
+		DisableSsl:          v.DisableSsl,
+		SkipVerify:          v.SkipVerify,
 	}
 }
 
@@ -363,6 +397,9 @@ func MapContentAPIToKube(v testkube.TestWorkflowContent) testworkflowsv1.Content
 		Git:     common.MapPtr(v.Git, MapContentGitAPIToKube),
 		Files:   common.MapSlice(v.Files, MapContentFileAPIToKube),
 		Tarball: common.MapSlice(v.Tarball, MapContentTarballAPIToKube),
+		Oci:     common.MapSlice(v.Oci, MapContentOciAPIToKube),
+		S3:      common.MapSlice(v.S3, MapContentObjectStorageAPIToKube),
+		Gcs:     common.MapSlice(v.Gcs, MapContentObjectStorageAPIToKube),
 	}
 }
 
@@ -1089,8 +1126,108 @@ func MapStepArtifactsAPIToKube(v testkube.TestWorkflowStepArtifacts) testworkflo
 
 func MapRetryPolicyAPIToKube(v testkube.TestWorkflowRetryPolicy) testworkflowsv1.RetryPolicy {
 	return testworkflowsv1.RetryPolicy{
-		Count: v.Count,
-		Until: v.Until,
+		Count:    v.Count,
+		Until:    v.Until,
+		Delay:    v.Delay,
+		Backoff:  MapRetryBackoffAPIToKube(v.Backoff),
+		MaxDelay: v.MaxDelay,
+		Jitter:   v.Jitter,
+		RetryOn:  common.MapPtr(v.RetryOn, MapRetryOnAPIToKube),
+	}
+}
+
+func MapRetryBackoffAPIToKube(v *testkube.TestWorkflowRetryBackoff) testworkflowsv1.RetryBackoff {
+	if v == nil {
+		return ""
+	}
+	return testworkflowsv1.RetryBackoff(*v)
+}
+
+func MapRetryOnAPIToKube(v testkube.TestWorkflowRetryOn) testworkflowsv1.RetryOn {
+	return testworkflowsv1.RetryOn{
+		ExitCodes: v.ExitCodes,
+		Logs:      v.Logs,
+	}
+}
+
+func MapApprovalDecisionAPIToKube(v *testkube.TestWorkflowApprovalDecision) testworkflowsv1.ApprovalDecision {
+	if v == nil {
+		return ""
+	}
+	return testworkflowsv1.ApprovalDecision(*v)
+}
+
+func MapStepApprovalAPIToKube(v testkube.TestWorkflowStepApproval) testworkflowsv1.StepApproval {
+	return testworkflowsv1.StepApproval{
+		Message:   v.Message,
+		Approvers: v.Approvers,
+		Timeout:   v.Timeout,
+		Default:   MapApprovalDecisionAPIToKube(v.Default),
+	}
+}
+
+func MapGateActionAPIToKube(v *testkube.TestWorkflowGateAction) testworkflowsv1.GateAction {
+	if v == nil {
+		return ""
+	}
+	return testworkflowsv1.GateAction(*v)
+}
+
+func MapGateAPIToKube(v testkube.TestWorkflowGate) testworkflowsv1.Gate {
+	return testworkflowsv1.Gate{
+		Name:        v.Name,
+		Description: v.Description,
+		Condition:   v.Condition,
+		OnFailure:   MapGateActionAPIToKube(v.OnFailure),
+	}
+}
+
+func MapOutputTypeAPIToKube(v *testkube.TestWorkflowOutputType) testworkflowsv1.OutputType {
+	if v == nil {
+		return ""
+	}
+	return testworkflowsv1.OutputType(*v)
+}
+
+func MapStepOutputAPIToKube(v testkube.TestWorkflowStepOutput) testworkflowsv1.StepOutput {
+	return testworkflowsv1.StepOutput{
+		Name:        v.Name,
+		Type:        MapOutputTypeAPIToKube(v.Type),
+		Description: v.Description,
+	}
+}
+
+func MapWorkflowOutputAPIToKube(v testkube.TestWorkflowSpecOutput) testworkflowsv1.WorkflowOutput {
+	return testworkflowsv1.WorkflowOutput{
+		StepOutput: testworkflowsv1.StepOutput{
+			Name:        v.Name,
+			Type:        MapOutputTypeAPIToKube(v.Type),
+			Description: v.Description,
+		},
+		Value: v.Value,
+	}
+}
+
+func MapEnvironmentHelmAPIToKube(v testkube.TestWorkflowEnvironmentHelm) testworkflowsv1.EnvironmentHelm {
+	return testworkflowsv1.EnvironmentHelm{
+		Chart:       v.Chart,
+		Repository:  v.Repository,
+		Version:     v.Version,
+		Release:     v.Release,
+		ValuesFiles: v.ValuesFiles,
+		Values:      v.Values,
+	}
+}
+
+func MapEnvironmentSpecAPIToKube(v testkube.TestWorkflowEnvironmentSpec) testworkflowsv1.EnvironmentSpec {
+	return testworkflowsv1.EnvironmentSpec{
+		Content:    common.MapPtr(v.Content, MapContentAPIToKube),
+		WorkingDir: MapBoxedStringToString(v.WorkingDir),
+		Manifests:  v.Manifests,
+		Helm:       common.MapPtr(v.Helm, MapEnvironmentHelmAPIToKube),
+		Labels:     v.Labels,
+		Image:      v.Image,
+		Timeout:    v.Timeout,
 	}
 }
 
@@ -1335,6 +1472,7 @@ func MapStepAPIToKube(v testkube.TestWorkflowStep) testworkflowsv1.Step {
 			Name:      v.Name,
 			Condition: v.Condition,
 			Pure:      MapBoxedBooleanToBool(v.Pure),
+			Outputs:   common.MapSlice(v.Outputs, MapStepOutputAPIToKube),
 		},
 		StepControl: testworkflowsv1.StepControl{
 			Paused:   v.Paused,
@@ -1353,6 +1491,7 @@ func MapStepAPIToKube(v testkube.TestWorkflowStep) testworkflowsv1.Step {
 			Run:       common.MapPtr(v.Run, MapStepRunAPIToKube),
 			Execute:   common.MapPtr(v.Execute, MapStepExecuteAPIToKube),
 			Artifacts: common.MapPtr(v.Artifacts, MapStepArtifactsAPIToKube),
+			Approval:  common.MapPtr(v.Approval, MapStepApprovalAPIToKube),
 		},
 		StepDefaults: testworkflowsv1.StepDefaults{
 			WorkingDir: MapBoxedStringToString(v.WorkingDir),
@@ -1373,6 +1512,7 @@ func MapIndependentStepAPIToKube(v testkube.TestWorkflowIndependentStep) testwor
 			Name:      v.Name,
 			Condition: v.Condition,
 			Pure:      MapBoxedBooleanToBool(v.Pure),
+			Outputs:   common.MapSlice(v.Outputs, MapStepOutputAPIToKube),
 		},
 		StepControl: testworkflowsv1.StepControl{
 			Paused:   v.Paused,
@@ -1391,6 +1531,7 @@ func MapIndependentStepAPIToKube(v testkube.TestWorkflowIndependentStep) testwor
 			Run:       common.MapPtr(v.Run, MapStepRunAPIToKube),
 			Execute:   common.MapPtr(v.Execute, MapStepExecuteAPIToKube),
 			Artifacts: common.MapPtr(v.Artifacts, MapStepArtifactsAPIToKube),
+			Approval:  common.MapPtr(v.Approval, MapStepApprovalAPIToKube),
 		},
 		StepDefaults: testworkflowsv1.StepDefaults{
 			WorkingDir: MapBoxedStringToString(v.WorkingDir),
@@ -1423,12 +1564,15 @@ func MapSpecAPIToKube(v testkube.TestWorkflowSpec) testworkflowsv1.TestWorkflowS
 			Execution:   common.MapPtr(v.Execution, MapTestWorkflowTagSchemaAPIToKube),
 			Timeouts:    common.MapPtr(v.Timeouts, MapTimeoutsAPIToKube),
 		},
-		Services: common.MapMap(v.Services, MapServiceSpecAPIToKube),
-		Use:      common.MapSlice(v.Use, MapTemplateRefAPIToKube),
-		Setup:    common.MapSlice(v.Setup, MapStepAPIToKube),
-		Steps:    common.MapSlice(v.Steps, MapStepAPIToKube),
-		After:    common.MapSlice(v.After, MapStepAPIToKube),
-		Pvcs:     common.MapMap(v.Pvcs, MapPvcConfigAPIToKube),
+		Environment: common.MapPtr(v.Environment, MapEnvironmentSpecAPIToKube),
+		Services:    common.MapMap(v.Services, MapServiceSpecAPIToKube),
+		Use:         common.MapSlice(v.Use, MapTemplateRefAPIToKube),
+		Setup:       common.MapSlice(v.Setup, MapStepAPIToKube),
+		Steps:       common.MapSlice(v.Steps, MapStepAPIToKube),
+		After:       common.MapSlice(v.After, MapStepAPIToKube),
+		Gates:       common.MapSlice(v.Gates, MapGateAPIToKube),
+		Outputs:     common.MapSlice(v.Outputs, MapWorkflowOutputAPIToKube),
+		Pvcs:        common.MapMap(v.Pvcs, MapPvcConfigAPIToKube),
 	}
 }
 
@@ -1559,6 +1703,22 @@ func MapTestWorkflowStepResultAPIToKube(v testkube.TestWorkflowStepResult) testw
 		QueuedAt:   metav1.Time{Time: v.QueuedAt},
 		StartedAt:  metav1.Time{Time: v.StartedAt},
 		FinishedAt: metav1.Time{Time: v.FinishedAt},
+		Approval:   common.MapPtr(v.Approval, MapTestWorkflowApprovalAPIToKube),
+	}
+}
+
+func MapTestWorkflowApprovalAPIToKube(v testkube.TestWorkflowApproval) testworkflowsv1.TestWorkflowApproval {
+	return testworkflowsv1.TestWorkflowApproval{
+		Ref:         v.Ref,
+		Message:     v.Message,
+		Approvers:   v.Approvers,
+		RequestedAt: metav1.Time{Time: v.RequestedAt},
+		Deadline:    metav1.Time{Time: v.Deadline},
+		Decision:    MapApprovalDecisionAPIToKube(v.Decision),
+		DecidedBy:   v.DecidedBy,
+		DecidedAt:   metav1.Time{Time: v.DecidedAt},
+		Comment:     v.Comment,
+		TimedOut:    v.TimedOut,
 	}
 }
 
@@ -1578,6 +1738,18 @@ func MapTestWorkflowPauseAPIToKube(v testkube.TestWorkflowPause) testworkflowsv1
 	}
 }
 
+func MapTestWorkflowGateResultAPIToKube(v testkube.TestWorkflowGateResult) testworkflowsv1.TestWorkflowGateResult {
+	return testworkflowsv1.TestWorkflowGateResult{
+		Name:      v.Name,
+		Condition: v.Condition,
+		Status: common.ResolvePtr(common.MapPtr(v.Status, func(status testkube.TestWorkflowGateStatus) testworkflowsv1.TestWorkflowGateStatus {
+			return (testworkflowsv1.TestWorkflowGateStatus)(status)
+		}), ""),
+		OnFailure: MapGateActionAPIToKube(v.OnFailure),
+		Message:   v.Message,
+	}
+}
+
 func MapTestWorkflowResultAPIToKube(v testkube.TestWorkflowResult) testworkflowsv1.TestWorkflowResult {
 	return testworkflowsv1.TestWorkflowResult{
 		Status: common.MapPtr(v.Status, func(status testkube.TestWorkflowStatus) testworkflowsv1.TestWorkflowStatus {
@@ -1597,6 +1769,7 @@ func MapTestWorkflowResultAPIToKube(v testkube.TestWorkflowResult) testworkflows
 		Pauses:          common.MapSlice(v.Pauses, MapTestWorkflowPauseAPIToKube),
 		Initialization:  common.MapPtr(v.Initialization, MapTestWorkflowStepResultAPIToKube),
 		Steps:           common.MapMap(v.Steps, MapTestWorkflowStepResultAPIToKube),
+		Gates:           common.MapSlice(v.Gates, MapTestWorkflowGateResultAPIToKube),
 	}
 }
 
@@ -1671,9 +1844,16 @@ func MapTestWorkflowTagSchemaAPIToKube(v testkube.TestWorkflowExecutionSchema) t
 		silent = common.Ptr(true)
 	}
 	return testworkflowsv1.TestWorkflowExecutionSchema{
-		Tags:   v.Tags,
-		Target: common.MapPtr(v.Target, commonmapper.MapTargetApiToKube),
-		Silent: silent,
+		Tags:                         v.Tags,
+		Target:                       common.MapPtr(v.Target, commonmapper.MapTargetApiToKube),
+		Silent:                       silent,
+		RetryOnInfrastructureFailure: common.MapPtr(v.RetryOnInfrastructureFailure, MapRetryOnInfrastructureFailurePolicyAPIToKube),
+	}
+}
+
+func MapRetryOnInfrastructureFailurePolicyAPIToKube(v testkube.TestWorkflowRetryOnInfrastructureFailurePolicy) testworkflowsv1.RetryOnInfrastructureFailurePolicy {
+	return testworkflowsv1.RetryOnInfrastructureFailurePolicy{
+		MaxAttempts: v.MaxAttempts,
 	}
 }
 
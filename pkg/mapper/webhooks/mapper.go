@@ -107,9 +107,10 @@ func MapEventArrayToCRDEvents(items []executorv1.EventType) (events []testkube.E
 func MapAPIToCRD(webhook testkube.Webhook) executorv1.Webhook {
 	return executorv1.Webhook{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      webhook.Name,
-			Namespace: webhook.Namespace,
-			Labels:    webhook.Labels,
+			Name:        webhook.Name,
+			Namespace:   webhook.Namespace,
+			Labels:      webhook.Labels,
+			Annotations: webhook.Annotations,
 		},
 		Spec: executorv1.WebhookSpec{
 			Uri:                      webhook.Uri,
@@ -132,9 +133,10 @@ func MapAPIToCRD(webhook testkube.Webhook) executorv1.Webhook {
 func MapAPICreateRequestToCRD(webhook testkube.WebhookCreateRequest) executorv1.Webhook {
 	return executorv1.Webhook{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      webhook.Name,
-			Namespace: webhook.Namespace,
-			Labels:    webhook.Labels,
+			Name:        webhook.Name,
+			Namespace:   webhook.Namespace,
+			Labels:      webhook.Labels,
+			Annotations: webhook.Annotations,
 		},
 		Spec: executorv1.WebhookSpec{
 			Uri:                      webhook.Uri,
@@ -18,6 +18,7 @@ func MapCRDToAPI(item executorv1.WebhookTemplate) testkube.WebhookTemplate {
 		Events:                   MapEventArrayToCRDEvents(item.Spec.Events),
 		Selector:                 item.Spec.Selector,
 		Labels:                   item.Labels,
+		Annotations:              item.Annotations,
 		PayloadObjectField:       item.Spec.PayloadObjectField,
 		PayloadTemplate:          item.Spec.PayloadTemplate,
 		PayloadTemplateReference: item.Spec.PayloadTemplateReference,
@@ -86,9 +87,10 @@ func MapEventArrayToCRDEvents(items []executorv1.EventType) (events []testkube.E
 func MapAPIToCRD(request testkube.WebhookTemplateCreateRequest) executorv1.WebhookTemplate {
 	return executorv1.WebhookTemplate{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      request.Name,
-			Namespace: request.Namespace,
-			Labels:    request.Labels,
+			Name:        request.Name,
+			Namespace:   request.Namespace,
+			Labels:      request.Labels,
+			Annotations: request.Annotations,
 		},
 		Spec: executorv1.WebhookTemplateSpec{
 			Uri:                      request.Uri,
@@ -149,7 +149,9 @@ func (c *APIClient) makeRequest(ctx context.Context, apiReq APIRequest) (string,
 	}
 
 	// Set standard headers
-	req.Header.Set("Authorization", "Bearer "+c.config.AccessToken)
+	if c.config.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.AccessToken)
+	}
 
 	// Set default Content-Type only if not already specified in custom headers
 	if _, hasContentType := apiReq.Headers["Content-Type"]; !hasContentType && apiReq.Body != nil {
@@ -420,6 +422,15 @@ func (c *APIClient) ListExecutions(ctx context.Context, params tools.ListExecuti
 	if params.EndDate != "" {
 		queryParams["endDate"] = params.EndDate
 	}
+	if params.TriageCategory != "" {
+		queryParams["triageCategory"] = params.TriageCategory
+	}
+	if params.TriageAssignee != "" {
+		queryParams["triageAssignee"] = params.TriageAssignee
+	}
+	if params.Triaged != "" {
+		queryParams["triaged"] = params.Triaged
+	}
 
 	path := "/agent/test-workflow-executions"
 	if params.WorkflowName != "" {
@@ -708,6 +719,49 @@ func (c *APIClient) UpdateExecutionTags(ctx context.Context, executionId string,
 	return err
 }
 
+func (c *APIClient) UpdateExecutionTriage(ctx context.Context, executionId string, request testkube.TestWorkflowExecutionTriageRequest) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPatch,
+		Path:   "/agent/test-workflow-executions/{executionId}/triage",
+		Scope:  ApiScopeOrgEnv,
+		PathParams: map[string]string{
+			"executionId": executionId,
+		},
+		Body: request,
+	})
+}
+
+func (c *APIClient) GetTriageStats(ctx context.Context, params tools.TriageStatsParams) (string, error) {
+	queryParams := make(map[string]string)
+	if params.Selector != "" {
+		queryParams["selector"] = params.Selector
+	}
+	if params.Status != "" {
+		queryParams["status"] = params.Status
+	}
+	if params.LastNDays > 0 {
+		queryParams["last"] = strconv.Itoa(params.LastNDays)
+	}
+
+	if params.WorkflowName != "" {
+		return c.makeRequest(ctx, APIRequest{
+			Method: http.MethodGet,
+			Path:   "/agent/test-workflows/{workflowName}/triage/stats",
+			Scope:  ApiScopeOrgEnv,
+			PathParams: map[string]string{
+				"workflowName": params.WorkflowName,
+			},
+			QueryParams: queryParams,
+		})
+	}
+	return c.makeRequest(ctx, APIRequest{
+		Method:      http.MethodGet,
+		Path:        "/agent/test-workflow-executions/triage/stats",
+		Scope:       ApiScopeOrgEnv,
+		QueryParams: queryParams,
+	})
+}
+
 func (c *APIClient) AbortWorkflowExecution(ctx context.Context, workflowName, executionId string) (string, error) {
 	return c.makeRequest(ctx, APIRequest{
 		Method: "POST",
@@ -762,6 +816,20 @@ func (c *APIClient) GetWorkflowExecutionMetrics(ctx context.Context, workflowNam
 }
 
 func (c *APIClient) WaitForExecutions(ctx context.Context, executionIds []string) (string, error) {
+	return waitForExecutions(ctx, executionIds, func(ctx context.Context, executionId string) (string, error) {
+		return c.makeRequest(ctx, APIRequest{
+			Method: "GET",
+			Path:   "/agent/test-workflow-executions/{executionId}",
+			Scope:  ApiScopeOrgEnv,
+			PathParams: map[string]string{
+				"executionId": executionId,
+			},
+		})
+	})
+}
+
+// waitForExecutions polls the executions with the provided getter until all of them reach the terminal status
+func waitForExecutions(ctx context.Context, executionIds []string, getExecution func(ctx context.Context, executionId string) (string, error)) (string, error) {
 	// Track completed executions to avoid re-checking them
 	completedExecutions := make(map[string]bool)
 	allResults := make(map[string]map[string]interface{})
@@ -788,14 +856,7 @@ func (c *APIClient) WaitForExecutions(ctx context.Context, executionIds []string
 			// Check status of remaining executions
 			for _, executionId := range remainingExecutions {
 				// Get execution status
-				response, err := c.makeRequest(ctx, APIRequest{
-					Method: "GET",
-					Path:   "/agent/test-workflow-executions/{executionId}",
-					Scope:  ApiScopeOrgEnv,
-					PathParams: map[string]string{
-						"executionId": executionId,
-					},
-				})
+				response, err := getExecution(ctx, executionId)
 				if err != nil {
 					return "", fmt.Errorf("failed to get execution %s: %w", executionId, err)
 				}
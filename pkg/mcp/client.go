@@ -13,6 +13,8 @@ type Client interface {
 	tools.ExecutionWaiter
 	tools.WorkflowExecutionAborter
 	tools.ExecutionTagUpdater
+	tools.ExecutionTriageUpdater
+	tools.TriageStatsGetter
 	tools.WorkflowExecutionMetricsGetter
 	tools.WorkflowResourceHistoryGetter
 
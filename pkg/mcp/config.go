@@ -38,6 +38,10 @@ type MCPServerConfig struct {
 	// EnvId for Testkube environment
 	EnvId string
 
+	// OpenSource connects to the Open Source Testkube API (/v1) at ControlPlaneUrl,
+	// instead of the organization and environment scoped control plane API
+	OpenSource bool
+
 	// Debug enables debug mode which includes detailed operation information in responses
 	Debug bool
 
@@ -116,6 +120,7 @@ func LoadConfigFromEnv() MCPServerConfig {
 		AccessToken:        os.Getenv("TK_ACCESS_TOKEN"),
 		OrgId:              os.Getenv("TK_ORG_ID"),
 		EnvId:              os.Getenv("TK_ENV_ID"),
+		OpenSource:         os.Getenv("TK_OPEN_SOURCE") == "true",
 		Debug:              os.Getenv("TK_DEBUG") == "true",
 		TelemetryEnabled:   os.Getenv("TK_TELEMETRY_ENABLED") != "false", // Default to true unless explicitly disabled
 		SkipEndpointChecks: os.Getenv("TK_SKIP_ENDPOINT_CHECKS") == "true",
@@ -126,15 +131,22 @@ func LoadConfigFromEnv() MCPServerConfig {
 
 // Validate checks if all required configuration is present
 func (c *MCPServerConfig) Validate() error {
-	if c.AccessToken == "" {
-		return fmt.Errorf("TK_ACCESS_TOKEN is required")
-	}
+	// The Open Source API is not scoped, and it may be exposed without authentication
+	if c.OpenSource {
+		if c.ControlPlaneUrl == "" {
+			return fmt.Errorf("TK_CONTROL_PLANE_URL is required")
+		}
+	} else {
+		if c.AccessToken == "" {
+			return fmt.Errorf("TK_ACCESS_TOKEN is required")
+		}
 
-	if c.OrgId == "" {
-		return fmt.Errorf("TK_ORG_ID is required")
-	}
-	if c.EnvId == "" {
-		return fmt.Errorf("TK_ENV_ID is required")
+		if c.OrgId == "" {
+			return fmt.Errorf("TK_ORG_ID is required")
+		}
+		if c.EnvId == "" {
+			return fmt.Errorf("TK_ENV_ID is required")
+		}
 	}
 
 	// Validate SHTTP configuration if using SHTTP transport
@@ -0,0 +1,398 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/junit"
+)
+
+const (
+	// DefaultDiagnosisMaxTokens is the default budget for the diagnosis bundle
+	DefaultDiagnosisMaxTokens = 4000
+
+	// charsPerToken is a rough estimation used for fitting the bundle in the token budget
+	charsPerToken = 4
+
+	diagnosisMaxFailedTests = 20
+	diagnosisMaxMessageLen  = 300
+	diagnosisMaxSpecChanges = 30
+)
+
+// FailingStep is the deepest failed step in the execution result tree
+type FailingStep struct {
+	Ref          string `json:"ref"`
+	Path         string `json:"path"`
+	Status       string `json:"status,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	ExitCode     int    `json:"exitCode,omitempty"`
+}
+
+// FindFailingStep walks the execution signature and returns the deepest failed step.
+// When the steps have passed, but the initialization has failed, it is reported instead.
+func FindFailingStep(exec *testkube.TestWorkflowExecution) *FailingStep {
+	if exec == nil || exec.Result == nil {
+		return nil
+	}
+	if step := findFailingStep(exec.Signature, exec.Result.Steps, nil); step != nil {
+		return step
+	}
+	if init := exec.Result.Initialization; init != nil && stepFailed(init) {
+		return &FailingStep{
+			Path:         "Initializing",
+			Status:       string(*init.Status),
+			ErrorMessage: init.ErrorMessage,
+			ExitCode:     int(init.ExitCode),
+		}
+	}
+	return nil
+}
+
+func stepFailed(result *testkube.TestWorkflowStepResult) bool {
+	return result.Status != nil && (result.Status.Failed() || result.Status.TimedOut() || result.Status.AnyAborted())
+}
+
+func findFailingStep(signature []testkube.TestWorkflowSignature, steps map[string]testkube.TestWorkflowStepResult, parents []string) *FailingStep {
+	for _, sig := range signature {
+		result, ok := steps[sig.Ref]
+		if !ok || sig.Optional || !stepFailed(&result) {
+			continue
+		}
+		name := sig.Name
+		if name == "" {
+			name = sig.Category
+		}
+		if name == "" {
+			name = sig.Ref
+		}
+		path := append(slices.Clone(parents), name)
+		if child := findFailingStep(sig.Children, steps, path); child != nil {
+			return child
+		}
+		return &FailingStep{
+			Ref:          sig.Ref,
+			Path:         strings.Join(path, " > "),
+			Status:       string(*result.Status),
+			ErrorMessage: result.ErrorMessage,
+			ExitCode:     int(result.ExitCode),
+		}
+	}
+	return nil
+}
+
+// FailedTest is a failed or errored test case from the JUnit report
+type FailedTest struct {
+	Report  string `json:"report"`
+	Suite   string `json:"suite,omitempty"`
+	Name    string `json:"name"`
+	Message string `json:"message,omitempty"`
+}
+
+// ParseFailedTests extracts the failed and errored test cases from the JUnit XML report
+func ParseFailedTests(report, content string) ([]FailedTest, error) {
+	root, err := junit.Parse([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	var result []FailedTest
+	root.Walk(func(s *junit.Suite, c *junit.Case) {
+		problem := c.Failure
+		if problem == nil {
+			problem = c.Error
+		}
+		if problem == nil {
+			return
+		}
+		message := strings.TrimSpace(problem.Message)
+		if message == "" {
+			message = strings.TrimSpace(problem.Text)
+		}
+		suite := s.Name
+		if suite == "" {
+			suite = c.ClassName
+		}
+		result = append(result, FailedTest{
+			Report:  report,
+			Suite:   suite,
+			Name:    c.Name,
+			Message: truncate(firstLine(message), diagnosisMaxMessageLen),
+		})
+	})
+	return result, nil
+}
+
+// SpecDiff lists the changes in the resolved workflow specification against the last passing execution
+type SpecDiff struct {
+	ExecutionID   string   `json:"executionId"`
+	ExecutionName string   `json:"executionName"`
+	Changes       []string `json:"changes"`
+	Truncated     int      `json:"truncated,omitempty"`
+}
+
+// FlipHistory describes how the workflow and the failing step behaved in the recent executions
+type FlipHistory struct {
+	// Statuses of the recent executions, from the newest one
+	Statuses []string `json:"statuses"`
+	// Flips is the number of the status changes between passed and failed
+	Flips int `json:"flips"`
+	// SameStepFailures is the number of the recent executions with the same step failing
+	SameStepFailures int `json:"sameStepFailures"`
+	// Checked is the number of the recent failed executions inspected for the failing step
+	Checked int `json:"checked"`
+}
+
+// DiagnosisInput holds the raw API responses used for building the diagnosis
+type DiagnosisInput struct {
+	// Execution is the raw TestWorkflowExecution to diagnose
+	Execution string
+	// Logs is the tail of the failing step log
+	Logs string
+	// Reports are the JUnit reports content by the file name
+	Reports map[string]string
+	// LastPassed is the raw TestWorkflowExecution of the last passing execution
+	LastPassed string
+	// Recent is the raw TestWorkflowExecutionsResult with the recent executions of the workflow
+	Recent string
+	// RecentFailed are the raw TestWorkflowExecution of the recent failed executions
+	RecentFailed []string
+	// MaxTokens is the budget for the bundle
+	MaxTokens int
+}
+
+type diagnosis struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	WorkflowName string       `json:"workflowName,omitempty"`
+	Status       string       `json:"status,omitempty"`
+	FailingStep  *FailingStep `json:"failingStep,omitempty"`
+	LogTail      []string     `json:"logTail,omitempty"`
+	FailedTests  []FailedTest `json:"failedTests,omitempty"`
+	SpecDiff     *SpecDiff    `json:"specDiff,omitempty"`
+	History      *FlipHistory `json:"history,omitempty"`
+	Notes        []string     `json:"notes,omitempty"`
+	noted        map[string]bool
+}
+
+func (d *diagnosis) note(key, message string) {
+	if d.noted[key] {
+		return
+	}
+	d.noted[key] = true
+	d.Notes = append(d.Notes, message)
+}
+
+// FormatDiagnosis assembles the compact root-cause bundle for the failed execution,
+// trimming the log tail, test cases and spec changes to fit in the token budget.
+func FormatDiagnosis(input DiagnosisInput) (string, error) {
+	exec, isEmpty, err := ParseJSON[testkube.TestWorkflowExecution](input.Execution)
+	if err != nil {
+		return "", err
+	}
+	if isEmpty {
+		return "{}", nil
+	}
+
+	d := diagnosis{ID: exec.Id, Name: exec.Name, noted: make(map[string]bool)}
+	if exec.Workflow != nil {
+		d.WorkflowName = exec.Workflow.Name
+	}
+	if exec.Result != nil && exec.Result.Status != nil {
+		d.Status = string(*exec.Result.Status)
+	}
+	d.FailingStep = FindFailingStep(&exec)
+
+	if logs := strings.TrimRight(input.Logs, "\n"); logs != "" {
+		d.LogTail = strings.Split(logs, "\n")
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(input.Reports)) {
+		failed, err := ParseFailedTests(name, input.Reports[name])
+		if err != nil {
+			d.note("report:"+name, fmt.Sprintf("report %s could not be parsed: %v", name, err))
+			continue
+		}
+		d.FailedTests = append(d.FailedTests, failed...)
+	}
+	if len(d.FailedTests) > diagnosisMaxFailedTests {
+		d.note("tests", fmt.Sprintf("%d more failed tests omitted", len(d.FailedTests)-diagnosisMaxFailedTests))
+		d.FailedTests = d.FailedTests[:diagnosisMaxFailedTests]
+	}
+
+	if !IsEmptyInput(input.LastPassed) {
+		passed, _, err := ParseJSON[testkube.TestWorkflowExecution](input.LastPassed)
+		if err != nil {
+			return "", err
+		}
+		d.SpecDiff = &SpecDiff{
+			ExecutionID:   passed.Id,
+			ExecutionName: passed.Name,
+			Changes:       diffSpecs(resolvedSpec(&passed), resolvedSpec(&exec)),
+		}
+		if len(d.SpecDiff.Changes) > diagnosisMaxSpecChanges {
+			d.SpecDiff.Truncated = len(d.SpecDiff.Changes) - diagnosisMaxSpecChanges
+			d.SpecDiff.Changes = d.SpecDiff.Changes[:diagnosisMaxSpecChanges]
+		}
+	} else {
+		d.note("passed", "no passing execution found to compare the specification with")
+	}
+
+	if !IsEmptyInput(input.Recent) {
+		d.History, err = buildFlipHistory(&exec, d.FailingStep, input.Recent, input.RecentFailed)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fitDiagnosis(&d, input.MaxTokens)
+}
+
+// fitDiagnosis shrinks the bundle until it fits in the token budget
+func fitDiagnosis(d *diagnosis, maxTokens int) (string, error) {
+	if maxTokens <= 0 {
+		maxTokens = DefaultDiagnosisMaxTokens
+	}
+	maxChars := maxTokens * charsPerToken
+	for {
+		out, err := FormatJSON(d)
+		if err != nil || len(out) <= maxChars {
+			return out, err
+		}
+		switch {
+		case len(d.LogTail) > 10:
+			d.note("logs", "log tail trimmed to fit the token budget")
+			d.LogTail = d.LogTail[len(d.LogTail)/2:]
+		case len(d.FailedTests) > 5:
+			d.note("tests", "failed tests trimmed to fit the token budget")
+			d.FailedTests = d.FailedTests[:len(d.FailedTests)/2]
+		case d.SpecDiff != nil && len(d.SpecDiff.Changes) > 5:
+			d.SpecDiff.Truncated += len(d.SpecDiff.Changes) - len(d.SpecDiff.Changes)/2
+			d.SpecDiff.Changes = d.SpecDiff.Changes[:len(d.SpecDiff.Changes)/2]
+		case len(d.LogTail) > 0:
+			d.note("logs", "log tail trimmed to fit the token budget")
+			d.LogTail = d.LogTail[1:]
+		case len(d.FailedTests) > 0:
+			d.note("tests", "failed tests trimmed to fit the token budget")
+			d.FailedTests = d.FailedTests[:len(d.FailedTests)-1]
+		default:
+			return out, nil
+		}
+	}
+}
+
+func buildFlipHistory(exec *testkube.TestWorkflowExecution, step *FailingStep, recent string, recentFailed []string) (*FlipHistory, error) {
+	result, _, err := ParseJSON[testkube.TestWorkflowExecutionsResult](recent)
+	if err != nil {
+		return nil, err
+	}
+	history := &FlipHistory{Statuses: make([]string, 0, len(result.Results))}
+	for _, e := range result.Results {
+		if e.Result == nil || e.Result.Status == nil {
+			continue
+		}
+		status := string(*e.Result.Status)
+		if n := len(history.Statuses); n > 0 && isFlip(history.Statuses[n-1], status) {
+			history.Flips++
+		}
+		history.Statuses = append(history.Statuses, status)
+	}
+
+	if step == nil {
+		return history, nil
+	}
+	for _, raw := range recentFailed {
+		other, _, err := ParseJSON[testkube.TestWorkflowExecution](raw)
+		if err != nil {
+			return nil, err
+		}
+		if other.Id == exec.Id {
+			continue
+		}
+		history.Checked++
+		if otherStep := FindFailingStep(&other); otherStep != nil && otherStep.Path == step.Path {
+			history.SameStepFailures++
+		}
+	}
+	return history, nil
+}
+
+func isFlip(a, b string) bool {
+	passed := string(testkube.PASSED_TestWorkflowStatus)
+	failed := string(testkube.FAILED_TestWorkflowStatus)
+	return (a == passed && b == failed) || (a == failed && b == passed)
+}
+
+func resolvedSpec(exec *testkube.TestWorkflowExecution) *testkube.TestWorkflowSpec {
+	if exec.ResolvedWorkflow != nil {
+		return exec.ResolvedWorkflow.Spec
+	}
+	if exec.Workflow != nil {
+		return exec.Workflow.Spec
+	}
+	return nil
+}
+
+// diffSpecs compares the flattened specifications, reporting the added (+), removed (-) and changed (~) properties
+func diffSpecs(before, after *testkube.TestWorkflowSpec) []string {
+	a, b := flattenJSON(before), flattenJSON(after)
+	changes := make([]string, 0)
+	for _, key := range slices.Sorted(maps.Keys(a)) {
+		if v, ok := b[key]; !ok {
+			changes = append(changes, fmt.Sprintf("- %s: %s", key, a[key]))
+		} else if v != a[key] {
+			changes = append(changes, fmt.Sprintf("~ %s: %s -> %s", key, a[key], v))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(b)) {
+		if _, ok := a[key]; !ok {
+			changes = append(changes, fmt.Sprintf("+ %s: %s", key, b[key]))
+		}
+	}
+	return changes
+}
+
+func flattenJSON(value any) map[string]string {
+	result := make(map[string]string)
+	b, err := json.Marshal(value)
+	if err != nil {
+		return result
+	}
+	var generic any
+	if err = json.Unmarshal(b, &generic); err != nil {
+		return result
+	}
+	flattenValue("spec", generic, result)
+	return result
+}
+
+func flattenValue(path string, value any, result map[string]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			flattenValue(path+"."+key, item, result)
+		}
+	case []any:
+		for i, item := range v {
+			flattenValue(fmt.Sprintf("%s.%d", path, i), item, result)
+		}
+	case nil:
+	default:
+		b, _ := json.Marshal(v)
+		result[path] = truncate(string(b), diagnosisMaxMessageLen)
+	}
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return strings.TrimSpace(line)
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "…"
+}
@@ -0,0 +1,139 @@
+package formatters
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const diagnosisExecution = `{
+	"id": "exec-3",
+	"name": "api-tests-3",
+	"workflow": {"name": "api-tests"},
+	"resolvedWorkflow": {"name": "api-tests", "spec": {"container": {"image": "node:22"}, "steps": [{"name": "Run tests", "shell": "npm test"}]}},
+	"signature": [
+		{"ref": "r1", "name": "Checkout"},
+		{"ref": "r2", "name": "Run tests", "children": [
+			{"ref": "r3", "name": "Install"},
+			{"ref": "r4", "name": "Test"}
+		]}
+	],
+	"result": {
+		"status": "failed",
+		"steps": {
+			"r1": {"status": "passed"},
+			"r2": {"status": "failed"},
+			"r3": {"status": "passed"},
+			"r4": {"status": "failed", "exitCode": 1, "errorMessage": "process exited with 1"}
+		}
+	}
+}`
+
+const diagnosisLastPassed = `{
+	"id": "exec-1",
+	"name": "api-tests-1",
+	"resolvedWorkflow": {"name": "api-tests", "spec": {"container": {"image": "node:20"}, "steps": [{"name": "Run tests", "shell": "npm test"}], "pod": {"labels": {"a": "b"}}}},
+	"result": {"status": "passed"}
+}`
+
+const diagnosisJUnit = `<?xml version="1.0"?>
+<testsuites>
+	<testsuite name="auth">
+		<testcase name="login works"/>
+		<testcase name="logout works"><failure message="expected 200, got 500">stack trace</failure></testcase>
+	</testsuite>
+	<testsuite name="users">
+		<testcase name="create"><error>timeout
+at line 2</error></testcase>
+	</testsuite>
+</testsuites>`
+
+func TestFormatDiagnosis_DeepestFailingStep(t *testing.T) {
+	out, err := FormatDiagnosis(DiagnosisInput{Execution: diagnosisExecution})
+	require.NoError(t, err)
+
+	var result diagnosis
+	require.NoError(t, json.Unmarshal([]byte(out), &result))
+	require.NotNil(t, result.FailingStep)
+	assert.Equal(t, "r4", result.FailingStep.Ref)
+	assert.Equal(t, "Run tests > Test", result.FailingStep.Path)
+	assert.Equal(t, 1, result.FailingStep.ExitCode)
+	assert.Equal(t, "process exited with 1", result.FailingStep.ErrorMessage)
+}
+
+func TestParseFailedTests(t *testing.T) {
+	failed, err := ParseFailedTests("report.xml", diagnosisJUnit)
+	require.NoError(t, err)
+	assert.Equal(t, []FailedTest{
+		{Report: "report.xml", Suite: "auth", Name: "logout works", Message: "expected 200, got 500"},
+		{Report: "report.xml", Suite: "users", Name: "create", Message: "timeout"},
+	}, failed)
+
+	_, err = ParseFailedTests("report.xml", "not xml")
+	assert.Error(t, err)
+}
+
+func TestFormatDiagnosis_Bundle(t *testing.T) {
+	recent := `{"results": [
+		{"id": "exec-3", "result": {"status": "failed"}},
+		{"id": "exec-2", "result": {"status": "failed"}},
+		{"id": "exec-1", "result": {"status": "passed"}},
+		{"id": "exec-0", "result": {"status": "failed"}}
+	]}`
+	previousFailure := strings.Replace(strings.Replace(diagnosisExecution, "exec-3", "exec-2", 1), "api-tests-3", "api-tests-2", 1)
+
+	out, err := FormatDiagnosis(DiagnosisInput{
+		Execution:    diagnosisExecution,
+		Logs:         "line 1\nline 2\nError: boom\n",
+		Reports:      map[string]string{"junit.xml": diagnosisJUnit},
+		LastPassed:   diagnosisLastPassed,
+		Recent:       recent,
+		RecentFailed: []string{diagnosisExecution, previousFailure},
+	})
+	require.NoError(t, err)
+
+	var result diagnosis
+	require.NoError(t, json.Unmarshal([]byte(out), &result))
+	assert.Equal(t, "api-tests", result.WorkflowName)
+	assert.Equal(t, "failed", result.Status)
+	assert.Equal(t, []string{"line 1", "line 2", "Error: boom"}, result.LogTail)
+	assert.Len(t, result.FailedTests, 2)
+
+	require.NotNil(t, result.SpecDiff)
+	assert.Equal(t, "exec-1", result.SpecDiff.ExecutionID)
+	assert.Equal(t, []string{
+		`~ spec.container.image: "node:20" -> "node:22"`,
+		`- spec.pod.labels.a: "b"`,
+	}, result.SpecDiff.Changes)
+
+	require.NotNil(t, result.History)
+	assert.Equal(t, []string{"failed", "failed", "passed", "failed"}, result.History.Statuses)
+	assert.Equal(t, 2, result.History.Flips)
+	assert.Equal(t, 1, result.History.Checked)
+	assert.Equal(t, 1, result.History.SameStepFailures)
+}
+
+func TestFormatDiagnosis_FitsTokenBudget(t *testing.T) {
+	lines := make([]string, 500)
+	for i := range lines {
+		lines[i] = strings.Repeat("x", 80)
+	}
+	lines[len(lines)-1] = "last line"
+
+	out, err := FormatDiagnosis(DiagnosisInput{
+		Execution: diagnosisExecution,
+		Logs:      strings.Join(lines, "\n"),
+		MaxTokens: 500,
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(out), 500*charsPerToken)
+
+	var result diagnosis
+	require.NoError(t, json.Unmarshal([]byte(out), &result))
+	assert.Equal(t, "last line", result.LogTail[len(result.LogTail)-1])
+	assert.Contains(t, result.Notes, "log tail trimmed to fit the token budget")
+	assert.Contains(t, result.Notes, "no passing execution found to compare the specification with")
+}
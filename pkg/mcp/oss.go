@@ -0,0 +1,445 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/mcp/tools"
+)
+
+// ErrNotSupported is returned for the operations that are not available in the Open Source API
+var ErrNotSupported = errors.New("operation is not supported by the Open Source Testkube API")
+
+// OSSClient implements the MCP tools against the Open Source Testkube API (/v1),
+// for the teams running only the agent without the control plane.
+type OSSClient struct {
+	api *APIClient
+}
+
+func NewOSSClient(cfg *MCPServerConfig, client *http.Client) *OSSClient {
+	return &OSSClient{api: NewAPIClient(cfg, client)}
+}
+
+func (c *OSSClient) makeRequest(ctx context.Context, apiReq APIRequest) (string, error) {
+	apiReq.Path = "/v1" + apiReq.Path
+	apiReq.Scope = ApiScopeNone
+	return c.api.makeRequest(ctx, apiReq)
+}
+
+func (c *OSSClient) ListArtifacts(ctx context.Context, executionID string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflow-executions/{executionId}/artifacts",
+		PathParams: map[string]string{
+			"executionId": executionID,
+		},
+	})
+}
+
+func (c *OSSClient) ReadArtifact(ctx context.Context, executionID, filename string, _ tools.ArtifactReadParams) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflow-executions/{executionId}/artifacts/{filename}",
+		PathParams: map[string]string{
+			"executionId": executionID,
+			"filename":    url.QueryEscape(filename),
+		},
+	})
+}
+
+// GetExecutionLogs reads the whole execution log, as the Open Source API has no server-side filtering
+func (c *OSSClient) GetExecutionLogs(ctx context.Context, executionID string, params tools.ExecutionLogParams) (string, error) {
+	if params.Step != "" || params.WorkerRef != "" {
+		return "", fmt.Errorf("filtering logs by step or worker: %w", ErrNotSupported)
+	}
+	logs, err := c.makeRequest(ctx, APIRequest{
+		Method:     http.MethodGet,
+		Path:       "/test-workflow-executions/{executionId}/logs",
+		PathParams: map[string]string{"executionId": executionID},
+	})
+	if err != nil {
+		return "", err
+	}
+	return filterLogLines(logs, params), nil
+}
+
+// filterLogLines applies the line range, substring and tail filters to the log
+func filterLogLines(logs string, params tools.ExecutionLogParams) string {
+	lines := strings.Split(strings.TrimSuffix(logs, "\n"), "\n")
+	if params.StartLine > 0 || params.EndLine > 0 {
+		start, end := max(params.StartLine, 1), len(lines)
+		if params.EndLine > 0 && params.EndLine < end {
+			end = params.EndLine
+		}
+		if start > end {
+			return ""
+		}
+		lines = lines[start-1 : end]
+	}
+	if params.Grep != "" {
+		filtered := make([]string, 0)
+		for _, line := range lines {
+			if strings.Contains(line, params.Grep) {
+				filtered = append(filtered, line)
+			}
+		}
+		lines = filtered
+	}
+	if params.Tail > 0 && params.Tail < len(lines) {
+		lines = lines[len(lines)-params.Tail:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (c *OSSClient) GetExecutionInfo(ctx context.Context, workflowName, executionID string) (string, error) {
+	if workflowName == "" {
+		return c.makeRequest(ctx, APIRequest{
+			Method: http.MethodGet,
+			Path:   "/test-workflow-executions/{executionId}",
+			PathParams: map[string]string{
+				"executionId": executionID,
+			},
+		})
+	}
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflows/{workflowName}/executions/{executionId}",
+		PathParams: map[string]string{
+			"workflowName": workflowName,
+			"executionId":  executionID,
+		},
+	})
+}
+
+func (c *OSSClient) ListExecutions(ctx context.Context, params tools.ListExecutionsParams) (string, error) {
+	queryParams := map[string]string{
+		"selector":    params.Selector,
+		"tagSelector": params.TagSelector,
+		"textSearch":  params.TextSearch,
+		"status":      params.Status,
+		"startDate":   params.StartDate,
+		"endDate":     params.EndDate,
+		"pageSize":    "10",
+		"page":        "0",
+
+		"triageCategory": params.TriageCategory,
+		"triageAssignee": params.TriageAssignee,
+		"triaged":        params.Triaged,
+	}
+	if params.PageSize > 0 {
+		queryParams["pageSize"] = strconv.Itoa(params.PageSize)
+	}
+	if params.Page > 0 {
+		queryParams["page"] = strconv.Itoa(params.Page)
+	}
+
+	if params.WorkflowName != "" {
+		return c.makeRequest(ctx, APIRequest{
+			Method: http.MethodGet,
+			Path:   "/test-workflows/{workflowName}/executions",
+			PathParams: map[string]string{
+				"workflowName": params.WorkflowName,
+			},
+			QueryParams: queryParams,
+		})
+	}
+	return c.makeRequest(ctx, APIRequest{
+		Method:      http.MethodGet,
+		Path:        "/test-workflow-executions",
+		QueryParams: queryParams,
+	})
+}
+
+func (c *OSSClient) LookupExecutionID(ctx context.Context, executionName string) (string, error) {
+	workflowName, _, err := extractWorkflowNameFromExecutionName(executionName)
+	if err != nil {
+		return "", fmt.Errorf("invalid execution name format: %w", err)
+	}
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflows/{workflowName}/executions",
+		PathParams: map[string]string{
+			"workflowName": workflowName,
+		},
+		QueryParams: map[string]string{
+			"textSearch": executionName,
+		},
+	})
+}
+
+func (c *OSSClient) WaitForExecutions(ctx context.Context, executionIds []string) (string, error) {
+	return waitForExecutions(ctx, executionIds, func(ctx context.Context, executionId string) (string, error) {
+		return c.GetExecutionInfo(ctx, "", executionId)
+	})
+}
+
+func (c *OSSClient) AbortWorkflowExecution(ctx context.Context, workflowName, executionId string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPost,
+		Path:   "/test-workflows/{workflowName}/executions/{executionId}/abort",
+		PathParams: map[string]string{
+			"workflowName": workflowName,
+			"executionId":  executionId,
+		},
+	})
+}
+
+func (c *OSSClient) UpdateExecutionTags(ctx context.Context, executionId string, tags map[string]string) error {
+	_, err := c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPatch,
+		Path:   "/test-workflow-executions/{executionId}/tags",
+		PathParams: map[string]string{
+			"executionId": executionId,
+		},
+		Body: tags,
+	})
+	return err
+}
+
+func (c *OSSClient) UpdateExecutionTriage(ctx context.Context, executionId string, request testkube.TestWorkflowExecutionTriageRequest) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPatch,
+		Path:   "/test-workflow-executions/{executionId}/triage",
+		PathParams: map[string]string{
+			"executionId": executionId,
+		},
+		Body: request,
+	})
+}
+
+func (c *OSSClient) GetTriageStats(ctx context.Context, params tools.TriageStatsParams) (string, error) {
+	queryParams := map[string]string{
+		"selector": params.Selector,
+		"status":   params.Status,
+	}
+	if params.LastNDays > 0 {
+		queryParams["last"] = strconv.Itoa(params.LastNDays)
+	}
+
+	if params.WorkflowName != "" {
+		return c.makeRequest(ctx, APIRequest{
+			Method: http.MethodGet,
+			Path:   "/test-workflows/{workflowName}/triage/stats",
+			PathParams: map[string]string{
+				"workflowName": params.WorkflowName,
+			},
+			QueryParams: queryParams,
+		})
+	}
+	return c.makeRequest(ctx, APIRequest{
+		Method:      http.MethodGet,
+		Path:        "/test-workflow-executions/triage/stats",
+		QueryParams: queryParams,
+	})
+}
+
+func (c *OSSClient) GetWorkflowExecutionMetrics(_ context.Context, _, _ string) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (c *OSSClient) GetWorkflowResourceHistory(ctx context.Context, params tools.WorkflowResourceHistoryParams) (string, error) {
+	pageSize := 50
+	if params.LastN > 0 {
+		pageSize = params.LastN
+	}
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflows/{workflowName}/executions",
+		PathParams: map[string]string{
+			"workflowName": params.WorkflowName,
+		},
+		QueryParams: map[string]string{
+			"pageSize": strconv.Itoa(pageSize),
+			"page":     "0",
+		},
+	})
+}
+
+func (c *OSSClient) ListLabels(ctx context.Context) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/labels",
+	})
+}
+
+func (c *OSSClient) ListResourceGroups(_ context.Context) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (c *OSSClient) ListAgents(_ context.Context, _ tools.ListAgentsParams) (string, error) {
+	return "", ErrNotSupported
+}
+
+// ListWorkflows retrieves workflows with the latest executions. The Open Source API doesn't paginate them.
+func (c *OSSClient) ListWorkflows(ctx context.Context, params tools.ListWorkflowsParams) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflow-with-executions",
+		QueryParams: map[string]string{
+			"selector":   params.Selector,
+			"textSearch": params.TextSearch,
+		},
+	})
+}
+
+func (c *OSSClient) GetWorkflow(ctx context.Context, workflowName string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflow-with-executions/{workflowName}",
+		PathParams: map[string]string{
+			"workflowName": workflowName,
+		},
+	})
+}
+
+func (c *OSSClient) GetWorkflowDefinition(ctx context.Context, workflowName string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflows/{workflowName}",
+		PathParams: map[string]string{
+			"workflowName": workflowName,
+		},
+		Headers: map[string]string{
+			"Accept": "text/yaml",
+		},
+	})
+}
+
+func (c *OSSClient) CreateWorkflow(ctx context.Context, workflowDefinition string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPost,
+		Path:   "/test-workflows",
+		Body:   workflowDefinition,
+		Headers: map[string]string{
+			"Content-Type": "text/yaml",
+		},
+	})
+}
+
+func (c *OSSClient) UpdateWorkflow(ctx context.Context, workflowName, workflowDefinition string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPut,
+		Path:   "/test-workflows/{workflowName}",
+		PathParams: map[string]string{
+			"workflowName": workflowName,
+		},
+		Body: workflowDefinition,
+		Headers: map[string]string{
+			"Content-Type": "text/yaml",
+		},
+	})
+}
+
+func (c *OSSClient) RunWorkflow(ctx context.Context, params tools.RunWorkflowParams) (string, error) {
+	body := map[string]any{
+		"config": params.Config,
+		"runningContext": map[string]any{
+			"actor": map[string]any{
+				"type": "user",
+			},
+			"interface": map[string]any{
+				"type": "api",
+			},
+		},
+	}
+	if params.Target != nil {
+		body["target"] = convertTargetToExecutionTarget(params.Target)
+	}
+
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPost,
+		Path:   "/test-workflows/{workflowName}/executions",
+		PathParams: map[string]string{
+			"workflowName": params.WorkflowName,
+		},
+		Body: body,
+	})
+}
+
+func (c *OSSClient) GetWorkflowMetrics(ctx context.Context, workflowName string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflows/{workflowName}/metrics",
+		PathParams: map[string]string{
+			"workflowName": workflowName,
+		},
+	})
+}
+
+func (c *OSSClient) ListWorkflowTemplates(ctx context.Context, selector string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflow-templates",
+		QueryParams: map[string]string{
+			"selector": selector,
+		},
+	})
+}
+
+func (c *OSSClient) GetWorkflowTemplateDefinition(ctx context.Context, templateName string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodGet,
+		Path:   "/test-workflow-templates/{templateName}",
+		PathParams: map[string]string{
+			"templateName": templateName,
+		},
+		Headers: map[string]string{
+			"Accept": "text/yaml",
+		},
+	})
+}
+
+func (c *OSSClient) CreateWorkflowTemplate(ctx context.Context, templateDefinition string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPost,
+		Path:   "/test-workflow-templates",
+		Body:   templateDefinition,
+		Headers: map[string]string{
+			"Content-Type": "text/yaml",
+		},
+	})
+}
+
+func (c *OSSClient) UpdateWorkflowTemplate(ctx context.Context, templateName, templateDefinition string) (string, error) {
+	return c.makeRequest(ctx, APIRequest{
+		Method: http.MethodPut,
+		Path:   "/test-workflow-templates/{templateName}",
+		PathParams: map[string]string{
+			"templateName": templateName,
+		},
+		Body: templateDefinition,
+		Headers: map[string]string{
+			"Content-Type": "text/yaml",
+		},
+	})
+}
+
+func (c *OSSClient) GetWorkflowDefinitions(_ context.Context, _ tools.ListWorkflowsParams) (map[string]string, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *OSSClient) GetExecutions(_ context.Context, _ tools.ListExecutionsParams) (map[string]string, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *OSSClient) ListInsightSeries(_ context.Context, _ tools.InsightSeriesCatalogParams) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (c *OSSClient) ListInsightMetricKeys(_ context.Context, _ tools.InsightMetricKeysParams) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (c *OSSClient) GetInsightMetricSeries(_ context.Context, _ tools.InsightMetricSeriesParams) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (c *OSSClient) ListInsightExecutions(_ context.Context, _ tools.InsightExecutionsParams) (string, error) {
+	return "", ErrNotSupported
+}
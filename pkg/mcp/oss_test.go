@@ -0,0 +1,93 @@
+package mcp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/mcp/tools"
+)
+
+func TestOSSClient_UsesV1Routes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/test-workflows/wf/executions/exec-1":
+			_, _ = io.WriteString(w, `{"id":"exec-1"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/test-workflow-executions/exec-1/artifacts/reports/junit.xml":
+			_, _ = io.WriteString(w, "<testsuites/>")
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/test-workflows/wf/executions/exec-1/abort":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/test-workflow-templates/tpl":
+			assert.Equal(t, "text/yaml", r.Header.Get("Accept"))
+			_, _ = io.WriteString(w, "kind: TestWorkflowTemplate")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewOSSClient(&MCPServerConfig{ControlPlaneUrl: server.URL, OpenSource: true}, server.Client())
+	ctx := context.Background()
+
+	info, err := client.GetExecutionInfo(ctx, "wf", "exec-1")
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"exec-1"}`, info)
+
+	artifact, err := client.ReadArtifact(ctx, "exec-1", "reports/junit.xml", tools.ArtifactReadParams{})
+	require.NoError(t, err)
+	assert.Equal(t, "<testsuites/>", artifact)
+
+	_, err = client.AbortWorkflowExecution(ctx, "wf", "exec-1")
+	require.NoError(t, err)
+
+	template, err := client.GetWorkflowTemplateDefinition(ctx, "tpl")
+	require.NoError(t, err)
+	assert.Equal(t, "kind: TestWorkflowTemplate", template)
+
+	_, err = client.ListResourceGroups(ctx)
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestOSSClient_GetExecutionLogs_FiltersLocally(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/test-workflow-executions/exec-1/logs", r.URL.Path)
+		_, _ = io.WriteString(w, "one\nERROR two\nthree\nERROR four\nfive\n")
+	}))
+	defer server.Close()
+
+	client := NewOSSClient(&MCPServerConfig{ControlPlaneUrl: server.URL, OpenSource: true}, server.Client())
+	ctx := context.Background()
+
+	logs, err := client.GetExecutionLogs(ctx, "exec-1", tools.ExecutionLogParams{Tail: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "ERROR four\nfive", logs)
+
+	logs, err = client.GetExecutionLogs(ctx, "exec-1", tools.ExecutionLogParams{StartLine: 2, EndLine: 4, Grep: "ERROR"})
+	require.NoError(t, err)
+	assert.Equal(t, "ERROR two\nERROR four", logs)
+
+	_, err = client.GetExecutionLogs(ctx, "exec-1", tools.ExecutionLogParams{Step: "run"})
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestNewMCPServer_OpenSourceHidesUnsupportedTools(t *testing.T) {
+	mcpServer, err := NewMCPServer(MCPServerConfig{ControlPlaneUrl: "http://localhost:8088", OpenSource: true}, nil)
+	require.NoError(t, err)
+
+	registered := mcpServer.ListTools()
+	assert.Contains(t, registered, "list_workflows")
+	assert.Contains(t, registered, "fetch_execution_logs")
+	assert.Contains(t, registered, "read_artifact")
+	assert.NotContains(t, registered, "list_resource_groups")
+	assert.NotContains(t, registered, "list_agents")
+	assert.NotContains(t, registered, "build_dashboard_url")
+	for name := range registered {
+		assert.NotContains(t, name, "insight")
+	}
+}
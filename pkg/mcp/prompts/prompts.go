@@ -0,0 +1,146 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const defaultFlakyExecutionsCount = 20
+
+func AuthorWorkflow() (prompt mcp.Prompt, handler server.PromptHandlerFunc) {
+	prompt = mcp.NewPrompt("author_workflow",
+		mcp.WithPromptDescription("Author a Test Workflow for the tests found in a repository"),
+		mcp.WithArgument("repository",
+			mcp.ArgumentDescription("Git URL or local path of the repository with the tests"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("path",
+			mcp.ArgumentDescription("Directory of the tests inside the repository"),
+		),
+		mcp.WithArgument("workflowName",
+			mcp.ArgumentDescription("Name for the new workflow"),
+		),
+	)
+
+	handler = func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		repository, err := requiredArgument(request, "repository")
+		if err != nil {
+			return nil, err
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "Author a Testkube Test Workflow running the tests from the repository %s", repository)
+		if path := request.Params.Arguments["path"]; path != "" {
+			fmt.Fprintf(&b, " (tests located in %s)", path)
+		}
+		b.WriteString(".\n\n")
+		b.WriteString("1. Inspect the repository to detect the test framework, its dependencies and the command running the tests.\n")
+		b.WriteString("2. Call get_workflow_schema to learn the Test Workflow structure, and list_workflowtemplates to find official templates worth reusing.\n")
+		b.WriteString("3. Check list_workflows for existing workflows of the same repository, and reuse their conventions (labels, images, resources).\n")
+		b.WriteString("4. Write the workflow: clone the repository with `content.git`, pick a container image with the required tooling, run the tests in steps, and collect reports with `artifacts`.\n")
+		if name := request.Params.Arguments["workflowName"]; name != "" {
+			fmt.Fprintf(&b, "5. Name the workflow %q, show the YAML for review, and create it with create_workflow once confirmed.\n", name)
+		} else {
+			b.WriteString("5. Name the workflow after the repository and the test type, show the YAML for review, and create it with create_workflow once confirmed.\n")
+		}
+
+		return mcp.NewGetPromptResult("Author a Test Workflow", []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String())),
+		}), nil
+	}
+
+	return prompt, handler
+}
+
+func ConvertGitHubActions() (prompt mcp.Prompt, handler server.PromptHandlerFunc) {
+	prompt = mcp.NewPrompt("convert_github_actions",
+		mcp.WithPromptDescription("Convert a GitHub Actions job into a Test Workflow"),
+		mcp.WithArgument("job",
+			mcp.ArgumentDescription("YAML of the GitHub Actions workflow or job to convert"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("workflowName",
+			mcp.ArgumentDescription("Name for the new workflow"),
+		),
+	)
+
+	handler = func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		job, err := requiredArgument(request, "job")
+		if err != nil {
+			return nil, err
+		}
+
+		var b strings.Builder
+		b.WriteString("Convert the following GitHub Actions job into a Testkube Test Workflow.\n\n")
+		b.WriteString("```yaml\n")
+		b.WriteString(strings.TrimSpace(job))
+		b.WriteString("\n```\n\n")
+		b.WriteString("- Call get_workflow_schema first, so the result follows the Test Workflow specification.\n")
+		b.WriteString("- Replace `actions/checkout` with `content.git`, and `runs-on` with a container image providing the same tooling.\n")
+		b.WriteString("- Map `env` to `container.env`, `run` steps to `shell` steps, and `services` to `services` of the workflow.\n")
+		b.WriteString("- Turn `matrix` into `parallel` or `services` matrix, and `actions/upload-artifact` into `artifacts`.\n")
+		b.WriteString("- Expose repository secrets as `config` parameters or Kubernetes secret references, never as literal values.\n")
+		b.WriteString("- List the actions that have no Test Workflow equivalent, and explain how they were handled.\n")
+		if name := request.Params.Arguments["workflowName"]; name != "" {
+			fmt.Fprintf(&b, "- Name the workflow %q.\n", name)
+		}
+		b.WriteString("\nShow the resulting YAML for review before creating it with create_workflow.\n")
+
+		return mcp.NewGetPromptResult("Convert a GitHub Actions job", []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String())),
+		}), nil
+	}
+
+	return prompt, handler
+}
+
+func InvestigateFlakyWorkflow() (prompt mcp.Prompt, handler server.PromptHandlerFunc) {
+	prompt = mcp.NewPrompt("investigate_flaky_workflow",
+		mcp.WithPromptDescription("Investigate why a Test Workflow passes and fails intermittently"),
+		mcp.WithArgument("workflowName",
+			mcp.ArgumentDescription("Name of the flaky workflow"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("executions",
+			mcp.ArgumentDescription(fmt.Sprintf("Number of recent executions to analyze (default: %d)", defaultFlakyExecutionsCount)),
+		),
+	)
+
+	handler = func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		workflowName, err := requiredArgument(request, "workflowName")
+		if err != nil {
+			return nil, err
+		}
+		count := request.Params.Arguments["executions"]
+		if count == "" {
+			count = fmt.Sprint(defaultFlakyExecutionsCount)
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "Investigate why the Test Workflow %q is flaky.\n\n", workflowName)
+		fmt.Fprintf(&b, "1. Call list_executions for the workflow with pageSize %s, and identify the pass/fail pattern over time.\n", count)
+		b.WriteString("2. Call diagnose_execution for the recent failed executions, and compare the failing steps, failed tests and error messages.\n")
+		b.WriteString("3. Compare the failures with the passing runs: specification changes, durations, tags, the time of day, and parallel executions.\n")
+		b.WriteString("4. Use get_workflow_resource_history to check whether failures correlate with CPU or memory pressure.\n")
+		fmt.Fprintf(&b, "5. Read the definition from the testkube://workflows/%s resource, and look for timeouts, shared state or external dependencies.\n\n", workflowName)
+		b.WriteString("Conclude with the most likely root causes ranked by evidence, and concrete changes to make the workflow reliable.\n")
+
+		return mcp.NewGetPromptResult("Investigate a flaky workflow", []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String())),
+		}), nil
+	}
+
+	return prompt, handler
+}
+
+func requiredArgument(request mcp.GetPromptRequest, name string) (string, error) {
+	value := strings.TrimSpace(request.Params.Arguments[name])
+	if value == "" {
+		return "", fmt.Errorf("missing required argument: %s", name)
+	}
+	return value, nil
+}
@@ -0,0 +1,31 @@
+package prompts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvestigateFlakyWorkflow(t *testing.T) {
+	_, handler := InvestigateFlakyWorkflow()
+	request := mcp.GetPromptRequest{}
+	request.Params.Arguments = map[string]string{"workflowName": "e2e"}
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, result.Messages, 1)
+
+	text := result.Messages[0].Content.(mcp.TextContent).Text
+	assert.Contains(t, text, `"e2e"`)
+	assert.Contains(t, text, "pageSize 20")
+	assert.Contains(t, text, "diagnose_execution")
+	assert.Contains(t, text, "testkube://workflows/e2e")
+}
+
+func TestConvertGitHubActions_RequiresJob(t *testing.T) {
+	_, handler := ConvertGitHubActions()
+	_, err := handler(context.Background(), mcp.GetPromptRequest{})
+	assert.EqualError(t, err, "missing required argument: job")
+}
@@ -0,0 +1,267 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/kubeshop/testkube/pkg/mcp/formatters"
+	"github.com/kubeshop/testkube/pkg/mcp/tools"
+)
+
+const (
+	// Scheme is the URI scheme used for all Testkube resources
+	Scheme = "testkube://"
+
+	WorkflowsURI  = Scheme + "workflows"
+	TemplatesURI  = Scheme + "templates"
+	ExecutionsURI = Scheme + "executions"
+
+	mimeJSON = "application/json"
+	mimeYAML = "text/yaml"
+	mimeText = "text/plain"
+
+	workflowsPageSize        = 100
+	recentExecutionsPageSize = 20
+	executionLogsTail        = 200
+)
+
+// ExecutionURI builds the resource URI for the execution with the given ID
+func ExecutionURI(executionId string) string {
+	return ExecutionsURI + "/" + executionId
+}
+
+// ExecutionLogsURI builds the resource URI for the logs of the execution with the given ID
+func ExecutionLogsURI(executionId string) string {
+	return ExecutionURI(executionId) + "/logs"
+}
+
+// ParseExecutionURI extracts the execution ID from any execution resource URI
+// (the execution itself, its logs or its artifacts)
+func ParseExecutionURI(uri string) (string, bool) {
+	rest, ok := strings.CutPrefix(uri, ExecutionsURI+"/")
+	if !ok {
+		return "", false
+	}
+	id, _, _ := strings.Cut(rest, "/")
+	return id, id != ""
+}
+
+func ListWorkflows(client tools.WorkflowLister) (resource mcp.Resource, handler server.ResourceHandlerFunc) {
+	resource = mcp.NewResource(WorkflowsURI, "Workflows",
+		mcp.WithResourceDescription("Test Workflows available in the environment"),
+		mcp.WithMIMEType(mimeJSON),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		result, err := client.ListWorkflows(ctx, tools.ListWorkflowsParams{PageSize: workflowsPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflows: %w", err)
+		}
+		formatted, err := formatters.FormatListWorkflows(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format workflows: %w", err)
+		}
+		return text(request.Params.URI, mimeJSON, formatted), nil
+	}
+
+	return resource, handler
+}
+
+func ListTemplates(client tools.WorkflowTemplateLister) (resource mcp.Resource, handler server.ResourceHandlerFunc) {
+	resource = mcp.NewResource(TemplatesURI, "Workflow Templates",
+		mcp.WithResourceDescription("Test Workflow Templates available in the environment"),
+		mcp.WithMIMEType(mimeJSON),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		result, err := client.ListWorkflowTemplates(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflow templates: %w", err)
+		}
+		formatted, err := formatters.FormatListWorkflowTemplates(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format workflow templates: %w", err)
+		}
+		return text(request.Params.URI, mimeJSON, formatted), nil
+	}
+
+	return resource, handler
+}
+
+func ListRecentExecutions(client tools.ExecutionLister) (resource mcp.Resource, handler server.ResourceHandlerFunc) {
+	resource = mcp.NewResource(ExecutionsURI, "Recent Executions",
+		mcp.WithResourceDescription("The most recent Test Workflow executions in the environment"),
+		mcp.WithMIMEType(mimeJSON),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		result, err := client.ListExecutions(ctx, tools.ListExecutionsParams{PageSize: recentExecutionsPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list executions: %w", err)
+		}
+		formatted, err := formatters.FormatListExecutions(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format executions: %w", err)
+		}
+		return text(request.Params.URI, mimeJSON, formatted), nil
+	}
+
+	return resource, handler
+}
+
+func WorkflowDefinition(client tools.WorkflowDefinitionGetter) (template mcp.ResourceTemplate, handler server.ResourceTemplateHandlerFunc) {
+	template = mcp.NewResourceTemplate(WorkflowsURI+"/{name}", "Workflow Definition",
+		mcp.WithTemplateDescription("YAML definition of a Test Workflow"),
+		mcp.WithTemplateMIMEType(mimeYAML),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		name, err := argument(request, "name")
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.GetWorkflowDefinition(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow definition: %w", err)
+		}
+		return text(request.Params.URI, mimeYAML, result), nil
+	}
+
+	return template, handler
+}
+
+func TemplateDefinition(client tools.WorkflowTemplateDefinitionGetter) (template mcp.ResourceTemplate, handler server.ResourceTemplateHandlerFunc) {
+	template = mcp.NewResourceTemplate(TemplatesURI+"/{name}", "Workflow Template Definition",
+		mcp.WithTemplateDescription("YAML definition of a Test Workflow Template"),
+		mcp.WithTemplateMIMEType(mimeYAML),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		name, err := argument(request, "name")
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.GetWorkflowTemplateDefinition(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow template definition: %w", err)
+		}
+		return text(request.Params.URI, mimeYAML, result), nil
+	}
+
+	return template, handler
+}
+
+func Execution(client tools.ExecutionInfoGetter) (template mcp.ResourceTemplate, handler server.ResourceTemplateHandlerFunc) {
+	template = mcp.NewResourceTemplate(ExecutionsURI+"/{id}", "Execution",
+		mcp.WithTemplateDescription("Status and step results of a Test Workflow execution. Subscribe to get notified when the status changes."),
+		mcp.WithTemplateMIMEType(mimeJSON),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		id, err := argument(request, "id")
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.GetExecutionInfo(ctx, "", id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get execution info: %w", err)
+		}
+		formatted, err := formatters.FormatExecutionInfo(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format execution info: %w", err)
+		}
+		return text(request.Params.URI, mimeJSON, formatted), nil
+	}
+
+	return template, handler
+}
+
+func ExecutionLogs(client tools.ExecutionLogger) (template mcp.ResourceTemplate, handler server.ResourceTemplateHandlerFunc) {
+	template = mcp.NewResourceTemplate(ExecutionsURI+"/{id}/logs", "Execution Logs",
+		mcp.WithTemplateDescription(fmt.Sprintf("Last %d lines of the Test Workflow execution logs", executionLogsTail)),
+		mcp.WithTemplateMIMEType(mimeText),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		id, err := argument(request, "id")
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.GetExecutionLogs(ctx, id, tools.ExecutionLogParams{Tail: executionLogsTail})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get execution logs: %w", err)
+		}
+		return text(request.Params.URI, mimeText, result), nil
+	}
+
+	return template, handler
+}
+
+func ExecutionArtifacts(client tools.ArtifactLister) (template mcp.ResourceTemplate, handler server.ResourceTemplateHandlerFunc) {
+	template = mcp.NewResourceTemplate(ExecutionsURI+"/{id}/artifacts", "Execution Artifacts",
+		mcp.WithTemplateDescription("Artifacts collected by a Test Workflow execution"),
+		mcp.WithTemplateMIMEType(mimeJSON),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		id, err := argument(request, "id")
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.ListArtifacts(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list artifacts: %w", err)
+		}
+		return text(request.Params.URI, mimeJSON, result), nil
+	}
+
+	return template, handler
+}
+
+func ExecutionArtifact(client tools.ArtifactReader) (template mcp.ResourceTemplate, handler server.ResourceTemplateHandlerFunc) {
+	template = mcp.NewResourceTemplate(ExecutionsURI+"/{id}/artifacts/{+path}", "Execution Artifact",
+		mcp.WithTemplateDescription("Content of a single artifact collected by a Test Workflow execution"),
+		mcp.WithTemplateMIMEType(mimeText),
+	)
+
+	handler = func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		id, err := argument(request, "id")
+		if err != nil {
+			return nil, err
+		}
+		path, err := argument(request, "path")
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.ReadArtifact(ctx, id, path, tools.ArtifactReadParams{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact: %w", err)
+		}
+		return text(request.Params.URI, mimeText, result), nil
+	}
+
+	return template, handler
+}
+
+// argument reads the variable matched from the URI template
+func argument(request mcp.ReadResourceRequest, name string) (string, error) {
+	var value string
+	switch v := request.Params.Arguments[name].(type) {
+	case string:
+		value = v
+	case []string:
+		value = strings.Join(v, "/")
+	}
+	if value == "" {
+		return "", fmt.Errorf("missing %s in resource URI: %s", name, request.Params.URI)
+	}
+	return value, nil
+}
+
+func text(uri, mimeType, content string) []mcp.ResourceContents {
+	return []mcp.ResourceContents{mcp.TextResourceContents{URI: uri, MIMEType: mimeType, Text: content}}
+}
@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/mcp/tools"
+)
+
+type mockClient struct {
+	logParams tools.ExecutionLogParams
+	artifact  string
+}
+
+func (m *mockClient) GetExecutionInfo(_ context.Context, _, executionId string) (string, error) {
+	return `{"id": "` + executionId + `", "name": "e2e-1", "result": {"status": "running"}}`, nil
+}
+
+func (m *mockClient) GetExecutionLogs(_ context.Context, _ string, params tools.ExecutionLogParams) (string, error) {
+	m.logParams = params
+	return "line 1\nline 2", nil
+}
+
+func (m *mockClient) ReadArtifact(_ context.Context, _, filename string, _ tools.ArtifactReadParams) (string, error) {
+	m.artifact = filename
+	return "<testsuites/>", nil
+}
+
+func readResource(t *testing.T, s *server.MCPServer, uri string) mcp.TextResourceContents {
+	t.Helper()
+	request, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "resources/read",
+		"params":  map[string]any{"uri": uri},
+	})
+	require.NoError(t, err)
+
+	response := s.HandleMessage(context.Background(), request)
+	result, ok := response.(mcp.JSONRPCResponse)
+	require.True(t, ok, "unexpected response: %#v", response)
+	read, ok := result.Result.(mcp.ReadResourceResult)
+	require.True(t, ok)
+	require.Len(t, read.Contents, 1)
+	return read.Contents[0].(mcp.TextResourceContents)
+}
+
+func TestExecutionResources(t *testing.T) {
+	client := &mockClient{}
+	s := server.NewMCPServer("test", "1.0.0", server.WithResourceCapabilities(true, false))
+	s.AddResourceTemplate(Execution(client))
+	s.AddResourceTemplate(ExecutionLogs(client))
+	s.AddResourceTemplate(ExecutionArtifact(client))
+
+	contents := readResource(t, s, "testkube://executions/exec-1")
+	assert.Equal(t, "application/json", contents.MIMEType)
+	assert.Contains(t, contents.Text, `"id":"exec-1"`)
+
+	contents = readResource(t, s, "testkube://executions/exec-1/logs")
+	assert.Equal(t, "line 1\nline 2", contents.Text)
+	assert.Equal(t, executionLogsTail, client.logParams.Tail)
+
+	contents = readResource(t, s, "testkube://executions/exec-1/artifacts/reports/junit.xml")
+	assert.Equal(t, "testkube://executions/exec-1/artifacts/reports/junit.xml", contents.URI)
+	assert.Equal(t, "<testsuites/>", contents.Text)
+	assert.Equal(t, "reports/junit.xml", client.artifact)
+}
+
+func TestParseExecutionURI(t *testing.T) {
+	id, ok := ParseExecutionURI(ExecutionLogsURI("exec-1"))
+	assert.True(t, ok)
+	assert.Equal(t, "exec-1", id)
+
+	id, ok = ParseExecutionURI("testkube://executions/exec-2/artifacts/a/b.txt")
+	assert.True(t, ok)
+	assert.Equal(t, "exec-2", id)
+
+	_, ok = ParseExecutionURI(ExecutionsURI)
+	assert.False(t, ok)
+	_, ok = ParseExecutionURI("testkube://workflows/wf")
+	assert.False(t, ok)
+}
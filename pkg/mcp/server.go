@@ -12,6 +12,8 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 
 	tkhttp "github.com/kubeshop/testkube/pkg/http"
+	"github.com/kubeshop/testkube/pkg/mcp/prompts"
+	"github.com/kubeshop/testkube/pkg/mcp/resources"
 	"github.com/kubeshop/testkube/pkg/mcp/tools"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -22,23 +24,38 @@ func NewMCPServer(cfg MCPServerConfig, client Client) (*server.MCPServer, error)
 		return nil, fmt.Errorf("configuration validation failed: %v", err)
 	}
 
+	// If no client is provided, use the default API client
+	if client == nil {
+		httpClient := tkhttp.NewClient(cfg.SkipTLS)
+		if cfg.OpenSource {
+			client = NewOSSClient(&cfg, httpClient)
+		} else {
+			client = NewAPIClient(&cfg, httpClient)
+		}
+	}
+
+	// Notify the subscribed sessions when the status of an execution changes
+	hooks := &server.Hooks{}
+	watcher := NewExecutionWatcher(client, defaultSubscriptionPollInterval)
+	watcher.RegisterHooks(hooks)
+
 	mcpServer := server.NewMCPServer(
 		"testkube-mcp",
 		cfg.Version,
 		server.WithRecovery(),
 		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(true, false),
+		server.WithPromptCapabilities(false),
+		server.WithHooks(hooks),
 		server.WithToolHandlerMiddleware(DebugMiddleware(&cfg)),
 		server.WithToolHandlerMiddleware(TelemetryMiddleware(&cfg)),
 	)
-
-	// If no client is provided, use the default API client
-	if client == nil {
-		httpClient := tkhttp.NewClient(cfg.SkipTLS)
-		client = NewAPIClient(&cfg, httpClient)
-	}
+	watcher.NotifyThrough(mcpServer)
 
 	// Dashboard tools
-	mcpServer.AddTool(tools.BuildDashboardUrl(cfg.DashboardUrl, cfg.OrgId, cfg.EnvId))
+	if !cfg.OpenSource {
+		mcpServer.AddTool(tools.BuildDashboardUrl(cfg.DashboardUrl, cfg.OrgId, cfg.EnvId))
+	}
 
 	// Workflow tools
 	mcpServer.AddTool(tools.ListWorkflows(client))
@@ -56,7 +73,8 @@ func NewMCPServer(cfg MCPServerConfig, client Client) (*server.MCPServer, error)
 	mcpServer.AddTool(tools.UpdateWorkflowTemplate(client))
 
 	// Query tools (JSONPath-based bulk queries)
-	// Only check backwards compatibility when using APIClient without SkipEndpointChecks
+	// Only check backwards compatibility when using APIClient without SkipEndpointChecks.
+	// The Open Source API has no bulk endpoints, so these are not available there.
 	if apiClient, ok := client.(*APIClient); ok && !cfg.SkipEndpointChecks {
 		ctx := context.Background()
 		if apiClient.SupportsEndpoint(ctx, "/agent/test-workflows/definitions") {
@@ -65,7 +83,7 @@ func NewMCPServer(cfg MCPServerConfig, client Client) (*server.MCPServer, error)
 		if apiClient.SupportsEndpoint(ctx, "/agent/test-workflow-executions/summaries") {
 			mcpServer.AddTool(tools.QueryExecutions(client))
 		}
-	} else {
+	} else if !cfg.OpenSource {
 		mcpServer.AddTool(tools.QueryWorkflows(client))
 		mcpServer.AddTool(tools.QueryExecutions(client))
 	}
@@ -77,33 +95,56 @@ func NewMCPServer(cfg MCPServerConfig, client Client) (*server.MCPServer, error)
 	// Labels tools
 	mcpServer.AddTool(tools.ListLabels(client))
 
-	// Resource groups tools
-	mcpServer.AddTool(tools.ListResourceGroups(client))
-
-	// Agent tools
-	mcpServer.AddTool(tools.ListAgents(client))
+	// Resource groups and agent tools
+	if !cfg.OpenSource {
+		mcpServer.AddTool(tools.ListResourceGroups(client))
+		mcpServer.AddTool(tools.ListAgents(client))
+	}
 
 	// Execution tools
 	mcpServer.AddTool(tools.FetchExecutionLogs(client))
 	mcpServer.AddTool(tools.ListExecutions(client))
 	mcpServer.AddTool(tools.LookupExecutionId(client))
 	mcpServer.AddTool(tools.GetExecutionInfo(client))
-	mcpServer.AddTool(tools.GetWorkflowExecutionMetrics(client))
+	mcpServer.AddTool(tools.DiagnoseExecution(client))
+	if !cfg.OpenSource {
+		mcpServer.AddTool(tools.GetWorkflowExecutionMetrics(client))
+	}
 	mcpServer.AddTool(tools.GetWorkflowResourceHistory(client))
 	mcpServer.AddTool(tools.WaitForExecutions(client))
 	mcpServer.AddTool(tools.AbortWorkflowExecution(client))
 	// Registered unconditionally — endpoint is parameterized and cannot be probed with SupportsEndpoint.
 	mcpServer.AddTool(tools.UpdateExecutionTags(client))
+	mcpServer.AddTool(tools.UpdateExecutionTriage(client))
+	mcpServer.AddTool(tools.GetTriageStats(client))
 
 	// Artifact tools
 	mcpServer.AddTool(tools.ListArtifacts(client))
 	mcpServer.AddTool(tools.ReadArtifact(client))
 
 	// Insight (ingested metrics) tools
-	mcpServer.AddTool(tools.ListInsightSeries(client))
-	mcpServer.AddTool(tools.ListInsightMetricKeys(client))
-	mcpServer.AddTool(tools.GetInsightMetricSeries(client))
-	mcpServer.AddTool(tools.ListInsightExecutions(client))
+	if !cfg.OpenSource {
+		mcpServer.AddTool(tools.ListInsightSeries(client))
+		mcpServer.AddTool(tools.ListInsightMetricKeys(client))
+		mcpServer.AddTool(tools.GetInsightMetricSeries(client))
+		mcpServer.AddTool(tools.ListInsightExecutions(client))
+	}
+
+	// Resources
+	mcpServer.AddResource(resources.ListWorkflows(client))
+	mcpServer.AddResource(resources.ListTemplates(client))
+	mcpServer.AddResource(resources.ListRecentExecutions(client))
+	mcpServer.AddResourceTemplate(resources.WorkflowDefinition(client))
+	mcpServer.AddResourceTemplate(resources.TemplateDefinition(client))
+	mcpServer.AddResourceTemplate(resources.Execution(client))
+	mcpServer.AddResourceTemplate(resources.ExecutionLogs(client))
+	mcpServer.AddResourceTemplate(resources.ExecutionArtifacts(client))
+	mcpServer.AddResourceTemplate(resources.ExecutionArtifact(client))
+
+	// Prompts
+	mcpServer.AddPrompt(prompts.AuthorWorkflow())
+	mcpServer.AddPrompt(prompts.ConvertGitHubActions())
+	mcpServer.AddPrompt(prompts.InvestigateFlakyWorkflow())
 
 	return mcpServer, nil
 }
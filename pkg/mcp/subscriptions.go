@@ -0,0 +1,215 @@
+package mcp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/mcp/formatters"
+	"github.com/kubeshop/testkube/pkg/mcp/resources"
+	"github.com/kubeshop/testkube/pkg/mcp/tools"
+)
+
+const defaultSubscriptionPollInterval = 5 * time.Second
+
+// ExecutionWatcher tracks the resource subscriptions of the MCP sessions,
+// and notifies them when the status of a subscribed execution changes
+type ExecutionWatcher struct {
+	client   tools.ExecutionInfoGetter
+	interval time.Duration
+	notify   func(sessionID, uri string) error
+
+	mu            sync.Mutex
+	subscriptions map[string]map[string]struct{}
+	statuses      map[string]testkube.TestWorkflowStatus
+	running       bool
+}
+
+// NewExecutionWatcher creates a watcher polling the subscribed executions with the given interval
+func NewExecutionWatcher(client tools.ExecutionInfoGetter, interval time.Duration) *ExecutionWatcher {
+	if interval <= 0 {
+		interval = defaultSubscriptionPollInterval
+	}
+	return &ExecutionWatcher{
+		client:        client,
+		interval:      interval,
+		notify:        func(string, string) error { return nil },
+		subscriptions: make(map[string]map[string]struct{}),
+		statuses:      make(map[string]testkube.TestWorkflowStatus),
+	}
+}
+
+// RegisterHooks makes the watcher follow the session subscriptions of the MCP server
+func (w *ExecutionWatcher) RegisterHooks(hooks *server.Hooks) {
+	hooks.AddAfterSubscribe(func(ctx context.Context, _ any, message *mcp.SubscribeRequest, _ *mcp.EmptyResult) {
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			w.Subscribe(ctx, session.SessionID(), message.Params.URI)
+		}
+	})
+	hooks.AddAfterUnsubscribe(func(ctx context.Context, _ any, message *mcp.UnsubscribeRequest, _ *mcp.EmptyResult) {
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			w.Unsubscribe(session.SessionID(), message.Params.URI)
+		}
+	})
+	hooks.AddOnUnregisterSession(func(_ context.Context, session server.ClientSession) {
+		w.RemoveSession(session.SessionID())
+	})
+}
+
+// NotifyThrough sends the resource update notifications through the MCP server
+func (w *ExecutionWatcher) NotifyThrough(mcpServer *server.MCPServer) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.notify = func(sessionID, uri string) error {
+		return mcpServer.SendNotificationToSpecificClient(sessionID, mcp.MethodNotificationResourceUpdated, map[string]any{"uri": uri})
+	}
+}
+
+// Subscribe starts watching the execution behind the URI for the session.
+// URIs that don't point to an execution are accepted, but never notified.
+func (w *ExecutionWatcher) Subscribe(ctx context.Context, sessionID, uri string) {
+	executionId, ok := resources.ParseExecutionURI(uri)
+	if !ok {
+		return
+	}
+
+	// Remember the current status, so the first change after subscribing is not missed
+	status, _ := w.fetchStatus(ctx, executionId)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.subscriptions[sessionID] == nil {
+		w.subscriptions[sessionID] = make(map[string]struct{})
+	}
+	w.subscriptions[sessionID][uri] = struct{}{}
+	if _, known := w.statuses[executionId]; !known && status != "" {
+		w.statuses[executionId] = status
+	}
+	if !w.running {
+		w.running = true
+		go w.run()
+	}
+}
+
+// Unsubscribe stops watching the URI for the session
+func (w *ExecutionWatcher) Unsubscribe(sessionID, uri string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.subscriptions[sessionID], uri)
+	if len(w.subscriptions[sessionID]) == 0 {
+		delete(w.subscriptions, sessionID)
+	}
+	w.cleanup()
+}
+
+// RemoveSession drops all the subscriptions of the session
+func (w *ExecutionWatcher) RemoveSession(sessionID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.subscriptions, sessionID)
+	w.cleanup()
+}
+
+// Poll checks the status of every subscribed execution once,
+// and notifies the subscribers of the executions that have changed
+func (w *ExecutionWatcher) Poll(ctx context.Context) {
+	for _, executionId := range w.watchedExecutions() {
+		status, err := w.fetchStatus(ctx, executionId)
+		if err != nil || status == "" {
+			continue
+		}
+
+		w.mu.Lock()
+		previous, known := w.statuses[executionId]
+		w.statuses[executionId] = status
+		var targets [][2]string
+		if known && previous != status {
+			for sessionID, uris := range w.subscriptions {
+				for uri := range uris {
+					if id, _ := resources.ParseExecutionURI(uri); id == executionId {
+						targets = append(targets, [2]string{sessionID, uri})
+					}
+				}
+			}
+		}
+		notify := w.notify
+		w.mu.Unlock()
+
+		for _, target := range targets {
+			// The session may be gone already, it will be cleaned up by the unregister hook
+			_ = notify(target[0], target[1])
+		}
+	}
+}
+
+func (w *ExecutionWatcher) run() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		w.mu.Lock()
+		if len(w.subscriptions) == 0 {
+			w.running = false
+			w.mu.Unlock()
+			return
+		}
+		w.mu.Unlock()
+		w.Poll(context.Background())
+	}
+}
+
+// watchedExecutions lists the subscribed executions that may still change
+func (w *ExecutionWatcher) watchedExecutions() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	seen := make(map[string]struct{})
+	result := make([]string, 0)
+	for _, uris := range w.subscriptions {
+		for uri := range uris {
+			executionId, _ := resources.ParseExecutionURI(uri)
+			if _, ok := seen[executionId]; ok {
+				continue
+			}
+			seen[executionId] = struct{}{}
+			if status, ok := w.statuses[executionId]; ok && status.Finished() {
+				continue
+			}
+			result = append(result, executionId)
+		}
+	}
+	return result
+}
+
+// cleanup forgets the statuses of executions that are no longer subscribed. It expects the lock to be held.
+func (w *ExecutionWatcher) cleanup() {
+	used := make(map[string]struct{})
+	for _, uris := range w.subscriptions {
+		for uri := range uris {
+			executionId, _ := resources.ParseExecutionURI(uri)
+			used[executionId] = struct{}{}
+		}
+	}
+	for executionId := range w.statuses {
+		if _, ok := used[executionId]; !ok {
+			delete(w.statuses, executionId)
+		}
+	}
+}
+
+func (w *ExecutionWatcher) fetchStatus(ctx context.Context, executionId string) (testkube.TestWorkflowStatus, error) {
+	raw, err := w.client.GetExecutionInfo(ctx, "", executionId)
+	if err != nil {
+		return "", err
+	}
+	exec, _, err := formatters.ParseJSON[testkube.TestWorkflowExecution](raw)
+	if err != nil {
+		return "", err
+	}
+	if exec.Result == nil || exec.Result.Status == nil {
+		return "", nil
+	}
+	return *exec.Result.Status, nil
+}
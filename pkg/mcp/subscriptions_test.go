@@ -0,0 +1,68 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type statusClient struct {
+	statuses map[string]string
+}
+
+func (c *statusClient) GetExecutionInfo(_ context.Context, _, executionId string) (string, error) {
+	return `{"id": "` + executionId + `", "result": {"status": "` + c.statuses[executionId] + `"}}`, nil
+}
+
+func TestExecutionWatcher_NotifiesOnStatusChange(t *testing.T) {
+	client := &statusClient{statuses: map[string]string{"exec-1": "running", "exec-2": "running"}}
+	watcher := NewExecutionWatcher(client, time.Hour)
+	notified := make([][2]string, 0)
+	watcher.notify = func(sessionID, uri string) error {
+		notified = append(notified, [2]string{sessionID, uri})
+		return nil
+	}
+	ctx := context.Background()
+
+	watcher.Subscribe(ctx, "session-a", "testkube://executions/exec-1")
+	watcher.Subscribe(ctx, "session-b", "testkube://executions/exec-1/logs")
+	watcher.Subscribe(ctx, "session-b", "testkube://executions/exec-2")
+	watcher.Subscribe(ctx, "session-b", "testkube://workflows/wf")
+
+	watcher.Poll(ctx)
+	assert.Empty(t, notified)
+
+	client.statuses["exec-1"] = "passed"
+	watcher.Poll(ctx)
+	assert.ElementsMatch(t, [][2]string{
+		{"session-a", "testkube://executions/exec-1"},
+		{"session-b", "testkube://executions/exec-1/logs"},
+	}, notified)
+
+	// Finished executions are not polled anymore
+	notified = notified[:0]
+	client.statuses["exec-1"] = "failed"
+	watcher.Poll(ctx)
+	assert.Empty(t, notified)
+
+	watcher.RemoveSession("session-b")
+	client.statuses["exec-2"] = "failed"
+	watcher.Poll(ctx)
+	assert.Empty(t, notified)
+	assert.NotContains(t, watcher.statuses, "exec-2")
+}
+
+func TestNewMCPServer_RegistersResourcesAndPrompts(t *testing.T) {
+	mcpServer, err := NewMCPServer(MCPServerConfig{ControlPlaneUrl: "http://localhost:8088", OpenSource: true}, nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, mcpServer.ListResources(), "testkube://workflows")
+	assert.Contains(t, mcpServer.ListResources(), "testkube://executions")
+	prompts := mcpServer.ListPrompts()
+	assert.Contains(t, prompts, "author_workflow")
+	assert.Contains(t, prompts, "convert_github_actions")
+	assert.Contains(t, prompts, "investigate_flaky_workflow")
+}
@@ -38,6 +38,8 @@ or an RFC 3339 timestamp (e.g., '2024-01-15T13:00:00Z').`
 	EndDateDescription = `Filter items on or before this time. Accepts a date (YYYY-MM-DD, e.g., '2024-01-31')
 or an RFC 3339 timestamp (e.g., '2024-01-31T16:00:00Z'). Combine with startDate for date ranges.`
 
+	TriageCategoryDescription = "Triage failure category, one of 'product-bug', 'test-bug', 'infra', 'flaky'."
+
 	FilenameDescription = "The name of the artifact file to retrieve"
 
 	// Workflow tool descriptions
@@ -60,6 +62,10 @@ or an RFC 3339 timestamp (e.g., '2024-01-31T16:00:00Z'). Combine with startDate
 	WaitForExecutionsDescription            = "Wait for a list of workflow executions to complete. Returns the final status of all executions. Use for synchronizing dependent workflows."
 	AbortWorkflowExecutionDescription       = "Abort a running workflow execution. Stops the execution and marks it as aborted. Use for cancelling long-running or stuck executions."
 	UpdateExecutionTagsDescription          = "Update tags on a workflow execution. Uses replace semantics: provided tags completely replace existing tags. Send empty map {} to clear all tags. Tags are key-value pairs for categorization and filtering."
+	UpdateExecutionTriageDescription        = "Update the triage of a finished workflow execution: failure category, assignee, notes and linked issue URLs. Only provided fields are changed, and each change is recorded in the triage history with the authenticated user. Returns the updated triage."
+	GetTriageStatsDescription               = "Get the share of failures per triage category (product-bug, test-bug, infra, flaky) for each workflow, with the number of untriaged failures. Use to find the workflows failing mostly because of infrastructure or flakiness."
+	DiagnoseExecutionDescription            = "Diagnose a failed workflow execution in a single call. Returns a compact, token-budgeted bundle: the failing step path, the tail of its log, failed JUnit test cases with messages, the specification changes since the last passing execution, and whether the same step failed recently (flip history). Use before fetching logs and artifacts separately."
+	MaxTokensDescription                    = "Approximate token budget for the response (default: 4000). Logs, test cases and specification changes are trimmed to fit."
 
 	// Additional parameter descriptions
 	ExecutionIdsDescription   = "Comma-separated list of execution IDs to wait for (e.g., 'exec1,exec2,exec3')."
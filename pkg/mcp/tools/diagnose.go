@@ -0,0 +1,182 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/mcp/formatters"
+)
+
+const (
+	diagnosisLogTail      = 50
+	diagnosisMaxReports   = 3
+	diagnosisHistorySize  = 10
+	diagnosisHistoryDepth = 5
+)
+
+// ExecutionDiagnoser combines the clients needed for building the failure diagnosis
+type ExecutionDiagnoser interface {
+	ExecutionInfoGetter
+	ExecutionLogger
+	ExecutionLister
+	ArtifactLister
+	ArtifactReader
+}
+
+func DiagnoseExecution(client ExecutionDiagnoser) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool("diagnose_execution",
+		mcp.WithDescription(DiagnoseExecutionDescription),
+		mcp.WithString("executionId", mcp.Required(), mcp.Description(ExecutionIdDescription)),
+		mcp.WithString("maxTokens", mcp.Description(MaxTokensDescription)),
+	)
+
+	handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		executionId, err := RequiredParam[string](request, "executionId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		input := formatters.DiagnosisInput{MaxTokens: formatters.DefaultDiagnosisMaxTokens}
+		if s := request.GetString("maxTokens", ""); s != "" {
+			v, err := strconv.Atoi(s)
+			if err != nil || v <= 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("maxTokens must be a positive integer, got %q", s)), nil
+			}
+			input.MaxTokens = v
+		}
+
+		input.Execution, err = client.GetExecutionInfo(ctx, "", executionId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get execution info: %v", err)), nil
+		}
+		exec, _, err := formatters.ParseJSON[testkube.TestWorkflowExecution](input.Execution)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to parse execution info: %v", err)), nil
+		}
+
+		// The remaining pieces are best-effort, the bundle is still useful without some of them
+		input.Logs = fetchFailingStepLogs(ctx, client, executionId, formatters.FindFailingStep(&exec))
+		input.Reports = fetchJUnitReports(ctx, client, &exec)
+		if exec.Workflow != nil && exec.Workflow.Name != "" {
+			input.LastPassed = fetchLastPassed(ctx, client, exec.Workflow.Name, exec.Id)
+			input.Recent, input.RecentFailed = fetchRecentExecutions(ctx, client, exec.Workflow.Name, exec.Id)
+		}
+
+		formatted, err := formatters.FormatDiagnosis(input)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to format diagnosis: %v", err)), nil
+		}
+		return mcp.NewToolResultText(formatted), nil
+	}
+
+	return tool, handler
+}
+
+// fetchFailingStepLogs reads the tail of the failing step log, falling back to the whole log tail
+// when the API doesn't support filtering by step
+func fetchFailingStepLogs(ctx context.Context, client ExecutionLogger, executionId string, step *formatters.FailingStep) string {
+	params := ExecutionLogParams{Tail: diagnosisLogTail}
+	if step != nil && step.Ref != "" {
+		params.Step = step.Ref
+		if logs, err := client.GetExecutionLogs(ctx, executionId, params); err == nil {
+			return logs
+		}
+		params.Step = ""
+	}
+	logs, _ := client.GetExecutionLogs(ctx, executionId, params)
+	return logs
+}
+
+// fetchJUnitReports reads the JUnit reports detected for the execution,
+// or the XML artifacts when the execution has no reports recorded
+func fetchJUnitReports(ctx context.Context, client ExecutionDiagnoser, exec *testkube.TestWorkflowExecution) map[string]string {
+	files := make([]string, 0)
+	for _, report := range exec.Reports {
+		if strings.EqualFold(report.Kind, "junit") && report.File != "" {
+			files = append(files, report.File)
+		}
+	}
+	if len(files) == 0 {
+		raw, err := client.ListArtifacts(ctx, exec.Id)
+		if err != nil {
+			return nil
+		}
+		artifacts, _, err := formatters.ParseJSON[[]testkube.Artifact](raw)
+		if err != nil {
+			return nil
+		}
+		for _, artifact := range artifacts {
+			if strings.HasSuffix(strings.ToLower(artifact.Name), ".xml") {
+				files = append(files, artifact.Name)
+			}
+		}
+	}
+
+	reports := make(map[string]string)
+	for _, file := range files {
+		if len(reports) == diagnosisMaxReports {
+			break
+		}
+		content, err := client.ReadArtifact(ctx, exec.Id, file, ArtifactReadParams{})
+		if err == nil && strings.Contains(content, "<testcase") {
+			reports[file] = content
+		}
+	}
+	return reports
+}
+
+func fetchLastPassed(ctx context.Context, client ExecutionDiagnoser, workflowName, executionId string) string {
+	raw, err := client.ListExecutions(ctx, ListExecutionsParams{
+		WorkflowName: workflowName,
+		Status:       string(testkube.PASSED_TestWorkflowStatus),
+		PageSize:     2,
+	})
+	if err != nil {
+		return ""
+	}
+	result, _, err := formatters.ParseJSON[testkube.TestWorkflowExecutionsResult](raw)
+	if err != nil {
+		return ""
+	}
+	for _, e := range result.Results {
+		if e.Id == executionId {
+			continue
+		}
+		info, err := client.GetExecutionInfo(ctx, workflowName, e.Id)
+		if err != nil {
+			return ""
+		}
+		return info
+	}
+	return ""
+}
+
+// fetchRecentExecutions lists the recent executions, and reads the details of the recent failed ones
+func fetchRecentExecutions(ctx context.Context, client ExecutionDiagnoser, workflowName, executionId string) (string, []string) {
+	raw, err := client.ListExecutions(ctx, ListExecutionsParams{WorkflowName: workflowName, PageSize: diagnosisHistorySize})
+	if err != nil {
+		return "", nil
+	}
+	result, _, err := formatters.ParseJSON[testkube.TestWorkflowExecutionsResult](raw)
+	if err != nil {
+		return raw, nil
+	}
+	failed := make([]string, 0)
+	for _, e := range result.Results {
+		if len(failed) == diagnosisHistoryDepth {
+			break
+		}
+		if e.Id == executionId || e.Result == nil || e.Result.Status == nil || *e.Result.Status != testkube.FAILED_TestWorkflowStatus {
+			continue
+		}
+		if info, err := client.GetExecutionInfo(ctx, workflowName, e.Id); err == nil {
+			failed = append(failed, info)
+		}
+	}
+	return raw, failed
+}
@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDiagnoser struct {
+	executions map[string]string
+	logCalls   []ExecutionLogParams
+	listCalls  []ListExecutionsParams
+	artifacts  map[string]string
+}
+
+func (m *mockDiagnoser) GetExecutionInfo(_ context.Context, _, executionId string) (string, error) {
+	if raw, ok := m.executions[executionId]; ok {
+		return raw, nil
+	}
+	return "", errors.New("not found")
+}
+
+func (m *mockDiagnoser) GetExecutionLogs(_ context.Context, _ string, params ExecutionLogParams) (string, error) {
+	m.logCalls = append(m.logCalls, params)
+	if params.Step != "" {
+		return "", errors.New("operation is not supported")
+	}
+	return "setup\nassertion failed", nil
+}
+
+func (m *mockDiagnoser) ListExecutions(_ context.Context, params ListExecutionsParams) (string, error) {
+	m.listCalls = append(m.listCalls, params)
+	if params.Status == "passed" {
+		return `{"results": [{"id": "exec-1", "result": {"status": "passed"}}]}`, nil
+	}
+	return `{"results": [{"id": "exec-2", "result": {"status": "failed"}}, {"id": "exec-1", "result": {"status": "passed"}}]}`, nil
+}
+
+func (m *mockDiagnoser) ListArtifacts(_ context.Context, _ string) (string, error) {
+	return `[{"name": "junit.xml"}, {"name": "screenshot.png"}]`, nil
+}
+
+func (m *mockDiagnoser) ReadArtifact(_ context.Context, _, filename string, _ ArtifactReadParams) (string, error) {
+	return m.artifacts[filename], nil
+}
+
+func TestDiagnoseExecution(t *testing.T) {
+	client := &mockDiagnoser{
+		executions: map[string]string{
+			"exec-2": `{"id": "exec-2", "name": "e2e-2", "workflow": {"name": "e2e"},
+				"signature": [{"ref": "rtest", "name": "Test"}],
+				"result": {"status": "failed", "steps": {"rtest": {"status": "failed", "exitCode": 2}}}}`,
+			"exec-1": `{"id": "exec-1", "name": "e2e-1", "workflow": {"name": "e2e"}, "result": {"status": "passed"}}`,
+		},
+		artifacts: map[string]string{
+			"junit.xml": `<testsuite name="e2e"><testcase name="checkout"><failure message="button not found"/></testcase></testsuite>`,
+		},
+	}
+
+	_, handler := DiagnoseExecution(client)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"executionId": "exec-2"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// The step filter is not supported, so it should fall back to the whole log tail
+	require.Len(t, client.logCalls, 2)
+	assert.Equal(t, "rtest", client.logCalls[0].Step)
+	assert.Equal(t, ExecutionLogParams{Tail: diagnosisLogTail}, client.logCalls[1])
+
+	var bundle struct {
+		FailingStep struct {
+			Path string `json:"path"`
+		} `json:"failingStep"`
+		LogTail     []string `json:"logTail"`
+		FailedTests []struct {
+			Name    string `json:"name"`
+			Message string `json:"message"`
+		} `json:"failedTests"`
+		SpecDiff struct {
+			ExecutionID string `json:"executionId"`
+		} `json:"specDiff"`
+		History struct {
+			Statuses []string `json:"statuses"`
+		} `json:"history"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &bundle))
+	assert.Equal(t, "Test", bundle.FailingStep.Path)
+	assert.Equal(t, []string{"setup", "assertion failed"}, bundle.LogTail)
+	require.Len(t, bundle.FailedTests, 1)
+	assert.Equal(t, "button not found", bundle.FailedTests[0].Message)
+	assert.Equal(t, "exec-1", bundle.SpecDiff.ExecutionID)
+	assert.Equal(t, []string{"failed", "passed"}, bundle.History.Statuses)
+}
+
+func TestDiagnoseExecution_InvalidMaxTokens(t *testing.T) {
+	_, handler := DiagnoseExecution(&mockDiagnoser{})
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"executionId": "exec-2", "maxTokens": "many"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
@@ -164,6 +164,10 @@ type ListExecutionsParams struct {
 	StartDate    string
 	EndDate      string
 	FetchAll     bool
+
+	TriageCategory string
+	TriageAssignee string
+	Triaged        string
 }
 
 type ExecutionLister interface {
@@ -183,6 +187,9 @@ func ListExecutions(client ExecutionLister) (tool mcp.Tool, handler server.ToolH
 		mcp.WithString("since", mcp.Description(SinceDescription)),
 		mcp.WithString("startDate", mcp.Description(StartDateDescription)),
 		mcp.WithString("endDate", mcp.Description(EndDateDescription)),
+		mcp.WithString("triageCategory", mcp.Description("Filter by "+TriageCategoryDescription)),
+		mcp.WithString("triageAssignee", mcp.Description("Filter by the triage assignee.")),
+		mcp.WithString("triaged", mcp.Description("Filter by triage state: 'true' for executions with the category set, 'false' for untriaged ones.")),
 	)
 
 	handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -195,6 +202,10 @@ func ListExecutions(client ExecutionLister) (tool mcp.Tool, handler server.ToolH
 			Since:        request.GetString("since", ""),
 			StartDate:    request.GetString("startDate", ""),
 			EndDate:      request.GetString("endDate", ""),
+
+			TriageCategory: request.GetString("triageCategory", ""),
+			TriageAssignee: request.GetString("triageAssignee", ""),
+			Triaged:        request.GetString("triaged", ""),
 		}
 
 		if pageSizeStr := request.GetString("pageSize", "10"); pageSizeStr != "" {
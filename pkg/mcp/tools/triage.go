@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+type ExecutionTriageUpdater interface {
+	UpdateExecutionTriage(ctx context.Context, executionId string, request testkube.TestWorkflowExecutionTriageRequest) (string, error)
+}
+
+func UpdateExecutionTriage(client ExecutionTriageUpdater) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool("update_execution_triage",
+		mcp.WithDescription(UpdateExecutionTriageDescription),
+		mcp.WithString("executionId", mcp.Required(), mcp.Description(ExecutionIdDescription)),
+		mcp.WithString("category", mcp.Description(TriageCategoryDescription+" Pass an empty string to clear it.")),
+		mcp.WithString("assignee", mcp.Description("Person responsible for the failure. Pass an empty string to clear it.")),
+		mcp.WithString("note", mcp.Description("Note added to the triage thread.")),
+		mcp.WithString("addIssues", mcp.Description("Comma-separated list of the issue URLs to link.")),
+		mcp.WithString("removeIssues", mcp.Description("Comma-separated list of the issue URLs to unlink.")),
+	)
+
+	handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		executionId, err := RequiredParam[string](request, "executionId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		triageRequest := testkube.TestWorkflowExecutionTriageRequest{
+			Note:         request.GetString("note", ""),
+			AddIssues:    splitList(request.GetString("addIssues", "")),
+			RemoveIssues: splitList(request.GetString("removeIssues", "")),
+		}
+		if category, ok, err := OptionalParamOK[string](request, "category"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			triageRequest.Category = &category
+		}
+		if assignee, ok, err := OptionalParamOK[string](request, "assignee"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			triageRequest.Assignee = &assignee
+		}
+
+		result, err := client.UpdateExecutionTriage(ctx, executionId, triageRequest)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update execution triage: %v", err)), nil
+		}
+		return mcp.NewToolResultText(result), nil
+	}
+
+	return tool, handler
+}
+
+type TriageStatsParams struct {
+	WorkflowName string
+	Selector     string
+	Status       string
+	LastNDays    int
+}
+
+type TriageStatsGetter interface {
+	GetTriageStats(ctx context.Context, params TriageStatsParams) (string, error)
+}
+
+func GetTriageStats(client TriageStatsGetter) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	tool = mcp.NewTool("get_triage_stats",
+		mcp.WithDescription(GetTriageStatsDescription),
+		mcp.WithString("workflowName", mcp.Description(WorkflowNameDescription)),
+		mcp.WithString("selector", mcp.Description(SelectorDescription)),
+		mcp.WithString("status", mcp.Description(StatusDescription+". Defaults to 'failed,aborted'.")),
+		mcp.WithString("lastNDays", mcp.Description("Consider only the executions from the last days.")),
+	)
+
+	handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params := TriageStatsParams{
+			WorkflowName: request.GetString("workflowName", ""),
+			Selector:     request.GetString("selector", ""),
+			Status:       request.GetString("status", ""),
+		}
+		if lastStr := request.GetString("lastNDays", ""); lastStr != "" {
+			if last, err := strconv.Atoi(lastStr); err == nil && last > 0 {
+				params.LastNDays = last
+			}
+		}
+
+		result, err := client.GetTriageStats(ctx, params)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get triage stats: %v", err)), nil
+		}
+		return mcp.NewToolResultText(result), nil
+	}
+
+	return tool, handler
+}
+
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
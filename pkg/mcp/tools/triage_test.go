@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+type mockExecutionTriageUpdater struct {
+	capturedID      string
+	capturedRequest testkube.TestWorkflowExecutionTriageRequest
+}
+
+func (m *mockExecutionTriageUpdater) UpdateExecutionTriage(_ context.Context, id string, request testkube.TestWorkflowExecutionTriageRequest) (string, error) {
+	m.capturedID = id
+	m.capturedRequest = request
+	return `{"category":"infra"}`, nil
+}
+
+func TestUpdateExecutionTriage_OnlyProvidedFieldsChanged(t *testing.T) {
+	m := &mockExecutionTriageUpdater{}
+	_, handler := UpdateExecutionTriage(m)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"executionId": "abc123",
+		"category":    "infra",
+		"addIssues":   "https://github.com/org/repo/issues/1, https://github.com/org/repo/issues/2",
+	}
+
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "abc123", m.capturedID)
+	assert.Equal(t, testkube.TestWorkflowExecutionTriageRequest{
+		Category:  common.Ptr("infra"),
+		AddIssues: []string{"https://github.com/org/repo/issues/1", "https://github.com/org/repo/issues/2"},
+	}, m.capturedRequest)
+}
+
+func TestUpdateExecutionTriage_ClearAssignee(t *testing.T) {
+	m := &mockExecutionTriageUpdater{}
+	_, handler := UpdateExecutionTriage(m)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"executionId": "abc123",
+		"assignee":    "",
+	}
+
+	_, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, m.capturedRequest.Assignee)
+	assert.Empty(t, *m.capturedRequest.Assignee)
+	assert.Nil(t, m.capturedRequest.Category)
+}
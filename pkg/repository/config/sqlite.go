@@ -0,0 +1,108 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/telemetry"
+)
+
+// NewSQLiteConfig is a constructor for the config stored in the embedded SQLite database
+func NewSQLiteConfig(db *sqlitedb.DB) *SQLiteConfig {
+	return &SQLiteConfig{db: db}
+}
+
+// SQLiteConfig contains config stored in the embedded SQLite database
+type SQLiteConfig struct {
+	db *sqlitedb.DB
+
+	data *testkube.Config
+	mu   sync.Mutex
+}
+
+func (c *SQLiteConfig) getDefaultClusterId() string {
+	return fmt.Sprintf("cluster%s", telemetry.GetMachineID())
+}
+
+// GetUniqueClusterId gets unique cluster based ID
+func (c *SQLiteConfig) GetUniqueClusterId(_ context.Context) (clusterId string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.data == nil {
+		return "", errors.New("config not loaded yet")
+	}
+	return c.data.ClusterId, nil
+}
+
+// GetTelemetryEnabled get telemetry enabled
+func (c *SQLiteConfig) GetTelemetryEnabled(_ context.Context) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.data == nil {
+		return false, errors.New("config not loaded yet")
+	}
+	return c.data.EnableTelemetry, nil
+}
+
+// Get config
+func (c *SQLiteConfig) Get(_ context.Context) (result testkube.Config, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.data == nil {
+		return result, errors.New("config not loaded yet")
+	}
+	return *c.data, nil
+}
+
+func (c *SQLiteConfig) Load(ctx context.Context, defaultTelemetryEnabled bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := testkube.Config{}
+	err := c.db.QueryRowContext(ctx, "SELECT cluster_id, enable_telemetry FROM config WHERE id = 1").
+		Scan(&data.ClusterId, &data.EnableTelemetry)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(err, "reading config error")
+	}
+	c.data = &data
+
+	// Create new configuration if it doesn't exist
+	if c.data.ClusterId == "" {
+		c.data.ClusterId = c.getDefaultClusterId()
+		c.data.EnableTelemetry = defaultTelemetryEnabled
+		_, err := c.upsert(ctx, *c.data)
+		return err
+	}
+	return nil
+}
+
+func (c *SQLiteConfig) upsert(ctx context.Context, result testkube.Config) (updated testkube.Config, err error) {
+	data := &testkube.Config{
+		ClusterId:       result.ClusterId,
+		EnableTelemetry: result.EnableTelemetry,
+	}
+	if data.ClusterId == "" {
+		data.ClusterId = c.getDefaultClusterId()
+	}
+	_, err = c.db.ExecContext(ctx, `INSERT INTO config (id, cluster_id, enable_telemetry) VALUES (1, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET cluster_id = excluded.cluster_id, enable_telemetry = excluded.enable_telemetry`,
+		data.ClusterId, data.EnableTelemetry)
+	if err != nil {
+		return result, errors.Wrap(err, "writing config error")
+	}
+	c.data = data
+	return result, nil
+}
+
+// Upsert inserts record if not exists, updates otherwise
+func (c *SQLiteConfig) Upsert(ctx context.Context, result testkube.Config) (updated testkube.Config, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.upsert(ctx, result)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kubeshop/testkube/pkg/controlplane/scheduling"
 	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
 )
 
@@ -14,6 +15,7 @@ type DatabaseType string
 const (
 	DatabaseTypeMongoDB    DatabaseType = "mongodb"
 	DatabaseTypePostgreSQL DatabaseType = "postgresql"
+	DatabaseTypeSQLite     DatabaseType = "sqlite"
 )
 
 // RepositoryFactory defines the interface for creating repository instances
@@ -24,6 +26,9 @@ type RepositoryFactory interface {
 	// TestWorkflow Repository (Test Workflow Executions)
 	NewTestWorkflowRepository() testworkflow.Repository
 
+	// Execution Logs Search Index
+	NewLogSearchRepository() logsearch.Repository
+
 	// TestWorkflow Execution Scheduler
 	NewScheduler() scheduling.Scheduler
 
@@ -45,6 +50,9 @@ type DatabaseRepository interface {
 	// TestWorkflow Repository (Test Workflow Executions)
 	TestWorkflow() testworkflow.Repository
 
+	// Execution Logs Search Index
+	LogSearch() logsearch.Repository
+
 	// Utility methods
 	GetDatabaseType() DatabaseType
 	Close(ctx context.Context) error
@@ -16,3 +16,24 @@ type Repository interface {
 	// TryAcquire tries to acquire lease from underlying datastore
 	TryAcquire(ctx context.Context, id, clusterID string) (leased bool, err error)
 }
+
+// Lease is the state of the lease stored in the data source
+type Lease struct {
+	ID         string
+	Identifier string
+	ClusterID  string
+	AcquiredAt time.Time
+	RenewedAt  time.Time
+}
+
+// Exporter lists the stored leases, so they can be copied to another data source
+type Exporter interface {
+	// ListLeases lists all the leases
+	ListLeases(ctx context.Context) ([]Lease, error)
+}
+
+// Importer stores the leases copied from another data source
+type Importer interface {
+	// ImportLease stores the lease unless it already exists, so the current holder is not overridden
+	ImportLease(ctx context.Context, lease Lease) (imported bool, err error)
+}
@@ -28,6 +28,8 @@ func (b *AcquireAlwaysLeaseBackend) TryAcquire(ctx context.Context, id, clusterI
 	return true, nil
 }
 
+var _ leasebackend.Exporter = (*MongoLeaseBackend)(nil)
+
 type MongoLeaseBackend struct {
 	coll *mongo.Collection
 }
@@ -64,6 +66,30 @@ func (b *MongoLeaseBackend) TryAcquire(ctx context.Context, id, clusterID string
 	return acquired, nil
 }
 
+// ListLeases lists all the leases
+func (b *MongoLeaseBackend) ListLeases(ctx context.Context) ([]leasebackend.Lease, error) {
+	cursor, err := b.coll.Find(ctx, bson.M{"_id": bson.M{"$regex": "^" + documentType + "-"}})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing lease documents in mongo")
+	}
+	var leases []MongoLease
+	if err = cursor.All(ctx, &leases); err != nil {
+		return nil, errors.Wrap(err, "error decoding lease mongo documents")
+	}
+
+	result := make([]leasebackend.Lease, len(leases))
+	for i, lease := range leases {
+		result[i] = leasebackend.Lease{
+			ID:         lease.ID,
+			Identifier: lease.Identifier,
+			ClusterID:  lease.ClusterID,
+			AcquiredAt: lease.AcquiredAt,
+			RenewedAt:  lease.RenewedAt,
+		}
+	}
+	return result, nil
+}
+
 func (b *MongoLeaseBackend) findOrInsertCurrentLease(ctx context.Context, leaseMongoID, id, clusterID string) (*Lease, error) {
 	res := b.coll.FindOne(ctx, bson.M{"_id": leaseMongoID})
 	if res.Err() == mongo.ErrNoDocuments {
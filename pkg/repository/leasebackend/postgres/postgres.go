@@ -18,6 +18,8 @@ const (
 	documentType = "lease"
 )
 
+var _ leasebackend.Importer = (*PostgresLeaseBackend)(nil)
+
 type PostgresLeaseBackend struct {
 	db      sqlc.DatabaseInterface
 	queries sqlc.LeaseBackendQueriesInterface
@@ -90,6 +92,29 @@ func (b *PostgresLeaseBackend) TryAcquire(ctx context.Context, id, clusterID str
 	return acquired, nil
 }
 
+// ImportLease stores the lease unless it already exists, so the current holder is not overridden
+func (b *PostgresLeaseBackend) ImportLease(ctx context.Context, lease leasebackend.Lease) (bool, error) {
+	_, err := b.queries.FindLeaseById(ctx, lease.ID)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return false, fmt.Errorf("error finding lease: %w", err)
+	}
+
+	_, err = b.queries.InsertLease(ctx, sqlc.InsertLeaseParams{
+		ID:         lease.ID,
+		Identifier: lease.Identifier,
+		ClusterID:  lease.ClusterID,
+		AcquiredAt: toPgTimestamp(lease.AcquiredAt),
+		RenewedAt:  toPgTimestamp(lease.RenewedAt),
+	})
+	if err != nil {
+		return false, fmt.Errorf("error inserting lease: %w", err)
+	}
+	return true, nil
+}
+
 func (b *PostgresLeaseBackend) findOrInsertCurrentLease(ctx context.Context, leaseID, id, clusterID string) (*sqlc.Lease, error) {
 	lease, err := b.queries.FindLeaseById(ctx, leaseID)
 	if err != nil {
@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
+)
+
+const (
+	documentType = "lease"
+)
+
+var _ leasebackend.Repository = (*SQLiteLeaseBackend)(nil)
+var _ leasebackend.Exporter = (*SQLiteLeaseBackend)(nil)
+var _ leasebackend.Importer = (*SQLiteLeaseBackend)(nil)
+
+type SQLiteLeaseBackend struct {
+	db *database.DB
+}
+
+func NewSQLiteLeaseBackend(db *database.DB) *SQLiteLeaseBackend {
+	return &SQLiteLeaseBackend{db: db}
+}
+
+// TryAcquire tries to acquire a lease for the given identifier and cluster ID.
+// The check and the update are done in a single transaction, as SQLite has a single writer.
+func (b *SQLiteLeaseBackend) TryAcquire(ctx context.Context, id, clusterID string) (acquired bool, err error) {
+	leaseID := newLeaseID(clusterID)
+	err = b.db.Tx(ctx, func(tx *sql.Tx) error {
+		now := time.Now()
+		current, err := findLease(ctx, tx, leaseID)
+		if errors.Is(err, sql.ErrNoRows) {
+			_, err = tx.ExecContext(ctx, `INSERT INTO leases (id, identifier, cluster_id, acquired_at, renewed_at) VALUES (?, ?, ?, ?, ?)`,
+				leaseID, id, clusterID, now.UnixMilli(), now.UnixMilli())
+			if err != nil {
+				return fmt.Errorf("error inserting lease: %w", err)
+			}
+			acquired = true
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error finding lease: %w", err)
+		}
+
+		isMyLease := current.Identifier == id && current.ClusterID == clusterID
+		isLeaseExpired := current.RenewedAt.Before(now.Add(-leasebackend.DefaultMaxLeaseDuration))
+		if !isMyLease && !isLeaseExpired {
+			return nil
+		}
+		acquiredAt := current.AcquiredAt
+		if current.Identifier != id {
+			acquiredAt = now
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE leases SET identifier = ?, cluster_id = ?, acquired_at = ?, renewed_at = ? WHERE id = ?`,
+			id, clusterID, acquiredAt.UnixMilli(), now.UnixMilli(), leaseID)
+		if err != nil {
+			return fmt.Errorf("error updating lease: %w", err)
+		}
+		acquired = true
+		return nil
+	})
+	return acquired, err
+}
+
+// ListLeases lists all the leases
+func (b *SQLiteLeaseBackend) ListLeases(ctx context.Context) ([]leasebackend.Lease, error) {
+	rows, err := b.db.QueryContext(ctx, `SELECT id, identifier, cluster_id, acquired_at, renewed_at FROM leases ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("error listing leases: %w", err)
+	}
+	defer rows.Close()
+	result := make([]leasebackend.Lease, 0)
+	for rows.Next() {
+		lease, err := scanLease(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error listing leases: %w", err)
+		}
+		result = append(result, lease)
+	}
+	return result, rows.Err()
+}
+
+// ImportLease stores the lease unless it already exists, so the current holder is not overridden
+func (b *SQLiteLeaseBackend) ImportLease(ctx context.Context, lease leasebackend.Lease) (bool, error) {
+	res, err := b.db.ExecContext(ctx, `INSERT INTO leases (id, identifier, cluster_id, acquired_at, renewed_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO NOTHING`, lease.ID, lease.Identifier, lease.ClusterID, lease.AcquiredAt.UnixMilli(), lease.RenewedAt.UnixMilli())
+	if err != nil {
+		return false, fmt.Errorf("error inserting lease: %w", err)
+	}
+	count, err := res.RowsAffected()
+	return count > 0, err
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanLease(row scanner) (lease leasebackend.Lease, err error) {
+	var acquiredAt, renewedAt int64
+	err = row.Scan(&lease.ID, &lease.Identifier, &lease.ClusterID, &acquiredAt, &renewedAt)
+	lease.AcquiredAt = time.UnixMilli(acquiredAt)
+	lease.RenewedAt = time.UnixMilli(renewedAt)
+	return lease, err
+}
+
+func findLease(ctx context.Context, tx *sql.Tx, leaseID string) (leasebackend.Lease, error) {
+	return scanLease(tx.QueryRowContext(ctx, `SELECT id, identifier, cluster_id, acquired_at, renewed_at FROM leases WHERE id = ?`, leaseID))
+}
+
+func newLeaseID(clusterID string) string {
+	return fmt.Sprintf("%s-%s", documentType, clusterID)
+}
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
+)
+
+func newTestLeaseBackend(t *testing.T) *SQLiteLeaseBackend {
+	ctx := context.Background()
+	db, err := database.Open(ctx, database.MemoryPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Migrate(ctx)
+	require.NoError(t, err)
+	return NewSQLiteLeaseBackend(db)
+}
+
+func TestSQLiteLeaseBackend_TryAcquire(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestLeaseBackend(t)
+
+	acquired, err := backend.TryAcquire(ctx, "instance-1", "cluster")
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = backend.TryAcquire(ctx, "instance-2", "cluster")
+	require.NoError(t, err)
+	assert.False(t, acquired, "the lease is held by another instance")
+
+	acquired, err = backend.TryAcquire(ctx, "instance-1", "cluster")
+	require.NoError(t, err)
+	assert.True(t, acquired, "the holder renews the lease")
+
+	acquired, err = backend.TryAcquire(ctx, "instance-2", "other-cluster")
+	require.NoError(t, err)
+	assert.True(t, acquired, "the leases are separate per cluster")
+}
+
+func TestSQLiteLeaseBackend_TryAcquireExpired(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestLeaseBackend(t)
+
+	expiredAt := time.Now().Add(-2 * leasebackend.DefaultMaxLeaseDuration)
+	imported, err := backend.ImportLease(ctx, leasebackend.Lease{
+		ID:         newLeaseID("cluster"),
+		Identifier: "instance-1",
+		ClusterID:  "cluster",
+		AcquiredAt: expiredAt,
+		RenewedAt:  expiredAt,
+	})
+	require.NoError(t, err)
+	assert.True(t, imported)
+
+	acquired, err := backend.TryAcquire(ctx, "instance-2", "cluster")
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	leases, err := backend.ListLeases(ctx)
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	assert.Equal(t, "instance-2", leases[0].Identifier)
+	assert.True(t, leases[0].AcquiredAt.After(expiredAt))
+
+	imported, err = backend.ImportLease(ctx, leases[0])
+	require.NoError(t, err)
+	assert.False(t, imported, "the existing lease is not overridden")
+}
@@ -0,0 +1,48 @@
+package logsearch
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+const (
+	// MaxStepLogSize is the maximum size of a single indexed document, the larger step logs are split into several documents
+	MaxStepLogSize = 1024 * 1024
+	// DefaultLimit is the default number of the matched lines returned
+	DefaultLimit = 100
+	// DefaultContextLines is the default number of the lines returned before and after the matched line
+	DefaultContextLines = 2
+)
+
+// Document is the indexed log of a single step in the execution
+type Document struct {
+	ExecutionId   string
+	ExecutionName string
+	WorkflowName  string
+	StepRef       string
+	ScheduledAt   time.Time
+	// FirstLine is the number of the first line stored in the document, when the step log is split
+	FirstLine int
+	Content   string
+}
+
+// Filter selects the documents containing all the terms
+type Filter struct {
+	Terms         []string
+	WorkflowNames []string
+	Since         time.Time
+	Limit         int
+}
+
+//go:generate go tool mockgen -destination=./mock_repository.go -package=logsearch "github.com/kubeshop/testkube/pkg/repository/logsearch" Repository
+type Repository interface {
+	// Index replaces the indexed logs of the execution, storing the documents as they are yielded
+	Index(ctx context.Context, executionId string, documents iter.Seq2[Document, error]) error
+	// Find lists the documents containing all the terms, starting from the latest execution
+	Find(ctx context.Context, filter Filter) ([]Document, error)
+	// DeleteByTestWorkflows deletes the indexed logs of the test workflows
+	DeleteByTestWorkflows(ctx context.Context, workflowNames []string) error
+	// DeleteAll deletes all the indexed logs
+	DeleteAll(ctx context.Context) error
+}
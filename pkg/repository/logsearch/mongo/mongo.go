@@ -0,0 +1,129 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+)
+
+var _ logsearch.Repository = (*MongoRepository)(nil)
+
+const CollectionName = "testworkflowexecutionlogs"
+
+type MongoRepository struct {
+	Coll *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{
+		Coll: db.Collection(CollectionName),
+	}
+}
+
+type logDocument struct {
+	ExecutionId   string    `bson:"executionid"`
+	ExecutionName string    `bson:"executionname"`
+	WorkflowName  string    `bson:"workflowname"`
+	StepRef       string    `bson:"stepref"`
+	ScheduledAt   time.Time `bson:"scheduledat"`
+	Order         int       `bson:"order"`
+	FirstLine     int       `bson:"firstline"`
+	Content       string    `bson:"content"`
+	// Terms are the words of the content, tokenized by the application, and indexed with the text index
+	Terms string `bson:"terms"`
+}
+
+// Index replaces the indexed logs of the execution
+func (r *MongoRepository) Index(ctx context.Context, executionId string, documents iter.Seq2[logsearch.Document, error]) error {
+	if _, err := r.Coll.DeleteMany(ctx, bson.M{"executionid": executionId}); err != nil {
+		return fmt.Errorf("failed to delete previous execution logs: %w", err)
+	}
+	order := 0
+	for document, err := range documents {
+		if err != nil {
+			return fmt.Errorf("failed to read execution logs: %w", err)
+		}
+		_, err = r.Coll.InsertOne(ctx, logDocument{
+			ExecutionId:   executionId,
+			ExecutionName: document.ExecutionName,
+			WorkflowName:  document.WorkflowName,
+			StepRef:       document.StepRef,
+			ScheduledAt:   document.ScheduledAt,
+			Order:         order,
+			FirstLine:     document.FirstLine,
+			Content:       document.Content,
+			Terms:         strings.Join(logsearch.Terms(document.Content), " "),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to index execution logs: %w", err)
+		}
+		order++
+	}
+	return nil
+}
+
+// Find lists the documents containing all the terms, starting from the latest execution
+func (r *MongoRepository) Find(ctx context.Context, filter logsearch.Filter) ([]logsearch.Document, error) {
+	// The quoted phrases are matched all together, while the separate words would match any of them
+	search := make([]string, len(filter.Terms))
+	for i := range filter.Terms {
+		search[i] = `"` + filter.Terms[i] + `"`
+	}
+	query := bson.M{"$text": bson.M{"$search": strings.Join(search, " ")}}
+	if len(filter.WorkflowNames) > 0 {
+		query["workflowname"] = bson.M{"$in": filter.WorkflowNames}
+	}
+	if !filter.Since.IsZero() {
+		query["scheduledat"] = bson.M{"$gte": filter.Since}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "scheduledat", Value: -1}, {Key: "executionid", Value: 1}, {Key: "order", Value: 1}}).
+		SetProjection(bson.M{"terms": 0})
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	cursor, err := r.Coll.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search execution logs: %w", err)
+	}
+	var docs []logDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to search execution logs: %w", err)
+	}
+	result := make([]logsearch.Document, len(docs))
+	for i, doc := range docs {
+		result[i] = logsearch.Document{
+			ExecutionId:   doc.ExecutionId,
+			ExecutionName: doc.ExecutionName,
+			WorkflowName:  doc.WorkflowName,
+			StepRef:       doc.StepRef,
+			ScheduledAt:   doc.ScheduledAt,
+			FirstLine:     doc.FirstLine,
+			Content:       doc.Content,
+		}
+	}
+	return result, nil
+}
+
+// DeleteByTestWorkflows deletes the indexed logs of the test workflows
+func (r *MongoRepository) DeleteByTestWorkflows(ctx context.Context, workflowNames []string) error {
+	if len(workflowNames) == 0 {
+		return nil
+	}
+	_, err := r.Coll.DeleteMany(ctx, bson.M{"workflowname": bson.M{"$in": workflowNames}})
+	return err
+}
+
+// DeleteAll deletes all the indexed logs
+func (r *MongoRepository) DeleteAll(ctx context.Context) error {
+	_, err := r.Coll.DeleteMany(ctx, bson.M{})
+	return err
+}
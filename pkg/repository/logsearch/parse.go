@@ -0,0 +1,169 @@
+package logsearch
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"iter"
+	"strings"
+	"unicode"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/instructions"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// timestampTPosition is the position of the 'T' separator in the RFC3339 timestamp prefixing each line
+const timestampTPosition = 10
+
+// maxTimestampSize is the maximum size of the RFC3339 timestamp prefixing each line
+const maxTimestampSize = 64
+
+// ParseDocuments reads the execution log, and yields the documents for its steps as soon as they are complete,
+// so the log is never kept in memory as a whole. The timestamps and the instructions are dropped,
+// and the lines before the first start hint belong to the initialization, with an empty reference.
+// The step logs larger than MaxStepLogSize are split into several documents, skipping the empty ones,
+// and the lines larger than MaxStepLogSize on their own are cut to it.
+func ParseDocuments(r io.Reader, execution testkube.TestWorkflowExecution) iter.Seq2[Document, error] {
+	return func(yield func(Document, error) bool) {
+		chunk := documentChunk{execution: execution, nextLine: 1}
+		reader := bufio.NewReaderSize(r, 64*1024)
+		for {
+			line, err := readLine(reader, MaxStepLogSize+maxTimestampSize)
+			if len(line) > 0 {
+				line = trimTimestamp(strings.TrimRight(line, "\r\n"))
+				start := instructions.StartHintRe.FindStringSubmatch(line)
+				instruction, _, _ := instructions.DetectInstruction([]byte(line))
+				if len(start) == 0 && instruction == nil {
+					if len(line) > MaxStepLogSize {
+						line = line[:MaxStepLogSize]
+					}
+					if chunk.size > 0 && chunk.size+len(line)+1 > MaxStepLogSize {
+						if !chunk.flush(yield) {
+							return
+						}
+					}
+					chunk.add(line)
+				} else {
+					// The instructions are printed after an additional line break
+					chunk.dropTrailingEmptyLine()
+				}
+				if len(start) > 0 {
+					if !chunk.flush(yield) {
+						return
+					}
+					chunk.stepRef = start[1]
+					chunk.nextLine = 1
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				chunk.flush(yield)
+				return
+			} else if err != nil {
+				yield(Document{}, err)
+				return
+			}
+		}
+	}
+}
+
+// readLine reads the next line, dropping its part above the limit, so the huge lines are not kept in memory
+func readLine(reader *bufio.Reader, limit int) (string, error) {
+	var line []byte
+	for {
+		part, err := reader.ReadSlice('\n')
+		if len(line) < limit {
+			line = append(line, part[:min(len(part), limit-len(line))]...)
+		}
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			return string(line), err
+		}
+	}
+}
+
+// documentChunk collects the consecutive lines of the step, that are stored in a single document
+type documentChunk struct {
+	execution testkube.TestWorkflowExecution
+	stepRef   string
+	firstLine int
+	nextLine  int
+	lines     []string
+	size      int
+}
+
+func (c *documentChunk) add(line string) {
+	if len(c.lines) == 0 {
+		c.firstLine = c.nextLine
+	}
+	c.lines = append(c.lines, line)
+	c.size += len(line) + 1
+	c.nextLine++
+}
+
+func (c *documentChunk) dropTrailingEmptyLine() {
+	if n := len(c.lines); n > 0 && c.lines[n-1] == "" {
+		c.lines = c.lines[:n-1]
+		c.size--
+		c.nextLine--
+	}
+}
+
+// flush yields the document with the collected lines unless they are empty, and starts the next chunk
+func (c *documentChunk) flush(yield func(Document, error) bool) bool {
+	content := strings.Join(c.lines, "\n")
+	c.lines = nil
+	c.size = 0
+	if strings.TrimSpace(content) == "" {
+		return true
+	}
+	workflowName := ""
+	if c.execution.Workflow != nil {
+		workflowName = c.execution.Workflow.Name
+	}
+	return yield(Document{
+		ExecutionId:   c.execution.Id,
+		ExecutionName: c.execution.Name,
+		WorkflowName:  workflowName,
+		StepRef:       c.stepRef,
+		ScheduledAt:   c.execution.ScheduledAt,
+		FirstLine:     c.firstLine,
+		Content:       content,
+	}, nil)
+}
+
+// Documents yields the provided documents
+func Documents(documents ...Document) iter.Seq2[Document, error] {
+	return func(yield func(Document, error) bool) {
+		for _, document := range documents {
+			if !yield(document, nil) {
+				return
+			}
+		}
+	}
+}
+
+// trimTimestamp drops the RFC3339 timestamp prefixing the line
+func trimTimestamp(line string) string {
+	if strings.Index(line, "T") != timestampTPosition {
+		return line
+	}
+	if space := strings.Index(line, " "); space > 0 {
+		return line[space+1:]
+	}
+	return line
+}
+
+// Terms splits the text into the unique lower-case words, that are indexed and searched
+func Terms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]struct{}, len(words))
+	result := make([]string, 0, len(words))
+	for _, word := range words {
+		if _, ok := seen[word]; !ok {
+			seen[word] = struct{}{}
+			result = append(result, word)
+		}
+	}
+	return result
+}
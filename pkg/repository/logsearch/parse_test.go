@@ -0,0 +1,112 @@
+package logsearch
+
+import (
+	"iter"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/instructions"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func timestamped(text string) string {
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for i := range lines {
+		lines[i] = "2026-10-19T10:00:00.000000000Z " + lines[i]
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func collect(t *testing.T, documents iter.Seq2[Document, error]) []Document {
+	var result []Document
+	for document, err := range documents {
+		require.NoError(t, err)
+		result = append(result, document)
+	}
+	return result
+}
+
+func TestParseDocuments(t *testing.T) {
+	execution := testkube.TestWorkflowExecution{
+		Id:          "exec-1",
+		Name:        "api-tests-1",
+		Workflow:    &testkube.TestWorkflow{Name: "api-tests"},
+		ScheduledAt: time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+	}
+	log := timestamped("Initializing\n" +
+		instructions.SprintHint("rstep1", "start") +
+		"npm test\n" +
+		instructions.SprintOutput("rstep1", "status", "passed") +
+		"Error: read ECONNRESET\n" +
+		instructions.SprintHint("rstep2", "start") +
+		" \n" +
+		instructions.SprintHint("rstep3", "start") +
+		"done")
+
+	documents := collect(t, ParseDocuments(strings.NewReader(log), execution))
+	require.Len(t, documents, 3)
+	assert.Equal(t, Document{
+		ExecutionId:   "exec-1",
+		ExecutionName: "api-tests-1",
+		WorkflowName:  "api-tests",
+		ScheduledAt:   execution.ScheduledAt,
+		FirstLine:     1,
+		Content:       "Initializing",
+	}, documents[0])
+	assert.Equal(t, "rstep1", documents[1].StepRef)
+	assert.Equal(t, 1, documents[1].FirstLine)
+	assert.Equal(t, "npm test\nError: read ECONNRESET", documents[1].Content)
+	assert.Equal(t, "rstep3", documents[2].StepRef)
+	assert.Equal(t, "done", documents[2].Content)
+}
+
+func TestTerms(t *testing.T) {
+	assert.Equal(t, []string{"error", "read", "econnreset", "127", "0", "1"}, Terms("Error: read ECONNRESET 127.0.0.1 error"))
+	assert.Empty(t, Terms(" -- "))
+}
+
+func TestParseDocuments_Split(t *testing.T) {
+	execution := testkube.TestWorkflowExecution{Id: "exec-1"}
+	long := strings.Repeat("x", MaxStepLogSize/2)
+	log := instructions.SprintHint("rstep1", "start") + long + "\n" + long + "\ntail\n" +
+		instructions.SprintHint("rstep2", "start") + "head\n" + long + long + long + "\n"
+
+	documents := collect(t, ParseDocuments(strings.NewReader(log), execution))
+	require.Len(t, documents, 4)
+	assert.Equal(t, "rstep1", documents[0].StepRef)
+	assert.Equal(t, 1, documents[0].FirstLine)
+	assert.Equal(t, long, documents[0].Content)
+	assert.Equal(t, "rstep1", documents[1].StepRef)
+	assert.Equal(t, 2, documents[1].FirstLine)
+	assert.Equal(t, long+"\ntail", documents[1].Content)
+	assert.Equal(t, "head", documents[2].Content)
+	assert.Equal(t, 2, documents[3].FirstLine)
+	assert.Len(t, documents[3].Content, MaxStepLogSize)
+
+	// The documents are read only when they are consumed
+	for range ParseDocuments(strings.NewReader(log), execution) {
+		break
+	}
+}
+
+func TestMatchLines(t *testing.T) {
+	document := Document{
+		ExecutionId: "exec-1",
+		StepRef:     "rstep1",
+		FirstLine:   10,
+		Content:     "a\nb\nError: read ECONNRESET\nc\nd\ne",
+	}
+
+	matches := MatchLines(document, []string{"econnreset", "read"}, 2)
+	require.Len(t, matches, 1)
+	assert.Equal(t, int32(12), matches[0].Line)
+	assert.Equal(t, "Error: read ECONNRESET", matches[0].Text)
+	assert.Equal(t, []string{"a", "b"}, matches[0].Before)
+	assert.Equal(t, []string{"c", "d"}, matches[0].After)
+
+	assert.Empty(t, MatchLines(document, []string{"econnrese"}, 2))
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/kubeshop/testkube/pkg/database/postgres/sqlc"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+)
+
+var _ logsearch.Repository = (*PostgresRepository)(nil)
+
+type PostgresRepository struct {
+	db             *pgxpool.Pool
+	queries        *sqlc.Queries
+	organizationID string
+	environmentID  string
+}
+
+type PostgresRepositoryOpt func(*PostgresRepository)
+
+func NewPostgresRepository(db *pgxpool.Pool, opts ...PostgresRepositoryOpt) *PostgresRepository {
+	r := &PostgresRepository{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// WithOrganizationID allows injecting organization id to support control panel
+func WithOrganizationID(organizationID string) PostgresRepositoryOpt {
+	return func(r *PostgresRepository) {
+		r.organizationID = organizationID
+	}
+}
+
+// WithEnvironmentID allows injecting environment id to support control panel
+func WithEnvironmentID(environmentID string) PostgresRepositoryOpt {
+	return func(r *PostgresRepository) {
+		r.environmentID = environmentID
+	}
+}
+
+func toPgTimestamp(t time.Time) pgtype.Timestamptz {
+	if t.IsZero() {
+		return pgtype.Timestamptz{Valid: false}
+	}
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+// Index replaces the indexed logs of the execution
+func (r *PostgresRepository) Index(ctx context.Context, executionId string, documents iter.Seq2[logsearch.Document, error]) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	err = qtx.DeleteTestWorkflowExecutionLogs(ctx, sqlc.DeleteTestWorkflowExecutionLogsParams{
+		ExecutionID:    executionId,
+		OrganizationID: r.organizationID,
+		EnvironmentID:  r.environmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete indexed execution logs: %w", err)
+	}
+	order := int32(0)
+	for document, err := range documents {
+		if err != nil {
+			return fmt.Errorf("failed to read execution logs: %w", err)
+		}
+		err = qtx.InsertTestWorkflowExecutionLog(ctx, sqlc.InsertTestWorkflowExecutionLogParams{
+			ExecutionID:    executionId,
+			ExecutionName:  document.ExecutionName,
+			WorkflowName:   document.WorkflowName,
+			StepRef:        document.StepRef,
+			StepOrder:      order,
+			ScheduledAt:    toPgTimestamp(document.ScheduledAt),
+			FirstLine:      int32(document.FirstLine),
+			Content:        document.Content,
+			Terms:          strings.Join(logsearch.Terms(document.Content), " "),
+			OrganizationID: r.organizationID,
+			EnvironmentID:  r.environmentID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to index execution logs: %w", err)
+		}
+		order++
+	}
+	return tx.Commit(ctx)
+}
+
+// Find lists the documents containing all the terms, starting from the latest execution
+func (r *PostgresRepository) Find(ctx context.Context, filter logsearch.Filter) ([]logsearch.Document, error) {
+	rows, err := r.queries.SearchTestWorkflowExecutionLogs(ctx, sqlc.SearchTestWorkflowExecutionLogsParams{
+		Query:          strings.Join(filter.Terms, " "),
+		OrganizationID: r.organizationID,
+		EnvironmentID:  r.environmentID,
+		WorkflowNames:  filter.WorkflowNames,
+		Since:          toPgTimestamp(filter.Since),
+		Lmt:            int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search execution logs: %w", err)
+	}
+	result := make([]logsearch.Document, len(rows))
+	for i, row := range rows {
+		result[i] = logsearch.Document{
+			ExecutionId:   row.ExecutionID,
+			ExecutionName: row.ExecutionName,
+			WorkflowName:  row.WorkflowName,
+			StepRef:       row.StepRef,
+			ScheduledAt:   row.ScheduledAt.Time,
+			FirstLine:     int(row.FirstLine),
+			Content:       row.Content,
+		}
+	}
+	return result, nil
+}
+
+// DeleteByTestWorkflows deletes the indexed logs of the test workflows
+func (r *PostgresRepository) DeleteByTestWorkflows(ctx context.Context, workflowNames []string) error {
+	if len(workflowNames) == 0 {
+		return nil
+	}
+	return r.queries.DeleteTestWorkflowExecutionLogsByTestWorkflows(ctx, sqlc.DeleteTestWorkflowExecutionLogsByTestWorkflowsParams{
+		WorkflowNames:  workflowNames,
+		OrganizationID: r.organizationID,
+		EnvironmentID:  r.environmentID,
+	})
+}
+
+// DeleteAll deletes all the indexed logs
+func (r *PostgresRepository) DeleteAll(ctx context.Context) error {
+	return r.queries.DeleteAllTestWorkflowExecutionLogs(ctx, sqlc.DeleteAllTestWorkflowExecutionLogsParams{
+		OrganizationID: r.organizationID,
+		EnvironmentID:  r.environmentID,
+	})
+}
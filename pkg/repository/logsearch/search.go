@@ -0,0 +1,97 @@
+package logsearch
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+var ErrEmptyQuery = errors.New("the search query must contain at least one word")
+
+// SearchOptions describes the lines to find in the indexed logs
+type SearchOptions struct {
+	Query         string
+	WorkflowNames []string
+	Since         time.Time
+	Limit         int
+	ContextLines  int
+}
+
+// Search finds the lines containing all the words of the query, starting from the latest execution
+func Search(ctx context.Context, repository Repository, options SearchOptions) ([]testkube.TestWorkflowExecutionLogMatch, error) {
+	terms := Terms(options.Query)
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+	limit := options.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	documents, err := repository.Find(ctx, Filter{
+		Terms:         terms,
+		WorkflowNames: options.WorkflowNames,
+		Since:         options.Since,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]testkube.TestWorkflowExecutionLogMatch, 0)
+	for _, document := range documents {
+		for _, match := range MatchLines(document, terms, options.ContextLines) {
+			if len(result) == limit {
+				return result, nil
+			}
+			result = append(result, match)
+		}
+	}
+	return result, nil
+}
+
+// MatchLines finds the lines of the document containing all the terms
+func MatchLines(document Document, terms []string, contextLines int) []testkube.TestWorkflowExecutionLogMatch {
+	if contextLines < 0 {
+		contextLines = 0
+	}
+	lines := strings.Split(document.Content, "\n")
+	result := make([]testkube.TestWorkflowExecutionLogMatch, 0)
+	for i, line := range lines {
+		if !containsTerms(line, terms) {
+			continue
+		}
+		result = append(result, testkube.TestWorkflowExecutionLogMatch{
+			ExecutionId:   document.ExecutionId,
+			ExecutionName: document.ExecutionName,
+			WorkflowName:  document.WorkflowName,
+			ScheduledAt:   document.ScheduledAt,
+			Step:          document.StepRef,
+			Line:          int32(document.FirstLine + i),
+			Text:          line,
+			Before:        lines[max(0, i-contextLines):i],
+			After:         lines[i+1 : min(len(lines), i+1+contextLines)],
+		})
+	}
+	return result
+}
+
+func containsTerms(line string, terms []string) bool {
+	words := Terms(line)
+	for _, term := range terms {
+		found := false
+		for _, word := range words {
+			if word == term {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"iter"
+	"strings"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+)
+
+var _ logsearch.Repository = (*SQLiteRepository)(nil)
+
+type SQLiteRepository struct {
+	db *database.DB
+}
+
+func NewSQLiteRepository(db *database.DB) *SQLiteRepository {
+	return &SQLiteRepository{db: db}
+}
+
+func deleteLogs(ctx context.Context, tx *sql.Tx, where string, args ...any) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM test_workflow_execution_log_terms
+		WHERE rowid IN (SELECT id FROM test_workflow_execution_logs WHERE `+where+`)`, args...)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM test_workflow_execution_logs WHERE `+where, args...)
+	return err
+}
+
+// Index replaces the indexed logs of the execution
+func (r *SQLiteRepository) Index(ctx context.Context, executionId string, documents iter.Seq2[logsearch.Document, error]) error {
+	err := r.db.Tx(ctx, func(tx *sql.Tx) error {
+		if err := deleteLogs(ctx, tx, "execution_id = ?", executionId); err != nil {
+			return err
+		}
+		for document, err := range documents {
+			if err != nil {
+				return err
+			}
+			res, err := tx.ExecContext(ctx, `INSERT INTO test_workflow_execution_logs
+				(execution_id, execution_name, workflow_name, step_ref, scheduled_at, first_line, content)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				executionId, document.ExecutionName, document.WorkflowName, document.StepRef,
+				database.Millis(document.ScheduledAt), document.FirstLine, document.Content)
+			if err != nil {
+				return err
+			}
+			id, err := res.LastInsertId()
+			if err != nil {
+				return err
+			}
+			terms := strings.Join(logsearch.Terms(document.Content), " ")
+			if _, err = tx.ExecContext(ctx, `INSERT INTO test_workflow_execution_log_terms (rowid, terms) VALUES (?, ?)`, id, terms); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to index execution logs: %w", err)
+	}
+	return nil
+}
+
+// Find lists the documents containing all the terms, starting from the latest execution
+func (r *SQLiteRepository) Find(ctx context.Context, filter logsearch.Filter) ([]logsearch.Document, error) {
+	// The terms contain only letters and digits, and the quoted terms are matched all together
+	match := make([]string, len(filter.Terms))
+	for i := range filter.Terms {
+		match[i] = `"` + filter.Terms[i] + `"`
+	}
+	q := database.Query{
+		Where:   "t.terms MATCH ?",
+		Args:    []any{strings.Join(match, " ")},
+		OrderBy: "l.scheduled_at DESC, l.id ASC",
+		Limit:   filter.Limit,
+	}
+	if len(filter.WorkflowNames) > 0 {
+		placeholders, args := database.Placeholders(filter.WorkflowNames)
+		q.Where += " AND l.workflow_name IN (" + placeholders + ")"
+		q.Args = append(q.Args, args...)
+	}
+	if !filter.Since.IsZero() {
+		q.Where += " AND l.scheduled_at >= ?"
+		q.Args = append(q.Args, filter.Since.UnixMilli())
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT l.execution_id, l.execution_name, l.workflow_name, l.step_ref, l.scheduled_at, l.first_line, l.content
+		FROM test_workflow_execution_log_terms t JOIN test_workflow_execution_logs l ON l.id = t.rowid`+q.String(), q.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search execution logs: %w", err)
+	}
+	defer rows.Close()
+	result := make([]logsearch.Document, 0)
+	for rows.Next() {
+		var document logsearch.Document
+		var scheduledAt sql.NullInt64
+		err = rows.Scan(&document.ExecutionId, &document.ExecutionName, &document.WorkflowName, &document.StepRef,
+			&scheduledAt, &document.FirstLine, &document.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search execution logs: %w", err)
+		}
+		document.ScheduledAt = database.FromMillis(scheduledAt)
+		result = append(result, document)
+	}
+	return result, rows.Err()
+}
+
+// DeleteByTestWorkflows deletes the indexed logs of the test workflows
+func (r *SQLiteRepository) DeleteByTestWorkflows(ctx context.Context, workflowNames []string) error {
+	if len(workflowNames) == 0 {
+		return nil
+	}
+	placeholders, args := database.Placeholders(workflowNames)
+	return r.db.Tx(ctx, func(tx *sql.Tx) error {
+		return deleteLogs(ctx, tx, "workflow_name IN ("+placeholders+")", args...)
+	})
+}
+
+// DeleteAll deletes all the indexed logs
+func (r *SQLiteRepository) DeleteAll(ctx context.Context) error {
+	return r.db.Tx(ctx, func(tx *sql.Tx) error {
+		return deleteLogs(ctx, tx, "1 = 1")
+	})
+}
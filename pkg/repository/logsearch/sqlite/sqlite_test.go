@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+)
+
+func newTestRepository(t *testing.T) *SQLiteRepository {
+	ctx := context.Background()
+	db, err := database.Open(ctx, database.MemoryPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Migrate(ctx)
+	require.NoError(t, err)
+	return NewSQLiteRepository(db)
+}
+
+func document(executionId, workflowName string, scheduledAt time.Time, content string) logsearch.Document {
+	return logsearch.Document{
+		ExecutionId:   executionId,
+		ExecutionName: executionId,
+		WorkflowName:  workflowName,
+		StepRef:       "rstep1",
+		ScheduledAt:   scheduledAt,
+		FirstLine:     1,
+		Content:       content,
+	}
+}
+
+func TestSQLiteRepository_Search(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	now := time.Now().Truncate(time.Millisecond)
+
+	require.NoError(t, repo.Index(ctx, "exec-1", logsearch.Documents(
+		document("exec-1", "api-tests", now.Add(-10*24*time.Hour), "Error: read ECONNRESET"),
+	)))
+	require.NoError(t, repo.Index(ctx, "exec-2", logsearch.Documents(
+		document("exec-2", "api-tests", now.Add(-time.Hour), "starting\nError: read ECONNRESET\ndone"),
+	)))
+	require.NoError(t, repo.Index(ctx, "exec-3", logsearch.Documents(
+		document("exec-3", "ui-tests", now, "read econnreset"),
+	)))
+
+	matches, err := logsearch.Search(ctx, repo, logsearch.SearchOptions{Query: "ECONNRESET read", ContextLines: 1})
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+	assert.Equal(t, "exec-3", matches[0].ExecutionId)
+	assert.Equal(t, "exec-2", matches[1].ExecutionId)
+	assert.Equal(t, int32(2), matches[1].Line)
+	assert.Equal(t, []string{"starting"}, matches[1].Before)
+	assert.Equal(t, []string{"done"}, matches[1].After)
+	assert.Equal(t, now.Add(-time.Hour), matches[1].ScheduledAt)
+
+	matches, err = logsearch.Search(ctx, repo, logsearch.SearchOptions{
+		Query:         "econnreset",
+		WorkflowNames: []string{"api-tests"},
+		Since:         now.Add(-7 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "exec-2", matches[0].ExecutionId)
+
+	_, err = logsearch.Search(ctx, repo, logsearch.SearchOptions{Query: " : "})
+	assert.ErrorIs(t, err, logsearch.ErrEmptyQuery)
+}
+
+func TestSQLiteRepository_Reindex(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	now := time.Now()
+
+	require.NoError(t, repo.Index(ctx, "exec-1", logsearch.Documents(document("exec-1", "api-tests", now, "first attempt"))))
+	require.NoError(t, repo.Index(ctx, "exec-1", logsearch.Documents(document("exec-1", "api-tests", now, "second attempt"))))
+
+	documents, err := repo.Find(ctx, logsearch.Filter{Terms: []string{"first"}})
+	require.NoError(t, err)
+	assert.Empty(t, documents)
+	documents, err = repo.Find(ctx, logsearch.Filter{Terms: []string{"attempt"}})
+	require.NoError(t, err)
+	assert.Len(t, documents, 1)
+}
+
+func TestSQLiteRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	now := time.Now()
+
+	require.NoError(t, repo.Index(ctx, "exec-1", logsearch.Documents(document("exec-1", "api-tests", now, "timeout"))))
+	require.NoError(t, repo.Index(ctx, "exec-2", logsearch.Documents(document("exec-2", "ui-tests", now, "timeout"))))
+
+	require.NoError(t, repo.DeleteByTestWorkflows(ctx, []string{"api-tests"}))
+	documents, err := repo.Find(ctx, logsearch.Filter{Terms: []string{"timeout"}})
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+	assert.Equal(t, "exec-2", documents[0].ExecutionId)
+
+	require.NoError(t, repo.DeleteAll(ctx))
+	documents, err = repo.Find(ctx, logsearch.Filter{Terms: []string{"timeout"}})
+	require.NoError(t, err)
+	assert.Empty(t, documents)
+}
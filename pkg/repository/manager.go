@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
 )
 
@@ -28,6 +29,10 @@ func (rm *RepositoryManager) TestWorkflow() testworkflow.Repository {
 	return rm.factory.NewTestWorkflowRepository()
 }
 
+func (rm *RepositoryManager) LogSearch() logsearch.Repository {
+	return rm.factory.NewLogSearchRepository()
+}
+
 func (rm *RepositoryManager) GetDatabaseType() DatabaseType {
 	return rm.factory.GetDatabaseType()
 }
@@ -45,6 +50,7 @@ type FactoryBuilder struct {
 	databaseType DatabaseType
 	mongoConfig  *MongoDBFactoryConfig
 	pgConfig     *PostgreSQLFactoryConfig
+	sqliteConfig *SQLiteFactoryConfig
 }
 
 func NewFactoryBuilder() *FactoryBuilder {
@@ -63,6 +69,12 @@ func (b *FactoryBuilder) WithPostgreSQL(config PostgreSQLFactoryConfig) *Factory
 	return b
 }
 
+func (b *FactoryBuilder) WithSQLite(config SQLiteFactoryConfig) *FactoryBuilder {
+	b.databaseType = DatabaseTypeSQLite
+	b.sqliteConfig = &config
+	return b
+}
+
 func (b *FactoryBuilder) Build() (RepositoryFactory, error) {
 	switch b.databaseType {
 	case DatabaseTypeMongoDB:
@@ -75,6 +87,11 @@ func (b *FactoryBuilder) Build() (RepositoryFactory, error) {
 			return nil, errors.New("PostgreSQL configuration is required")
 		}
 		return NewPostgreSQLFactory(*b.pgConfig), nil
+	case DatabaseTypeSQLite:
+		if b.sqliteConfig == nil {
+			return nil, errors.New("SQLite configuration is required")
+		}
+		return NewSQLiteFactory(*b.sqliteConfig), nil
 	default:
 		return nil, fmt.Errorf("unsupported database type: %s", b.databaseType)
 	}
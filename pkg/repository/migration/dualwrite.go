@@ -0,0 +1,198 @@
+package migration
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/controlplane/scheduling"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+)
+
+var _ testworkflow.Repository = (*DualWriteRepository)(nil)
+
+// DualWriteRepository keeps the primary repository as the source of truth,
+// and mirrors every changed execution to the secondary one during the migration window.
+// The scheduler and the execution controller write to the primary database directly,
+// so they have to be wrapped with Scheduler and Controller to be mirrored too.
+// The mirroring failures are only logged, the next migration pass repairs them.
+type DualWriteRepository struct {
+	testworkflow.Repository
+	secondary testworkflow.Repository
+	logger    *zap.SugaredLogger
+}
+
+func NewDualWriteRepository(primary, secondary testworkflow.Repository, logger *zap.SugaredLogger) *DualWriteRepository {
+	return &DualWriteRepository{
+		Repository: primary,
+		secondary:  secondary,
+		logger:     logger,
+	}
+}
+
+// mirror copies the current state of the execution from the primary repository
+func (r *DualWriteRepository) mirror(ctx context.Context, id string) {
+	execution, err := r.Repository.Get(ctx, id)
+	if err == nil {
+		err = upsert(ctx, r.secondary, execution)
+	}
+	if err != nil {
+		r.logger.Warnw("failed to mirror the execution to the secondary database", "id", id, "error", err)
+	}
+}
+
+func (r *DualWriteRepository) mirrorIf(ctx context.Context, id string, changed bool, err error) (bool, error) {
+	if err == nil && changed {
+		r.mirror(ctx, id)
+	}
+	return changed, err
+}
+
+func (r *DualWriteRepository) mirrorErr(ctx context.Context, id string, err error) error {
+	_, err = r.mirrorIf(ctx, id, true, err)
+	return err
+}
+
+func (r *DualWriteRepository) Insert(ctx context.Context, result testkube.TestWorkflowExecution) error {
+	return r.mirrorErr(ctx, result.Id, r.Repository.Insert(ctx, result))
+}
+
+func (r *DualWriteRepository) Update(ctx context.Context, result testkube.TestWorkflowExecution) error {
+	return r.mirrorErr(ctx, result.Id, r.Repository.Update(ctx, result))
+}
+
+func (r *DualWriteRepository) UpdateResult(ctx context.Context, id string, result *testkube.TestWorkflowResult) error {
+	return r.mirrorErr(ctx, id, r.Repository.UpdateResult(ctx, id, result))
+}
+
+func (r *DualWriteRepository) UpdateResultStrict(ctx context.Context, id, runnerId string, result *testkube.TestWorkflowResult) (bool, error) {
+	updated, err := r.Repository.UpdateResultStrict(ctx, id, runnerId, result)
+	return r.mirrorIf(ctx, id, updated, err)
+}
+
+func (r *DualWriteRepository) FinishResultStrict(ctx context.Context, id, runnerId string, result *testkube.TestWorkflowResult) (bool, error) {
+	updated, err := r.Repository.FinishResultStrict(ctx, id, runnerId, result)
+	return r.mirrorIf(ctx, id, updated, err)
+}
+
+func (r *DualWriteRepository) UpdateReport(ctx context.Context, id string, report *testkube.TestWorkflowReport) error {
+	return r.mirrorErr(ctx, id, r.Repository.UpdateReport(ctx, id, report))
+}
+
+func (r *DualWriteRepository) UpdateOutput(ctx context.Context, id string, output []testkube.TestWorkflowOutput) error {
+	return r.mirrorErr(ctx, id, r.Repository.UpdateOutput(ctx, id, output))
+}
+
+func (r *DualWriteRepository) UpdateResourceAggregations(ctx context.Context, id string, resourceAggregations *testkube.TestWorkflowExecutionResourceAggregationsReport) error {
+	return r.mirrorErr(ctx, id, r.Repository.UpdateResourceAggregations(ctx, id, resourceAggregations))
+}
+
+func (r *DualWriteRepository) AddApproval(ctx context.Context, id string, approval testkube.TestWorkflowApproval) (bool, error) {
+	added, err := r.Repository.AddApproval(ctx, id, approval)
+	return r.mirrorIf(ctx, id, added, err)
+}
+
+func (r *DualWriteRepository) UpdateTags(ctx context.Context, id string, tags map[string]string) error {
+	return r.mirrorErr(ctx, id, r.Repository.UpdateTags(ctx, id, tags))
+}
+
+func (r *DualWriteRepository) UpdateTriage(ctx context.Context, id string, previous, triage *testkube.TestWorkflowExecutionTriage) (bool, error) {
+	updated, err := r.Repository.UpdateTriage(ctx, id, previous, triage)
+	return r.mirrorIf(ctx, id, updated, err)
+}
+
+func (r *DualWriteRepository) Init(ctx context.Context, id string, data testworkflow.InitData) error {
+	return r.mirrorErr(ctx, id, r.Repository.Init(ctx, id, data))
+}
+
+func (r *DualWriteRepository) Assign(ctx context.Context, id string, prevRunnerId string, newRunnerId string, assignedAt *time.Time) (bool, error) {
+	assigned, err := r.Repository.Assign(ctx, id, prevRunnerId, newRunnerId, assignedAt)
+	return r.mirrorIf(ctx, id, assigned, err)
+}
+
+func (r *DualWriteRepository) AbortIfQueued(ctx context.Context, id string) (bool, error) {
+	aborted, err := r.Repository.AbortIfQueued(ctx, id)
+	return r.mirrorIf(ctx, id, aborted, err)
+}
+
+func (r *DualWriteRepository) DeleteByTestWorkflow(ctx context.Context, workflowName string) error {
+	if err := r.Repository.DeleteByTestWorkflow(ctx, workflowName); err != nil {
+		return err
+	}
+	if err := r.secondary.DeleteByTestWorkflow(ctx, workflowName); err != nil {
+		r.logger.Warnw("failed to delete the executions from the secondary database", "workflow", workflowName, "error", err)
+	}
+	return nil
+}
+
+func (r *DualWriteRepository) DeleteAll(ctx context.Context) error {
+	if err := r.Repository.DeleteAll(ctx); err != nil {
+		return err
+	}
+	if err := r.secondary.DeleteAll(ctx); err != nil {
+		r.logger.Warnw("failed to delete the executions from the secondary database", "error", err)
+	}
+	return nil
+}
+
+func (r *DualWriteRepository) DeleteByTestWorkflows(ctx context.Context, workflowNames []string) error {
+	if err := r.Repository.DeleteByTestWorkflows(ctx, workflowNames); err != nil {
+		return err
+	}
+	if err := r.secondary.DeleteByTestWorkflows(ctx, workflowNames); err != nil {
+		r.logger.Warnw("failed to delete the executions from the secondary database", "workflows", workflowNames, "error", err)
+	}
+	return nil
+}
+
+// Scheduler mirrors the executions assigned by the scheduler
+func (r *DualWriteRepository) Scheduler(scheduler scheduling.Scheduler) scheduling.Scheduler {
+	return &dualWriteScheduler{Scheduler: scheduler, repo: r}
+}
+
+// Controller mirrors the executions changed by the execution controller
+func (r *DualWriteRepository) Controller(controller scheduling.Controller) scheduling.Controller {
+	return &dualWriteController{controller: controller, repo: r}
+}
+
+type dualWriteScheduler struct {
+	scheduling.Scheduler
+	repo *DualWriteRepository
+}
+
+func (s *dualWriteScheduler) ScheduleExecution(ctx context.Context, info scheduling.RunnerInfo) (testkube.TestWorkflowExecution, bool, error) {
+	execution, found, err := s.Scheduler.ScheduleExecution(ctx, info)
+	_, err = s.repo.mirrorIf(ctx, execution.Id, found, err)
+	return execution, found, err
+}
+
+type dualWriteController struct {
+	controller scheduling.Controller
+	repo       *DualWriteRepository
+}
+
+func (c *dualWriteController) StartExecution(ctx context.Context, executionId string) error {
+	return c.repo.mirrorErr(ctx, executionId, c.controller.StartExecution(ctx, executionId))
+}
+
+func (c *dualWriteController) PauseExecution(ctx context.Context, executionId string) error {
+	return c.repo.mirrorErr(ctx, executionId, c.controller.PauseExecution(ctx, executionId))
+}
+
+func (c *dualWriteController) ResumeExecution(ctx context.Context, executionId string) error {
+	return c.repo.mirrorErr(ctx, executionId, c.controller.ResumeExecution(ctx, executionId))
+}
+
+func (c *dualWriteController) AbortExecution(ctx context.Context, executionId string) error {
+	return c.repo.mirrorErr(ctx, executionId, c.controller.AbortExecution(ctx, executionId))
+}
+
+func (c *dualWriteController) CancelExecution(ctx context.Context, executionId string) error {
+	return c.repo.mirrorErr(ctx, executionId, c.controller.CancelExecution(ctx, executionId))
+}
+
+func (c *dualWriteController) ForceCancelExecution(ctx context.Context, executionId string) error {
+	return c.repo.mirrorErr(ctx, executionId, c.controller.ForceCancelExecution(ctx, executionId))
+}
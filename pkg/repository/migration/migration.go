@@ -0,0 +1,232 @@
+// Package migration copies the execution history between the database backends,
+// i.e. when moving an existing installation from MongoDB to PostgreSQL.
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
+	"github.com/kubeshop/testkube/pkg/repository/sequence"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+const DefaultBatchSize = 100
+
+// ExportingRepository is the execution repository that can be used as the migration source
+type ExportingRepository interface {
+	testworkflow.Repository
+	testworkflow.Exporter
+}
+
+// Source is the database the data is copied from
+type Source struct {
+	Executions ExportingRepository
+	Sequences  sequence.Exporter
+	Leases     leasebackend.Exporter
+}
+
+// Target is the database the data is copied to
+type Target struct {
+	Executions testworkflow.Repository
+	Sequences  sequence.Importer
+	Leases     leasebackend.Importer
+}
+
+// Progress is called after every copied batch
+type Progress func(state State)
+
+// Report summarizes the migration pass
+type Report struct {
+	// Incremental is set when the previous pass has been finished, so only the changed executions were copied
+	Incremental bool
+	// Checked is the number of the compared executions
+	Checked    int64
+	Executions int64
+	Sequences  int
+	Leases     int
+}
+
+type Migrator struct {
+	source    Source
+	target    Target
+	state     StateStore
+	batchSize int
+	progress  Progress
+}
+
+type Opt func(*Migrator)
+
+func WithBatchSize(size int) Opt {
+	return func(m *Migrator) {
+		if size > 0 {
+			m.batchSize = size
+		}
+	}
+}
+
+func WithProgress(progress Progress) Opt {
+	return func(m *Migrator) {
+		m.progress = progress
+	}
+}
+
+func NewMigrator(source Source, target Target, state StateStore, opts ...Opt) *Migrator {
+	m := &Migrator{
+		source:    source,
+		target:    target,
+		state:     state,
+		batchSize: DefaultBatchSize,
+		progress:  func(State) {},
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Run copies the executions in batches, then the sequences and leases.
+// Every pass compares the checksums of all the executions, and copies only the ones missing or different in the target,
+// so the changes done to any part of the execution are copied, even when they are written directly to the source.
+// It may be repeated while the source is still used, and once more after stopping it for the cut over.
+// The interrupted pass is resumed from the last copied batch.
+func (m *Migrator) Run(ctx context.Context) (report Report, err error) {
+	state, err := m.state.Load(ctx)
+	if err != nil {
+		return report, fmt.Errorf("loading migration state: %w", err)
+	}
+	if state.StartedAt.IsZero() {
+		state.StartedAt = time.Now()
+	}
+	report.Incremental = !state.SyncedAt.IsZero()
+
+	numbers := make(map[string]int32)
+	for {
+		batch, err := m.source.Executions.Export(ctx, testworkflow.ExportOptions{AfterID: state.LastID, Limit: m.batchSize})
+		if err != nil {
+			return report, fmt.Errorf("reading executions after '%s': %w", state.LastID, err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for i := range batch {
+			copied, err := copyIfChanged(ctx, m.source.Executions, m.target.Executions, batch[i])
+			if err != nil {
+				return report, fmt.Errorf("copying execution '%s': %w", batch[i].Id, err)
+			}
+			if copied {
+				state.Copied++
+				report.Executions++
+			}
+			if name := workflowName(batch[i]); name != "" && batch[i].Number > numbers[name] {
+				numbers[name] = batch[i].Number
+			}
+		}
+		state.LastID = batch[len(batch)-1].Id
+		report.Checked += int64(len(batch))
+		if err = m.state.Save(ctx, state); err != nil {
+			return report, fmt.Errorf("saving migration state: %w", err)
+		}
+		m.progress(state)
+	}
+
+	if report.Sequences, err = m.copySequences(ctx, numbers); err != nil {
+		return report, err
+	}
+	if report.Leases, err = m.copyLeases(ctx); err != nil {
+		return report, err
+	}
+
+	state = State{SyncedAt: state.StartedAt, Copied: state.Copied}
+	if err = m.state.Save(ctx, state); err != nil {
+		return report, fmt.Errorf("saving migration state: %w", err)
+	}
+	return report, nil
+}
+
+// copySequences raises the target execution numbers, so the next executions won't reuse the copied numbers
+func (m *Migrator) copySequences(ctx context.Context, numbers map[string]int32) (int, error) {
+	if m.target.Sequences == nil {
+		return 0, nil
+	}
+	if m.source.Sequences != nil {
+		current, err := m.source.Sequences.ListExecutionNumbers(ctx, sequence.ExecutionTypeTestWorkflow)
+		if err != nil {
+			return 0, fmt.Errorf("reading execution sequences: %w", err)
+		}
+		for name, number := range current {
+			if number > numbers[name] {
+				numbers[name] = number
+			}
+		}
+	}
+	for name, number := range numbers {
+		if err := m.target.Sequences.ImportExecutionNumber(ctx, name, sequence.ExecutionTypeTestWorkflow, number); err != nil {
+			return 0, fmt.Errorf("copying execution sequence '%s': %w", name, err)
+		}
+	}
+	return len(numbers), nil
+}
+
+func (m *Migrator) copyLeases(ctx context.Context) (int, error) {
+	if m.source.Leases == nil || m.target.Leases == nil {
+		return 0, nil
+	}
+	leases, err := m.source.Leases.ListLeases(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("reading leases: %w", err)
+	}
+	count := 0
+	for _, lease := range leases {
+		imported, err := m.target.Leases.ImportLease(ctx, lease)
+		if err != nil {
+			return count, fmt.Errorf("copying lease '%s': %w", lease.ID, err)
+		}
+		if imported {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// copyIfChanged stores the exported execution with all the related data, unless the target already has the same one.
+// Both executions are compared as read with Get, so they are completed the same way by the backends.
+func copyIfChanged(ctx context.Context, source, target testworkflow.Repository, execution testkube.TestWorkflowExecution) (bool, error) {
+	existing, err := target.Get(ctx, execution.Id)
+	if utils.IsNotFound(err) {
+		return true, target.Insert(ctx, execution)
+	}
+	if err != nil {
+		return false, err
+	}
+	original, err := source.Get(ctx, execution.Id)
+	if err != nil {
+		return false, err
+	}
+	if Checksum(existing) == Checksum(original) {
+		return false, nil
+	}
+	return true, target.Update(ctx, execution)
+}
+
+// upsert stores the execution with all the related data, replacing the existing one
+func upsert(ctx context.Context, repo testworkflow.Repository, execution testkube.TestWorkflowExecution) error {
+	_, err := repo.Get(ctx, execution.Id)
+	if utils.IsNotFound(err) {
+		return repo.Insert(ctx, execution)
+	}
+	if err != nil {
+		return err
+	}
+	return repo.Update(ctx, execution)
+}
+
+func workflowName(execution testkube.TestWorkflowExecution) string {
+	if execution.Workflow == nil {
+		return ""
+	}
+	return execution.Workflow.Name
+}
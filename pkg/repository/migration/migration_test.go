@@ -0,0 +1,397 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/controlplane/scheduling"
+	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
+	"github.com/kubeshop/testkube/pkg/repository/sequence"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+)
+
+// memoryRepository is the minimal in-memory execution repository used by the migration
+type memoryRepository struct {
+	testworkflow.Repository
+	executions map[string]testkube.TestWorkflowExecution
+	failInsert string
+	exports    int
+}
+
+func newMemoryRepository(executions ...testkube.TestWorkflowExecution) *memoryRepository {
+	r := &memoryRepository{executions: map[string]testkube.TestWorkflowExecution{}}
+	for _, e := range executions {
+		r.executions[e.Id] = e
+	}
+	return r
+}
+
+func (r *memoryRepository) Get(_ context.Context, id string) (testkube.TestWorkflowExecution, error) {
+	e, ok := r.executions[id]
+	if !ok {
+		return e, mongo.ErrNoDocuments
+	}
+	return e, nil
+}
+
+func (r *memoryRepository) Insert(_ context.Context, e testkube.TestWorkflowExecution) error {
+	if e.Id == r.failInsert {
+		return errors.New("connection lost")
+	}
+	r.executions[e.Id] = e
+	return nil
+}
+
+func (r *memoryRepository) Update(_ context.Context, e testkube.TestWorkflowExecution) error {
+	r.executions[e.Id] = e
+	return nil
+}
+
+func (r *memoryRepository) UpdateTags(_ context.Context, id string, tags map[string]string) error {
+	e := r.executions[id]
+	e.Tags = tags
+	r.executions[id] = e
+	return nil
+}
+
+func (r *memoryRepository) Count(_ context.Context, _ testworkflow.Filter) (int64, error) {
+	return int64(len(r.executions)), nil
+}
+
+func (r *memoryRepository) Export(_ context.Context, options testworkflow.ExportOptions) ([]testkube.TestWorkflowExecution, error) {
+	r.exports++
+	ids := make([]string, 0, len(r.executions))
+	for id := range r.executions {
+		if id > options.AfterID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) > options.Limit {
+		ids = ids[:options.Limit]
+	}
+	result := make([]testkube.TestWorkflowExecution, len(ids))
+	for i, id := range ids {
+		result[i] = r.executions[id]
+	}
+	return result, nil
+}
+
+type memorySequences map[string]int32
+
+func (s memorySequences) ListExecutionNumbers(_ context.Context, _ sequence.ExecutionType) (map[string]int32, error) {
+	return s, nil
+}
+
+func (s memorySequences) ImportExecutionNumber(_ context.Context, name string, _ sequence.ExecutionType, number int32) error {
+	if number > s[name] {
+		s[name] = number
+	}
+	return nil
+}
+
+type memoryLeases map[string]leasebackend.Lease
+
+func (l memoryLeases) ListLeases(_ context.Context) ([]leasebackend.Lease, error) {
+	result := make([]leasebackend.Lease, 0, len(l))
+	for _, lease := range l {
+		result = append(result, lease)
+	}
+	return result, nil
+}
+
+func (l memoryLeases) ImportLease(_ context.Context, lease leasebackend.Lease) (bool, error) {
+	if _, ok := l[lease.ID]; ok {
+		return false, nil
+	}
+	l[lease.ID] = lease
+	return true, nil
+}
+
+func execution(id, workflow string, number int32, statusAt time.Time) testkube.TestWorkflowExecution {
+	return testkube.TestWorkflowExecution{
+		Id:       id,
+		Name:     workflow + "-" + id,
+		Number:   number,
+		StatusAt: statusAt,
+		Workflow: &testkube.TestWorkflow{Name: workflow},
+		Result:   &testkube.TestWorkflowResult{Status: common.Ptr(testkube.PASSED_TestWorkflowStatus)},
+	}
+}
+
+func TestMigrator_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	source := newMemoryRepository(
+		execution("a1", "api", 1, now),
+		execution("a2", "api", 2, now),
+		execution("b1", "ui", 7, now),
+	)
+	target := newMemoryRepository()
+	targetSequences := memorySequences{"api": 1}
+	targetLeases := memoryLeases{"lease-old": {ID: "lease-old"}}
+	state := NewMemoryStateStore()
+
+	m := NewMigrator(
+		Source{Executions: source, Sequences: memorySequences{"api": 5}, Leases: memoryLeases{"lease-old": {ID: "lease-old"}, "lease-new": {ID: "lease-new"}}},
+		Target{Executions: target, Sequences: targetSequences, Leases: targetLeases},
+		state,
+		WithBatchSize(2),
+	)
+	report, err := m.Run(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, Report{Checked: 3, Executions: 3, Sequences: 2, Leases: 1}, report)
+	assert.Len(t, target.executions, 3)
+	assert.Equal(t, memorySequences{"api": 5, "ui": 7}, targetSequences)
+	assert.Len(t, targetLeases, 2)
+	saved, _ := state.Load(ctx)
+	assert.Equal(t, int64(3), saved.Copied)
+	assert.Empty(t, saved.LastID)
+	assert.False(t, saved.SyncedAt.IsZero())
+}
+
+func TestMigrator_Run_Resume(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	source := newMemoryRepository(
+		execution("a1", "api", 1, now),
+		execution("a2", "api", 2, now),
+		execution("a3", "api", 3, now),
+	)
+	target := newMemoryRepository()
+	target.failInsert = "a3"
+	state := NewMemoryStateStore()
+	m := NewMigrator(Source{Executions: source}, Target{Executions: target}, state, WithBatchSize(2))
+
+	_, err := m.Run(ctx)
+	require.Error(t, err)
+	saved, _ := state.Load(ctx)
+	assert.Equal(t, "a2", saved.LastID)
+
+	target.failInsert = ""
+	report, err := m.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), report.Executions)
+	assert.False(t, report.Incremental)
+	assert.Len(t, target.executions, 3)
+}
+
+func TestMigrator_Run_Incremental(t *testing.T) {
+	ctx := context.Background()
+	old := time.Now().Add(-time.Hour)
+	source := newMemoryRepository(execution("a1", "api", 1, old), execution("a2", "api", 2, old))
+	target := newMemoryRepository()
+	m := NewMigrator(Source{Executions: source}, Target{Executions: target}, NewMemoryStateStore())
+
+	_, err := m.Run(ctx)
+	require.NoError(t, err)
+
+	// The status time is not changed by the tags, triage, reports or outputs
+	changed := execution("a2", "api", 2, old)
+	changed.Tags = map[string]string{"changed": "true"}
+	changed.Triage = &testkube.TestWorkflowExecutionTriage{Category: common.Ptr(testkube.FLAKY_TestWorkflowTriageCategory)}
+	source.executions["a2"] = changed
+	report, err := m.Run(ctx)
+
+	require.NoError(t, err)
+	assert.True(t, report.Incremental)
+	assert.Equal(t, int64(2), report.Checked)
+	assert.Equal(t, int64(1), report.Executions)
+	assert.Equal(t, changed, target.executions["a2"])
+
+	report, err = m.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), report.Executions)
+}
+
+func TestMigrator_Verify(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	source := newMemoryRepository(execution("a1", "api", 1, now), execution("a2", "api", 2, now), execution("a3", "api", 3, now))
+	target := newMemoryRepository(execution("a1", "api", 1, now), execution("a2", "api", 2, now), execution("a3", "api", 3, now))
+	m := NewMigrator(Source{Executions: source}, Target{Executions: target}, NewMemoryStateStore(), WithBatchSize(2))
+
+	report, err := m.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, int64(3), report.Checked)
+
+	delete(target.executions, "a1")
+	target.executions["a2"] = execution("a2", "api", 2, now.Add(time.Second))
+	report, err = m.Verify(ctx)
+	require.NoError(t, err)
+	assert.False(t, report.OK())
+	assert.Equal(t, []string{"a1"}, report.Missing)
+	assert.Equal(t, []string{"a2"}, report.Mismatched)
+	assert.Equal(t, int64(2), report.Failures)
+}
+
+func TestChecksum(t *testing.T) {
+	now := time.Now()
+	e := execution("a1", "api", 1, now)
+	same := e
+	same.StatusAt = now.Add(time.Microsecond * 100)
+	assert.Equal(t, Checksum(e), Checksum(same), "sub-millisecond precision is lost by the databases")
+
+	same.Reports = []testkube.TestWorkflowReport{}
+	same.Tags = map[string]string{}
+	assert.Equal(t, Checksum(e), Checksum(same), "empty values are stored differently by the databases")
+
+	different := e
+	different.Result = &testkube.TestWorkflowResult{Status: common.Ptr(testkube.FAILED_TestWorkflowStatus)}
+	assert.NotEqual(t, Checksum(e), Checksum(different))
+
+	for name, change := range map[string]func(e *testkube.TestWorkflowExecution){
+		"triage": func(e *testkube.TestWorkflowExecution) {
+			e.Triage = &testkube.TestWorkflowExecutionTriage{Assignee: "jane"}
+		},
+		"reports": func(e *testkube.TestWorkflowExecution) {
+			e.Reports = []testkube.TestWorkflowReport{{Ref: "run", Kind: "junit", Summary: &testkube.TestWorkflowReportSummary{Failed: 1}}}
+		},
+		"outputs": func(e *testkube.TestWorkflowExecution) {
+			e.Output = []testkube.TestWorkflowOutput{{Ref: "run", Name: "status", Value: map[string]interface{}{"code": 1}}}
+		},
+		"approvals": func(e *testkube.TestWorkflowExecution) {
+			e.Approvals = []testkube.TestWorkflowApproval{{Ref: "gate", DecidedBy: "jane"}}
+		},
+		"step status": func(e *testkube.TestWorkflowExecution) {
+			e.Result = &testkube.TestWorkflowResult{
+				Status: common.Ptr(testkube.PASSED_TestWorkflowStatus),
+				Steps:  map[string]testkube.TestWorkflowStepResult{"run": {ErrorMessage: "timeout"}},
+			}
+		},
+		"paused time": func(e *testkube.TestWorkflowExecution) {
+			e.Result = &testkube.TestWorkflowResult{Status: common.Ptr(testkube.PASSED_TestWorkflowStatus), PausedMs: 1500}
+		},
+		"signature": func(e *testkube.TestWorkflowExecution) {
+			e.Signature = []testkube.TestWorkflowSignature{{Ref: "run", Name: "Run tests"}}
+		},
+		"resource aggregations": func(e *testkube.TestWorkflowExecution) {
+			e.ResourceAggregations = &testkube.TestWorkflowExecutionResourceAggregationsReport{
+				Global: map[string]map[string]testkube.TestWorkflowExecutionResourceAggregations{"cpu": {"millicores": {Max: 250}}},
+			}
+		},
+		"resolved workflow": func(e *testkube.TestWorkflowExecution) {
+			e.ResolvedWorkflow = &testkube.TestWorkflow{Name: "api", Spec: &testkube.TestWorkflowSpec{
+				Steps: []testkube.TestWorkflowStep{{Name: "run", Shell: "make test"}},
+			}}
+		},
+		"config params": func(e *testkube.TestWorkflowExecution) {
+			e.ConfigParams = map[string]testkube.TestWorkflowExecutionConfigValue{"env": {Value: "staging"}}
+		},
+		"running context": func(e *testkube.TestWorkflowExecution) {
+			e.RunningContext = &testkube.TestWorkflowRunningContext{Actor: &testkube.TestWorkflowRunningContextActor{Name: "jane"}}
+		},
+	} {
+		modified := e
+		change(&modified)
+		changedAgain := modified
+		change(&changedAgain)
+		assert.NotEqual(t, Checksum(e), Checksum(modified), name)
+		assert.Equal(t, Checksum(modified), Checksum(changedAgain), name)
+	}
+
+	// The content of the fields is compared, not only their length
+	e.Reports = []testkube.TestWorkflowReport{{Ref: "run", Summary: &testkube.TestWorkflowReportSummary{Failed: 1}}}
+	different = e
+	different.Reports = []testkube.TestWorkflowReport{{Ref: "run", Summary: &testkube.TestWorkflowReportSummary{Failed: 2}}}
+	assert.NotEqual(t, Checksum(e), Checksum(different))
+
+	// The fields not stored by every backend are skipped
+	e.Signature = []testkube.TestWorkflowSignature{{Ref: "run", Children: []testkube.TestWorkflowSignature{{Ref: "step"}}}}
+	same = e
+	same.Signature = []testkube.TestWorkflowSignature{{Id: "1", Ref: "run", Children: []testkube.TestWorkflowSignature{{Id: "2", Ref: "step"}}}}
+	same.Workflow = &testkube.TestWorkflow{Name: "api", Sync: &testkube.Syncable{GitOpsOwner: &testkube.GitOpsOwner{AgentId: "agent-1"}}}
+	assert.Equal(t, Checksum(e), Checksum(same))
+}
+
+func TestFileStateStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileStateStore(filepath.Join(t.TempDir(), "state.json"))
+
+	state, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, State{}, state)
+
+	expected := State{LastID: "a2", Copied: 2, StartedAt: time.Now().UTC().Truncate(time.Second)}
+	require.NoError(t, store.Save(ctx, expected))
+	state, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected.LastID, state.LastID)
+	assert.Equal(t, expected.Copied, state.Copied)
+	assert.True(t, expected.StartedAt.Equal(state.StartedAt))
+}
+
+func TestDualWriteRepository(t *testing.T) {
+	ctx := context.Background()
+	primary := newMemoryRepository()
+	secondary := newMemoryRepository()
+	repo := NewDualWriteRepository(primary, secondary, zap.NewNop().Sugar())
+
+	require.NoError(t, repo.Insert(ctx, execution("a1", "api", 1, time.Now())))
+	require.NoError(t, repo.UpdateTags(ctx, "a1", map[string]string{"key": "value"}))
+
+	assert.Equal(t, primary.executions, secondary.executions)
+	assert.Equal(t, map[string]string{"key": "value"}, secondary.executions["a1"].Tags)
+
+	secondary.failInsert = "a2"
+	require.NoError(t, repo.Insert(ctx, execution("a2", "api", 2, time.Now())), "secondary failures should not break the primary writes")
+	assert.Contains(t, primary.executions, "a2")
+}
+
+// memoryController updates the executions directly, like the database-specific execution controllers
+type memoryController struct {
+	scheduling.Controller
+	repo *memoryRepository
+}
+
+func (c memoryController) AbortExecution(_ context.Context, id string) error {
+	e := c.repo.executions[id]
+	e.Result = &testkube.TestWorkflowResult{Status: common.Ptr(testkube.ABORTED_TestWorkflowStatus)}
+	c.repo.executions[id] = e
+	return nil
+}
+
+type memoryScheduler struct {
+	repo *memoryRepository
+}
+
+func (s memoryScheduler) ScheduleExecution(_ context.Context, info scheduling.RunnerInfo) (testkube.TestWorkflowExecution, bool, error) {
+	for id, e := range s.repo.executions {
+		if e.RunnerId == "" {
+			e.RunnerId = info.Id
+			s.repo.executions[id] = e
+			return e, true, nil
+		}
+	}
+	return testkube.TestWorkflowExecution{}, false, nil
+}
+
+func TestDualWriteRepository_Scheduling(t *testing.T) {
+	ctx := context.Background()
+	primary := newMemoryRepository()
+	secondary := newMemoryRepository()
+	repo := NewDualWriteRepository(primary, secondary, zap.NewNop().Sugar())
+	require.NoError(t, repo.Insert(ctx, execution("a1", "api", 1, time.Now())))
+
+	_, found, err := repo.Scheduler(memoryScheduler{repo: primary}).ScheduleExecution(ctx, scheduling.RunnerInfo{Id: "runner-1"})
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "runner-1", secondary.executions["a1"].RunnerId)
+
+	require.NoError(t, repo.Controller(memoryController{repo: primary}).AbortExecution(ctx, "a1"))
+	assert.Equal(t, primary.executions, secondary.executions)
+	assert.Equal(t, testkube.ABORTED_TestWorkflowStatus, *secondary.executions["a1"].Result.Status)
+}
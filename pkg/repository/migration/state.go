@@ -0,0 +1,88 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// State is the migration checkpoint, that allows resuming the interrupted pass
+type State struct {
+	// LastID is the last execution copied in the current pass
+	LastID string `json:"lastId,omitempty"`
+	// StartedAt is the start time of the current pass, it's zero when no pass is in progress
+	StartedAt time.Time `json:"startedAt,omitempty"`
+	// SyncedAt is the start time of the last finished pass
+	SyncedAt time.Time `json:"syncedAt,omitempty"`
+	// Copied is the total number of copied executions
+	Copied int64 `json:"copied"`
+}
+
+// StateStore persists the migration checkpoint between the runs
+type StateStore interface {
+	Load(ctx context.Context) (State, error)
+	Save(ctx context.Context, state State) error
+}
+
+type fileStateStore struct {
+	path string
+}
+
+// NewFileStateStore keeps the migration checkpoint in the local JSON file
+func NewFileStateStore(path string) StateStore {
+	return &fileStateStore{path: path}
+}
+
+func (s *fileStateStore) Load(_ context.Context) (state State, err error) {
+	content, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+	err = json.Unmarshal(content, &state)
+	return state, err
+}
+
+func (s *fileStateStore) Save(_ context.Context, state State) error {
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write atomically, so the interrupted run doesn't leave the broken checkpoint
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+type memoryStateStore struct {
+	state State
+}
+
+// NewMemoryStateStore keeps the migration checkpoint in memory only
+func NewMemoryStateStore() StateStore {
+	return &memoryStateStore{}
+}
+
+func (s *memoryStateStore) Load(_ context.Context) (State, error) {
+	return s.state, nil
+}
+
+func (s *memoryStateStore) Save(_ context.Context, state State) error {
+	s.state = state
+	return nil
+}
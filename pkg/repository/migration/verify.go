@@ -0,0 +1,195 @@
+package migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+// maxReportedIDs limits the number of listed executions that don't match
+const maxReportedIDs = 20
+
+// VerifyReport compares the executions in the source and the target
+type VerifyReport struct {
+	SourceCount    int64
+	TargetCount    int64
+	Checked        int64
+	SourceChecksum string
+	TargetChecksum string
+	// Missing are the executions not copied to the target yet
+	Missing []string
+	// Mismatched are the executions that differ between the source and the target
+	Mismatched []string
+	// Failures is the total number of the missing and mismatched executions
+	Failures int64
+}
+
+// OK checks if the target contains the same executions as the source
+func (r VerifyReport) OK() bool {
+	return r.Failures == 0 && r.SourceCount == r.TargetCount && r.SourceChecksum == r.TargetChecksum
+}
+
+// Verify compares the execution counts, and the checksums of every execution in the source and the target
+func (m *Migrator) Verify(ctx context.Context) (report VerifyReport, err error) {
+	filter := testworkflow.NewExecutionsFilter()
+	if report.SourceCount, err = m.source.Executions.Count(ctx, filter); err != nil {
+		return report, fmt.Errorf("counting source executions: %w", err)
+	}
+	if report.TargetCount, err = m.target.Executions.Count(ctx, filter); err != nil {
+		return report, fmt.Errorf("counting target executions: %w", err)
+	}
+
+	sourceHash, targetHash := sha256.New(), sha256.New()
+	lastID := ""
+	for {
+		batch, err := m.source.Executions.Export(ctx, testworkflow.ExportOptions{AfterID: lastID, Limit: m.batchSize})
+		if err != nil {
+			return report, fmt.Errorf("reading executions after '%s': %w", lastID, err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for i := range batch {
+			report.Checked++
+			original, err := m.source.Executions.Get(ctx, batch[i].Id)
+			if err != nil {
+				return report, fmt.Errorf("reading source execution '%s': %w", batch[i].Id, err)
+			}
+			expected := Checksum(original)
+			writeChecksum(sourceHash, expected)
+
+			copied, err := m.target.Executions.Get(ctx, batch[i].Id)
+			if utils.IsNotFound(err) {
+				report.addFailure(&report.Missing, batch[i].Id)
+				continue
+			}
+			if err != nil {
+				return report, fmt.Errorf("reading target execution '%s': %w", batch[i].Id, err)
+			}
+			actual := Checksum(copied)
+			writeChecksum(targetHash, actual)
+			if actual != expected {
+				report.addFailure(&report.Mismatched, batch[i].Id)
+			}
+		}
+		lastID = batch[len(batch)-1].Id
+	}
+	report.SourceChecksum = hex.EncodeToString(sourceHash.Sum(nil))
+	report.TargetChecksum = hex.EncodeToString(targetHash.Sum(nil))
+	return report, nil
+}
+
+func (r *VerifyReport) addFailure(list *[]string, id string) {
+	r.Failures++
+	if len(*list) < maxReportedIDs {
+		*list = append(*list, id)
+	}
+}
+
+func writeChecksum(h hash.Hash, checksum string) {
+	_, _ = h.Write([]byte(checksum))
+}
+
+// Checksum computes the checksum of the whole execution, that has to match after the migration.
+// The execution should be read with Get, as the backends complete the config parameters only there.
+func Checksum(execution testkube.TestWorkflowExecution) string {
+	content, _ := json.Marshal(normalize(execution))
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalize converts the execution to the generic JSON structure, that is the same for every backend.
+// The empty values are dropped, as the backends differ in storing them,
+// the timestamps are reduced to the precision supported by every backend,
+// and the fields not stored by every backend are skipped.
+func normalize(execution testkube.TestWorkflowExecution) interface{} {
+	content, err := json.Marshal(execution)
+	if err != nil {
+		return nil
+	}
+	var document map[string]interface{}
+	if err = json.Unmarshal(content, &document); err != nil {
+		return nil
+	}
+	dropBackendSpecific(document)
+	return normalizeValue(document)
+}
+
+// dropBackendSpecific removes the fields that are not kept by every backend:
+// the signature IDs and the workflow sync status are not stored in PostgreSQL
+func dropBackendSpecific(document map[string]interface{}) {
+	for _, key := range []string{"workflow", "resolvedWorkflow"} {
+		if workflow, ok := document[key].(map[string]interface{}); ok {
+			delete(workflow, "sync")
+		}
+	}
+	dropSignatureIDs(document["signature"])
+}
+
+func dropSignatureIDs(signatures interface{}) {
+	list, _ := signatures.([]interface{})
+	for _, item := range list {
+		if signature, ok := item.(map[string]interface{}); ok {
+			delete(signature, "id")
+			dropSignatureIDs(signature["children"])
+		}
+	}
+}
+
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key := range v {
+			if v[key] = normalizeValue(v[key]); v[key] == nil {
+				delete(v, key)
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		for i := range v {
+			v[i] = normalizeValue(v[i])
+		}
+		return v
+	case string:
+		if v == "" {
+			return nil
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return millis(t)
+		}
+		return v
+	case bool:
+		if !v {
+			return nil
+		}
+		return v
+	case float64:
+		if v == 0 {
+			return nil
+		}
+		return v
+	}
+	return value
+}
+
+// millis normalizes the time to the precision supported by every backend
+func millis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
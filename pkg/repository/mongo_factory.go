@@ -8,6 +8,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/controlplane/scheduling"
 	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
 	leasebackendmongo "github.com/kubeshop/testkube/pkg/repository/leasebackend/mongo"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+	logsearchmongo "github.com/kubeshop/testkube/pkg/repository/logsearch/mongo"
 	"github.com/kubeshop/testkube/pkg/repository/sequence"
 	sequencemongo "github.com/kubeshop/testkube/pkg/repository/sequence/mongo"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
@@ -22,6 +24,7 @@ type MongoDBFactory struct {
 	sequenceRepo     sequence.Repository
 	leaseBackendRepo leasebackend.Repository
 	testWorkflowRepo testworkflow.Repository
+	logSearchRepo    logsearch.Repository
 }
 
 type MongoDBFactoryConfig struct {
@@ -61,6 +64,13 @@ func (f *MongoDBFactory) NewTestWorkflowRepository() testworkflow.Repository {
 	return f.testWorkflowRepo
 }
 
+func (f *MongoDBFactory) NewLogSearchRepository() logsearch.Repository {
+	if f.logSearchRepo == nil {
+		f.logSearchRepo = logsearchmongo.NewMongoRepository(f.db)
+	}
+	return f.logSearchRepo
+}
+
 func (f *MongoDBFactory) NewScheduler() scheduling.Scheduler {
 	return scheduling.NewMongoScheduler(f.db.Collection(testworkflowmongo.CollectionName))
 }
@@ -9,6 +9,8 @@ import (
 	database "github.com/kubeshop/testkube/pkg/database/postgres"
 	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
 	leasebackendpostgres "github.com/kubeshop/testkube/pkg/repository/leasebackend/postgres"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+	logsearchpostgres "github.com/kubeshop/testkube/pkg/repository/logsearch/postgres"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
 	testworkflowpostgres "github.com/kubeshop/testkube/pkg/repository/testworkflow/postgres"
 )
@@ -19,6 +21,7 @@ type PostgreSQLFactory struct {
 	schedulerDb      *database.DB
 	leaseBackendRepo leasebackend.Repository
 	testWorkflowRepo testworkflow.Repository
+	logSearchRepo    logsearch.Repository
 }
 
 type PostgreSQLFactoryConfig struct {
@@ -51,6 +54,13 @@ func (f *PostgreSQLFactory) NewTestWorkflowRepository() testworkflow.Repository
 	return f.testWorkflowRepo
 }
 
+func (f *PostgreSQLFactory) NewLogSearchRepository() logsearch.Repository {
+	if f.logSearchRepo == nil {
+		f.logSearchRepo = logsearchpostgres.NewPostgresRepository(f.db)
+	}
+	return f.logSearchRepo
+}
+
 func (f *PostgreSQLFactory) NewScheduler() scheduling.Scheduler {
 	return scheduling.NewPostgresScheduler(f.schedulerDb)
 }
@@ -23,3 +23,15 @@ type Repository interface {
 	// DeleteAllExecutionNumbers deletes all execution numbers by type
 	DeleteAllExecutionNumbers(ctx context.Context, executionType ExecutionType) (err error)
 }
+
+// Exporter lists the execution numbers, so they can be copied to another repository
+type Exporter interface {
+	// ListExecutionNumbers lists the current execution numbers by name for the type
+	ListExecutionNumbers(ctx context.Context, executionType ExecutionType) (map[string]int32, error)
+}
+
+// Importer stores the execution numbers copied from another repository
+type Importer interface {
+	// ImportExecutionNumber raises the execution number by name and type to at least the provided one
+	ImportExecutionNumber(ctx context.Context, name string, executionType ExecutionType, number int32) error
+}
@@ -3,6 +3,7 @@ package mongo
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -12,6 +13,7 @@ import (
 )
 
 var _ sequence.Repository = (*MongoRepository)(nil)
+var _ sequence.Exporter = (*MongoRepository)(nil)
 
 const (
 	CollectionSequences = "sequences"
@@ -142,6 +144,25 @@ func (r *MongoRepository) DeleteAllExecutionNumbers(ctx context.Context, executi
 	return err
 }
 
+// ListExecutionNumbers lists the current execution numbers by name for the type
+func (r *MongoRepository) ListExecutionNumbers(ctx context.Context, executionType sequence.ExecutionType) (map[string]int32, error) {
+	cursor, err := r.Coll.Find(ctx, bson.M{"executionType": executionType})
+	if err != nil {
+		return nil, err
+	}
+	var items []executionNumber
+	if err = cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	prefix := getMongoId("", executionType)
+	numbers := make(map[string]int32, len(items))
+	for _, item := range items {
+		numbers[strings.TrimPrefix(item.Id, prefix)] = int32(item.Number)
+	}
+	return numbers, nil
+}
+
 func (r *MongoRepository) getOldNumber(ctx context.Context, name string, executionType sequence.ExecutionType) (int32, error) {
 	var oldExecutionNumber oldExecutionNumber
 
@@ -12,6 +12,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/repository/sequence"
 )
 
+var _ sequence.Importer = (*PostgresRepository)(nil)
+
 type PostgresRepository struct {
 	db             sqlc.DatabaseInterface
 	queries        sqlc.ExecutionSequenceQueriesInterface
@@ -125,3 +127,17 @@ func (r *PostgresRepository) DeleteAllExecutionNumbers(ctx context.Context, _ se
 	}
 	return nil
 }
+
+// ImportExecutionNumber raises the execution number by name to at least the provided one
+func (r *PostgresRepository) ImportExecutionNumber(ctx context.Context, name string, _ sequence.ExecutionType, number int32) error {
+	err := r.queries.UpsertExecutionSequenceAtLeast(ctx, sqlc.UpsertExecutionSequenceAtLeastParams{
+		Name:           name,
+		Number:         number,
+		OrganizationID: r.organizationID,
+		EnvironmentID:  r.environmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import execution sequence: %w", err)
+	}
+	return nil
+}
@@ -38,6 +38,11 @@ func (m *MockQueriesInterface) DeleteAllExecutionSequences(ctx context.Context,
 	return args.Error(0)
 }
 
+func (m *MockQueriesInterface) UpsertExecutionSequenceAtLeast(ctx context.Context, arg sqlc.UpsertExecutionSequenceAtLeastParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
 func TestPostgresRepository_GetNextExecutionNumber(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// Arrange
@@ -265,3 +270,27 @@ func TestPostgresRepository_DeleteAllExecutionNumbers(t *testing.T) {
 		mockQueries.AssertExpectations(t)
 	})
 }
+
+func TestPostgresRepository_ImportExecutionNumber(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		mockQueries := &MockQueriesInterface{}
+		repo := &PostgresRepository{
+			queries:        mockQueries,
+			organizationID: "org-id",
+			environmentID:  "env-id",
+		}
+
+		ctx := context.Background()
+		name := "test-execution"
+
+		mockQueries.On("UpsertExecutionSequenceAtLeast", ctx, sqlc.UpsertExecutionSequenceAtLeastParams{Name: name, Number: 42, OrganizationID: "org-id", EnvironmentID: "env-id"}).Return(nil)
+
+		// Act
+		err := repo.ImportExecutionNumber(ctx, name, sequence.ExecutionTypeTestWorkflow, 42)
+
+		// Assert
+		assert.NoError(t, err)
+		mockQueries.AssertExpectations(t)
+	})
+}
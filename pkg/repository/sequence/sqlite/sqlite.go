@@ -0,0 +1,93 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/sequence"
+)
+
+var _ sequence.Repository = (*SQLiteRepository)(nil)
+var _ sequence.Exporter = (*SQLiteRepository)(nil)
+var _ sequence.Importer = (*SQLiteRepository)(nil)
+
+type SQLiteRepository struct {
+	db *database.DB
+}
+
+func NewSQLiteRepository(db *database.DB) *SQLiteRepository {
+	return &SQLiteRepository{db: db}
+}
+
+// GetNextExecutionNumber gets next execution number by name and type
+func (r *SQLiteRepository) GetNextExecutionNumber(ctx context.Context, name string, executionType sequence.ExecutionType) (number int32, err error) {
+	err = r.db.QueryRowContext(ctx, `INSERT INTO execution_sequences (name, type, number) VALUES (?, ?, 1)
+		ON CONFLICT (name, type) DO UPDATE SET number = number + 1
+		RETURNING number`, name, string(executionType)).Scan(&number)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next execution number: %w", err)
+	}
+	return number, nil
+}
+
+// DeleteExecutionNumber deletes execution number by name and type
+func (r *SQLiteRepository) DeleteExecutionNumber(ctx context.Context, name string, executionType sequence.ExecutionType) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM execution_sequences WHERE name = ? AND type = ?`, name, string(executionType))
+	if err != nil {
+		return fmt.Errorf("failed to delete execution sequence: %w", err)
+	}
+	return nil
+}
+
+// DeleteExecutionNumbers deletes multiple execution numbers by names and type
+func (r *SQLiteRepository) DeleteExecutionNumbers(ctx context.Context, names []string, executionType sequence.ExecutionType) error {
+	if len(names) == 0 {
+		return nil
+	}
+	placeholders, args := database.Placeholders(names)
+	_, err := r.db.ExecContext(ctx, `DELETE FROM execution_sequences WHERE type = ? AND name IN (`+placeholders+`)`,
+		append([]any{string(executionType)}, args...)...)
+	if err != nil {
+		return fmt.Errorf("failed to delete execution sequences: %w", err)
+	}
+	return nil
+}
+
+// DeleteAllExecutionNumbers deletes all execution numbers by type
+func (r *SQLiteRepository) DeleteAllExecutionNumbers(ctx context.Context, executionType sequence.ExecutionType) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM execution_sequences WHERE type = ?`, string(executionType))
+	if err != nil {
+		return fmt.Errorf("failed to delete all execution sequences: %w", err)
+	}
+	return nil
+}
+
+// ListExecutionNumbers lists the current execution numbers by name for the type
+func (r *SQLiteRepository) ListExecutionNumbers(ctx context.Context, executionType sequence.ExecutionType) (map[string]int32, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT name, number FROM execution_sequences WHERE type = ?`, string(executionType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list execution sequences: %w", err)
+	}
+	defer rows.Close()
+	result := make(map[string]int32)
+	for rows.Next() {
+		var name string
+		var number int32
+		if err = rows.Scan(&name, &number); err != nil {
+			return nil, fmt.Errorf("failed to list execution sequences: %w", err)
+		}
+		result[name] = number
+	}
+	return result, rows.Err()
+}
+
+// ImportExecutionNumber raises the execution number by name and type to at least the provided one
+func (r *SQLiteRepository) ImportExecutionNumber(ctx context.Context, name string, executionType sequence.ExecutionType, number int32) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO execution_sequences (name, type, number) VALUES (?, ?, ?)
+		ON CONFLICT (name, type) DO UPDATE SET number = MAX(number, excluded.number)`, name, string(executionType), number)
+	if err != nil {
+		return fmt.Errorf("failed to import execution sequence: %w", err)
+	}
+	return nil
+}
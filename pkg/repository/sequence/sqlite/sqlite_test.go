@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/sequence"
+)
+
+func newTestRepository(t *testing.T) *SQLiteRepository {
+	ctx := context.Background()
+	db, err := database.Open(ctx, database.MemoryPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Migrate(ctx)
+	require.NoError(t, err)
+	return NewSQLiteRepository(db)
+}
+
+func TestSQLiteRepository_GetNextExecutionNumber(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+
+	for i := int32(1); i <= 3; i++ {
+		number, err := repo.GetNextExecutionNumber(ctx, "workflow", sequence.ExecutionTypeTestWorkflow)
+		require.NoError(t, err)
+		assert.Equal(t, i, number)
+	}
+
+	number, err := repo.GetNextExecutionNumber(ctx, "other", sequence.ExecutionTypeTestWorkflow)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), number)
+
+	require.NoError(t, repo.DeleteExecutionNumber(ctx, "workflow", sequence.ExecutionTypeTestWorkflow))
+	number, err = repo.GetNextExecutionNumber(ctx, "workflow", sequence.ExecutionTypeTestWorkflow)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), number)
+}
+
+func TestSQLiteRepository_ImportExecutionNumber(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+
+	_, err := repo.GetNextExecutionNumber(ctx, "workflow", sequence.ExecutionTypeTestWorkflow)
+	require.NoError(t, err)
+	require.NoError(t, repo.ImportExecutionNumber(ctx, "workflow", sequence.ExecutionTypeTestWorkflow, 10))
+	require.NoError(t, repo.ImportExecutionNumber(ctx, "workflow", sequence.ExecutionTypeTestWorkflow, 5))
+	require.NoError(t, repo.ImportExecutionNumber(ctx, "imported", sequence.ExecutionTypeTestWorkflow, 7))
+
+	numbers, err := repo.ListExecutionNumbers(ctx, sequence.ExecutionTypeTestWorkflow)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"workflow": 10, "imported": 7}, numbers)
+
+	require.NoError(t, repo.DeleteAllExecutionNumbers(ctx, sequence.ExecutionTypeTestWorkflow))
+	numbers, err = repo.ListExecutionNumbers(ctx, sequence.ExecutionTypeTestWorkflow)
+	require.NoError(t, err)
+	assert.Empty(t, numbers)
+}
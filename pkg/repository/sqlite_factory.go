@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/pkg/controlplane/scheduling"
+	sqlitedb "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/leasebackend"
+	leasebackendsqlite "github.com/kubeshop/testkube/pkg/repository/leasebackend/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/logsearch"
+	logsearchsqlite "github.com/kubeshop/testkube/pkg/repository/logsearch/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	testworkflowsqlite "github.com/kubeshop/testkube/pkg/repository/testworkflow/sqlite"
+)
+
+// SQLite Factory Implementation
+type SQLiteFactory struct {
+	db               *sqlitedb.DB
+	leaseBackendRepo leasebackend.Repository
+	testWorkflowRepo testworkflow.Repository
+	logSearchRepo    logsearch.Repository
+}
+
+type SQLiteFactoryConfig struct {
+	Database *sqlitedb.DB
+}
+
+func NewSQLiteFactory(config SQLiteFactoryConfig) *SQLiteFactory {
+	return &SQLiteFactory{
+		db: config.Database,
+	}
+}
+
+func (f *SQLiteFactory) NewLeaseBackendRepository() leasebackend.Repository {
+	if f.leaseBackendRepo == nil {
+		f.leaseBackendRepo = leasebackendsqlite.NewSQLiteLeaseBackend(f.db)
+	}
+	return f.leaseBackendRepo
+}
+
+func (f *SQLiteFactory) NewTestWorkflowRepository() testworkflow.Repository {
+	if f.testWorkflowRepo == nil {
+		f.testWorkflowRepo = testworkflowsqlite.NewSQLiteRepository(f.db)
+	}
+	return f.testWorkflowRepo
+}
+
+func (f *SQLiteFactory) NewLogSearchRepository() logsearch.Repository {
+	if f.logSearchRepo == nil {
+		f.logSearchRepo = logsearchsqlite.NewSQLiteRepository(f.db)
+	}
+	return f.logSearchRepo
+}
+
+func (f *SQLiteFactory) NewScheduler() scheduling.Scheduler {
+	return scheduling.NewSQLiteScheduler(f.db)
+}
+
+func (f *SQLiteFactory) NewExecutionController() scheduling.Controller {
+	return scheduling.NewSQLiteExecutionController(f.db)
+}
+
+func (f *SQLiteFactory) NewExecutionQuerier() scheduling.ExecutionQuerier {
+	return scheduling.NewSQLiteExecutionQuerier(f.db)
+}
+
+func (f *SQLiteFactory) GetDatabaseType() DatabaseType {
+	return DatabaseTypeSQLite
+}
+
+func (f *SQLiteFactory) Close(ctx context.Context) error {
+	return f.db.Close()
+}
+
+func (f *SQLiteFactory) HealthCheck(ctx context.Context) error {
+	return f.db.PingContext(ctx)
+}
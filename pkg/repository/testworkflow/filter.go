@@ -7,26 +7,29 @@ import (
 )
 
 type FilterImpl struct {
-	FName          string
-	FNames         []string
-	FLastNDays     int
-	FStartDate     *time.Time
-	FEndDate       *time.Time
-	FStatuses      []testkube.TestWorkflowStatus
-	FPage          int
-	FPageSize      int
-	FSkip          *int
-	FTextSearch    string
-	FSelector      string
-	FTagSelector   string
-	FLabelSelector *LabelSelector
-	FActorName     string
-	FActorType     testkube.TestWorkflowRunningContextActorType
-	FGroupID       string
-	FRunnerID      string
-	FInitialized   *bool
-	FAssigned      *bool
-	FHealthRanges  [][2]float64
+	FName           string
+	FNames          []string
+	FLastNDays      int
+	FStartDate      *time.Time
+	FEndDate        *time.Time
+	FStatuses       []testkube.TestWorkflowStatus
+	FPage           int
+	FPageSize       int
+	FSkip           *int
+	FTextSearch     string
+	FSelector       string
+	FTagSelector    string
+	FLabelSelector  *LabelSelector
+	FActorName      string
+	FActorType      testkube.TestWorkflowRunningContextActorType
+	FGroupID        string
+	FRunnerID       string
+	FInitialized    *bool
+	FAssigned       *bool
+	FHealthRanges   [][2]float64
+	FTriageCategory testkube.TestWorkflowTriageCategory
+	FTriageAssignee string
+	FTriaged        *bool
 }
 
 func NewExecutionsFilter() *FilterImpl {
@@ -137,6 +140,21 @@ func (f *FilterImpl) WithHealthRanges(ranges [][2]float64) *FilterImpl {
 	return f
 }
 
+func (f *FilterImpl) WithTriageCategory(category testkube.TestWorkflowTriageCategory) *FilterImpl {
+	f.FTriageCategory = category
+	return f
+}
+
+func (f *FilterImpl) WithTriageAssignee(assignee string) *FilterImpl {
+	f.FTriageAssignee = assignee
+	return f
+}
+
+func (f *FilterImpl) WithTriaged(triaged bool) *FilterImpl {
+	f.FTriaged = &triaged
+	return f
+}
+
 func (f FilterImpl) Name() string {
 	return f.FName
 }
@@ -285,3 +303,30 @@ func (f FilterImpl) HealthRangesDefined() bool {
 func (f FilterImpl) HealthRanges() [][2]float64 {
 	return f.FHealthRanges
 }
+
+func (f FilterImpl) TriageCategoryDefined() bool {
+	return f.FTriageCategory != ""
+}
+
+func (f FilterImpl) TriageCategory() testkube.TestWorkflowTriageCategory {
+	return f.FTriageCategory
+}
+
+func (f FilterImpl) TriageAssigneeDefined() bool {
+	return f.FTriageAssignee != ""
+}
+
+func (f FilterImpl) TriageAssignee() string {
+	return f.FTriageAssignee
+}
+
+func (f FilterImpl) TriagedDefined() bool {
+	return f.FTriaged != nil
+}
+
+func (f FilterImpl) Triaged() bool {
+	if f.FTriaged == nil {
+		return false
+	}
+	return *f.FTriaged
+}
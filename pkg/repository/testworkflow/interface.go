@@ -2,11 +2,13 @@ package testworkflow
 
 import (
 	"context"
+	"errors"
 	"io"
 	"time"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/repository/sequence"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
 )
 
 type Label struct {
@@ -95,6 +97,12 @@ type Filter interface {
 	InitializedDefined() bool
 	HealthRanges() [][2]float64
 	HealthRangesDefined() bool
+	TriageCategory() testkube.TestWorkflowTriageCategory
+	TriageCategoryDefined() bool
+	TriageAssignee() string
+	TriageAssigneeDefined() bool
+	Triaged() bool
+	TriagedDefined() bool
 }
 
 //go:generate go tool mockgen -destination=./mock_repository.go -package=testworkflow "github.com/kubeshop/testkube/pkg/repository/testworkflow" Repository
@@ -143,8 +151,15 @@ type Repository interface {
 	UpdateOutput(ctx context.Context, id string, output []testkube.TestWorkflowOutput) (err error)
 	// UpdateResourceAggregations updates the summary of resource metrics
 	UpdateResourceAggregations(ctx context.Context, id string, resourceAggregations *testkube.TestWorkflowExecutionResourceAggregationsReport) (err error)
+	// AddApproval records the decision for the approval step, it returns false when the decision has been already taken
+	AddApproval(ctx context.Context, id string, approval testkube.TestWorkflowApproval) (added bool, err error)
 	// UpdateTags replaces execution tags with the provided set
 	UpdateTags(ctx context.Context, id string, tags map[string]string) (err error)
+	// UpdateTriage replaces the execution triage, unless it has been changed since the previous one was read,
+	// it returns false then
+	UpdateTriage(ctx context.Context, id string, previous, triage *testkube.TestWorkflowExecutionTriage) (updated bool, err error)
+	// GetTriageStats counts the executions matching the filter per workflow and triage category
+	GetTriageStats(ctx context.Context, filter Filter) ([]TriageCount, error)
 	// DeleteByTestWorkflow deletes execution results by workflow
 	DeleteByTestWorkflow(ctx context.Context, workflowName string) error
 	// DeleteAll deletes all execution results
@@ -163,6 +178,20 @@ type Repository interface {
 	AbortIfQueued(ctx context.Context, id string) (bool, error)
 }
 
+// ExportOptions selects the batch of executions to copy to another repository
+type ExportOptions struct {
+	// AfterID skips the executions up to the provided ID (inclusive)
+	AfterID string
+	// Limit is the maximum number of executions in the batch
+	Limit int
+}
+
+// Exporter reads all the executions in a stable order, so they can be copied in batches
+type Exporter interface {
+	// Export gets the batch of executions sorted by ID
+	Export(ctx context.Context, options ExportOptions) ([]testkube.TestWorkflowExecution, error)
+}
+
 type Sequences interface {
 	// GetNextExecutionNumber gets next execution number by name
 	GetNextExecutionNumber(ctx context.Context, name string) (number int32, err error)
@@ -178,6 +207,8 @@ type OutputRepository interface {
 	SaveLog(ctx context.Context, id, workflowName string, reader io.Reader) error
 	// ReadLog streams the output from Minio
 	ReadLog(ctx context.Context, id, workflowName string) (io.ReadCloser, error)
+	// ReadLogWithOptions streams the part of the output from Minio, i.e. for a single step or the last lines
+	ReadLogWithOptions(ctx context.Context, id, workflowName string, options testworkflowlogs.ReadOptions) (io.ReadCloser, error)
 	// HasLog checks if there is an output in Minio
 	HasLog(ctx context.Context, id, workflowName string) (bool, error)
 
@@ -187,4 +218,15 @@ type OutputRepository interface {
 	DeleteOutputForTestWorkflows(ctx context.Context, workflowNames []string) error
 }
 
+// ErrChunkedLog is returned when the whole output is requested, while it's stored in the compressed chunks
+var ErrChunkedLog = errors.New("the execution log is stored in the compressed chunks, upgrade the client to read it")
+
+// ChunkedOutputRepository is implemented by the output repositories that store the finished logs in the compressed chunks
+type ChunkedOutputRepository interface {
+	// CompactLog rewrites the output uploaded as a whole into the compressed chunks indexed by the step
+	CompactLog(ctx context.Context, id, workflowName string) error
+	// PresignReadLogChunks builds presigned storage URLs to read the chunks, or returns nil index when the output is not chunked
+	PresignReadLogChunks(ctx context.Context, id, workflowName string) (*testworkflowlogs.Index, []string, error)
+}
+
 type HookFn func(ctx context.Context, name string, executionType sequence.ExecutionType) error
@@ -1,19 +1,33 @@
 package minio
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"time"
 
-	"github.com/kubeshop/testkube/pkg/bufferedstream"
+	"github.com/minio/minio-go/v7"
+
 	"github.com/kubeshop/testkube/pkg/log"
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
 	"github.com/kubeshop/testkube/pkg/storage"
+	minioclient "github.com/kubeshop/testkube/pkg/storage/minio"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
 )
 
-var _ testworkflow.OutputRepository = (*MinioRepository)(nil)
+var (
+	_ testworkflow.OutputRepository        = (*MinioRepository)(nil)
+	_ testworkflow.ChunkedOutputRepository = (*MinioRepository)(nil)
+)
 
-const bucketFolder = "testworkflows"
+const (
+	bucketFolder = "testworkflows"
+	// chunksFolderSuffix is appended to the execution ID for the folder with the compressed chunks of the output
+	chunksFolderSuffix = ".chunks"
+)
 
 type MinioRepository struct {
 	storage             storage.Client
@@ -35,32 +49,140 @@ func (m *MinioRepository) PresignSaveLog(ctx context.Context, id, workflowName s
 	return m.storage.PresignUploadFileToBucket(ctx, m.bucket, bucketFolder, id, 24*time.Hour)
 }
 
-// PresignReadLog builds presigned storage URL to read the output from Cloud
+// PresignReadLog builds presigned storage URL to read the output from Cloud.
+// The compacted output has no single file anymore, so it fails with ErrChunkedLog then.
 func (m *MinioRepository) PresignReadLog(ctx context.Context, id, workflowName string) (string, error) {
+	index, err := m.readIndex(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if index != nil {
+		return "", testworkflow.ErrChunkedLog
+	}
 	return m.storage.PresignDownloadFileFromBucket(ctx, m.bucket, bucketFolder, id, 15*time.Minute)
 }
 
+func chunksFolder(id string) string {
+	return bucketFolder + "/" + id + chunksFolderSuffix
+}
+
+func isNotFound(err error) bool {
+	var response minio.ErrorResponse
+	return errors.Is(err, minioclient.ErrArtifactsNotFound) || (errors.As(err, &response) && response.Code == "NoSuchKey")
+}
+
+// readIndex reads the index of the output chunks, or returns nil when the output is not chunked
+func (m *MinioRepository) readIndex(ctx context.Context, id string) (*testworkflowlogs.Index, error) {
+	file, _, err := m.storage.DownloadFileFromBucket(ctx, m.bucket, chunksFolder(id), testworkflowlogs.IndexFileName)
+	if isNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var index testworkflowlogs.Index
+	if err = json.NewDecoder(file).Decode(&index); err != nil {
+		return nil, fmt.Errorf("failed to read the output chunks index: %w", err)
+	}
+	return &index, nil
+}
+
+// saveChunks stores the output as the compressed chunks with the index
+func (m *MinioRepository) saveChunks(ctx context.Context, id string, reader io.Reader) error {
+	index, err := testworkflowlogs.Split(reader, testworkflowlogs.DefaultChunkSize, func(chunk testworkflowlogs.Chunk, data []byte) error {
+		return m.storage.UploadFileToBucket(ctx, m.bucket, chunksFolder(id), chunk.Name(), bytes.NewReader(data), int64(len(data)))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save the output chunks: %w", err)
+	}
+	indexData, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	return m.storage.UploadFileToBucket(ctx, m.bucket, chunksFolder(id), testworkflowlogs.IndexFileName, bytes.NewReader(indexData), int64(len(indexData)))
+}
+
 func (m *MinioRepository) SaveLog(ctx context.Context, id, workflowName string, reader io.Reader) error {
 	log.DefaultLogger.Debugw("inserting output", "id", id, "workflowName", workflowName)
-	buffer, err := bufferedstream.NewBufferedStream("", "log", reader)
-	if err != nil {
+	if err := m.DeleteOutput(ctx, id); err != nil {
+		return err
+	}
+	return m.saveChunks(ctx, id, reader)
+}
+
+// CompactLog rewrites the output uploaded through the presigned URL into the compressed chunks
+func (m *MinioRepository) CompactLog(ctx context.Context, id, workflowName string) error {
+	index, err := m.readIndex(ctx, id)
+	if err != nil || index != nil {
+		return err
+	}
+	file, _, err := m.storage.DownloadFileFromBucket(ctx, m.bucket, bucketFolder, id)
+	if isNotFound(err) {
 		return nil
+	} else if err != nil {
+		return err
+	}
+	log.DefaultLogger.Debugw("compacting output", "id", id, "workflowName", workflowName)
+	if err = m.saveChunks(ctx, id, file); err != nil {
+		return err
+	}
+	// The index is already saved, so the readers are no longer using the original output
+	return m.storage.DeleteFileFromBucket(ctx, m.bucket, bucketFolder, id)
+}
+
+// PresignReadLogChunks builds presigned storage URLs to read the output chunks
+func (m *MinioRepository) PresignReadLogChunks(ctx context.Context, id, workflowName string) (*testworkflowlogs.Index, []string, error) {
+	index, err := m.readIndex(ctx, id)
+	if err != nil || index == nil {
+		return nil, nil, err
 	}
-	defer buffer.Cleanup()
-	return m.storage.UploadFileToBucket(ctx, m.bucket, bucketFolder, id, buffer, int64(buffer.Len()))
+	urls := make([]string, len(index.Chunks))
+	for i, chunk := range index.Chunks {
+		urls[i], err = m.storage.PresignDownloadFileFromBucket(ctx, m.bucket, chunksFolder(id), chunk.Name(), 15*time.Minute)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return index, urls, nil
 }
 
 func (m *MinioRepository) ReadLog(ctx context.Context, id, workflowName string) (io.ReadCloser, error) {
+	return m.ReadLogWithOptions(ctx, id, workflowName, testworkflowlogs.ReadOptions{})
+}
+
+func (m *MinioRepository) ReadLogWithOptions(ctx context.Context, id, workflowName string, options testworkflowlogs.ReadOptions) (io.ReadCloser, error) {
+	index, err := m.readIndex(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if index != nil {
+		segments := testworkflowlogs.ChunkSegments(index.Select(options), func(ctx context.Context, chunk testworkflowlogs.Chunk) (io.ReadCloser, error) {
+			file, _, err := m.storage.DownloadFileFromBucket(ctx, m.bucket, chunksFolder(id), chunk.Name())
+			if err != nil {
+				return nil, err
+			}
+			return testworkflowlogs.Decompress(io.NopCloser(file))
+		})
+		return testworkflowlogs.Read(ctx, segments, options), nil
+	}
+
 	file, _, err := m.storage.DownloadFileFromBucket(ctx, m.bucket, bucketFolder, id)
 	if err != nil {
 		return nil, err
 	}
-	return io.NopCloser(file), nil
+	if options.IsZero() {
+		return io.NopCloser(file), nil
+	}
+	return testworkflowlogs.Read(ctx, []testworkflowlogs.Segment{{Open: func(_ context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(file), nil
+	}}}, options), nil
 }
 
 func (m *MinioRepository) HasLog(ctx context.Context, id, workflowName string) (bool, error) {
 	subCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	if index, err := m.readIndex(subCtx, id); err != nil || index != nil {
+		return index != nil, err
+	}
 	_, _, err := m.storage.DownloadFileFromBucket(subCtx, m.bucket, bucketFolder, id)
 	if err != nil {
 		return false, err
@@ -98,5 +220,19 @@ func (m *MinioRepository) DeleteOutputForTestWorkflows(ctx context.Context, work
 
 func (m *MinioRepository) DeleteOutput(ctx context.Context, id string) error {
 	log.DefaultLogger.Debugw("deleting test workflow output", "id", id)
+	index, err := m.readIndex(ctx, id)
+	if err != nil {
+		return err
+	}
+	if index != nil {
+		for _, chunk := range index.Chunks {
+			if err = m.storage.DeleteFileFromBucket(ctx, m.bucket, chunksFolder(id), chunk.Name()); err != nil {
+				return err
+			}
+		}
+		if err = m.storage.DeleteFileFromBucket(ctx, m.bucket, chunksFolder(id), testworkflowlogs.IndexFileName); err != nil {
+			return err
+		}
+	}
 	return m.storage.DeleteFileFromBucket(ctx, m.bucket, bucketFolder, id)
 }
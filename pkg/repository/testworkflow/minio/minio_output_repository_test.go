@@ -0,0 +1,39 @@
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/storage"
+	minioclient "github.com/kubeshop/testkube/pkg/storage/minio"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
+)
+
+func TestMinioRepository_PresignReadLog(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := storage.NewMockClient(ctrl)
+	repo := NewMinioOutputRepository(client, nil, "logs")
+
+	client.EXPECT().DownloadFileFromBucket(gomock.Any(), "logs", chunksFolder("exec-1"), testworkflowlogs.IndexFileName).
+		Return(nil, minio.ObjectInfo{}, minioclient.ErrArtifactsNotFound)
+	client.EXPECT().PresignDownloadFileFromBucket(gomock.Any(), "logs", bucketFolder, "exec-1", 15*time.Minute).
+		Return("https://storage/exec-1", nil)
+	url, err := repo.PresignReadLog(ctx, "exec-1", "api-tests")
+	require.NoError(t, err)
+	assert.Equal(t, "https://storage/exec-1", url)
+
+	// The compacted log has no original file to read
+	client.EXPECT().DownloadFileFromBucket(gomock.Any(), "logs", chunksFolder("exec-2"), testworkflowlogs.IndexFileName).
+		Return(strings.NewReader(`{"chunks":[]}`), minio.ObjectInfo{}, nil)
+	_, err = repo.PresignReadLog(ctx, "exec-2", "api-tests")
+	assert.ErrorIs(t, err, testworkflow.ErrChunkedLog)
+}
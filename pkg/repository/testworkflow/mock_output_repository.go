@@ -14,6 +14,7 @@ import (
 	io "io"
 	reflect "reflect"
 
+	testworkflowlogs "github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -129,6 +130,21 @@ func (mr *MockOutputRepositoryMockRecorder) ReadLog(ctx, id, workflowName any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadLog", reflect.TypeOf((*MockOutputRepository)(nil).ReadLog), ctx, id, workflowName)
 }
 
+// ReadLogWithOptions mocks base method.
+func (m *MockOutputRepository) ReadLogWithOptions(ctx context.Context, id, workflowName string, options testworkflowlogs.ReadOptions) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadLogWithOptions", ctx, id, workflowName, options)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadLogWithOptions indicates an expected call of ReadLogWithOptions.
+func (mr *MockOutputRepositoryMockRecorder) ReadLogWithOptions(ctx, id, workflowName, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadLogWithOptions", reflect.TypeOf((*MockOutputRepository)(nil).ReadLogWithOptions), ctx, id, workflowName, options)
+}
+
 // SaveLog mocks base method.
 func (m *MockOutputRepository) SaveLog(ctx context.Context, id, workflowName string, reader io.Reader) error {
 	m.ctrl.T.Helper()
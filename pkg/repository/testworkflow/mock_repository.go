@@ -57,6 +57,21 @@ func (mr *MockRepositoryMockRecorder) AbortIfQueued(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortIfQueued", reflect.TypeOf((*MockRepository)(nil).AbortIfQueued), ctx, id)
 }
 
+// AddApproval mocks base method.
+func (m *MockRepository) AddApproval(ctx context.Context, id string, approval testkube.TestWorkflowApproval) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddApproval", ctx, id, approval)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddApproval indicates an expected call of AddApproval.
+func (mr *MockRepositoryMockRecorder) AddApproval(ctx, id, approval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddApproval", reflect.TypeOf((*MockRepository)(nil).AddApproval), ctx, id, approval)
+}
+
 // Assign mocks base method.
 func (m *MockRepository) Assign(ctx context.Context, id, prevRunnerId, newRunnerId string, assignedAt *time.Time) (bool, error) {
 	m.ctrl.T.Helper()
@@ -344,6 +359,21 @@ func (mr *MockRepositoryMockRecorder) GetTestWorkflowMetrics(ctx, name, limit, l
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestWorkflowMetrics", reflect.TypeOf((*MockRepository)(nil).GetTestWorkflowMetrics), ctx, name, limit, last)
 }
 
+// GetTriageStats mocks base method.
+func (m *MockRepository) GetTriageStats(ctx context.Context, filter Filter) ([]TriageCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriageStats", ctx, filter)
+	ret0, _ := ret[0].([]TriageCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTriageStats indicates an expected call of GetTriageStats.
+func (mr *MockRepositoryMockRecorder) GetTriageStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriageStats", reflect.TypeOf((*MockRepository)(nil).GetTriageStats), ctx, filter)
+}
+
 // GetUnassigned mocks base method.
 func (m *MockRepository) GetUnassigned(ctx context.Context) ([]testkube.TestWorkflowExecution, error) {
 	m.ctrl.T.Helper()
@@ -500,3 +530,18 @@ func (mr *MockRepositoryMockRecorder) UpdateTags(ctx, id, tags any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*MockRepository)(nil).UpdateTags), ctx, id, tags)
 }
+
+// UpdateTriage mocks base method.
+func (m *MockRepository) UpdateTriage(ctx context.Context, id string, previous, triage *testkube.TestWorkflowExecutionTriage) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTriage", ctx, id, previous, triage)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTriage indicates an expected call of UpdateTriage.
+func (mr *MockRepositoryMockRecorder) UpdateTriage(ctx, id, previous, triage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriage", reflect.TypeOf((*MockRepository)(nil).UpdateTriage), ctx, id, previous, triage)
+}
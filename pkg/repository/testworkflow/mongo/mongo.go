@@ -21,6 +21,7 @@ import (
 )
 
 var _ testworkflow.Repository = (*MongoRepository)(nil)
+var _ testworkflow.Exporter = (*MongoRepository)(nil)
 
 const (
 	CollectionName       = "testworkflowresults"
@@ -364,6 +365,33 @@ func (r *MongoRepository) GetExecutions(ctx context.Context, filter testworkflow
 	return
 }
 
+// Export gets the batch of executions sorted by ID
+func (r *MongoRepository) Export(ctx context.Context, opts testworkflow.ExportOptions) (result []testkube.TestWorkflowExecution, err error) {
+	result = make([]testkube.TestWorkflowExecution, 0)
+	query := bson.M{}
+	if opts.AfterID != "" {
+		query["id"] = bson.M{"$gt": opts.AfterID}
+	}
+	findOpts := options.Find().SetSort(bson.D{{Key: "id", Value: 1}})
+	if opts.Limit > 0 {
+		findOpts.SetLimit(int64(opts.Limit))
+	}
+	if r.allowDiskUse {
+		findOpts.SetAllowDiskUse(r.allowDiskUse)
+	}
+
+	cursor, err := r.Coll.Find(ctx, query, findOpts)
+	if err != nil {
+		return
+	}
+	err = cursor.All(ctx, &result)
+
+	for i := range result {
+		result[i].UnscapeDots()
+	}
+	return
+}
+
 type TestWorkflowExecutionSummaryWithResolvedWorkflow struct {
 	testkube.TestWorkflowExecutionSummary `json:",inline" bson:",inline"`
 	ResolvedWorkflow                      *testkube.TestWorkflow `json:"resolvedWorkflow,omitempty"`
@@ -571,6 +599,71 @@ func (r *MongoRepository) UpdateTags(ctx context.Context, id string, tags map[st
 	return
 }
 
+func (r *MongoRepository) UpdateTriage(ctx context.Context, id string, previous, triage *testkube.TestWorkflowExecutionTriage) (bool, error) {
+	query := bson.M{"id": id, "triage": nil}
+	if previous != nil {
+		query = bson.M{"id": id, "triage.updatedat": previous.UpdatedAt}
+	}
+	res, err := r.Coll.UpdateOne(ctx, query, bson.M{"$set": bson.M{"triage": triage}})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (r *MongoRepository) GetTriageStats(ctx context.Context, filter testworkflow.Filter) ([]testworkflow.TriageCount, error) {
+	query, _ := composeQueryAndOpts(filter)
+	pipeline := []bson.M{
+		{"$match": query},
+		{"$group": bson.M{
+			"_id": bson.M{
+				"workflow": "$workflow.name",
+				"category": bson.M{"$ifNull": bson.A{"$triage.category", ""}},
+			},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
+
+	opts := options.Aggregate()
+	if r.allowDiskUse {
+		opts.SetAllowDiskUse(r.allowDiskUse)
+	}
+
+	cursor, err := r.Coll.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []struct {
+		ID struct {
+			Workflow string `bson:"workflow"`
+			Category string `bson:"category"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	if err = cursor.All(ctx, &res); err != nil {
+		return nil, err
+	}
+
+	counts := make([]testworkflow.TriageCount, len(res))
+	for i := range res {
+		counts[i] = testworkflow.TriageCount{
+			Workflow: res[i].ID.Workflow,
+			Category: testkube.TestWorkflowTriageCategory(res[i].ID.Category),
+			Count:    res[i].Count,
+		}
+	}
+	return counts, nil
+}
+
+func (r *MongoRepository) AddApproval(ctx context.Context, id string, approval testkube.TestWorkflowApproval) (bool, error) {
+	res, err := r.Coll.UpdateOne(ctx, bson.M{"id": id, "approvals.ref": bson.M{"$ne": approval.Ref}}, bson.M{"$push": bson.M{"approvals": approval}})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
 func composeQueryAndOpts(filter testworkflow.Filter) (bson.M, *options.FindOptionsBuilder) {
 	query := bson.M{}
 	opts := options.Find()
@@ -681,6 +774,20 @@ func composeQueryAndOpts(filter testworkflow.Filter) (bson.M, *options.FindOptio
 		query["runningcontext.actor.type_"] = filter.ActorType()
 	}
 
+	if filter.TriageCategoryDefined() {
+		query["triage.category"] = filter.TriageCategory()
+	} else if filter.TriagedDefined() {
+		if filter.Triaged() {
+			query["triage.category"] = bson.M{"$not": bson.M{"$in": bson.A{nil, ""}}}
+		} else {
+			query["triage.category"] = bson.M{"$in": bson.A{nil, ""}}
+		}
+	}
+
+	if filter.TriageAssigneeDefined() {
+		query["triage.assignee"] = filter.TriageAssignee()
+	}
+
 	if filter.RunnerIDDefined() {
 		query["runnerid"] = filter.RunnerID()
 	} else if filter.AssignedDefined() {
@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowlogs"
 )
 
 var _ testworkflow.OutputRepository = (*NoneRepository)(nil)
@@ -35,6 +36,10 @@ func (n *NoneRepository) ReadLog(_ context.Context, _, _ string) (io.ReadCloser,
 	return io.NopCloser(&bytes.Reader{}), nil
 }
 
+func (n *NoneRepository) ReadLogWithOptions(_ context.Context, _, _ string, _ testworkflowlogs.ReadOptions) (io.ReadCloser, error) {
+	return io.NopCloser(&bytes.Reader{}), nil
+}
+
 func (n *NoneRepository) HasLog(_ context.Context, _, _ string) (bool, error) {
 	return false, nil
 }
@@ -241,7 +241,7 @@ func (r *PostgresRepository) convertCompleteRowToExecutionWithRelated(row sqlc.G
 		execution.Result, err = r.buildResultFromRow(
 			row.Status, row.PredictedStatus, row.QueuedAt, row.StartedAt, row.FinishedAt,
 			row.Duration, row.TotalDuration, row.DurationMs, row.PausedMs, row.TotalDurationMs,
-			row.Pauses, row.Initialization, row.Steps,
+			row.Pauses, row.Initialization, row.Steps, row.Gates,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build result from row: %w", err)
@@ -303,6 +303,13 @@ func (r *PostgresRepository) convertCompleteRowToExecutionWithRelated(row sqlc.G
 		}
 	}
 
+	// Parse approval decisions
+	if len(row.Approvals) > 0 {
+		if err := json.Unmarshal(row.Approvals, &execution.Approvals); err != nil {
+			return nil, fmt.Errorf("failed to parse approvals JSON: %w", err)
+		}
+	}
+
 	// Parse resource aggregations
 	if len(row.ResourceAggregationsGlobal) > 0 || len(row.ResourceAggregationsStep) > 0 {
 		execution.ResourceAggregations = &testkube.TestWorkflowExecutionResourceAggregationsReport{}
@@ -320,6 +327,13 @@ func (r *PostgresRepository) convertCompleteRowToExecutionWithRelated(row sqlc.G
 		}
 	}
 
+	// Parse triage
+	if len(row.Triage) > 0 {
+		if err := json.Unmarshal(row.Triage, &execution.Triage); err != nil {
+			return nil, fmt.Errorf("failed to parse triage JSON: %w", err)
+		}
+	}
+
 	// Populate config params if resolved workflow exists
 	if execution.ResolvedWorkflow != nil && execution.ResolvedWorkflow.Spec != nil {
 		execution.ConfigParams = populateConfigParams(execution.ResolvedWorkflow, execution.ConfigParams)
@@ -926,6 +940,9 @@ func (r *PostgresRepository) insertExecutionWithTransaction(ctx context.Context,
 		if err = r.insertResult(ctx, qtx, execution.Id, execution.Result); err != nil {
 			return err
 		}
+		if err = r.insertApprovals(ctx, qtx, execution.Id, execution.Approvals); err != nil {
+			return err
+		}
 	}
 
 	if err = r.insertOutputs(ctx, qtx, execution.Id, execution.Output); err != nil {
@@ -942,6 +959,12 @@ func (r *PostgresRepository) insertExecutionWithTransaction(ctx context.Context,
 		}
 	}
 
+	if execution.Triage != nil {
+		if _, err = r.upsertTriage(ctx, qtx, execution.Id, execution.Triage); err != nil {
+			return err
+		}
+	}
+
 	if execution.Workflow != nil {
 		if err = r.insertWorkflow(ctx, qtx, execution.Id, "workflow", execution.Workflow); err != nil {
 			return err
@@ -1074,7 +1097,7 @@ func (r *PostgresRepository) insertResult(ctx context.Context, qtx sqlc.TestWork
 		predictedStatus = toPgText(string(*result.PredictedStatus))
 	}
 
-	return qtx.InsertTestWorkflowResult(ctx, sqlc.InsertTestWorkflowResultParams{
+	err = qtx.InsertTestWorkflowResult(ctx, sqlc.InsertTestWorkflowResultParams{
 		ExecutionID:     executionId,
 		Status:          status,
 		PredictedStatus: predictedStatus,
@@ -1090,6 +1113,50 @@ func (r *PostgresRepository) insertResult(ctx context.Context, qtx sqlc.TestWork
 		Initialization:  initialization,
 		Steps:           steps,
 	})
+	if err != nil {
+		return err
+	}
+
+	return r.updateResultGates(ctx, qtx, executionId, result.Gates)
+}
+
+func (r *PostgresRepository) updateResultGates(ctx context.Context, qtx sqlc.TestWorkflowExecutionQueriesInterface, executionId string, gates []testkube.TestWorkflowGateResult) error {
+	if len(gates) == 0 {
+		return nil
+	}
+	data, err := toJSONB(gates)
+	if err != nil {
+		return err
+	}
+	return qtx.UpdateTestWorkflowResultGates(ctx, sqlc.UpdateTestWorkflowResultGatesParams{
+		Gates:       data,
+		ExecutionID: executionId,
+	})
+}
+
+func (r *PostgresRepository) insertApprovals(ctx context.Context, qtx sqlc.TestWorkflowExecutionQueriesInterface, executionId string, approvals []testkube.TestWorkflowApproval) error {
+	for _, approval := range approvals {
+		if _, err := r.addApproval(ctx, qtx, executionId, approval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *PostgresRepository) addApproval(ctx context.Context, qtx sqlc.TestWorkflowExecutionQueriesInterface, executionId string, approval testkube.TestWorkflowApproval) (bool, error) {
+	data, err := toJSONB(approval)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := qtx.AddTestWorkflowResultApproval(ctx, sqlc.AddTestWorkflowResultApprovalParams{
+		Approval:    data,
+		ExecutionID: executionId,
+		Ref:         approval.Ref,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
 }
 
 func (r *PostgresRepository) insertOutputs(ctx context.Context, qtx sqlc.TestWorkflowExecutionQueriesInterface, executionId string, outputs []testkube.TestWorkflowOutput) error {
@@ -1212,6 +1279,21 @@ func (r *PostgresRepository) upsertResourceAggregations(ctx context.Context, qtx
 	})
 }
 
+func (r *PostgresRepository) upsertTriage(ctx context.Context, qtx sqlc.TestWorkflowExecutionQueriesInterface, executionId string, triage *testkube.TestWorkflowExecutionTriage) (int64, error) {
+	data, err := toJSONB(triage)
+	if err != nil {
+		return 0, err
+	}
+	return qtx.UpsertTestWorkflowExecutionTriage(ctx, sqlc.UpsertTestWorkflowExecutionTriageParams{
+		Category:       string(triage.GetCategory()),
+		Assignee:       triage.Assignee,
+		Triage:         data,
+		ExecutionID:    executionId,
+		OrganizationID: r.organizationID,
+		EnvironmentID:  r.environmentID,
+	})
+}
+
 func (r *PostgresRepository) insertWorkflow(ctx context.Context, qtx sqlc.TestWorkflowExecutionQueriesInterface, executionId, workflowType string, workflow *testkube.TestWorkflow) error {
 	labels, err := toJSONB(workflow.Labels)
 	if err != nil {
@@ -1318,6 +1400,9 @@ func (r *PostgresRepository) updateExecutionWithTransaction(ctx context.Context,
 		if err = r.insertResult(ctx, qtx, execution.Id, execution.Result); err != nil {
 			return err
 		}
+		if err = r.insertApprovals(ctx, qtx, execution.Id, execution.Approvals); err != nil {
+			return err
+		}
 	}
 
 	if err = r.insertOutputs(ctx, qtx, execution.Id, execution.Output); err != nil {
@@ -1334,6 +1419,12 @@ func (r *PostgresRepository) updateExecutionWithTransaction(ctx context.Context,
 		}
 	}
 
+	if execution.Triage != nil {
+		if _, err = r.upsertTriage(ctx, qtx, execution.Id, execution.Triage); err != nil {
+			return err
+		}
+	}
+
 	if execution.Workflow != nil {
 		if err = r.insertWorkflow(ctx, qtx, execution.Id, "workflow", execution.Workflow); err != nil {
 			return err
@@ -1521,6 +1612,11 @@ func (r *PostgresRepository) FinishResultStrict(ctx context.Context, id, runnerI
 		return false, err
 	}
 
+	// Store the outcomes of the result gates
+	if err = r.updateResultGates(ctx, qtx, updatedID, result.Gates); err != nil {
+		return false, err
+	}
+
 	// Update status_at if status changed
 	currentStatus := fromPgText(currentExecution.Status)
 	newStatus := string(*result.Status)
@@ -1665,6 +1761,76 @@ func (r *PostgresRepository) UpdateTags(ctx context.Context, id string, tags map
 	return nil
 }
 
+// UpdateTriage replaces the execution triage, unless it has been changed since the previous one was read
+func (r *PostgresRepository) UpdateTriage(ctx context.Context, id string, previous, triage *testkube.TestWorkflowExecutionTriage) (bool, error) {
+	if triage == nil {
+		triage = &testkube.TestWorkflowExecutionTriage{}
+	}
+	data, err := toJSONB(triage)
+	if err != nil {
+		return false, err
+	}
+	// The previous version is compared with the JSON document, so it's serialized the same way
+	previousUpdatedAt := ""
+	if previous != nil {
+		value, err := previous.UpdatedAt.MarshalText()
+		if err != nil {
+			return false, err
+		}
+		previousUpdatedAt = string(value)
+	}
+	rowsAffected, err := r.queries.UpdateTestWorkflowExecutionTriageStrict(ctx, sqlc.UpdateTestWorkflowExecutionTriageStrictParams{
+		Category:          string(triage.GetCategory()),
+		Assignee:          triage.Assignee,
+		Triage:            data,
+		ExecutionID:       id,
+		OrganizationID:    r.organizationID,
+		EnvironmentID:     r.environmentID,
+		PreviousUpdatedAt: previousUpdatedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// GetTriageStats counts the executions matching the filter per workflow and triage category
+func (r *PostgresRepository) GetTriageStats(ctx context.Context, filter testworkflow.Filter) ([]testworkflow.TriageCount, error) {
+	params, err := r.buildTestWorkflowExecutionParams(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.GetTestWorkflowTriageStats(ctx, sqlc.GetTestWorkflowTriageStatsParams{
+		OrganizationID: params.OrganizationID,
+		EnvironmentID:  params.EnvironmentID,
+		WorkflowName:   params.WorkflowName,
+		WorkflowNames:  params.WorkflowNames,
+		StartDate:      params.StartDate,
+		EndDate:        params.EndDate,
+		LastNDays:      params.LastNDays,
+		Statuses:       params.Statuses,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]testworkflow.TriageCount, len(rows))
+	for i, row := range rows {
+		counts[i] = testworkflow.TriageCount{
+			Workflow: row.WorkflowName.String,
+			Category: testkube.TestWorkflowTriageCategory(row.Category),
+			Count:    int(row.Count),
+		}
+	}
+	return counts, nil
+}
+
+// AddApproval records the decision for the approval step, unless it has been already taken
+func (r *PostgresRepository) AddApproval(ctx context.Context, id string, approval testkube.TestWorkflowApproval) (bool, error) {
+	return r.addApproval(ctx, r.queries, id, approval)
+}
+
 // UpdateResourceAggregations updates resource aggregations
 func (r *PostgresRepository) UpdateResourceAggregations(ctx context.Context, id string, resourceAggregations *testkube.TestWorkflowExecutionResourceAggregationsReport) error {
 	return r.upsertResourceAggregations(ctx, r.queries, id, resourceAggregations)
@@ -1998,6 +2164,9 @@ func (r *PostgresRepository) Count(ctx context.Context, filter testworkflow.Filt
 		LabelConditions:    params.LabelConditions,
 		SelectorKeys:       params.SelectorKeys,
 		SelectorConditions: params.SelectorConditions,
+		TriageCategory:     params.TriageCategory,
+		TriageAssignee:     params.TriageAssignee,
+		Triaged:            params.Triaged,
 	})
 }
 
@@ -2113,6 +2282,20 @@ func (r *PostgresRepository) buildTestWorkflowExecutionParams(filter testworkflo
 		params.TagConditions = conditions
 	}
 
+	// Triage filters
+	if filter.TriageCategoryDefined() {
+		params.TriageCategory = string(filter.TriageCategory())
+	}
+
+	if filter.TriageAssigneeDefined() {
+		params.TriageAssignee = filter.TriageAssignee()
+	}
+
+	params.Triaged = pgtype.Bool{}
+	if filter.TriagedDefined() {
+		params.Triaged = toPgBool(filter.Triaged())
+	}
+
 	if filter.SkipDefined() {
 		params.Fst = int32(filter.Skip())
 	}
@@ -2273,6 +2456,19 @@ func (r *PostgresRepository) buildTestWorkflowExecutionTotalParams(filter testwo
 		params.TagConditions = conditions
 	}
 
+	if filter.TriageCategoryDefined() {
+		params.TriageCategory = string(filter.TriageCategory())
+	}
+
+	if filter.TriageAssigneeDefined() {
+		params.TriageAssignee = filter.TriageAssignee()
+	}
+
+	params.Triaged = pgtype.Bool{}
+	if filter.TriagedDefined() {
+		params.Triaged = toPgBool(filter.Triaged())
+	}
+
 	return params, nil
 }
 
@@ -2331,7 +2527,7 @@ func (r *PostgresRepository) buildResultFromRow(
 	queuedAt, startedAt, finishedAt pgtype.Timestamptz,
 	duration, totalDuration pgtype.Text,
 	durationMs, pausedMs, totalDurationMs pgtype.Int4,
-	pauses, initialization, steps []byte,
+	pauses, initialization, steps, gates []byte,
 ) (*testkube.TestWorkflowResult, error) {
 	var err error
 	result := &testkube.TestWorkflowResult{
@@ -2370,6 +2566,10 @@ func (r *PostgresRepository) buildResultFromRow(
 		json.Unmarshal(steps, &result.Steps)
 	}
 
+	if len(gates) > 0 {
+		json.Unmarshal(gates, &result.Gates)
+	}
+
 	return result, nil
 }
 
@@ -159,6 +159,26 @@ func (m *MockTestWorkflowExecutionQueriesInterface) FinishTestWorkflowExecutionR
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockTestWorkflowExecutionQueriesInterface) UpdateTestWorkflowResultGates(ctx context.Context, arg sqlc.UpdateTestWorkflowResultGatesParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockTestWorkflowExecutionQueriesInterface) AddTestWorkflowResultApproval(ctx context.Context, arg sqlc.AddTestWorkflowResultApprovalParams) (int64, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTestWorkflowExecutionQueriesInterface) UpsertTestWorkflowExecutionTriage(ctx context.Context, arg sqlc.UpsertTestWorkflowExecutionTriageParams) (int64, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTestWorkflowExecutionQueriesInterface) UpdateTestWorkflowExecutionTriageStrict(ctx context.Context, arg sqlc.UpdateTestWorkflowExecutionTriageStrictParams) (int64, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockTestWorkflowExecutionQueriesInterface) UpdateExecutionStatusAt(ctx context.Context, arg sqlc.UpdateExecutionStatusAtParams) error {
 	args := m.Called(ctx, arg)
 	return args.Error(0)
@@ -208,6 +228,11 @@ func (m *MockTestWorkflowExecutionQueriesInterface) GetTestWorkflowExecutionTags
 	return args.Get(0).([]sqlc.GetTestWorkflowExecutionTagsRow), args.Error(1)
 }
 
+func (m *MockTestWorkflowExecutionQueriesInterface) GetTestWorkflowTriageStats(ctx context.Context, arg sqlc.GetTestWorkflowTriageStatsParams) ([]sqlc.GetTestWorkflowTriageStatsRow, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).([]sqlc.GetTestWorkflowTriageStatsRow), args.Error(1)
+}
+
 func (m *MockTestWorkflowExecutionQueriesInterface) InitTestWorkflowExecution(ctx context.Context, arg sqlc.InitTestWorkflowExecutionParams) error {
 	args := m.Called(ctx, arg)
 	return args.Error(0)
@@ -514,6 +539,30 @@ func (m *MockFilter) HealthRangesDefined() bool {
 	return m.Called().Bool(0)
 }
 
+func (m *MockFilter) TriageCategory() testkube.TestWorkflowTriageCategory {
+	return m.Called().Get(0).(testkube.TestWorkflowTriageCategory)
+}
+
+func (m *MockFilter) TriageCategoryDefined() bool {
+	return m.Called().Bool(0)
+}
+
+func (m *MockFilter) TriageAssignee() string {
+	return m.Called().String(0)
+}
+
+func (m *MockFilter) TriageAssigneeDefined() bool {
+	return m.Called().Bool(0)
+}
+
+func (m *MockFilter) Triaged() bool {
+	return m.Called().Bool(0)
+}
+
+func (m *MockFilter) TriagedDefined() bool {
+	return m.Called().Bool(0)
+}
+
 // Helper functions for tests
 func createTestExecution() *testkube.TestWorkflowExecution {
 	status := testkube.PASSED_TestWorkflowStatus
@@ -558,6 +607,9 @@ func createTestFilter() *MockFilter {
 	filter.On("GroupIDDefined").Return(false)
 	filter.On("InitializedDefined").Return(false)
 	filter.On("HealthRangesDefined").Return(false)
+	filter.On("TriageCategoryDefined").Return(false)
+	filter.On("TriageAssigneeDefined").Return(false)
+	filter.On("TriagedDefined").Return(false)
 	filter.On("Selector").Return("")
 	filter.On("TagSelector").Return("")
 	filter.On("LabelSelector").Return((*testworkflow.LabelSelector)(nil))
@@ -0,0 +1,192 @@
+package sqlite
+
+import (
+	"strings"
+	"time"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+)
+
+// query builds the conditions joined with AND
+type query struct {
+	database.Query
+}
+
+func (q query) and(condition string, args ...any) query {
+	if q.Where == "" {
+		q.Where = condition
+	} else {
+		q.Where = "(" + q.Where + ") AND (" + condition + ")"
+	}
+	q.Args = append(append([]any{}, q.Args...), args...)
+	return q
+}
+
+func (q query) in(column string, values ...any) query {
+	placeholders, args := database.Placeholders(values)
+	return q.and(column+" IN ("+placeholders+")", args...)
+}
+
+// documentField extracts the value of the key in the object at the path of the JSON document,
+// quoting the key, so it may contain dots
+func documentField(path string) string {
+	return "json_extract(document, '" + path + ".' || json_quote(?))"
+}
+
+func composeQuery(filter testworkflow.Filter) query {
+	q := query{Query: database.Query{OrderBy: orderLatest}}
+
+	if filter.NameDefined() {
+		q = q.and("workflow_name = ?", filter.Name())
+	}
+
+	if filter.NamesDefined() {
+		placeholders, args := database.Placeholders(filter.Names())
+		q = q.and("workflow_name IN ("+placeholders+")", args...)
+	}
+
+	if filter.TextSearchDefined() {
+		q = q.and("name LIKE '%' || ? || '%'", filter.TextSearch())
+	}
+
+	if filter.LastNDaysDefined() {
+		q = q.and("scheduled_at >= ?", time.Now().Add(-time.Duration(filter.LastNDays())*24*time.Hour).UnixMilli())
+	}
+
+	if filter.StartDateDefined() {
+		q = q.and("scheduled_at >= ?", filter.StartDate().UnixMilli())
+	}
+
+	if filter.EndDateDefined() {
+		q = q.and("scheduled_at <= ?", filter.EndDate().UnixMilli())
+	}
+
+	if filter.StatusesDefined() {
+		placeholders, args := database.Placeholders(filter.Statuses())
+		q = q.and("status IN ("+placeholders+")", args...)
+	}
+
+	if filter.Selector() != "" {
+		for _, item := range strings.Split(filter.Selector(), ",") {
+			elements := strings.Split(item, "=")
+			if len(elements) == 2 {
+				q = q.and(documentField("$.workflow.labels")+" = ?", elements[0], elements[1])
+			} else if len(elements) == 1 {
+				q = q.and(documentField("$.workflow.labels")+" IS NOT NULL", elements[0])
+			}
+		}
+	}
+
+	if filter.TagSelector() != "" {
+		values := make(map[string][]any)
+		exists := make(map[string]struct{})
+		keys := make([]string, 0)
+		for _, item := range strings.Split(filter.TagSelector(), ",") {
+			elements := strings.Split(item, "=")
+			if _, ok := values[elements[0]]; !ok {
+				keys = append(keys, elements[0])
+				values[elements[0]] = nil
+			}
+			if len(elements) == 2 {
+				values[elements[0]] = append(values[elements[0]], elements[1])
+			} else if len(elements) == 1 {
+				exists[elements[0]] = struct{}{}
+			}
+		}
+		for _, key := range keys {
+			if _, ok := exists[key]; ok || len(values[key]) == 0 {
+				q = q.and(documentField("$.tags")+" IS NOT NULL", key)
+				continue
+			}
+			placeholders, args := database.Placeholders(values[key])
+			q = q.and(documentField("$.tags")+" IN ("+placeholders+")", append([]any{key}, args...)...)
+		}
+	}
+
+	if filter.LabelSelector() != nil && len(filter.LabelSelector().Or) > 0 {
+		conditions := make([]string, 0)
+		args := make([]any, 0)
+		for _, label := range filter.LabelSelector().Or {
+			if label.Value != nil {
+				conditions = append(conditions, documentField("$.workflow.labels")+" = ?")
+				args = append(args, label.Key, *label.Value)
+			} else if label.Exists != nil && *label.Exists {
+				conditions = append(conditions, documentField("$.workflow.labels")+" IS NOT NULL")
+				args = append(args, label.Key)
+			} else if label.Exists != nil {
+				conditions = append(conditions, documentField("$.workflow.labels")+" IS NULL")
+				args = append(args, label.Key)
+			}
+		}
+		if len(conditions) > 0 {
+			q = q.and(strings.Join(conditions, " OR "), args...)
+		}
+	}
+
+	if filter.ActorNameDefined() {
+		q = q.and("json_extract(document, '$.runningContext.actor.name') = ?", filter.ActorName())
+	}
+
+	if filter.ActorTypeDefined() {
+		q = q.and("json_extract(document, '$.runningContext.actor.type') = ?", string(filter.ActorType()))
+	}
+
+	if filter.TriageCategoryDefined() {
+		q = q.and("json_extract(document, '$.triage.category') = ?", string(filter.TriageCategory()))
+	} else if filter.TriagedDefined() {
+		if filter.Triaged() {
+			q = q.and("COALESCE(json_extract(document, '$.triage.category'), '') != ''")
+		} else {
+			q = q.and("COALESCE(json_extract(document, '$.triage.category'), '') = ''")
+		}
+	}
+
+	if filter.TriageAssigneeDefined() {
+		q = q.and("json_extract(document, '$.triage.assignee') = ?", filter.TriageAssignee())
+	}
+
+	if filter.RunnerIDDefined() {
+		q = q.and("runner_id = ?", filter.RunnerID())
+	} else if filter.AssignedDefined() {
+		if filter.Assigned() {
+			q = q.and("runner_id != ''")
+		} else {
+			q = q.and("runner_id = ''")
+		}
+	}
+
+	if filter.InitializedDefined() {
+		noSteps := "COALESCE(json_extract(document, '$.result.steps'), '{}') = '{}'"
+		if filter.Initialized() {
+			q = q.and("status != 'queued' OR NOT " + noSteps)
+		} else {
+			q = q.and("status = 'queued' AND " + noSteps)
+		}
+	}
+
+	if filter.HealthRangesDefined() {
+		conditions := make([]string, 0)
+		args := make([]any, 0)
+		for _, rng := range filter.HealthRanges() {
+			conditions = append(conditions, "json_extract(document, '$.workflow.status.health.overallHealth') BETWEEN ? AND ?")
+			args = append(args, rng[0], rng[1])
+		}
+		if len(conditions) > 0 {
+			q = q.and(strings.Join(conditions, " OR "), args...)
+		}
+	}
+
+	if filter.GroupIDDefined() {
+		q = q.and("id = ? OR group_id = ?", filter.GroupID(), filter.GroupID())
+	}
+
+	if filter.SkipDefined() {
+		q.Offset = filter.Skip()
+	} else {
+		q.Offset = filter.Page() * filter.PageSize()
+	}
+	q.Limit = filter.PageSize()
+
+	return q
+}
@@ -0,0 +1,616 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	repositorycommon "github.com/kubeshop/testkube/pkg/repository/common"
+	"github.com/kubeshop/testkube/pkg/repository/sequence"
+	sequencesqlite "github.com/kubeshop/testkube/pkg/repository/sequence/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+var _ testworkflow.Repository = (*SQLiteRepository)(nil)
+var _ testworkflow.Exporter = (*SQLiteRepository)(nil)
+
+const (
+	configParamSizeLimit = 100
+	orderLatest          = "scheduled_at DESC, id DESC"
+)
+
+type SQLiteRepository struct {
+	db                 *database.DB
+	sequenceRepository sequence.Repository
+}
+
+type SQLiteRepositoryOpt func(*SQLiteRepository)
+
+func NewSQLiteRepository(db *database.DB, opts ...SQLiteRepositoryOpt) *SQLiteRepository {
+	r := &SQLiteRepository{
+		db:                 db,
+		sequenceRepository: sequencesqlite.NewSQLiteRepository(db),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func WithSQLiteRepositorySequence(sequenceRepository sequence.Repository) SQLiteRepositoryOpt {
+	return func(r *SQLiteRepository) {
+		r.sequenceRepository = sequenceRepository
+	}
+}
+
+func (r *SQLiteRepository) Get(ctx context.Context, id string) (testkube.TestWorkflowExecution, error) {
+	result, err := r.db.FindExecution(ctx, database.Query{Where: "id = ? OR name = ?", Args: []any{id, id}})
+	if err == nil && result.ResolvedWorkflow != nil && result.ResolvedWorkflow.Spec != nil {
+		result.ConfigParams = populateConfigParams(result.ResolvedWorkflow, result.ConfigParams)
+	}
+	return result, err
+}
+
+func (r *SQLiteRepository) GetWithRunner(ctx context.Context, id, runner string) (testkube.TestWorkflowExecution, error) {
+	result, err := r.db.FindExecution(ctx, database.Query{Where: "(id = ? OR name = ?) AND runner_id = ?", Args: []any{id, id, runner}})
+	if err == nil && result.ResolvedWorkflow != nil && result.ResolvedWorkflow.Spec != nil {
+		result.ConfigParams = populateConfigParams(result.ResolvedWorkflow, result.ConfigParams)
+	}
+	return result, err
+}
+
+func (r *SQLiteRepository) GetByNameAndTestWorkflow(ctx context.Context, name, workflowName string) (testkube.TestWorkflowExecution, error) {
+	return r.db.FindExecution(ctx, database.Query{Where: "(id = ? OR name = ?) AND workflow_name = ?", Args: []any{name, name, workflowName}})
+}
+
+// GetLatestByTestWorkflow retrieves the latest test workflow execution for a given workflow name with configurable sorting
+func (r *SQLiteRepository) GetLatestByTestWorkflow(ctx context.Context, workflowName string, sortBy testworkflow.LatestSortBy) (*testkube.TestWorkflowExecution, error) {
+	orderBy := orderLatest
+	switch sortBy {
+	case testworkflow.LatestSortByNumber:
+		orderBy = "number DESC"
+	case testworkflow.LatestSortByStatusAt:
+		orderBy = "status_at DESC"
+	}
+	result, err := r.db.FindExecution(ctx, database.Query{Where: "workflow_name = ?", Args: []any{workflowName}, OrderBy: orderBy})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *SQLiteRepository) GetLatestByTestWorkflows(ctx context.Context, workflowNames []string) ([]testkube.TestWorkflowExecutionSummary, error) {
+	if len(workflowNames) == 0 {
+		return nil, nil
+	}
+	placeholders, args := database.Placeholders(workflowNames)
+	executions, err := r.db.FindExecutions(ctx, database.Query{
+		Where: `id IN (SELECT id FROM (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY workflow_name ORDER BY ` + orderLatest + `) AS position
+			FROM test_workflow_executions WHERE workflow_name IN (` + placeholders + `)
+		) WHERE position = 1)`,
+		Args:    args,
+		OrderBy: orderLatest,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]testkube.TestWorkflowExecutionSummary, len(executions))
+	for i := range executions {
+		result[i] = executionToSummary(executions[i])
+	}
+	return result, nil
+}
+
+func (r *SQLiteRepository) GetRunning(ctx context.Context) ([]testkube.TestWorkflowExecution, error) {
+	placeholders, args := database.Placeholders(testkube.TestWorkflowExecutingStatus)
+	return r.db.FindExecutions(ctx, database.Query{Where: "status IN (" + placeholders + ")", Args: args, OrderBy: orderLatest})
+}
+
+func (r *SQLiteRepository) GetFinished(ctx context.Context, filter testworkflow.Filter) ([]testkube.TestWorkflowExecution, error) {
+	q := composeQuery(filter)
+	q = q.and("status IN (?, ?, ?) AND silent_health = 0",
+		testkube.PASSED_TestWorkflowStatus, testkube.FAILED_TestWorkflowStatus, testkube.ABORTED_TestWorkflowStatus)
+	return r.db.FindExecutions(ctx, q.Query)
+}
+
+func (r *SQLiteRepository) GetUnassigned(ctx context.Context) ([]testkube.TestWorkflowExecution, error) {
+	return r.db.FindExecutions(ctx, database.Query{
+		Where:   "status = ? AND runner_id = ''",
+		Args:    []any{testkube.QUEUED_TestWorkflowStatus},
+		OrderBy: orderLatest,
+	})
+}
+
+func (r *SQLiteRepository) GetExecutionsTotals(ctx context.Context, filter ...testworkflow.Filter) (totals testkube.ExecutionsTotals, err error) {
+	inner := database.Query{OrderBy: orderLatest}
+	if len(filter) > 0 {
+		inner = composeQuery(filter[0]).Query
+	}
+	rows, err := r.db.QueryContext(ctx, "SELECT status, COUNT(*) FROM (SELECT status FROM test_workflow_executions"+inner.String()+") GROUP BY status", inner.Args...)
+	if err != nil {
+		return totals, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var status string
+		var count int32
+		if err = rows.Scan(&status, &count); err != nil {
+			return totals, err
+		}
+		totals.Results += count
+		switch testkube.TestWorkflowStatus(status) {
+		case testkube.QUEUED_TestWorkflowStatus, testkube.ASSIGNED_TestWorkflowStatus, testkube.STARTING_TestWorkflowStatus, testkube.SCHEDULING_TestWorkflowStatus:
+			totals.Queued += count
+		case testkube.RUNNING_TestWorkflowStatus, testkube.PAUSING_TestWorkflowStatus, testkube.PAUSED_TestWorkflowStatus, testkube.RESUMING_TestWorkflowStatus, testkube.STOPPING_TestWorkflowStatus:
+			totals.Running += count
+		case testkube.PASSED_TestWorkflowStatus:
+			totals.Passed += count
+		case testkube.FAILED_TestWorkflowStatus, testkube.ABORTED_TestWorkflowStatus, testkube.CANCELED_TestWorkflowStatus:
+			totals.Failed += count
+		}
+	}
+	return totals, rows.Err()
+}
+
+func (r *SQLiteRepository) Count(ctx context.Context, filter testworkflow.Filter) (int64, error) {
+	return r.db.CountExecutions(ctx, composeQuery(filter).Query)
+}
+
+func (r *SQLiteRepository) GetExecutions(ctx context.Context, filter testworkflow.Filter) ([]testkube.TestWorkflowExecution, error) {
+	return r.db.FindExecutions(ctx, composeQuery(filter).Query)
+}
+
+func (r *SQLiteRepository) GetExecutionsSummary(ctx context.Context, filter testworkflow.Filter) ([]testkube.TestWorkflowExecutionSummary, error) {
+	executions, err := r.db.FindExecutions(ctx, composeQuery(filter).Query)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]testkube.TestWorkflowExecutionSummary, len(executions))
+	for i := range executions {
+		if executions[i].ResolvedWorkflow != nil && executions[i].ResolvedWorkflow.Spec != nil {
+			executions[i].ConfigParams = populateConfigParams(executions[i].ResolvedWorkflow, executions[i].ConfigParams)
+		}
+		result[i] = executionToSummary(executions[i])
+	}
+	return result, nil
+}
+
+// Export gets the batch of executions sorted by ID
+func (r *SQLiteRepository) Export(ctx context.Context, opts testworkflow.ExportOptions) ([]testkube.TestWorkflowExecution, error) {
+	q := query{Query: database.Query{OrderBy: "id ASC", Limit: opts.Limit}}
+	if opts.AfterID != "" {
+		q = q.and("id > ?", opts.AfterID)
+	}
+	return r.db.FindExecutions(ctx, q.Query)
+}
+
+func (r *SQLiteRepository) Insert(ctx context.Context, result testkube.TestWorkflowExecution) error {
+	if result.Reports == nil {
+		result.Reports = []testkube.TestWorkflowReport{}
+	}
+	return r.db.InsertExecution(ctx, result)
+}
+
+func (r *SQLiteRepository) Update(ctx context.Context, result testkube.TestWorkflowExecution) error {
+	if result.Reports == nil {
+		result.Reports = []testkube.TestWorkflowReport{}
+	}
+	return r.db.ReplaceExecution(ctx, result)
+}
+
+// update modifies the execution by ID, ignoring the missing ones like MongoDB's UpdateOne does
+func (r *SQLiteRepository) update(ctx context.Context, id string, fn func(execution *testkube.TestWorkflowExecution)) error {
+	_, _, err := r.db.UpdateExecution(ctx, database.Query{Where: "id = ?", Args: []any{id}}, func(execution *testkube.TestWorkflowExecution) bool {
+		fn(execution)
+		return true
+	})
+	if utils.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *SQLiteRepository) UpdateResult(ctx context.Context, id string, result *testkube.TestWorkflowResult) error {
+	return r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		execution.Result = result
+		if !result.FinishedAt.IsZero() {
+			execution.StatusAt = result.FinishedAt
+		}
+	})
+}
+
+// UpdateResultStrict is a stricter version of UpdateResult which checks for matching runner id and valid states.
+func (r *SQLiteRepository) UpdateResultStrict(ctx context.Context, id, runnerId string, result *testkube.TestWorkflowResult) (bool, error) {
+	if result.IsFinished() {
+		return false, errors.New("invalid state")
+	}
+	q := query{Query: database.Query{Where: "id = ? AND runner_id = ?", Args: []any{id, runnerId}}}.in("status",
+		testkube.ASSIGNED_TestWorkflowStatus,
+		testkube.STARTING_TestWorkflowStatus,
+		testkube.SCHEDULING_TestWorkflowStatus,
+		testkube.RUNNING_TestWorkflowStatus,
+		testkube.PAUSING_TestWorkflowStatus,
+		testkube.PAUSED_TestWorkflowStatus,
+		testkube.RESUMING_TestWorkflowStatus,
+	)
+	_, updated, err := r.db.UpdateExecution(ctx, q.Query, func(execution *testkube.TestWorkflowExecution) bool {
+		if execution.Result == nil || execution.Result.Status == nil || result.Status == nil || *execution.Result.Status != *result.Status {
+			execution.StatusAt = time.Now()
+		}
+		execution.Result = result
+		return true
+	})
+	return updated, err
+}
+
+func (r *SQLiteRepository) FinishResultStrict(ctx context.Context, id, runnerId string, result *testkube.TestWorkflowResult) (bool, error) {
+	if !result.IsFinished() {
+		return false, errors.New("invalid state")
+	}
+	// The expected statuses from which an execution may be finished, see the MongoDB repository for details.
+	q := query{Query: database.Query{Where: "id = ? AND runner_id = ?", Args: []any{id, runnerId}}}.in("status",
+		testkube.QUEUED_TestWorkflowStatus,
+		testkube.ASSIGNED_TestWorkflowStatus,
+		testkube.RUNNING_TestWorkflowStatus,
+		testkube.STOPPING_TestWorkflowStatus,
+		testkube.STARTING_TestWorkflowStatus,
+		testkube.SCHEDULING_TestWorkflowStatus,
+	)
+	_, updated, err := r.db.UpdateExecution(ctx, q.Query, func(execution *testkube.TestWorkflowExecution) bool {
+		execution.Result = result
+		execution.StatusAt = result.FinishedAt
+		return true
+	})
+	return updated, err
+}
+
+func (r *SQLiteRepository) UpdateReport(ctx context.Context, id string, report *testkube.TestWorkflowReport) error {
+	return r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		execution.Reports = append(execution.Reports, *report)
+	})
+}
+
+func (r *SQLiteRepository) UpdateOutput(ctx context.Context, id string, refs []testkube.TestWorkflowOutput) error {
+	return r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		execution.Output = refs
+	})
+}
+
+func (r *SQLiteRepository) UpdateResourceAggregations(ctx context.Context, id string, resourceAggregations *testkube.TestWorkflowExecutionResourceAggregationsReport) error {
+	return r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		execution.ResourceAggregations = resourceAggregations
+	})
+}
+
+func (r *SQLiteRepository) UpdateTags(ctx context.Context, id string, tags map[string]string) error {
+	return r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		execution.Tags = tags
+	})
+}
+
+func (r *SQLiteRepository) UpdateTriage(ctx context.Context, id string, previous, triage *testkube.TestWorkflowExecutionTriage) (bool, error) {
+	updated := false
+	err := r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		if triageUpdatedAt(execution.Triage).Equal(triageUpdatedAt(previous)) {
+			execution.Triage = triage
+			updated = true
+		}
+	})
+	return updated, err
+}
+
+func triageUpdatedAt(triage *testkube.TestWorkflowExecutionTriage) time.Time {
+	if triage == nil {
+		return time.Time{}
+	}
+	return triage.UpdatedAt
+}
+
+func (r *SQLiteRepository) GetTriageStats(ctx context.Context, filter testworkflow.Filter) ([]testworkflow.TriageCount, error) {
+	inner := composeQuery(filter).Query
+	inner.Limit, inner.Offset, inner.OrderBy = 0, 0, ""
+	rows, err := r.db.QueryContext(ctx, `SELECT workflow_name, category, COUNT(*) FROM (
+		SELECT workflow_name, COALESCE(json_extract(document, '$.triage.category'), '') AS category
+		FROM test_workflow_executions`+inner.String()+`
+	) GROUP BY workflow_name, category`, inner.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := make([]testworkflow.TriageCount, 0)
+	for rows.Next() {
+		var count testworkflow.TriageCount
+		if err = rows.Scan(&count.Workflow, &count.Category, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+func (r *SQLiteRepository) AddApproval(ctx context.Context, id string, approval testkube.TestWorkflowApproval) (bool, error) {
+	added := false
+	err := r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		if execution.GetApprovalDecision(approval.Ref) == nil {
+			execution.Approvals = append(execution.Approvals, approval)
+			added = true
+		}
+	})
+	return added, err
+}
+
+// DeleteByTestWorkflow deletes execution results by workflow
+func (r *SQLiteRepository) DeleteByTestWorkflow(ctx context.Context, workflowName string) error {
+	if r.sequenceRepository != nil {
+		if err := r.sequenceRepository.DeleteExecutionNumber(ctx, workflowName, sequence.ExecutionTypeTestWorkflow); err != nil {
+			return err
+		}
+	}
+	return r.db.DeleteExecutions(ctx, database.Query{Where: "workflow_name = ?", Args: []any{workflowName}})
+}
+
+// DeleteAll deletes all execution results
+func (r *SQLiteRepository) DeleteAll(ctx context.Context) error {
+	if r.sequenceRepository != nil {
+		if err := r.sequenceRepository.DeleteAllExecutionNumbers(ctx, sequence.ExecutionTypeTestWorkflow); err != nil {
+			return err
+		}
+	}
+	return r.db.DeleteExecutions(ctx, database.Query{})
+}
+
+// DeleteByTestWorkflows deletes execution results by workflows
+func (r *SQLiteRepository) DeleteByTestWorkflows(ctx context.Context, workflowNames []string) error {
+	if len(workflowNames) == 0 {
+		return nil
+	}
+	if r.sequenceRepository != nil {
+		if err := r.sequenceRepository.DeleteExecutionNumbers(ctx, workflowNames, sequence.ExecutionTypeTestWorkflow); err != nil {
+			return err
+		}
+	}
+	placeholders, args := database.Placeholders(workflowNames)
+	return r.db.DeleteExecutions(ctx, database.Query{Where: "workflow_name IN (" + placeholders + ")", Args: args})
+}
+
+// GetTestWorkflowMetrics returns test executions metrics
+func (r *SQLiteRepository) GetTestWorkflowMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error) {
+	if limit == 0 {
+		limit = 100
+	}
+	q := query{Query: database.Query{Where: "workflow_name = ?", Args: []any{name}, OrderBy: orderLatest, Limit: limit}}
+	if last > 0 {
+		q = q.and("scheduled_at >= ?", time.Now().Add(-time.Duration(last)*24*time.Hour).UnixMilli())
+	}
+	executions, err := r.db.FindExecutions(ctx, q.Query)
+	if err != nil {
+		return metrics, err
+	}
+
+	items := make([]testkube.ExecutionsMetricsExecutions, len(executions))
+	for i, execution := range executions {
+		items[i] = testkube.ExecutionsMetricsExecutions{
+			ExecutionId: execution.Id,
+			GroupId:     execution.GroupId,
+			Name:        execution.Name,
+			StartTime:   execution.ScheduledAt,
+			RunnerId:    execution.RunnerId,
+			SilentMode:  execution.SilentMode,
+		}
+		if execution.Result != nil {
+			items[i].Duration = execution.Result.Duration
+			items[i].DurationMs = execution.Result.DurationMs
+			if execution.Result.Status != nil {
+				items[i].Status = string(*execution.Result.Status)
+			}
+		}
+	}
+	return repositorycommon.CalculateMetrics(items), nil
+}
+
+// GetPreviousFinishedState gets previous finished execution state by test workflow
+func (r *SQLiteRepository) GetPreviousFinishedState(ctx context.Context, testWorkflowName string, date time.Time, opts testworkflow.GetPreviousFinishedStateOptions) (testkube.TestWorkflowStatus, error) {
+	q := query{Query: database.Query{Where: "workflow_name = ? AND finished_at < ?", Args: []any{testWorkflowName, date.UnixMilli()}, OrderBy: "finished_at DESC"}}.
+		in("status", "passed", "failed", "skipped", "aborted", "canceled", "timeout")
+	if opts.SkipSilentWebhookExecutions {
+		q = q.and("silent_webhooks = 0")
+	}
+	result, err := r.db.FindExecution(ctx, q.Query)
+	if utils.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error decoding previous finished execution status: %w", err)
+	}
+	if result.Result == nil || result.Result.Status == nil {
+		return "", nil
+	}
+	return *result.Result.Status, nil
+}
+
+// GetNextExecutionNumber gets next execution number by name
+func (r *SQLiteRepository) GetNextExecutionNumber(ctx context.Context, name string) (int32, error) {
+	if r.sequenceRepository == nil {
+		return 0, errors.New("no sequence repository provided")
+	}
+	return r.sequenceRepository.GetNextExecutionNumber(ctx, name, sequence.ExecutionTypeTestWorkflow)
+}
+
+func (r *SQLiteRepository) GetExecutionTags(ctx context.Context, testWorkflowName string) (map[string][]string, error) {
+	q := query{}
+	if testWorkflowName != "" {
+		q = q.and("e.workflow_name = ?", testWorkflowName)
+	}
+	rows, err := r.db.QueryContext(ctx, `SELECT DISTINCT t.key, t.value
+		FROM test_workflow_executions e, json_each(e.document, '$.tags') t`+q.String()+` ORDER BY t.key, t.value`, q.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tags := make(map[string][]string)
+	for rows.Next() {
+		var key, value string
+		if err = rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+		tags[key] = append(tags[key], value)
+	}
+	return tags, rows.Err()
+}
+
+func (r *SQLiteRepository) Init(ctx context.Context, id string, data testworkflow.InitData) error {
+	return r.update(ctx, id, func(execution *testkube.TestWorkflowExecution) {
+		execution.Namespace = data.Namespace
+		execution.Signature = data.Signature
+		execution.RunnerId = data.RunnerID
+		if execution.Result == nil {
+			execution.Result = &testkube.TestWorkflowResult{}
+		}
+		execution.Result.Status = common.Ptr(testkube.SCHEDULING_TestWorkflowStatus)
+		execution.StatusAt = time.Now()
+	})
+}
+
+func (r *SQLiteRepository) Assign(ctx context.Context, id string, prevRunnerId string, newRunnerId string, assignedAt *time.Time) (bool, error) {
+	oneMinuteAgo := time.Now().Add(-1 * time.Minute).UnixMilli()
+	var assignedAtValue time.Time
+	if assignedAt != nil {
+		assignedAtValue = *assignedAt
+	}
+	assignedAtMs := database.Millis(assignedAtValue)
+	_, _, err := r.db.UpdateExecution(ctx, database.Query{
+		Where: `id = ? AND status = ? AND (
+			runner_id = ''
+			OR (runner_id = ? AND assigned_at < ?)
+			OR (runner_id = ? AND assigned_at < ? AND assigned_at < ?)
+		)`,
+		Args: []any{id, testkube.QUEUED_TestWorkflowStatus, newRunnerId, assignedAtMs, prevRunnerId, oneMinuteAgo, assignedAtMs},
+	}, func(execution *testkube.TestWorkflowExecution) bool {
+		execution.RunnerId = newRunnerId
+		execution.AssignedAt = assignedAtValue
+		return true
+	})
+	if utils.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *SQLiteRepository) AbortIfQueued(ctx context.Context, id string) (bool, error) {
+	placeholders, args := database.Placeholders(testkube.TestWorkflowStoppableStatus)
+	q := query{Query: database.Query{Where: "id = ? AND runner_id = ''", Args: []any{id}}}.and("status IN ("+placeholders+")", args...)
+	ts := time.Now()
+	_, modified, err := r.db.UpdateExecution(ctx, q.Query, func(execution *testkube.TestWorkflowExecution) bool {
+		if execution.Result == nil {
+			execution.Result = &testkube.TestWorkflowResult{}
+		}
+		if execution.Result.Initialization == nil {
+			execution.Result.Initialization = &testkube.TestWorkflowStepResult{}
+		}
+		execution.StatusAt = ts
+		execution.Result.Status = common.Ptr(testkube.ABORTED_TestWorkflowStatus)
+		execution.Result.PredictedStatus = common.Ptr(testkube.ABORTED_TestWorkflowStatus)
+		execution.Result.FinishedAt = ts
+		execution.Result.Initialization.Status = common.Ptr(testkube.ABORTED_TestWorkflowStepStatus)
+		execution.Result.Initialization.ErrorMessage = "Aborted before initialization."
+		execution.Result.Initialization.FinishedAt = ts
+		return true
+	})
+	if utils.IsNotFound(err) {
+		return false, nil
+	}
+	return modified, err
+}
+
+func executionToSummary(execution testkube.TestWorkflowExecution) testkube.TestWorkflowExecutionSummary {
+	summary := testkube.TestWorkflowExecutionSummary{
+		Id:                   execution.Id,
+		GroupId:              execution.GroupId,
+		RunnerId:             execution.RunnerId,
+		Name:                 execution.Name,
+		Number:               execution.Number,
+		ScheduledAt:          execution.ScheduledAt,
+		StatusAt:             execution.StatusAt,
+		Tags:                 execution.Tags,
+		RunningContext:       execution.RunningContext,
+		ConfigParams:         execution.ConfigParams,
+		Runtime:              execution.Runtime,
+		Reports:              execution.Reports,
+		ResourceAggregations: execution.ResourceAggregations,
+		SilentMode:           execution.SilentMode,
+	}
+	if result := execution.Result; result != nil {
+		summary.Result = &testkube.TestWorkflowResultSummary{
+			Status:          result.Status,
+			PredictedStatus: result.PredictedStatus,
+			QueuedAt:        result.QueuedAt,
+			StartedAt:       result.StartedAt,
+			FinishedAt:      result.FinishedAt,
+			Duration:        result.Duration,
+			TotalDuration:   result.TotalDuration,
+			DurationMs:      result.DurationMs,
+			TotalDurationMs: result.TotalDurationMs,
+			PausedMs:        result.PausedMs,
+		}
+	}
+	if workflow := execution.Workflow; workflow != nil {
+		summary.Workflow = &testkube.TestWorkflowSummary{
+			Name:        workflow.Name,
+			Namespace:   workflow.Namespace,
+			Labels:      workflow.Labels,
+			Annotations: workflow.Annotations,
+		}
+		if workflow.Status != nil {
+			summary.Workflow.Health = workflow.Status.Health
+		}
+	}
+	return summary
+}
+
+// populateConfigParams - same as in mongo repository
+func populateConfigParams(resolvedWorkflow *testkube.TestWorkflow, configParams map[string]testkube.TestWorkflowExecutionConfigValue) map[string]testkube.TestWorkflowExecutionConfigValue {
+	if configParams == nil {
+		configParams = make(map[string]testkube.TestWorkflowExecutionConfigValue)
+	}
+
+	for k, v := range resolvedWorkflow.Spec.Config {
+		if v.Sensitive {
+			configParams[k] = testkube.TestWorkflowExecutionConfigValue{
+				Sensitive:         true,
+				EmptyValue:        true,
+				EmptyDefaultValue: true,
+			}
+			continue
+		}
+
+		if _, ok := configParams[k]; !ok {
+			configParams[k] = testkube.TestWorkflowExecutionConfigValue{
+				EmptyValue: true,
+			}
+		}
+
+		data := configParams[k]
+		if len(data.Value) > configParamSizeLimit {
+			data.Value = data.Value[:configParamSizeLimit]
+			data.Truncated = true
+		}
+
+		if v.Default_ != nil {
+			data.DefaultValue = v.Default_.Value
+		} else {
+			data.EmptyDefaultValue = true
+		}
+
+		configParams[k] = data
+	}
+
+	return configParams
+}
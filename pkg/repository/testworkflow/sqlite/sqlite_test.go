@@ -0,0 +1,22 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	database "github.com/kubeshop/testkube/pkg/database/sqlite"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow/testsuite"
+)
+
+func TestSQLiteRepositorySuite(t *testing.T) {
+	ctx := context.Background()
+	db, err := database.Open(ctx, database.MemoryPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Migrate(ctx)
+	require.NoError(t, err)
+
+	testsuite.RunRepositoryTests(t, NewSQLiteRepository(db))
+}
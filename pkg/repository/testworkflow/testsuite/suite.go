@@ -19,6 +19,9 @@ func RunRepositoryTests(t *testing.T, repo testworkflow.Repository) {
 	t.Run("UpdateResourceAggregations", func(t *testing.T) { testUpdateResourceAggregations(t, repo) })
 	t.Run("UpdateTags", func(t *testing.T) { testUpdateTags(t, repo) })
 	t.Run("GetExecutionTags", func(t *testing.T) { testGetExecutionTags(t, repo) })
+	t.Run("UpdateTriage", func(t *testing.T) { testUpdateTriage(t, repo) })
+	t.Run("GetExecutionsByTriage", func(t *testing.T) { testGetExecutionsByTriage(t, repo) })
+	t.Run("GetTriageStats", func(t *testing.T) { testGetTriageStats(t, repo) })
 	t.Run("GetExecutions", func(t *testing.T) { testGetExecutions(t, repo) })
 	t.Run("GetExecutionsByName", func(t *testing.T) { testGetExecutionsByName(t, repo) })
 	t.Run("GetExecutionsByStatus", func(t *testing.T) { testGetExecutionsByStatus(t, repo) })
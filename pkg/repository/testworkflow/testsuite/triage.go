@@ -0,0 +1,137 @@
+package testsuite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/test/fixtures"
+)
+
+func newTriage(category testkube.TestWorkflowTriageCategory, assignee string) *testkube.TestWorkflowExecutionTriage {
+	triage, _ := (*testkube.TestWorkflowExecutionTriage)(nil).Apply(testkube.TestWorkflowExecutionTriageRequest{
+		Category: fixtures.Ptr(string(category)),
+		Assignee: fixtures.Ptr(assignee),
+		Note:     "investigated",
+	}, "jane", time.Now().UTC().Truncate(time.Millisecond))
+	return triage
+}
+
+func testUpdateTriage(t *testing.T, repo testworkflow.Repository) {
+	ctx := context.Background()
+
+	execution := fixtures.NewFailedExecution("triage-test")
+	err := repo.Insert(ctx, execution)
+	require.NoError(t, err)
+
+	triage := newTriage(testkube.INFRA_TestWorkflowTriageCategory, "jane")
+	updated, err := repo.UpdateTriage(ctx, execution.Id, nil, triage)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	got, err := repo.Get(ctx, execution.Id)
+	require.NoError(t, err)
+	require.NotNil(t, got.Triage)
+	assert.Equal(t, testkube.INFRA_TestWorkflowTriageCategory, got.Triage.GetCategory())
+	assert.Equal(t, "jane", got.Triage.Assignee)
+	assert.Len(t, got.Triage.Notes, 1)
+	assert.Len(t, got.Triage.History, 3)
+
+	// The update based on the outdated triage is rejected
+	updated, err = repo.UpdateTriage(ctx, execution.Id, nil, newTriage(testkube.FLAKY_TestWorkflowTriageCategory, "john"))
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	next, err := got.Triage.Apply(testkube.TestWorkflowExecutionTriageRequest{Note: "node evicted"}, "john", got.Triage.UpdatedAt.Add(time.Second))
+	require.NoError(t, err)
+	updated, err = repo.UpdateTriage(ctx, execution.Id, got.Triage, next)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	updated, err = repo.UpdateTriage(ctx, execution.Id, got.Triage, next)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	got, err = repo.Get(ctx, execution.Id)
+	require.NoError(t, err)
+	assert.Equal(t, testkube.INFRA_TestWorkflowTriageCategory, got.Triage.GetCategory())
+	assert.Len(t, got.Triage.Notes, 2)
+}
+
+func updateTriage(t *testing.T, repo testworkflow.Repository, id string, triage *testkube.TestWorkflowExecutionTriage) {
+	updated, err := repo.UpdateTriage(context.Background(), id, nil, triage)
+	require.NoError(t, err)
+	require.True(t, updated)
+}
+
+func testGetExecutionsByTriage(t *testing.T, repo testworkflow.Repository) {
+	ctx := context.Background()
+
+	wfName := fmt.Sprintf("triage-filter-wf-%d", time.Now().UnixNano())
+	infra := fixtures.NewFailedExecution(wfName, fixtures.WithNumber(1))
+	flaky := fixtures.NewFailedExecution(wfName, fixtures.WithNumber(2))
+	untriaged := fixtures.NewFailedExecution(wfName, fixtures.WithNumber(3))
+	for _, execution := range []testkube.TestWorkflowExecution{infra, flaky, untriaged} {
+		require.NoError(t, repo.Insert(ctx, execution))
+	}
+	updateTriage(t, repo, infra.Id, newTriage(testkube.INFRA_TestWorkflowTriageCategory, "jane"))
+	updateTriage(t, repo, flaky.Id, newTriage(testkube.FLAKY_TestWorkflowTriageCategory, "john"))
+
+	results, err := repo.GetExecutions(ctx, testworkflow.NewExecutionsFilter().WithName(wfName).WithTriageCategory(testkube.INFRA_TestWorkflowTriageCategory))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, infra.Id, results[0].Id)
+
+	results, err = repo.GetExecutions(ctx, testworkflow.NewExecutionsFilter().WithName(wfName).WithTriageAssignee("john"))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, flaky.Id, results[0].Id)
+
+	results, err = repo.GetExecutions(ctx, testworkflow.NewExecutionsFilter().WithName(wfName).WithTriaged(false))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, untriaged.Id, results[0].Id)
+
+	count, err := repo.Count(ctx, testworkflow.NewExecutionsFilter().WithName(wfName).WithTriaged(true))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func testGetTriageStats(t *testing.T, repo testworkflow.Repository) {
+	ctx := context.Background()
+
+	wfName := fmt.Sprintf("triage-stats-wf-%d", time.Now().UnixNano())
+	categories := []testkube.TestWorkflowTriageCategory{
+		testkube.INFRA_TestWorkflowTriageCategory,
+		testkube.INFRA_TestWorkflowTriageCategory,
+		testkube.FLAKY_TestWorkflowTriageCategory,
+		"",
+	}
+	for i, category := range categories {
+		execution := fixtures.NewFailedExecution(wfName, fixtures.WithNumber(int32(i+1)))
+		require.NoError(t, repo.Insert(ctx, execution))
+		if category != "" {
+			updateTriage(t, repo, execution.Id, newTriage(category, ""))
+		}
+	}
+	passed := fixtures.NewPassedExecution(wfName, fixtures.WithNumber(int32(len(categories)+1)))
+	require.NoError(t, repo.Insert(ctx, passed))
+
+	counts, err := repo.GetTriageStats(ctx, testworkflow.NewExecutionsFilter().WithName(wfName).WithStatus(string(testkube.FAILED_TestWorkflowStatus)))
+	require.NoError(t, err)
+
+	stats := testworkflow.BuildTriageStats(counts)
+	require.Len(t, stats, 1)
+	assert.Equal(t, wfName, stats[0].Workflow)
+	assert.Equal(t, int32(4), stats[0].Failures)
+	assert.Equal(t, int32(1), stats[0].Untriaged)
+	assert.ElementsMatch(t, []testkube.TestWorkflowTriageCategoryStats{
+		{Category: testkube.INFRA_TestWorkflowTriageCategory, Count: 2, Share: 50},
+		{Category: testkube.FLAKY_TestWorkflowTriageCategory, Count: 1, Share: 25},
+	}, stats[0].Categories)
+}
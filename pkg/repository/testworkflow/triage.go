@@ -0,0 +1,56 @@
+package testworkflow
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// TriageCount is the number of the workflow executions in the triage category.
+// Empty category stands for the executions that are not triaged yet.
+type TriageCount struct {
+	Workflow string                              `json:"workflow"`
+	Category testkube.TestWorkflowTriageCategory `json:"category,omitempty"`
+	Count    int                                 `json:"count"`
+}
+
+// BuildTriageStats computes the share of each triage category in the executions of every workflow
+func BuildTriageStats(counts []TriageCount) []testkube.TestWorkflowTriageStats {
+	byWorkflow := make(map[string]map[testkube.TestWorkflowTriageCategory]int)
+	for _, c := range counts {
+		if byWorkflow[c.Workflow] == nil {
+			byWorkflow[c.Workflow] = make(map[testkube.TestWorkflowTriageCategory]int)
+		}
+		byWorkflow[c.Workflow][c.Category] += c.Count
+	}
+
+	result := make([]testkube.TestWorkflowTriageStats, 0, len(byWorkflow))
+	for workflow, categories := range byWorkflow {
+		stats := testkube.TestWorkflowTriageStats{Workflow: workflow}
+		for _, count := range categories {
+			stats.Failures += int32(count)
+		}
+		for category, count := range categories {
+			if !slices.Contains(testkube.TestWorkflowTriageCategories, category) {
+				stats.Untriaged += int32(count)
+			}
+		}
+		for _, category := range testkube.TestWorkflowTriageCategories {
+			if categories[category] == 0 {
+				continue
+			}
+			stats.Categories = append(stats.Categories, testkube.TestWorkflowTriageCategoryStats{
+				Category: category,
+				Count:    int32(categories[category]),
+				Share:    math.Round(float64(categories[category])*10000/float64(stats.Failures)) / 100,
+			})
+		}
+		result = append(result, stats)
+	}
+	slices.SortFunc(result, func(a, b testkube.TestWorkflowTriageStats) int {
+		return strings.Compare(a.Workflow, b.Workflow)
+	})
+	return result
+}
@@ -0,0 +1,28 @@
+package testworkflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestBuildTriageStats(t *testing.T) {
+	stats := BuildTriageStats([]TriageCount{
+		{Workflow: "web", Category: testkube.FLAKY_TestWorkflowTriageCategory, Count: 1},
+		{Workflow: "api", Category: "", Count: 2},
+		{Workflow: "api", Category: testkube.INFRA_TestWorkflowTriageCategory, Count: 3},
+		{Workflow: "api", Category: testkube.PRODUCT_BUG_TestWorkflowTriageCategory, Count: 1},
+	})
+
+	assert.Equal(t, []testkube.TestWorkflowTriageStats{
+		{Workflow: "api", Failures: 6, Untriaged: 2, Categories: []testkube.TestWorkflowTriageCategoryStats{
+			{Category: testkube.PRODUCT_BUG_TestWorkflowTriageCategory, Count: 1, Share: 16.67},
+			{Category: testkube.INFRA_TestWorkflowTriageCategory, Count: 3, Share: 50},
+		}},
+		{Workflow: "web", Failures: 1, Categories: []testkube.TestWorkflowTriageCategoryStats{
+			{Category: testkube.FLAKY_TestWorkflowTriageCategory, Count: 1, Share: 100},
+		}},
+	}, stats)
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"io"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BodyLimit buffers the streamed request body up to the configured limit,
+// so only the StreamedBodyPaths routes may receive the larger bodies.
+func (s *HTTPServer) BodyLimit() fiber.Handler {
+	limit := s.Config.Http.BodyLimit
+	if limit <= 0 {
+		limit = fiber.DefaultBodyLimit
+	}
+	return func(c *fiber.Ctx) error {
+		if slices.Contains(s.Config.StreamedBodyPaths, c.Path()) {
+			return c.Next()
+		}
+		if c.Request().Header.ContentLength() > limit {
+			// The body is not read, so the connection can't be reused
+			c.Context().SetConnectionClose()
+			return fiber.ErrRequestEntityTooLarge
+		}
+		stream := c.Context().RequestBodyStream()
+		if stream == nil {
+			return c.Next()
+		}
+		body, err := io.ReadAll(io.LimitReader(stream, int64(limit)+1))
+		if err != nil {
+			return fiber.ErrBadRequest
+		}
+		if len(body) > limit {
+			c.Context().SetConnectionClose()
+			return fiber.ErrRequestEntityTooLarge
+		}
+		c.Request().SetBody(body)
+		return c.Next()
+	}
+}
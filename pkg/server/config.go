@@ -11,6 +11,9 @@ type Config struct {
 	Port          int
 	Http          fiber.Config
 	EnableTracing bool
+	// StreamedBodyPaths are the routes reading the request body stream with their own size limit,
+	// while the bodies of the other routes are limited with Http.BodyLimit
+	StreamedBodyPaths []string
 }
 
 // Addr returns port based address
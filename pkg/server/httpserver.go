@@ -17,6 +17,10 @@ import (
 // NewServer returns new HTTP server instance, initializes logger and metrics
 func NewServer(config Config) HTTPServer {
 	config.Http.DisableStartupMessage = true
+	if len(config.StreamedBodyPaths) > 0 {
+		config.Http.StreamRequestBody = true
+		config.Http.DisablePreParseMultipartForm = true
+	}
 
 	s := HTTPServer{
 		Mux:    fiber.New(config.Http),
@@ -45,6 +49,10 @@ func (s *HTTPServer) Init() {
 		return c.Next()
 	})
 
+	if s.Config.Http.StreamRequestBody {
+		s.Mux.Use(s.BodyLimit())
+	}
+
 	s.Mux.Use(pprof.New())
 
 	if s.Config.EnableTracing {
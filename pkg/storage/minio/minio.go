@@ -168,6 +168,27 @@ func (c *Client) listFiles(ctx context.Context, bucket, bucketFolder string) ([]
 	return toReturn, nil
 }
 
+// ListFilesFromBucket lists available files in given bucket, with names relative to the bucket folder
+func (c *Client) ListFilesFromBucket(ctx context.Context, bucket, bucketFolder string) ([]testkube.Artifact, error) {
+	c.Log.Debugw("listing files", "bucket", bucket, "bucketFolder", bucketFolder)
+	if err := c.Connect(); err != nil {
+		return nil, err
+	}
+	listOptions := minio.ListObjectsOptions{Recursive: true}
+	if bucketFolder = strings.Trim(bucketFolder, "/"); bucketFolder != "" {
+		listOptions.Prefix = bucketFolder + "/"
+	}
+
+	var toReturn []testkube.Artifact
+	for obj := range c.minioClient.ListObjects(ctx, bucket, listOptions) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		toReturn = append(toReturn, testkube.Artifact{Name: strings.TrimPrefix(obj.Key, listOptions.Prefix), Size: int32(obj.Size)})
+	}
+	return toReturn, nil
+}
+
 // ListFiles lists available files in the bucket from the config
 func (c *Client) ListFiles(ctx context.Context, bucketFolder string) ([]testkube.Artifact, error) {
 	c.Log.Infow("listing files", "bucket", c.bucket, "bucketFolder", bucketFolder)
@@ -0,0 +1,145 @@
+package executionarchive
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the version of the archive format
+	Version = 1
+	// FileExtension is the suggested extension of the archive files
+	FileExtension = ".tkx.tar.gz"
+
+	ManifestFile   = "manifest.json"
+	SignatureFile  = "manifest.sig"
+	ExecutionFile  = "execution.json"
+	LogFile        = "logs.txt"
+	ArtifactsDir   = "artifacts/"
+	ImportedTagKey = "testkube.io/imported"
+
+	// DefaultMaxExtractedSize limits the total size of the files extracted from the archive
+	DefaultMaxExtractedSize = 1024 * 1024 * 1024
+)
+
+var (
+	ErrInvalidSignature = errors.New("the archive signature is invalid")
+	ErrUntrustedKey     = errors.New("the archive is signed with untrusted key")
+	ErrArchiveTooLarge  = errors.New("the archive content exceeds the size limit")
+	ErrNoSigningKey     = errors.New("the signing key is required")
+)
+
+// Manifest describes the content of the archive, and is signed to detect tampering
+type Manifest struct {
+	Version       int       `json:"version"`
+	CreatedAt     time.Time `json:"createdAt"`
+	ExecutionID   string    `json:"executionId"`
+	ExecutionName string    `json:"executionName"`
+	WorkflowName  string    `json:"workflowName"`
+	Files         []File    `json:"files"`
+	// PublicKey is the ed25519 key to verify the signature with
+	PublicKey []byte `json:"publicKey"`
+}
+
+// File describes a single file in the archive
+type File struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Artifacts returns the artifact files, with the paths relative to the artifacts directory
+func (m *Manifest) Artifacts() []File {
+	result := make([]File, 0)
+	for _, f := range m.Files {
+		if strings.HasPrefix(f.Path, ArtifactsDir) {
+			f.Path = strings.TrimPrefix(f.Path, ArtifactsDir)
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// Fingerprint is the short identifier of the key used to sign the archive
+func (m *Manifest) Fingerprint() string {
+	return KeyFingerprint(m.PublicKey)
+}
+
+// KeyFingerprint builds the short identifier of the public key
+func KeyFingerprint(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// LoadPrivateKey reads the PEM-encoded PKCS #8 ed25519 private key,
+// like the one generated with `openssl genpkey -algorithm ed25519`.
+func LoadPrivateKey(filePath string) (ed25519.PrivateKey, error) {
+	block, err := readPEM(filePath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key must be ed25519, got %T", key)
+	}
+	return privateKey, nil
+}
+
+// LoadPublicKey reads the PEM-encoded PKIX ed25519 public key,
+// like the one generated with `openssl pkey -pubout`.
+func LoadPublicKey(filePath string) (ed25519.PublicKey, error) {
+	block, err := readPEM(filePath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key: %w", err)
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key must be ed25519, got %T", key)
+	}
+	return publicKey, nil
+}
+
+// GenerateKey creates the new ed25519 signing key
+func GenerateKey() (ed25519.PrivateKey, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	return key, err
+}
+
+func readPEM(filePath string) (*pem.Block, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data found", filePath)
+	}
+	return block, nil
+}
+
+// cleanPath validates the path of the file in the archive, so it can't escape the extraction directory
+func cleanPath(filePath string) (string, error) {
+	cleaned := path.Clean(strings.ReplaceAll(filePath, "\\", "/"))
+	if cleaned == "." || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("invalid file path in the archive: %s", filePath)
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,164 @@
+package executionarchive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func sampleExecution() testkube.TestWorkflowExecution {
+	return testkube.TestWorkflowExecution{
+		Id:       "6630f0b1c3b5a1e2f4d5c6b7",
+		Name:     "api-tests-12",
+		Workflow: &testkube.TestWorkflow{Name: "api-tests"},
+		Result: &testkube.TestWorkflowResult{
+			Status:     common.Ptr(testkube.PASSED_TestWorkflowStatus),
+			FinishedAt: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func generateKey(t *testing.T) ed25519.PrivateKey {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	return key
+}
+
+func buildArchive(t *testing.T, key ed25519.PrivateKey) []byte {
+	buf := &bytes.Buffer{}
+	writer := NewWriter(buf, key)
+	require.NoError(t, writer.WriteExecution(sampleExecution()))
+	require.NoError(t, writer.WriteLog([]byte("2026-10-19T12:00:00Z hello\n")))
+	require.NoError(t, writer.WriteArtifact("reports/junit.xml", 9, strings.NewReader("<junit/>\n")))
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+// rewrite passes the entries of the archive through the function, to simulate tampering
+func rewrite(t *testing.T, data []byte, fn func(header *tar.Header, content []byte) []byte) []byte {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		content = fn(header, content)
+		header.Size = int64(len(content))
+		require.NoError(t, tarWriter.WriteHeader(header))
+		_, err = tarWriter.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func TestArchive_RoundTrip(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	dir := t.TempDir()
+
+	archive, err := Extract(bytes.NewReader(buildArchive(t, key)), dir, DefaultMaxExtractedSize)
+	require.NoError(t, err)
+
+	assert.Equal(t, sampleExecution(), archive.Execution)
+	assert.Equal(t, "api-tests", archive.Manifest.WorkflowName)
+	assert.True(t, archive.HasLog())
+	assert.NoError(t, archive.Verify(key.Public().(ed25519.PublicKey)))
+	require.Len(t, archive.Manifest.Artifacts(), 1)
+	assert.Equal(t, "reports/junit.xml", archive.Manifest.Artifacts()[0].Path)
+
+	content, err := os.ReadFile(filepath.Join(dir, "artifacts", "reports", "junit.xml"))
+	require.NoError(t, err)
+	assert.Equal(t, "<junit/>\n", string(content))
+}
+
+func TestArchive_UntrustedKey(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	other, err := GenerateKey()
+	require.NoError(t, err)
+
+	archive, err := Extract(bytes.NewReader(buildArchive(t, key)), t.TempDir(), DefaultMaxExtractedSize)
+	require.NoError(t, err)
+	assert.ErrorIs(t, archive.Verify(other.Public().(ed25519.PublicKey)), ErrUntrustedKey)
+}
+
+func TestArchive_TamperedFile(t *testing.T) {
+	data := rewrite(t, buildArchive(t, generateKey(t)), func(header *tar.Header, content []byte) []byte {
+		if header.Name == LogFile {
+			return []byte("2026-10-19T12:00:00Z changed\n")
+		}
+		return content
+	})
+
+	_, err := Extract(bytes.NewReader(data), t.TempDir(), DefaultMaxExtractedSize)
+	assert.ErrorContains(t, err, "doesn't match the manifest")
+}
+
+func TestArchive_TamperedManifest(t *testing.T) {
+	data := rewrite(t, buildArchive(t, generateKey(t)), func(header *tar.Header, content []byte) []byte {
+		if header.Name == ManifestFile {
+			return bytes.Replace(content, []byte("api-tests-12"), []byte("api-tests-13"), 1)
+		}
+		return content
+	})
+
+	_, err := Extract(bytes.NewReader(data), t.TempDir(), DefaultMaxExtractedSize)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestArchive_PathTraversal(t *testing.T) {
+	writer := NewWriter(io.Discard, nil)
+	assert.Error(t, writer.WriteArtifact("../../etc/passwd", 1, strings.NewReader("x")))
+
+	data := rewrite(t, buildArchive(t, generateKey(t)), func(header *tar.Header, content []byte) []byte {
+		if header.Name == LogFile {
+			header.Name = "../" + LogFile
+		}
+		return content
+	})
+	_, err := Extract(bytes.NewReader(data), t.TempDir(), DefaultMaxExtractedSize)
+	assert.ErrorContains(t, err, "invalid file path")
+}
+
+func TestArchive_NoSigningKey(t *testing.T) {
+	writer := NewWriter(io.Discard, nil)
+	require.NoError(t, writer.WriteExecution(sampleExecution()))
+	assert.ErrorIs(t, writer.Close(), ErrNoSigningKey)
+}
+
+func TestArchive_TooLarge(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer := NewWriter(buf, generateKey(t))
+	require.NoError(t, writer.WriteExecution(sampleExecution()))
+	require.NoError(t, writer.WriteArtifact("zeros.bin", 1024*1024, bytes.NewReader(make([]byte, 1024*1024))))
+	require.NoError(t, writer.Close())
+
+	dir := t.TempDir()
+	_, err := Extract(bytes.NewReader(buf.Bytes()), dir, 512*1024)
+	assert.ErrorIs(t, err, ErrArchiveTooLarge)
+	_, err = os.Stat(filepath.Join(dir, "artifacts", "zeros.bin"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,99 @@
+package executionarchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+var (
+	ErrExecutionExists      = errors.New("the execution already exists")
+	ErrExecutionNotFinished = errors.New("only finished executions can be imported")
+)
+
+// Importer recreates the archived executions in the repository and the storage
+type Importer struct {
+	results       testworkflow.Repository
+	output        testworkflow.OutputRepository
+	storageClient storage.Client
+	bucket        string
+}
+
+func NewImporter(results testworkflow.Repository, output testworkflow.OutputRepository, storageClient storage.Client, bucket string) *Importer {
+	return &Importer{
+		results:       results,
+		output:        output,
+		storageClient: storageClient,
+		bucket:        bucket,
+	}
+}
+
+// Import stores the execution with its log and artifacts.
+// The execution is tagged as imported, so it's kept read-only.
+func (i *Importer) Import(ctx context.Context, archive *Archive) (testkube.TestWorkflowExecution, error) {
+	execution := archive.Execution
+	if execution.Result == nil || !execution.Result.IsFinished() {
+		return execution, ErrExecutionNotFinished
+	}
+	if execution.Workflow == nil {
+		return execution, errors.New("the archived execution has no workflow")
+	}
+	for _, idOrName := range []string{execution.Id, execution.Name} {
+		_, err := i.results.Get(ctx, idOrName)
+		if err == nil {
+			return execution, fmt.Errorf("%w: %s", ErrExecutionExists, idOrName)
+		} else if !utils.IsNotFound(err) {
+			return execution, fmt.Errorf("checking existing execution: %w", err)
+		}
+	}
+
+	// Store the files first, so the execution is never visible without them
+	if archive.HasLog() {
+		if err := i.uploadLog(ctx, archive, execution); err != nil {
+			return execution, fmt.Errorf("saving log: %w", err)
+		}
+	}
+	for _, artifact := range archive.Manifest.Artifacts() {
+		if err := i.uploadArtifact(ctx, archive, execution.Id, artifact); err != nil {
+			return execution, fmt.Errorf("saving artifact %s: %w", artifact.Path, err)
+		}
+	}
+
+	if execution.Tags == nil {
+		execution.Tags = make(map[string]string)
+	}
+	execution.Tags[ImportedTagKey] = "true"
+	if err := i.results.Insert(ctx, execution); err != nil {
+		return execution, fmt.Errorf("saving execution: %w", err)
+	}
+	return execution, nil
+}
+
+func (i *Importer) uploadLog(ctx context.Context, archive *Archive, execution testkube.TestWorkflowExecution) error {
+	f, err := os.Open(archive.Path(LogFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return i.output.SaveLog(ctx, execution.Id, execution.Workflow.Name, f)
+}
+
+func (i *Importer) uploadArtifact(ctx context.Context, archive *Archive, executionID string, artifact File) error {
+	f, err := os.Open(archive.Path(ArtifactsDir + artifact.Path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return i.storageClient.UploadFileToBucket(ctx, i.bucket, executionID, artifact.Path, f, artifact.Size)
+}
+
+// IsImported checks if the execution has been imported from the archive, so it should not be modified
+func IsImported(execution *testkube.TestWorkflowExecution) bool {
+	return execution != nil && execution.Tags[ImportedTagKey] != ""
+}
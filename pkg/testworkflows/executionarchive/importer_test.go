@@ -0,0 +1,58 @@
+package executionarchive
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testworkflow"
+	"github.com/kubeshop/testkube/pkg/storage"
+)
+
+func TestImporter_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	results := testworkflow.NewMockRepository(ctrl)
+	output := testworkflow.NewMockOutputRepository(ctrl)
+	storageClient := storage.NewMockClient(ctrl)
+
+	archive, err := Extract(bytes.NewReader(buildArchive(t, generateKey(t))), t.TempDir(), DefaultMaxExtractedSize)
+	require.NoError(t, err)
+	execution := sampleExecution()
+
+	results.EXPECT().Get(gomock.Any(), execution.Id).Return(testkube.TestWorkflowExecution{}, mongo.ErrNoDocuments)
+	results.EXPECT().Get(gomock.Any(), execution.Name).Return(testkube.TestWorkflowExecution{}, mongo.ErrNoDocuments)
+	output.EXPECT().SaveLog(gomock.Any(), execution.Id, "api-tests", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, r io.Reader) error {
+			data, err := io.ReadAll(r)
+			assert.Equal(t, "2026-10-19T12:00:00Z hello\n", string(data))
+			return err
+		})
+	storageClient.EXPECT().UploadFileToBucket(gomock.Any(), "artifacts", execution.Id, "reports/junit.xml", gomock.Any(), int64(9)).Return(nil)
+	results.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, result testkube.TestWorkflowExecution) error {
+		assert.True(t, IsImported(&result))
+		return nil
+	})
+
+	imported, err := NewImporter(results, output, storageClient, "artifacts").Import(context.Background(), archive)
+	require.NoError(t, err)
+	assert.Equal(t, "true", imported.Tags[ImportedTagKey])
+}
+
+func TestImporter_ImportExisting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	results := testworkflow.NewMockRepository(ctrl)
+
+	archive, err := Extract(bytes.NewReader(buildArchive(t, generateKey(t))), t.TempDir(), DefaultMaxExtractedSize)
+	require.NoError(t, err)
+	results.EXPECT().Get(gomock.Any(), archive.Execution.Id).Return(archive.Execution, nil)
+
+	_, err = NewImporter(results, nil, nil, "artifacts").Import(context.Background(), archive)
+	assert.ErrorIs(t, err, ErrExecutionExists)
+}
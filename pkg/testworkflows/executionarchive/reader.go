@@ -0,0 +1,156 @@
+package executionarchive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// maxManifestSize limits the manifest and the signature kept in memory
+const maxManifestSize = 16 * 1024 * 1024
+
+// Archive is the execution archive extracted to the local directory
+type Archive struct {
+	Dir       string
+	Manifest  Manifest
+	Execution testkube.TestWorkflowExecution
+}
+
+// Extract unpacks the archive to the directory, and verifies its signature and the digests of all the files.
+// The total size of the extracted files is limited to maxSize bytes, to not fill the disk with the compressed payload.
+func Extract(r io.Reader, dir string, maxSize int64) (*Archive, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	var manifest, signature []byte
+	digests := make(map[string]File)
+	remaining := maxSize
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("unexpected entry in the archive: %s", header.Name)
+		}
+		name, err := cleanPath(header.Name)
+		if err != nil {
+			return nil, err
+		}
+		switch name {
+		case ManifestFile:
+			manifest, err = io.ReadAll(io.LimitReader(tarReader, maxManifestSize))
+		case SignatureFile:
+			signature, err = io.ReadAll(io.LimitReader(tarReader, maxManifestSize))
+		default:
+			if _, ok := digests[name]; ok {
+				return nil, fmt.Errorf("duplicated file in the archive: %s", name)
+			}
+			// The tar reader yields exactly the declared size of the entry
+			if header.Size > remaining {
+				return nil, ErrArchiveTooLarge
+			}
+			digests[name], err = extractFile(tarReader, dir, name)
+			remaining -= digests[name].Size
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", name, err)
+		}
+	}
+
+	archive := &Archive{Dir: dir}
+	if manifest == nil || signature == nil {
+		return nil, errors.New("the archive has no signed manifest")
+	}
+	if err = json.Unmarshal(manifest, &archive.Manifest); err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	if archive.Manifest.Version > Version {
+		return nil, fmt.Errorf("unsupported archive version %d, upgrade the Testkube", archive.Manifest.Version)
+	}
+	sig, err := hex.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil || len(archive.Manifest.PublicKey) != ed25519.PublicKeySize ||
+		!ed25519.Verify(archive.Manifest.PublicKey, manifest, sig) {
+		return nil, ErrInvalidSignature
+	}
+
+	// Ensure the archive contains exactly the files from the manifest
+	if len(archive.Manifest.Files) != len(digests) {
+		return nil, fmt.Errorf("the archive has %d files, while the manifest lists %d", len(digests), len(archive.Manifest.Files))
+	}
+	for _, expected := range archive.Manifest.Files {
+		if actual, ok := digests[expected.Path]; !ok || actual != expected {
+			return nil, fmt.Errorf("the archive file %s doesn't match the manifest", expected.Path)
+		}
+	}
+
+	data, err := os.ReadFile(archive.Path(ExecutionFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading execution: %w", err)
+	}
+	if err = json.Unmarshal(data, &archive.Execution); err != nil {
+		return nil, fmt.Errorf("reading execution: %w", err)
+	}
+	if archive.Execution.Id != archive.Manifest.ExecutionID {
+		return nil, errors.New("the archived execution doesn't match the manifest")
+	}
+	return archive, nil
+}
+
+func extractFile(r io.Reader, dir, name string) (File, error) {
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return File{}, err
+	}
+	f, err := os.Create(target)
+	if err != nil {
+		return File{}, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, hash), r)
+	if err != nil {
+		return File{}, err
+	}
+	return File{Path: name, Size: size, SHA256: hex.EncodeToString(hash.Sum(nil))}, nil
+}
+
+// Verify ensures the archive was signed with the trusted key
+func (a *Archive) Verify(trusted ed25519.PublicKey) error {
+	if !trusted.Equal(ed25519.PublicKey(a.Manifest.PublicKey)) {
+		return ErrUntrustedKey
+	}
+	return nil
+}
+
+// Path returns the local path of the file from the archive
+func (a *Archive) Path(name string) string {
+	return filepath.Join(a.Dir, filepath.FromSlash(name))
+}
+
+// HasLog checks if the archive contains the execution log
+func (a *Archive) HasLog() bool {
+	for _, f := range a.Manifest.Files {
+		if f.Path == LogFile {
+			return true
+		}
+	}
+	return false
+}
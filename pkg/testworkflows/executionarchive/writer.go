@@ -0,0 +1,135 @@
+package executionarchive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Writer builds the archive of the execution.
+// The manifest and its signature are written last, once all the files are known.
+type Writer struct {
+	key      ed25519.PrivateKey
+	gzip     *gzip.Writer
+	tar      *tar.Writer
+	manifest Manifest
+	paths    map[string]struct{}
+}
+
+// NewWriter creates the archive writer, signing the manifest with the key
+func NewWriter(w io.Writer, key ed25519.PrivateKey) *Writer {
+	gzipWriter := gzip.NewWriter(w)
+	return &Writer{
+		key:      key,
+		gzip:     gzipWriter,
+		tar:      tar.NewWriter(gzipWriter),
+		manifest: Manifest{Version: Version, Files: make([]File, 0)},
+		paths:    make(map[string]struct{}),
+	}
+}
+
+// WriteExecution adds the execution with its result and the resolved workflow
+func (w *Writer) WriteExecution(execution testkube.TestWorkflowExecution) error {
+	data, err := json.Marshal(execution)
+	if err != nil {
+		return fmt.Errorf("marshaling execution: %w", err)
+	}
+	w.manifest.ExecutionID = execution.Id
+	w.manifest.ExecutionName = execution.Name
+	if execution.Workflow != nil {
+		w.manifest.WorkflowName = execution.Workflow.Name
+	}
+	return w.WriteFile(ExecutionFile, int64(len(data)), bytes.NewReader(data))
+}
+
+// WriteLog adds the raw execution log
+func (w *Writer) WriteLog(log []byte) error {
+	return w.WriteFile(LogFile, int64(len(log)), bytes.NewReader(log))
+}
+
+// WriteArtifact adds the artifact file
+func (w *Writer) WriteArtifact(name string, size int64, r io.Reader) error {
+	name, err := cleanPath(name)
+	if err != nil {
+		return err
+	}
+	return w.WriteFile(ArtifactsDir+name, size, r)
+}
+
+// WriteFile adds the file to the archive, and records its digest in the manifest
+func (w *Writer) WriteFile(filePath string, size int64, r io.Reader) error {
+	filePath, err := cleanPath(filePath)
+	if err != nil {
+		return err
+	}
+	if filePath == ManifestFile || filePath == SignatureFile {
+		return fmt.Errorf("%s is reserved for the manifest", filePath)
+	}
+	if _, ok := w.paths[filePath]; ok {
+		return fmt.Errorf("duplicated file in the archive: %s", filePath)
+	}
+	err = w.tar.WriteHeader(&tar.Header{
+		Name:    filePath,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("writing tar header for %s: %w", filePath, err)
+	}
+	hash := sha256.New()
+	written, err := io.Copy(io.MultiWriter(w.tar, hash), io.LimitReader(r, size))
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", filePath, err)
+	}
+	if written != size {
+		return fmt.Errorf("writing %s: expected %d bytes, got %d", filePath, size, written)
+	}
+	w.paths[filePath] = struct{}{}
+	w.manifest.Files = append(w.manifest.Files, File{Path: filePath, Size: size, SHA256: hex.EncodeToString(hash.Sum(nil))})
+	return nil
+}
+
+// Close signs and writes the manifest, and finalizes the archive
+func (w *Writer) Close() error {
+	if w.manifest.ExecutionID == "" {
+		return errors.New("the execution has not been written to the archive")
+	}
+	// The archives signed with one-time keys can't be told apart from the forged ones
+	key := w.key
+	if len(key) != ed25519.PrivateKeySize {
+		return ErrNoSigningKey
+	}
+	w.manifest.CreatedAt = time.Now().UTC()
+	w.manifest.PublicKey = key.Public().(ed25519.PublicKey)
+	manifest, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling manifest: %w", err)
+	}
+	signature := []byte(hex.EncodeToString(ed25519.Sign(key, manifest)))
+	for _, f := range []struct {
+		name string
+		data []byte
+	}{{ManifestFile, manifest}, {SignatureFile, signature}} {
+		if err = w.tar.WriteHeader(&tar.Header{Name: f.name, Mode: 0o644, Size: int64(len(f.data)), ModTime: w.manifest.CreatedAt}); err != nil {
+			return fmt.Errorf("writing tar header for %s: %w", f.name, err)
+		}
+		if _, err = w.tar.Write(f.data); err != nil {
+			return fmt.Errorf("writing %s: %w", f.name, err)
+		}
+	}
+	if err = w.tar.Close(); err != nil {
+		return fmt.Errorf("finalizing archive: %w", err)
+	}
+	return w.gzip.Close()
+}
@@ -0,0 +1,117 @@
+package executionretry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/cloud"
+	commonmapper "github.com/kubeshop/testkube/pkg/mapper/common"
+)
+
+const (
+	// AttemptTagKey holds the attempt number of the execution, starting from 1 for the original execution
+	AttemptTagKey = "testkube.io/attempt"
+	// RetryOfTagKey holds the ID of the previous attempt that has been terminated by the infrastructure
+	RetryOfTagKey = "testkube.io/retry-of"
+	// RetryReasonTagKey holds the reason why the previous attempt has been terminated
+	RetryReasonTagKey = "testkube.io/retry-reason"
+)
+
+var (
+	ErrSensitiveConfig = errors.New("the execution with sensitive or truncated parameters can't be retried")
+)
+
+// Attempt returns the attempt number of the execution, starting from 1 for the original execution.
+func Attempt(execution *testkube.TestWorkflowExecution) int {
+	if execution == nil {
+		return 1
+	}
+	attempt, err := strconv.Atoi(execution.Tags[AttemptTagKey])
+	if err != nil || attempt < 1 {
+		return 1
+	}
+	return attempt
+}
+
+// MaxAttempts returns the maximum number of attempts configured for the executed workflow,
+// or 0 when the workflow should not be retried on the infrastructure failure.
+func MaxAttempts(execution *testkube.TestWorkflowExecution) int {
+	if execution == nil || execution.ResolvedWorkflow == nil || execution.ResolvedWorkflow.Spec == nil {
+		return 0
+	}
+	schema := execution.ResolvedWorkflow.Spec.Execution
+	if schema == nil || schema.RetryOnInfrastructureFailure == nil {
+		return 0
+	}
+	return int(schema.RetryOnInfrastructureFailure.MaxAttempts)
+}
+
+// IsNested determines if the execution has been started by another workflow,
+// so it is up to the parent to decide on retrying it.
+func IsNested(execution *testkube.TestWorkflowExecution) bool {
+	return execution.RunningContext != nil && execution.RunningContext.Actor != nil &&
+		execution.RunningContext.Actor.Type_ != nil &&
+		*execution.RunningContext.Actor.Type_ == testkube.TESTWORKFLOW_TestWorkflowRunningContextActorType
+}
+
+// Next builds the request to schedule the next attempt of the execution terminated by the infrastructure.
+// It returns nil when the execution should not be retried. The running context is left for the caller.
+func Next(execution *testkube.TestWorkflowExecution) (*cloud.ScheduleRequest, error) {
+	if execution == nil || IsNested(execution) {
+		return nil, nil
+	}
+	reason := execution.Result.InfrastructureFailureReason()
+	if reason == "" {
+		return nil, nil
+	}
+	attempt := Attempt(execution)
+	if attempt >= MaxAttempts(execution) {
+		return nil, nil
+	}
+
+	// Reuse the resolved snapshot, so the same workflow definition is retried
+	resolvedWorkflow, err := json.Marshal(execution.ResolvedWorkflow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the resolved workflow: %w", err)
+	}
+
+	config := make(map[string]string)
+	for key, value := range execution.ConfigParams {
+		if value.Sensitive || value.Truncated {
+			return nil, ErrSensitiveConfig
+		}
+		if !value.EmptyValue {
+			config[key] = value.Value
+		}
+	}
+
+	tags := make(map[string]string, len(execution.Tags)+3)
+	maps.Copy(tags, execution.Tags)
+	tags[AttemptTagKey] = strconv.Itoa(attempt + 1)
+	tags[RetryOfTagKey] = execution.Id
+	tags[RetryReasonTagKey] = reason
+
+	scheduleExecution := &cloud.ScheduleExecution{
+		Selector: &cloud.ScheduleResourceSelector{Name: execution.ResolvedWorkflow.Name},
+		Config:   config,
+	}
+	if execution.RunnerTarget != nil {
+		scheduleExecution.Targets = []*cloud.ExecutionTarget{commonmapper.MapTargetApiToGrpc(execution.RunnerTarget)}
+	}
+	if execution.Runtime != nil && len(execution.Runtime.Variables) > 0 {
+		scheduleExecution.Runtime = &cloud.TestWorkflowRuntime{EnvVars: execution.Runtime.Variables}
+	}
+
+	return &cloud.ScheduleRequest{
+		Executions:         []*cloud.ScheduleExecution{scheduleExecution},
+		DisableWebhooks:    execution.DisableWebhooks,
+		Tags:               tags,
+		ExecutionReference: &execution.Id,
+		ResolvedWorkflow:   resolvedWorkflow,
+		SilentMode:         commonmapper.MapSilentModeApiToGrpc(execution.SilentMode),
+	}, nil
+}
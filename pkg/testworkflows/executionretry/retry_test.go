@@ -0,0 +1,110 @@
+package executionretry
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func evictedExecution(maxAttempts int32) testkube.TestWorkflowExecution {
+	workflow := &testkube.TestWorkflow{
+		Name: "long-suite",
+		Spec: &testkube.TestWorkflowSpec{
+			Execution: &testkube.TestWorkflowExecutionSchema{
+				RetryOnInfrastructureFailure: &testkube.TestWorkflowRetryOnInfrastructureFailurePolicy{MaxAttempts: maxAttempts},
+			},
+		},
+	}
+	return testkube.TestWorkflowExecution{
+		Id:               "exec-1",
+		Workflow:         workflow,
+		ResolvedWorkflow: workflow,
+		Tags:             map[string]string{"team": "qa"},
+		ConfigParams: map[string]testkube.TestWorkflowExecutionConfigValue{
+			"browser": {Value: "chrome"},
+		},
+		Result: &testkube.TestWorkflowResult{
+			Status: common.Ptr(testkube.ABORTED_TestWorkflowStatus),
+			Initialization: &testkube.TestWorkflowStepResult{
+				Status:       common.Ptr(testkube.ABORTED_TestWorkflowStepStatus),
+				ErrorMessage: "The execution has been aborted. (Evicted: The node was low on resource: memory)",
+			},
+		},
+	}
+}
+
+func TestNext(t *testing.T) {
+	execution := evictedExecution(3)
+
+	request, err := Next(&execution)
+	require.NoError(t, err)
+	require.NotNil(t, request)
+
+	assert.Equal(t, map[string]string{
+		"team":            "qa",
+		AttemptTagKey:     "2",
+		RetryOfTagKey:     "exec-1",
+		RetryReasonTagKey: "Evicted",
+	}, request.Tags)
+	assert.Equal(t, "exec-1", *request.ExecutionReference)
+	require.Len(t, request.Executions, 1)
+	assert.Equal(t, "long-suite", request.Executions[0].Selector.Name)
+	assert.Equal(t, map[string]string{"browser": "chrome"}, request.Executions[0].Config)
+
+	var resolved testkube.TestWorkflow
+	require.NoError(t, json.Unmarshal(request.ResolvedWorkflow, &resolved))
+	assert.Equal(t, "long-suite", resolved.Name)
+
+	// The original tags are not modified
+	assert.Equal(t, map[string]string{"team": "qa"}, execution.Tags)
+}
+
+func TestNext_Skipped(t *testing.T) {
+	exhausted := evictedExecution(3)
+	exhausted.Tags = map[string]string{AttemptTagKey: "3"}
+
+	noPolicy := evictedExecution(3)
+	noPolicy.ResolvedWorkflow = &testkube.TestWorkflow{Name: "long-suite", Spec: &testkube.TestWorkflowSpec{}}
+
+	testFailure := evictedExecution(3)
+	testFailure.Result = &testkube.TestWorkflowResult{Status: common.Ptr(testkube.FAILED_TestWorkflowStatus)}
+
+	nested := evictedExecution(3)
+	nested.RunningContext = &testkube.TestWorkflowRunningContext{
+		Actor: &testkube.TestWorkflowRunningContextActor{Type_: common.Ptr(testkube.TESTWORKFLOW_TestWorkflowRunningContextActorType)},
+	}
+
+	tests := map[string]testkube.TestWorkflowExecution{
+		"attempts exhausted":        exhausted,
+		"no retry policy":           noPolicy,
+		"failed by the test itself": testFailure,
+		"nested execution":          nested,
+	}
+	for name, execution := range tests {
+		t.Run(name, func(t *testing.T) {
+			request, err := Next(&execution)
+			require.NoError(t, err)
+			assert.Nil(t, request)
+		})
+	}
+}
+
+func TestNext_SensitiveConfig(t *testing.T) {
+	execution := evictedExecution(2)
+	execution.ConfigParams["token"] = testkube.TestWorkflowExecutionConfigValue{Sensitive: true}
+
+	_, err := Next(&execution)
+	assert.ErrorIs(t, err, ErrSensitiveConfig)
+}
+
+func TestAttempt(t *testing.T) {
+	assert.Equal(t, 1, Attempt(nil))
+	assert.Equal(t, 1, Attempt(&testkube.TestWorkflowExecution{}))
+	assert.Equal(t, 1, Attempt(&testkube.TestWorkflowExecution{Tags: map[string]string{AttemptTagKey: "invalid"}}))
+	assert.Equal(t, 4, Attempt(&testkube.TestWorkflowExecution{Tags: map[string]string{AttemptTagKey: "4"}}))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sync"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -68,6 +69,31 @@ func cleanupPvcs(labelName string) func(ctx context.Context, clientSet kubernete
 	}
 }
 
+// cleanupNamespaces deletes the dedicated namespaces of the ephemeral environments.
+// Namespaces are cluster-scoped, so lack of the permissions means that there were no environments provisioned.
+func cleanupNamespaces(labelName string) func(ctx context.Context, clientSet kubernetes.Interface, namespace, id string) error {
+	return func(ctx context.Context, clientSet kubernetes.Interface, _, id string) error {
+		list, err := clientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", labelName, id),
+		})
+		if k8serrors.IsForbidden(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		var errs []error
+		for _, item := range list.Items {
+			err = clientSet.CoreV1().Namespaces().Delete(ctx, item.Name, metav1.DeleteOptions{
+				PropagationPolicy: common.Ptr(metav1.DeletePropagationBackground),
+			})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
 func Cleanup(ctx context.Context, clientSet kubernetes.Interface, namespace, id string) error {
 	var errs []error
 	var errsMu sync.Mutex
@@ -83,6 +109,8 @@ func Cleanup(ctx context.Context, clientSet kubernetes.Interface, namespace, id
 		cleanupSecrets(constants.ResourceIdLabelName),
 		cleanupPvcs(constants.RootResourceIdLabelName),
 		cleanupPvcs(constants.ResourceIdLabelName),
+		cleanupNamespaces(constants.RootResourceIdLabelName),
+		cleanupNamespaces(constants.ResourceIdLabelName),
 	}
 	wg.Add(len(ops))
 	for _, op := range ops {
@@ -110,6 +138,7 @@ func CleanupGroup(ctx context.Context, clientSet kubernetes.Interface, namespace
 		cleanupConfigMaps(constants.GroupIdLabelName),
 		cleanupSecrets(constants.GroupIdLabelName),
 		cleanupPvcs(constants.GroupIdLabelName),
+		cleanupNamespaces(constants.GroupIdLabelName),
 	}
 	wg.Add(len(ops))
 	for _, op := range ops {
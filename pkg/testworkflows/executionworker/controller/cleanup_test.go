@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/constants"
+)
+
+func environmentNamespace(name, rootId string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   name,
+		Labels: map[string]string{constants.RootResourceIdLabelName: rootId, constants.ResourceIdLabelName: rootId},
+	}}
+}
+
+func TestCleanup_EnvironmentNamespaces(t *testing.T) {
+	clientSet := fake.NewClientset(
+		environmentNamespace("tk-env-exec1", "exec1"),
+		environmentNamespace("tk-env-exec2", "exec2"),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "testkube"}},
+	)
+
+	err := Cleanup(context.Background(), clientSet, "testkube", "exec1")
+	require.NoError(t, err)
+
+	list, err := clientSet.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	names := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+	assert.ElementsMatch(t, []string{"tk-env-exec2", "testkube"}, names)
+}
+
+func TestCleanup_EnvironmentNamespacesForbidden(t *testing.T) {
+	clientSet := fake.NewClientset()
+	clientSet.PrependReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", nil)
+	})
+
+	assert.NoError(t, Cleanup(context.Background(), clientSet, "testkube", "exec1"))
+}
@@ -239,6 +239,26 @@ func (n *notifier) Instruction(ts time.Time, hint instructions.Instruction, exec
 				}
 			}
 		}
+	case constants.InstructionAttempt:
+		serialized, _ := json.Marshal(hint.Value)
+		var attemptResult constants.AttemptResult
+		if err := json.Unmarshal(serialized, &attemptResult); err == nil {
+			step.Attempts = appendStepAttempt(step.Attempts, testkube.TestWorkflowStepAttempt{
+				Number:     attemptResult.Number,
+				Status:     common.Ptr(testkube.TestWorkflowStepStatus(attemptResult.Status)),
+				ExitCode:   float64(attemptResult.ExitCode),
+				StartedAt:  attemptResult.StartedAt,
+				FinishedAt: attemptResult.FinishedAt,
+				DelayMs:    int32(attemptResult.DelayMs),
+			})
+		}
+	case constants.InstructionApproval:
+		serialized, _ := json.Marshal(hint.Value)
+		var approval testkube.TestWorkflowApproval
+		if err := json.Unmarshal(serialized, &approval); err == nil {
+			approval.Ref = hint.Ref
+			step.Approval = &approval
+		}
 	}
 
 	// Save the step
@@ -407,3 +427,14 @@ func getExecutionId(state watchers2.ExecutionState) string {
 	}
 	return job.Name()
 }
+
+// appendStepAttempt adds the attempt to the list, replacing the one with the same number when it's delivered again
+func appendStepAttempt(attempts []testkube.TestWorkflowStepAttempt, attempt testkube.TestWorkflowStepAttempt) []testkube.TestWorkflowStepAttempt {
+	for i := range attempts {
+		if attempts[i].Number == attempt.Number {
+			attempts[i] = attempt
+			return attempts
+		}
+	}
+	return append(attempts, attempt)
+}
@@ -440,9 +440,22 @@ func (e *executionState) ExecutionError() string {
 		return "Fatal Error"
 	}
 	if podErr == "" || (podErr == "Fatal Error" && jobErr != "" && !strings.HasPrefix(jobErr, "BackoffLimitExceeded")) {
-		return jobErr
+		return e.explainTimeout(jobErr)
 	}
-	return podErr
+	return e.explainTimeout(podErr)
+}
+
+// explainTimeout points to the scheduling or image pull problem,
+// when the execution timed out because the pod could not proceed.
+func (e *executionState) explainTimeout(err string) string {
+	if !strings.HasPrefix(err, "Job timed out") && !strings.HasPrefix(err, "Pod timed out") {
+		return err
+	}
+	reason, message := e.podEvents.PendingError()
+	if reason == "" {
+		return err
+	}
+	return fmt.Sprintf("%s: %s (%s)", reason, message, err)
 }
 
 func (e *executionState) Debug() map[string]string {
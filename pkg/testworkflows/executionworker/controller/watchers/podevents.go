@@ -13,6 +13,37 @@ var (
 	scheduledRe = regexp.MustCompile(`/\s+(\S+)`)
 )
 
+// isTerminationEvent determines if the event is about the pod being terminated by the cluster.
+func isTerminationEvent(event *corev1.Event) bool {
+	switch event.Reason {
+	case "Evicted", "ExceededGracePeriod", "Preempted", "Preempting", "TaintManagerEviction":
+		// (Evicted) The node was low on resource: ephemeral-storage
+		// (ExceededGracePeriod) Container runtime did not kill the pod within specified grace period
+		// (Preempted) Preempted by pod 2c4d8d5e-... on node spot-1
+		// (Preempting) Preempting other pods to admit a critical pod
+		// (TaintManagerEviction) Marking for deletion Pod distributed-tests/66c49ca3284bce9380023421-78fmp
+		return true
+	}
+	return false
+}
+
+// isImagePullFailureEvent determines if the event is about the container image that cannot be pulled.
+func isImagePullFailureEvent(event *corev1.Event) bool {
+	switch event.Reason {
+	case "Failed":
+		// (Failed) Failed to pull image "busybox:1.0.0": rpc error: code = NotFound desc = ...
+		// (Failed) Error: ErrImagePull
+		// (Failed) Error: ImagePullBackOff
+		return strings.HasPrefix(event.Message, "Failed to pull image") ||
+			strings.Contains(event.Message, "ErrImagePull") ||
+			strings.Contains(event.Message, "ImagePullBackOff")
+	case "BackOff":
+		// (BackOff) Back-off pulling image "busybox:1.0.0"
+		return strings.HasPrefix(event.Message, "Back-off pulling image")
+	}
+	return false
+}
+
 type podEvents struct {
 	events []*corev1.Event
 }
@@ -30,6 +61,7 @@ type PodEvents interface {
 	Error() bool
 	ErrorReason() string
 	ErrorMessage() string
+	PendingError() (reason, message string)
 	Debug() string
 
 	Container(name string) ContainerEvents
@@ -127,15 +159,12 @@ func (p *podEvents) StartTimestamp() time.Time {
 
 func (p *podEvents) FinishTimestamp() time.Time {
 	for i := range p.events {
-		if p.events[i].Reason == "Evicted" || p.events[i].Reason == "ExceededGracePeriod" {
-			// (Evicted) The node was low on resource: ephemeral-storage
-			// (ExceededGracePeriod) Container runtime did not kill the pod within specified grace period
+		if isTerminationEvent(p.events[i]) {
 			return GetEventTimestamp(p.events[i])
 		}
 
 		// TODO: Consider approximation, but quite accurate
 		// (Killing) Stopping container 1 [ONLY NUMERIC CONTAINERS]
-		// (Preempting) ???
 		// (Failed) Back-off restarting failed container [ONLY NUMERIC CONTAINERS]
 	}
 	return time.Time{}
@@ -143,52 +172,75 @@ func (p *podEvents) FinishTimestamp() time.Time {
 
 func (p *podEvents) Error() bool {
 	for i := range p.events {
-		if p.events[i].Reason == "Evicted" || p.events[i].Reason == "ExceededGracePeriod" {
-			// (Evicted) The node was low on resource: ephemeral-storage
-			// (ExceededGracePeriod) Container runtime did not kill the pod within specified grace period
+		if isTerminationEvent(p.events[i]) {
 			return true
 		}
-
-		// TODO: Consider approximation, but quite accurate
-		// (Killing) Stopping container 1 [ONLY NUMERIC CONTAINERS]
-		// (Preempting) ???
-		// (Failed) Back-off restarting failed container [ONLY NUMERIC CONTAINERS]
 	}
 	return false
 }
 
 func (p *podEvents) ErrorReason() string {
 	for i := range p.events {
-		if p.events[i].Reason == "Evicted" || p.events[i].Reason == "ExceededGracePeriod" {
-			// (Evicted) The node was low on resource: ephemeral-storage
-			// (ExceededGracePeriod) Container runtime did not kill the pod within specified grace period
+		if isTerminationEvent(p.events[i]) {
 			return p.events[i].Reason
 		}
-
-		// TODO: Consider approximation, but quite accurate
-		// (Killing) Stopping container 1 [ONLY NUMERIC CONTAINERS]
-		// (Preempting) ???
-		// (Failed) Back-off restarting failed container [ONLY NUMERIC CONTAINERS]
 	}
 	return ""
 }
 
 func (p *podEvents) ErrorMessage() string {
 	for i := range p.events {
-		if p.events[i].Reason == "Evicted" || p.events[i].Reason == "ExceededGracePeriod" {
-			// (Evicted) The node was low on resource: ephemeral-storage
-			// (ExceededGracePeriod) Container runtime did not kill the pod within specified grace period
+		if isTerminationEvent(p.events[i]) {
 			return p.events[i].Message
 		}
-
-		// TODO: Consider approximation, but quite accurate
-		// (Killing) Stopping container 1 [ONLY NUMERIC CONTAINERS]
-		// (Preempting) ???
-		// (Failed) Back-off restarting failed container [ONLY NUMERIC CONTAINERS]
 	}
 	return ""
 }
 
+// PendingError explains why the pod is stuck before running the next container:
+// either it cannot be scheduled, or the container image cannot be pulled.
+func (p *podEvents) PendingError() (reason, message string) {
+	scheduled := false
+	var lastStartedTs time.Time
+	for i := range p.events {
+		switch p.events[i].Reason {
+		case "Scheduled":
+			scheduled = true
+		case "Started":
+			if ts := GetEventTimestamp(p.events[i]); ts.After(lastStartedTs) {
+				lastStartedTs = ts
+			}
+		}
+	}
+
+	if !scheduled {
+		for i := range p.events {
+			if p.events[i].Reason == "FailedScheduling" {
+				// (FailedScheduling) 0/3 nodes are available: 3 Insufficient cpu.
+				message = p.events[i].Message
+			}
+		}
+		if message != "" {
+			return "FailedScheduling", message
+		}
+		return "", ""
+	}
+
+	for i := range p.events {
+		if !isImagePullFailureEvent(p.events[i]) || GetEventTimestamp(p.events[i]).Before(lastStartedTs) {
+			continue
+		}
+		// Prefer the detailed pull failure over the generic back-off notice
+		if message == "" || strings.HasPrefix(p.events[i].Message, "Failed to pull image") {
+			message = p.events[i].Message
+		}
+	}
+	if message != "" {
+		return "ImagePullBackOff", message
+	}
+	return "", ""
+}
+
 func (p *podEvents) Debug() string {
 	firstTs := p.FirstTimestamp()
 	result := make([]string, len(p.events))
@@ -0,0 +1,80 @@
+package watchers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func podEvent(reason, message string, ts time.Time) *corev1.Event {
+	return &corev1.Event{
+		Reason:        reason,
+		Message:       message,
+		LastTimestamp: metav1.NewTime(ts),
+	}
+}
+
+func TestPodEvents_Error(t *testing.T) {
+	ts := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	events := NewPodEvents([]*corev1.Event{
+		podEvent("Scheduled", "Successfully assigned distributed-tests/abc-78fmp to spot-1", ts),
+		podEvent("Preempted", "Preempted by pod 2c4d8d5e on node spot-1", ts.Add(time.Minute)),
+	})
+
+	assert.True(t, events.Error())
+	assert.Equal(t, "Preempted", events.ErrorReason())
+	assert.Equal(t, "Preempted by pod 2c4d8d5e on node spot-1", events.ErrorMessage())
+	assert.Equal(t, ts.Add(time.Minute), events.FinishTimestamp())
+}
+
+func TestPodEvents_PendingError(t *testing.T) {
+	ts := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		events      []*corev1.Event
+		wantReason  string
+		wantMessage string
+	}{
+		"not scheduled": {
+			events: []*corev1.Event{
+				podEvent("FailedScheduling", "0/3 nodes are available: 3 Insufficient cpu.", ts),
+				podEvent("FailedScheduling", "0/4 nodes are available: 4 Insufficient cpu.", ts.Add(time.Minute)),
+			},
+			wantReason:  "FailedScheduling",
+			wantMessage: "0/4 nodes are available: 4 Insufficient cpu.",
+		},
+		"image not pulled": {
+			events: []*corev1.Event{
+				podEvent("Scheduled", "Successfully assigned distributed-tests/abc-78fmp to node-1", ts),
+				podEvent("Failed", `Failed to pull image "busybox:0.0.0": not found`, ts.Add(time.Second)),
+				podEvent("Failed", "Error: ErrImagePull", ts.Add(time.Second)),
+				podEvent("BackOff", `Back-off pulling image "busybox:0.0.0"`, ts.Add(time.Minute)),
+			},
+			wantReason:  "ImagePullBackOff",
+			wantMessage: `Failed to pull image "busybox:0.0.0": not found`,
+		},
+		"image pulled eventually": {
+			events: []*corev1.Event{
+				podEvent("Scheduled", "Successfully assigned distributed-tests/abc-78fmp to node-1", ts),
+				podEvent("BackOff", `Back-off pulling image "busybox:1.36"`, ts.Add(time.Second)),
+				podEvent("Started", "Started container 1", ts.Add(time.Minute)),
+			},
+		},
+		"running": {
+			events: []*corev1.Event{
+				podEvent("Scheduled", "Successfully assigned distributed-tests/abc-78fmp to node-1", ts),
+				podEvent("Started", "Started container 1", ts.Add(time.Second)),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, message := NewPodEvents(tc.events).PendingError()
+			assert.Equal(t, tc.wantReason, reason)
+			assert.Equal(t, tc.wantMessage, message)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package testworkflowexpressions
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kubeshop/testkube/pkg/expressions"
+)
+
+// Result describes the evaluation of a single expression or template
+type Result struct {
+	Input    string
+	Template bool
+	// Tokens are the tokens of the expression, not available for templates
+	Tokens []string
+	// Expression is the parsed expression, before resolving it with the machines
+	Expression expressions.Expression
+	// Resolved is the expression after resolving it as far as possible
+	Resolved expressions.Expression
+	// Unresolved lists the variables that are not known in the context
+	Unresolved []string
+	// UnknownFunctions lists the functions that are not known in the context
+	UnknownFunctions []string
+	Error            error
+}
+
+// Static tells whether the expression has been fully resolved to a value
+func (r *Result) Static() bool {
+	return r.Resolved != nil && r.Resolved.Static() != nil
+}
+
+// Type returns the type of the result, detected even for partially resolved expressions when possible
+func (r *Result) Type() expressions.Type {
+	if r.Resolved == nil {
+		return expressions.TypeUnknown
+	}
+	return r.Resolved.Type()
+}
+
+// IsTemplate detects if the input should be treated as a template rather than an expression
+func IsTemplate(input string) bool {
+	return strings.Contains(input, "{{")
+}
+
+// Evaluate tokenizes, parses and resolves the input with the provided machines
+func Evaluate(input string, template bool, machines ...expressions.Machine) Result {
+	result := Result{Input: input, Template: template}
+	if template {
+		result.Expression, result.Error = expressions.CompileTemplate(input)
+	} else {
+		result.Tokens, result.Error = expressions.Tokens(input)
+		if result.Error == nil {
+			result.Expression, result.Error = expressions.Compile(input)
+		}
+	}
+	if result.Error != nil {
+		return result
+	}
+
+	result.Resolved, result.Error = result.Expression.Resolve(machines...)
+	if result.Error != nil {
+		return result
+	}
+	result.Unresolved = sortedKeys(result.Resolved.Accessors(), nil)
+	result.UnknownFunctions = sortedKeys(result.Resolved.Functions(), expressions.IsStdFunction)
+	return result
+}
+
+func sortedKeys(m map[string]struct{}, skip func(string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if skip == nil || !skip(k) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,75 @@
+package testworkflowexpressions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/expressions"
+)
+
+var workflow = &testworkflowsv1.TestWorkflow{
+	ObjectMeta: metav1.ObjectMeta{Name: "k6-load", Labels: map[string]string{"team": "qa"}},
+	Spec: testworkflowsv1.TestWorkflowSpec{
+		TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+			Config: map[string]testworkflowsv1.ParameterSchema{
+				"vus":    {Type: testworkflowsv1.ParameterTypeInteger, Default: common.Ptr(intstr.FromInt32(10))},
+				"target": {Type: testworkflowsv1.ParameterTypeString},
+			},
+			Container: &testworkflowsv1.ContainerConfig{
+				Env: []testworkflowsv1.EnvVar{
+					{EnvVar: corev1.EnvVar{Name: "MODE", Value: "vus-{{config.vus}}"}},
+					{EnvVar: corev1.EnvVar{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{}}},
+				},
+			},
+		},
+	},
+}
+
+func TestEvaluate(t *testing.T) {
+	machine, err := CreateMachine(MachineOptions{Workflow: workflow, Config: map[string]string{"target": "https://example.com"}})
+	require.NoError(t, err)
+
+	result := Evaluate(`config.vus * 2`, false, machine)
+	require.NoError(t, result.Error)
+	assert.True(t, result.Static())
+	assert.Equal(t, "20", result.Resolved.String())
+	assert.Equal(t, []string{"config.vus", "*", "2"}, result.Tokens)
+
+	result = Evaluate(`{{workflow.name}}/{{env.MODE}} on {{config.target}} ({{labels.team}}, {{execution.number}})`, true, machine)
+	require.NoError(t, result.Error)
+	assert.Equal(t, "k6-load/vus-10 on https://example.com (qa, 1)", result.Resolved.Template())
+}
+
+func TestEvaluate_Partial(t *testing.T) {
+	machine, err := CreateMachine(MachineOptions{Workflow: workflow, Env: map[string]string{"MODE": "smoke"}})
+	require.NoError(t, err)
+
+	result := Evaluate(`env.MODE == "smoke" ? upper(env.TOKEN) + custom(config.target) : "none"`, false, machine)
+	require.NoError(t, result.Error)
+	assert.False(t, result.Static())
+	assert.Equal(t, []string{"config.target", "env.TOKEN"}, result.Unresolved)
+	assert.Equal(t, []string{"custom"}, result.UnknownFunctions)
+	assert.Equal(t, expressions.TypeString, result.Type())
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	machine, err := CreateMachine(MachineOptions{})
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, Evaluate(`a # b`, false, machine).Error, "unknown character")
+	assert.ErrorContains(t, Evaluate(`(a + b`, false, machine).Error, "parser error")
+	assert.Error(t, Evaluate(`int("abc")`, false, machine).Error)
+	assert.Error(t, Evaluate(`{{ a `, true, machine).Error)
+}
+
+func TestCreateMachine_InvalidConfig(t *testing.T) {
+	_, err := CreateMachine(MachineOptions{Workflow: workflow, Config: map[string]string{"vus": "many"}})
+	assert.Error(t, err)
+}
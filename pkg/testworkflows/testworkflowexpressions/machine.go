@@ -0,0 +1,95 @@
+package testworkflowexpressions
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/expressions"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowconfig"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+const sampleExecutionId = "0123456789abcdef01234567"
+
+// MachineOptions describe the context available for the evaluated expressions
+type MachineOptions struct {
+	// Workflow provides the "workflow", "labels" and "config" values, along with the environment variables from its container
+	Workflow *testworkflowsv1.TestWorkflow
+	// Config overrides the "config" parameters
+	Config map[string]string
+	// Env overrides the "env" variables
+	Env map[string]string
+	// Execution overrides the sample execution details
+	Execution *testworkflowconfig.ExecutionConfig
+}
+
+// SampleExecution builds the execution details used when there is no real execution behind
+func SampleExecution(workflowName string) *testworkflowconfig.ExecutionConfig {
+	if workflowName == "" {
+		workflowName = "workflow"
+	}
+	return &testworkflowconfig.ExecutionConfig{
+		Id:          sampleExecutionId,
+		GroupId:     sampleExecutionId,
+		Name:        fmt.Sprintf("%s-1", workflowName),
+		Number:      1,
+		ScheduledAt: time.Now().UTC(),
+	}
+}
+
+// CreateMachine builds the machine with the "config", "env", "execution" and "workflow" values,
+// leaving everything else unresolved
+func CreateMachine(opts MachineOptions) (expressions.Machine, error) {
+	workflow := opts.Workflow
+	if workflow == nil {
+		workflow = &testworkflowsv1.TestWorkflow{}
+	}
+	execution := opts.Execution
+	if execution == nil {
+		execution = SampleExecution(workflow.Name)
+	}
+
+	cfg := make(map[string]intstr.IntOrString, len(opts.Config))
+	for k, v := range opts.Config {
+		cfg[k] = intstr.FromString(v)
+	}
+	configMachine, err := testworkflowresolver.CreateConfigMachine(cfg, workflow.Spec.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return expressions.CombinedMachines(
+		configMachine,
+		createEnvMachine(workflow, opts.Env),
+		testworkflowconfig.CreateExecutionMachine(execution),
+		testworkflowconfig.CreateWorkflowMachine(&testworkflowconfig.WorkflowConfig{
+			Name:   workflow.Name,
+			Labels: workflow.Labels,
+		}),
+	), nil
+}
+
+// createEnvMachine exposes the static environment variables of the workflow container,
+// while the ones loaded from other sources are unknown until the execution
+func createEnvMachine(workflow *testworkflowsv1.TestWorkflow, overrides map[string]string) expressions.Machine {
+	env := make(map[string]interface{})
+	if workflow.Spec.Container != nil {
+		for _, e := range workflow.Spec.Container.Env {
+			if e.ValueFrom != nil {
+				continue
+			}
+			if expr, err := expressions.CompileTemplate(e.Value); err == nil {
+				env[e.Name] = expr
+			} else {
+				env[e.Name] = e.Value
+			}
+		}
+	}
+	for k, v := range overrides {
+		env[k] = v
+	}
+	return expressions.NewMachine().RegisterMap("env", env)
+}
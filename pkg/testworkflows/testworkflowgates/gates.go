@@ -0,0 +1,168 @@
+package testworkflowgates
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/expressions"
+)
+
+// Gates returns the result gates declared for the execution
+func Gates(execution *testkube.TestWorkflowExecution) []testkube.TestWorkflowGate {
+	workflow := execution.ResolvedWorkflow
+	if workflow == nil || workflow.Spec == nil {
+		workflow = execution.Workflow
+	}
+	if workflow == nil || workflow.Spec == nil {
+		return nil
+	}
+	return workflow.Spec.Gates
+}
+
+// CreateMachine builds the expressions machine with the aggregated execution data available for the gates
+func CreateMachine(execution *testkube.TestWorkflowExecution, result *testkube.TestWorkflowResult) expressions.Machine {
+	reports := map[string]interface{}{
+		"tests":    0,
+		"passed":   0,
+		"failed":   0,
+		"skipped":  0,
+		"errored":  0,
+		"duration": 0,
+		"passRate": 0.0,
+	}
+	var tests, passed, failed, skipped, errored int32
+	var duration int64
+	metrics := make(map[string]interface{})
+	for _, report := range execution.Reports {
+		if report.Summary == nil {
+			continue
+		}
+		tests += report.Summary.Tests
+		passed += report.Summary.Passed
+		failed += report.Summary.Failed
+		skipped += report.Summary.Skipped
+		errored += report.Summary.Errored
+		duration += report.Summary.Duration
+		if len(report.Summary.Metrics) > 0 && report.Kind != "" {
+			kindMetrics, _ := metrics[report.Kind].(map[string]interface{})
+			if kindMetrics == nil {
+				kindMetrics = make(map[string]interface{})
+				metrics[report.Kind] = kindMetrics
+			}
+			for name, value := range report.Summary.Metrics {
+				kindMetrics[name] = value
+			}
+		}
+	}
+	reports["tests"] = tests
+	reports["passed"] = passed
+	reports["failed"] = failed
+	reports["skipped"] = skipped
+	reports["errored"] = errored
+	reports["duration"] = duration
+	if executed := tests - skipped; executed > 0 {
+		reports["passRate"] = float64(passed) / float64(executed)
+	}
+
+	steps := make(map[string]interface{})
+	status := ""
+	if result != nil {
+		status = string(common.ResolvePtr(result.Status, ""))
+		for ref, step := range result.Steps {
+			steps[ref] = map[string]interface{}{
+				"status":   string(common.ResolvePtr(step.Status, "")),
+				"exitCode": step.ExitCode,
+			}
+		}
+	}
+
+	return expressions.NewMachine().
+		Register("reports", reports).
+		Register("metrics", metrics).
+		Register("resources", resourcesData(execution.ResourceAggregations)).
+		Register("steps", steps).
+		Register("status", status).
+		Register("passed", status == string(testkube.PASSED_TestWorkflowStatus))
+}
+
+func resourcesData(report *testkube.TestWorkflowExecutionResourceAggregationsReport) map[string]interface{} {
+	result := make(map[string]interface{})
+	if report == nil || len(report.Global) == 0 {
+		return result
+	}
+	// Convert through JSON to expose the aggregations with their serialized names
+	data, err := json.Marshal(report.Global)
+	if err != nil {
+		return result
+	}
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
+// Evaluate computes the outcome of each gate against the execution data
+func Evaluate(gates []testkube.TestWorkflowGate, machine expressions.Machine) []testkube.TestWorkflowGateResult {
+	results := make([]testkube.TestWorkflowGateResult, 0, len(gates))
+	for _, gate := range gates {
+		results = append(results, evaluate(gate, machine))
+	}
+	return results
+}
+
+func evaluate(gate testkube.TestWorkflowGate, machine expressions.Machine) testkube.TestWorkflowGateResult {
+	result := testkube.TestWorkflowGateResult{
+		Name:      gate.Name,
+		Condition: gate.Condition,
+		OnFailure: common.Ptr(common.ResolvePtr(gate.OnFailure, testkube.FAIL_TestWorkflowGateAction)),
+	}
+	value, err := expressions.EvalExpression(gate.Condition, machine, expressions.FinalizerFail)
+	if err != nil {
+		result.Status = common.Ptr(testkube.ERROR_TestWorkflowGateStatus)
+		result.Message = fmt.Sprintf("failed to evaluate condition: %s", err.Error())
+		return result
+	}
+	passed, err := value.BoolValue()
+	if err != nil {
+		result.Status = common.Ptr(testkube.ERROR_TestWorkflowGateStatus)
+		result.Message = fmt.Sprintf("condition is not a boolean: %s", err.Error())
+		return result
+	}
+	if passed {
+		result.Status = common.Ptr(testkube.PASSED_TestWorkflowGateStatus)
+	} else {
+		result.Status = common.Ptr(testkube.FAILED_TestWorkflowGateStatus)
+		result.Message = fmt.Sprintf("condition not satisfied: %s", gate.Condition)
+	}
+	return result
+}
+
+// IsBlocking determines if the gate outcome should fail the execution
+func IsBlocking(result testkube.TestWorkflowGateResult) bool {
+	if result.Status != nil && *result.Status == testkube.PASSED_TestWorkflowGateStatus {
+		return false
+	}
+	return result.OnFailure == nil || *result.OnFailure == testkube.FAIL_TestWorkflowGateAction
+}
+
+// Apply evaluates the gates declared for the execution and stores their outcomes in the result.
+// The passed result is marked as failed when any blocking gate is not satisfied.
+// It returns true when there were any gates to evaluate.
+func Apply(execution *testkube.TestWorkflowExecution, result *testkube.TestWorkflowResult) bool {
+	gates := Gates(execution)
+	if len(gates) == 0 || result == nil || result.IsAborted() || result.IsCanceled() {
+		return false
+	}
+	result.Gates = Evaluate(gates, CreateMachine(execution, result))
+	if !result.IsPassed() {
+		return true
+	}
+	for _, gate := range result.Gates {
+		if IsBlocking(gate) {
+			result.Status = common.Ptr(testkube.FAILED_TestWorkflowStatus)
+			result.PredictedStatus = result.Status
+			break
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package testworkflowgates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func gatesExecution(gates ...testkube.TestWorkflowGate) *testkube.TestWorkflowExecution {
+	return &testkube.TestWorkflowExecution{
+		ResolvedWorkflow: &testkube.TestWorkflow{Spec: &testkube.TestWorkflowSpec{Gates: gates}},
+		Reports: []testkube.TestWorkflowReport{
+			{Ref: "junit", Kind: ReportKindJUnit, Summary: &testkube.TestWorkflowReportSummary{Tests: 10, Passed: 8, Failed: 1, Skipped: 1}},
+			{Ref: "k6", Kind: ReportKindK6, Summary: &testkube.TestWorkflowReportSummary{Metrics: map[string]float64{"http_req_duration.p(95)": 320}}},
+		},
+	}
+}
+
+func passedResult() *testkube.TestWorkflowResult {
+	return &testkube.TestWorkflowResult{
+		Status:          common.Ptr(testkube.PASSED_TestWorkflowStatus),
+		PredictedStatus: common.Ptr(testkube.PASSED_TestWorkflowStatus),
+		Steps: map[string]testkube.TestWorkflowStepResult{
+			"abc": {Status: common.Ptr(testkube.PASSED_TestWorkflowStepStatus)},
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	execution := gatesExecution()
+	machine := CreateMachine(execution, passedResult())
+
+	results := Evaluate([]testkube.TestWorkflowGate{
+		{Name: "pass-rate", Condition: "reports.passRate >= 0.85"},
+		{Name: "latency", Condition: `at(metrics.k6, "http_req_duration.p(95)") < 300`},
+		{Name: "unknown", Condition: "reports.tests > missing.value"},
+		{Name: "steps", Condition: `steps.abc.status == "passed" && passed`},
+	}, machine)
+
+	require.Len(t, results, 4)
+	assert.Equal(t, testkube.PASSED_TestWorkflowGateStatus, *results[0].Status)
+	assert.Equal(t, testkube.FAILED_TestWorkflowGateStatus, *results[1].Status)
+	assert.Equal(t, testkube.FAIL_TestWorkflowGateAction, *results[1].OnFailure)
+	assert.NotEmpty(t, results[1].Message)
+	assert.Equal(t, testkube.ERROR_TestWorkflowGateStatus, *results[2].Status)
+	assert.Equal(t, testkube.PASSED_TestWorkflowGateStatus, *results[3].Status)
+}
+
+func TestApply_BlockingGate(t *testing.T) {
+	execution := gatesExecution(testkube.TestWorkflowGate{Name: "all-passed", Condition: "reports.failed == 0"})
+	result := passedResult()
+
+	assert.True(t, Apply(execution, result))
+	assert.Len(t, result.Gates, 1)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *result.Status)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *result.PredictedStatus)
+}
+
+func TestApply_WarningGate(t *testing.T) {
+	execution := gatesExecution(testkube.TestWorkflowGate{
+		Name:      "all-passed",
+		Condition: "reports.failed == 0",
+		OnFailure: common.Ptr(testkube.WARN_TestWorkflowGateAction),
+	})
+	result := passedResult()
+
+	assert.True(t, Apply(execution, result))
+	assert.Equal(t, testkube.FAILED_TestWorkflowGateStatus, *result.Gates[0].Status)
+	assert.Equal(t, testkube.PASSED_TestWorkflowStatus, *result.Status)
+}
+
+func TestApply_NoGates(t *testing.T) {
+	result := passedResult()
+
+	assert.False(t, Apply(gatesExecution(), result))
+	assert.Nil(t, result.Gates)
+	assert.Equal(t, testkube.PASSED_TestWorkflowStatus, *result.Status)
+}
+
+func TestApply_Aborted(t *testing.T) {
+	execution := gatesExecution(testkube.TestWorkflowGate{Name: "all-passed", Condition: "reports.failed == 0"})
+	result := passedResult()
+	result.Status = common.Ptr(testkube.ABORTED_TestWorkflowStatus)
+
+	assert.False(t, Apply(execution, result))
+	assert.Nil(t, result.Gates)
+}
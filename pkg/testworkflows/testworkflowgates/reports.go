@@ -0,0 +1,143 @@
+package testworkflowgates
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/junit"
+)
+
+const (
+	ReportKindJUnit  = "junit"
+	ReportKindK6     = "k6"
+	ReportKindJMeter = "jmeter"
+)
+
+// SummarizeReport detects the report kind by its content and builds its summary, so it may be used by the result gates
+func SummarizeReport(filePath string, data []byte) (string, *testkube.TestWorkflowReportSummary, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return "", nil, fmt.Errorf("empty report")
+	}
+	if trimmed[0] == '<' {
+		summary, err := summarizeJUnit(trimmed)
+		return ReportKindJUnit, summary, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &fields); err != nil {
+		return "", nil, fmt.Errorf("invalid report %s: %w", filePath, err)
+	}
+	switch {
+	case fields["metrics"] != nil:
+		summary, err := summarizeK6(trimmed)
+		return ReportKindK6, summary, err
+	case isJMeterStatistics(fields):
+		summary, err := summarizeJMeterStatistics(trimmed)
+		return ReportKindJMeter, summary, err
+	}
+	return "", nil, fmt.Errorf("unrecognized report format of %s", filePath)
+}
+
+// isJMeterStatistics checks if the report is the JMeter statistics.json,
+// keeping the sample statistics by the transaction name
+func isJMeterStatistics(fields map[string]json.RawMessage) bool {
+	for _, value := range fields {
+		var entry struct {
+			SampleCount *float64 `json:"sampleCount"`
+		}
+		if json.Unmarshal(value, &entry) == nil && entry.SampleCount != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func summarizeJUnit(data []byte) (*testkube.TestWorkflowReportSummary, error) {
+	root, err := junit.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	summary := &testkube.TestWorkflowReportSummary{}
+	root.Walk(func(_ *junit.Suite, c *junit.Case) {
+		summary.Tests++
+		summary.Duration += int64(math.Round(c.Time * 1000))
+		switch {
+		case c.Error != nil:
+			summary.Errored++
+		case c.Failure != nil:
+			summary.Failed++
+		case c.Skipped != nil:
+			summary.Skipped++
+		default:
+			summary.Passed++
+		}
+	})
+	return summary, nil
+}
+
+func summarizeK6(data []byte) (*testkube.TestWorkflowReportSummary, error) {
+	var report struct {
+		Metrics map[string]map[string]json.RawMessage `json:"metrics"`
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("invalid k6 summary: %w", err)
+	}
+	metrics := make(map[string]float64)
+	for name, metric := range report.Metrics {
+		// The handleSummary() format keeps the statistics under "values",
+		// while --summary-export puts them directly in the metric.
+		if values, ok := metric["values"]; ok {
+			addNumericFields(metrics, name, values)
+			continue
+		}
+		for key, value := range metric {
+			if key == "type" || key == "contains" || key == "thresholds" {
+				continue
+			}
+			var v float64
+			if json.Unmarshal(value, &v) == nil {
+				metrics[name+"."+key] = v
+			}
+		}
+	}
+	if len(metrics) == 0 {
+		return nil, fmt.Errorf("no metrics found in the k6 summary")
+	}
+	return &testkube.TestWorkflowReportSummary{Metrics: metrics}, nil
+}
+
+func summarizeJMeterStatistics(data []byte) (*testkube.TestWorkflowReportSummary, error) {
+	var report map[string]json.RawMessage
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("invalid jmeter statistics: %w", err)
+	}
+	metrics := make(map[string]float64)
+	for transaction, entry := range report {
+		addNumericFields(metrics, transaction, entry)
+	}
+	if len(metrics) == 0 {
+		return nil, fmt.Errorf("no metrics found in the jmeter statistics")
+	}
+	summary := &testkube.TestWorkflowReportSummary{Metrics: metrics}
+	if samples, ok := metrics["Total.sampleCount"]; ok {
+		summary.Tests = int32(samples)
+		summary.Failed = int32(metrics["Total.errorCount"])
+		summary.Passed = summary.Tests - summary.Failed
+	}
+	return summary, nil
+}
+
+func addNumericFields(metrics map[string]float64, prefix string, data json.RawMessage) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return
+	}
+	for key, value := range fields {
+		if v, ok := value.(float64); ok {
+			metrics[prefix+"."+key] = v
+		}
+	}
+}
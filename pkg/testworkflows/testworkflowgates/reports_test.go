@@ -0,0 +1,90 @@
+package testworkflowgates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeReport_JUnit(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="a">
+    <testcase name="1" time="0.5"/>
+    <testcase name="2" time="0.25"><failure message="boom"/></testcase>
+    <testsuite name="nested">
+      <testcase name="3"><skipped/></testcase>
+      <testcase name="4"><error/></testcase>
+    </testsuite>
+  </testsuite>
+</testsuites>`)
+
+	kind, summary, err := SummarizeReport("report.xml", data)
+
+	require.NoError(t, err)
+	assert.Equal(t, ReportKindJUnit, kind)
+	assert.Equal(t, int32(4), summary.Tests)
+	assert.Equal(t, int32(1), summary.Passed)
+	assert.Equal(t, int32(1), summary.Failed)
+	assert.Equal(t, int32(1), summary.Skipped)
+	assert.Equal(t, int32(1), summary.Errored)
+	assert.Equal(t, int64(750), summary.Duration)
+}
+
+func TestSummarizeReport_K6(t *testing.T) {
+	data := []byte(`{"metrics": {
+		"http_req_duration": {"type": "trend", "values": {"avg": 120.5, "p(95)": 250}},
+		"http_req_failed": {"rate": 0.01, "passes": 1, "thresholds": {"rate<0.05": false}}
+	}}`)
+
+	kind, summary, err := SummarizeReport("summary.json", data)
+
+	require.NoError(t, err)
+	assert.Equal(t, ReportKindK6, kind)
+	assert.Equal(t, map[string]float64{
+		"http_req_duration.avg":   120.5,
+		"http_req_duration.p(95)": 250,
+		"http_req_failed.rate":    0.01,
+		"http_req_failed.passes":  1,
+	}, summary.Metrics)
+}
+
+func TestSummarizeReport_JMeter(t *testing.T) {
+	data := []byte(`{
+		"Total": {"transaction": "Total", "sampleCount": 100, "errorCount": 3, "meanResTime": 42.1},
+		"Login": {"transaction": "Login", "sampleCount": 50, "errorCount": 0}
+	}`)
+
+	kind, summary, err := SummarizeReport("/data/report/statistics.json", data)
+
+	require.NoError(t, err)
+	assert.Equal(t, ReportKindJMeter, kind)
+	assert.Equal(t, int32(100), summary.Tests)
+	assert.Equal(t, int32(3), summary.Failed)
+	assert.Equal(t, int32(97), summary.Passed)
+	assert.Equal(t, 42.1, summary.Metrics["Total.meanResTime"])
+	assert.Equal(t, float64(50), summary.Metrics["Login.sampleCount"])
+}
+
+func TestSummarizeReport_Invalid(t *testing.T) {
+	_, _, err := SummarizeReport("empty.xml", []byte("  "))
+	assert.Error(t, err)
+
+	_, _, err = SummarizeReport("summary.json", []byte(`{"metrics": {}}`))
+	assert.Error(t, err)
+}
+
+func TestSummarizeReport_DetectsJSONByContent(t *testing.T) {
+	kind, _, err := SummarizeReport("statistics.json", []byte(`{"metrics": {"iterations": {"count": 10}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, ReportKindK6, kind)
+
+	kind, summary, err := SummarizeReport("jmeter-stats.json", []byte(`{"Total": {"sampleCount": 10, "errorCount": 1}}`))
+	require.NoError(t, err)
+	assert.Equal(t, ReportKindJMeter, kind)
+	assert.Equal(t, int32(9), summary.Passed)
+
+	_, _, err = SummarizeReport("results.json", []byte(`{"stats": {"tests": 3}}`))
+	assert.EqualError(t, err, "unrecognized report format of results.json")
+}
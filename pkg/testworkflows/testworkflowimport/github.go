@@ -0,0 +1,407 @@
+package testworkflowimport
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+)
+
+var (
+	githubExpressionRe = regexp.MustCompile(`\$\{\{\s*(.*?)\s*}}`)
+	githubSecretRe     = regexp.MustCompile(`^\$\{\{\s*secrets\.([A-Za-z_][A-Za-z0-9_]*)\s*}}$`)
+	githubPropertyRe   = regexp.MustCompile(`^(matrix|env|secrets|vars|inputs)\.([A-Za-z_][A-Za-z0-9_-]*)$`)
+
+	// githubSetupImages maps the setup actions to the images providing the same tooling
+	githubSetupImages = map[string]struct{ image, version string }{
+		"actions/setup-node":   {"node", "node-version"},
+		"actions/setup-python": {"python", "python-version"},
+		"actions/setup-go":     {"golang", "go-version"},
+		"actions/setup-java":   {"eclipse-temurin", "java-version"},
+		"actions/setup-dotnet": {"mcr.microsoft.com/dotnet/sdk", "dotnet-version"},
+	}
+)
+
+type githubWorkflow struct {
+	Name string            `yaml:"name"`
+	Env  map[string]string `yaml:"env"`
+	Jobs yaml.Node         `yaml:"jobs"`
+}
+
+type githubJob struct {
+	Name            string                     `yaml:"name"`
+	Needs           stringList                 `yaml:"needs"`
+	If              string                     `yaml:"if"`
+	RunsOn          yaml.Node                  `yaml:"runs-on"`
+	Env             map[string]string          `yaml:"env"`
+	Container       githubContainer            `yaml:"container"`
+	Services        map[string]githubContainer `yaml:"services"`
+	Strategy        *githubStrategy            `yaml:"strategy"`
+	Steps           []yaml.Node                `yaml:"steps"`
+	TimeoutMinutes  string                     `yaml:"timeout-minutes"`
+	ContinueOnError string                     `yaml:"continue-on-error"`
+}
+
+type githubContainer struct {
+	Image string            `yaml:"image"`
+	Env   map[string]string `yaml:"env"`
+}
+
+func (c *githubContainer) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		c.Image = node.Value
+		return nil
+	}
+	type plain githubContainer
+	return node.Decode((*plain)(c))
+}
+
+type githubStrategy struct {
+	Matrix      yaml.Node `yaml:"matrix"`
+	FailFast    *bool     `yaml:"fail-fast"`
+	MaxParallel int32     `yaml:"max-parallel"`
+}
+
+type githubStep struct {
+	Id               string            `yaml:"id"`
+	Name             string            `yaml:"name"`
+	If               string            `yaml:"if"`
+	Uses             string            `yaml:"uses"`
+	Run              string            `yaml:"run"`
+	Shell            string            `yaml:"shell"`
+	WorkingDirectory string            `yaml:"working-directory"`
+	With             map[string]string `yaml:"with"`
+	Env              map[string]string `yaml:"env"`
+	TimeoutMinutes   string            `yaml:"timeout-minutes"`
+	ContinueOnError  string            `yaml:"continue-on-error"`
+}
+
+type githubImporter struct {
+	*builder
+}
+
+func importGitHubActions(b *builder, content []byte) error {
+	root, err := documentRoot(content)
+	if err != nil {
+		return fmt.Errorf("parsing GitHub Actions workflow: %w", err)
+	}
+	var workflow githubWorkflow
+	if err = root.Decode(&workflow); err != nil {
+		return fmt.Errorf("parsing GitHub Actions workflow: %w", err)
+	}
+	b.warnUnsupportedKeys("", root, "name", "on", "env", "jobs")
+	if hasKey(root, "on") {
+		b.warn("on", "triggers are not converted, use the Test Workflow events or the Test Triggers instead")
+	}
+	g := githubImporter{builder: b}
+	b.env = g.envList("env", workflow.Env)
+
+	type entry struct {
+		id  string
+		job githubJob
+		raw *yaml.Node
+	}
+	var jobs []entry
+	for _, pair := range mapping(&workflow.Jobs) {
+		var job githubJob
+		if err = pair[1].Decode(&job); err != nil {
+			return fmt.Errorf("parsing jobs.%s: %w", pair[0].Value, err)
+		}
+		jobs = append(jobs, entry{id: pair[0].Value, job: job, raw: pair[1]})
+	}
+	if len(jobs) > 1 {
+		b.warn("jobs", "the jobs are run sequentially, in the order of their dependencies")
+	}
+
+	// Run the jobs in the order of their dependencies, keeping the file order otherwise
+	done := make(map[string]bool, len(jobs))
+	for len(done) < len(jobs) {
+		progress := false
+		for _, e := range jobs {
+			if done[e.id] || !g.ready(e.job.Needs, done) {
+				continue
+			}
+			done[e.id] = true
+			progress = true
+			if step, ok := g.job("jobs."+e.id, e.id, e.job, e.raw); ok {
+				b.spec.Steps = append(b.spec.Steps, step)
+			}
+		}
+		if !progress {
+			return fmt.Errorf("jobs have circular or missing dependencies")
+		}
+	}
+	return nil
+}
+
+func (g *githubImporter) ready(needs []string, done map[string]bool) bool {
+	for _, need := range needs {
+		if !done[need] {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *githubImporter) job(p, id string, job githubJob, raw *yaml.Node) (testworkflowsv1.Step, bool) {
+	g.warnUnsupportedKeys(p, raw, "name", "needs", "if", "runs-on", "env", "container", "services",
+		"strategy", "steps", "timeout-minutes", "continue-on-error")
+	if len(job.Steps) == 0 {
+		g.warn(p, "job without steps, i.e. calling a reusable workflow, is not supported, skipped")
+		return testworkflowsv1.Step{}, false
+	}
+	if job.RunsOn.Kind == yaml.ScalarNode && !strings.Contains(job.RunsOn.Value, "ubuntu") && job.RunsOn.Value != "self-hosted" {
+		g.warn(joinPath(p, "runs-on"), "only Linux containers are supported, the %s runner is not", job.RunsOn.Value)
+	}
+
+	// Decode the steps, and detect the image from the setup actions
+	steps := make([]githubStep, len(job.Steps))
+	image := g.expression(joinPath(p, "container"), job.Container.Image)
+	for i := range job.Steps {
+		if err := job.Steps[i].Decode(&steps[i]); err != nil {
+			g.warn(fmt.Sprintf("%s.steps[%d]", p, i), "invalid step: %s", err.Error())
+			continue
+		}
+		if setup, ok := githubSetupImages[actionName(steps[i].Uses)]; ok && image == "" {
+			image = setup.image
+			if version := steps[i].With[setup.version]; version != "" && !strings.Contains(version, "${{") {
+				image += ":" + strings.TrimSuffix(strings.TrimSuffix(version, ".x"), ".*")
+			} else if version != "" {
+				g.warn(fmt.Sprintf("%s.steps[%d].with.%s", p, i, setup.version), "dynamic version is not supported, %s image is used", image)
+			}
+		}
+	}
+	if image == "" {
+		image = DefaultImage
+		g.warn(p, "no container image detected, %s is used", DefaultImage)
+	}
+
+	name := g.expression(joinPath(p, "name"), job.Name)
+	if name == "" {
+		name = escapeText(id)
+	}
+	container := &testworkflowsv1.ContainerConfig{Image: image}
+	container.Env = append(g.envList(joinPath(p, "container.env"), job.Container.Env), g.envList(joinPath(p, "env"), job.Env)...)
+
+	var services map[string]testworkflowsv1.ServiceSpec
+	for _, serviceName := range slices.Sorted(maps.Keys(job.Services)) {
+		svc := job.Services[serviceName]
+		if services == nil {
+			services = make(map[string]testworkflowsv1.ServiceSpec)
+		}
+		key := sanitizeName(serviceName)
+		servicePath := joinPath(p, "services."+serviceName)
+		services[key] = service(g.expression(servicePath, svc.Image), g.envList(joinPath(servicePath, "env"), svc.Env))
+		g.warn(joinPath(p, "services."+serviceName), "the service is available at {{ services.%s.0.ip }} address, instead of its host name or localhost", key)
+	}
+
+	var children []testworkflowsv1.Step
+	for i := range steps {
+		if step, ok := g.step(fmt.Sprintf("%s.steps[%d]", p, i), steps[i], container); ok {
+			children = append(children, step)
+		}
+	}
+
+	var result testworkflowsv1.Step
+	if job.Strategy != nil && job.Strategy.Matrix.Kind != 0 {
+		parallel := &testworkflowsv1.StepParallel{
+			Parallelism: job.Strategy.MaxParallel,
+			FailFast:    job.Strategy.FailFast == nil || *job.Strategy.FailFast,
+			StepExecuteStrategy: testworkflowsv1.StepExecuteStrategy{
+				Matrix: g.matrix(joinPath(p, "strategy.matrix"), &job.Strategy.Matrix),
+			},
+			Container: container,
+			Services:  services,
+			Steps:     children,
+		}
+		result = g.parallelStep(name, parallel)
+	} else {
+		result = testworkflowsv1.Step{
+			StepMeta:     testworkflowsv1.StepMeta{Name: name},
+			StepDefaults: testworkflowsv1.StepDefaults{Container: container},
+			Services:     services,
+			Steps:        children,
+		}
+	}
+	result.Condition = g.condition(joinPath(p, "if"), job.If)
+	result.Optional = g.boolean(joinPath(p, "continue-on-error"), job.ContinueOnError)
+	result.Timeout = g.timeout(joinPath(p, "timeout-minutes"), job.TimeoutMinutes)
+	return result, true
+}
+
+func (g *githubImporter) step(p string, s githubStep, container *testworkflowsv1.ContainerConfig) (testworkflowsv1.Step, bool) {
+	name := g.expression(joinPath(p, "name"), s.Name)
+	var step testworkflowsv1.Step
+	switch action := actionName(s.Uses); {
+	case s.Run != "":
+		if s.Shell != "" && s.Shell != "bash" && s.Shell != "sh" {
+			g.warn(joinPath(p, "shell"), "only the default shell is supported, %s is not", s.Shell)
+		}
+		script := g.expression(joinPath(p, "run"), s.Run)
+		if name == "" {
+			name = strings.SplitN(strings.TrimSpace(script), "\n", 2)[0]
+		}
+		step = shellStep(name, script)
+	case action == "actions/checkout":
+		uri := ""
+		if repository := s.With["repository"]; repository != "" {
+			uri = "https://github.com/" + g.expression(joinPath(p, "with.repository"), repository) + ".git"
+		}
+		g.checkout(uri, g.expression(joinPath(p, "with.ref"), s.With["ref"]))
+		return step, false
+	case githubSetupImages[action].image != "":
+		return step, false
+	case action == "actions/cache":
+		container.VolumeMounts = append(container.VolumeMounts, g.cacheMounts(joinPath(p, "with.path"), splitLines(s.With["path"]))...)
+		return step, false
+	case action == "actions/upload-artifact":
+		if name == "" {
+			name = "Upload artifacts"
+		}
+		condition := s.If
+		if condition == "" {
+			condition = "success()"
+		}
+		return artifactsStep(name, splitLines(g.expression(joinPath(p, "with.path"), s.With["path"])), g.condition(joinPath(p, "if"), condition)), true
+	default:
+		g.warn(joinPath(p, "uses"), "the %s action is not supported, skipped", s.Uses)
+		return step, false
+	}
+
+	if len(s.Env) > 0 {
+		step.Container = &testworkflowsv1.ContainerConfig{Env: g.envList(joinPath(p, "env"), s.Env)}
+	}
+	if s.WorkingDirectory != "" {
+		wd := g.expression(joinPath(p, "working-directory"), s.WorkingDirectory)
+		if !path.IsAbs(wd) {
+			wd = path.Join(RepositoryPath, wd)
+		}
+		step.WorkingDir = &wd
+	}
+	step.Condition = g.condition(joinPath(p, "if"), s.If)
+	step.Optional = g.boolean(joinPath(p, "continue-on-error"), s.ContinueOnError)
+	step.Timeout = g.timeout(joinPath(p, "timeout-minutes"), s.TimeoutMinutes)
+	return step, true
+}
+
+func (g *githubImporter) matrix(p string, node *yaml.Node) map[string]testworkflowsv1.DynamicList {
+	if node.Kind != yaml.MappingNode {
+		g.warn(p, "dynamic matrix is not supported, skipped")
+		return nil
+	}
+	values := make(map[string][]interface{})
+	for _, pair := range mapping(node) {
+		key := pair[0].Value
+		if key == "include" || key == "exclude" {
+			g.warn(joinPath(p, key), "not supported, skipped")
+			continue
+		}
+		var list []interface{}
+		if err := pair[1].Decode(&list); err != nil {
+			g.warn(joinPath(p, key), "only static lists are supported, skipped")
+			continue
+		}
+		values[matrixKey(key)] = list
+	}
+	return matrixOf(values)
+}
+
+// expression converts the GitHub Actions expressions into the Test Workflow expressions
+func (g *githubImporter) expression(p, value string) string {
+	return replaceExpressions(value, githubExpressionRe, func(match []string) string {
+		expr := match[1]
+		switch expr {
+		case "github.workspace":
+			return RepositoryPath
+		case "runner.os":
+			return "Linux"
+		case "runner.temp":
+			return "/tmp"
+		}
+		property := githubPropertyRe.FindStringSubmatch(expr)
+		if property == nil {
+			g.warn(p, "expression ${{ %s }} is not supported, removed", expr)
+			return ""
+		}
+		switch property[1] {
+		case "matrix":
+			return "{{ matrix." + matrixKey(property[2]) + " }}"
+		case "env":
+			return "{{ env." + property[2] + " }}"
+		case "secrets":
+			return g.secret(property[2])
+		default:
+			return g.addConfig(matrixKey(property[2]), fmt.Sprintf("Imported from %s.%s", property[1], property[2]))
+		}
+	})
+}
+
+// envList converts the environment variables, reading the secrets directly from the Kubernetes secret
+func (g *githubImporter) envList(p string, vars map[string]string) []testworkflowsv1.EnvVar {
+	return envList(vars, func(name, value string) testworkflowsv1.EnvVar {
+		if m := githubSecretRe.FindStringSubmatch(value); m != nil {
+			return g.secretEnv(name, m[1])
+		}
+		return env(name, g.expression(joinPath(p, name), value))
+	})
+}
+
+// condition converts the status check functions, that are the only conditions supported
+func (g *githubImporter) condition(p, value string) string {
+	if value == "" {
+		return ""
+	}
+	expr := strings.TrimSpace(value)
+	if m := githubExpressionRe.FindStringSubmatch(expr); m != nil && m[0] == expr {
+		expr = m[1]
+	}
+	switch expr {
+	case "always()":
+		return "always"
+	case "failure()":
+		return "failed"
+	case "success()":
+		return "passed"
+	}
+	g.warn(p, "condition %s is not supported, removed", value)
+	return ""
+}
+
+func (g *githubImporter) boolean(p, value string) bool {
+	if value == "" {
+		return false
+	}
+	v, err := strconv.ParseBool(value)
+	if err != nil {
+		g.warn(p, "dynamic value %s is not supported, removed", value)
+	}
+	return v
+}
+
+func (g *githubImporter) timeout(p, minutes string) string {
+	if minutes == "" {
+		return ""
+	}
+	if _, err := strconv.Atoi(minutes); err != nil {
+		g.warn(p, "dynamic value %s is not supported, removed", minutes)
+		return ""
+	}
+	return minutes + "m"
+}
+
+// actionName returns the action without its version
+func actionName(uses string) string {
+	return strings.SplitN(uses, "@", 2)[0]
+}
+
+// matrixKey converts the key into the identifier, that may be used in the expressions
+func matrixKey(key string) string {
+	return strings.ReplaceAll(key, "-", "_")
+}
@@ -0,0 +1,489 @@
+package testworkflowimport
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+var (
+	gitlabDefaultStages     = []string{"build", "test", "deploy"}
+	gitlabPredefinedRe      = regexp.MustCompile(`\$\{?(CI_[A-Z0-9_]+|GITLAB_[A-Z0-9_]+)`)
+	gitlabProvidedVariables = map[string]string{
+		"CI_PROJECT_DIR": RepositoryPath,
+		"CI":             "true",
+	}
+	gitlabJobKeys = []string{"image", "services", "variables", "stage", "before_script", "script", "after_script",
+		"artifacts", "cache", "parallel", "allow_failure", "retry", "timeout", "when"}
+)
+
+type gitlabConfig struct {
+	Image        *gitlabImage              `yaml:"image"`
+	Services     []gitlabService           `yaml:"services"`
+	Variables    map[string]gitlabVariable `yaml:"variables"`
+	BeforeScript stringList                `yaml:"before_script"`
+	AfterScript  stringList                `yaml:"after_script"`
+	Cache        gitlabCacheList           `yaml:"cache"`
+}
+
+type gitlabJob struct {
+	gitlabConfig `yaml:",inline"`
+	Stage        string          `yaml:"stage"`
+	Script       stringList      `yaml:"script"`
+	Artifacts    *gitlabArtifact `yaml:"artifacts"`
+	Parallel     *gitlabParallel `yaml:"parallel"`
+	AllowFailure yaml.Node       `yaml:"allow_failure"`
+	Retry        yaml.Node       `yaml:"retry"`
+	Timeout      string          `yaml:"timeout"`
+	When         string          `yaml:"when"`
+}
+
+type gitlabImage struct {
+	Name       string   `yaml:"name"`
+	Entrypoint []string `yaml:"entrypoint"`
+}
+
+func (i *gitlabImage) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		i.Name = node.Value
+		return nil
+	}
+	type plain gitlabImage
+	return node.Decode((*plain)(i))
+}
+
+type gitlabService struct {
+	Name      string                    `yaml:"name"`
+	Alias     string                    `yaml:"alias"`
+	Variables map[string]gitlabVariable `yaml:"variables"`
+	Command   []string                  `yaml:"command"`
+}
+
+func (s *gitlabService) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		s.Name = node.Value
+		return nil
+	}
+	type plain gitlabService
+	return node.Decode((*plain)(s))
+}
+
+type gitlabVariable struct {
+	Value       string `yaml:"value"`
+	Description string `yaml:"description"`
+}
+
+func (v *gitlabVariable) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		v.Value = node.Value
+		return nil
+	}
+	type plain gitlabVariable
+	return node.Decode((*plain)(v))
+}
+
+type gitlabCache struct {
+	Paths []string `yaml:"paths"`
+}
+
+type gitlabCacheList []gitlabCache
+
+func (c *gitlabCacheList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.MappingNode {
+		var cache gitlabCache
+		if err := node.Decode(&cache); err != nil {
+			return err
+		}
+		*c = gitlabCacheList{cache}
+		return nil
+	}
+	var list []gitlabCache
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	*c = list
+	return nil
+}
+
+type gitlabArtifact struct {
+	Paths   []string `yaml:"paths"`
+	When    string   `yaml:"when"`
+	Reports struct {
+		Junit stringList `yaml:"junit"`
+	} `yaml:"reports"`
+}
+
+type gitlabParallel struct {
+	Count  int32
+	Matrix []map[string]stringList `yaml:"matrix"`
+}
+
+func (p *gitlabParallel) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&p.Count)
+	}
+	var v struct {
+		Matrix []map[string]stringList `yaml:"matrix"`
+	}
+	if err := node.Decode(&v); err != nil {
+		return err
+	}
+	p.Matrix = v.Matrix
+	return nil
+}
+
+type gitlabImporter struct {
+	*builder
+	defaults gitlabConfig
+	// provided are the predefined GitLab variables, that are available in the workflow
+	provided map[string]bool
+}
+
+func importGitLabCI(b *builder, content []byte) error {
+	root, err := documentRoot(content)
+	if err != nil {
+		return fmt.Errorf("parsing GitLab CI configuration: %w", err)
+	}
+	g := gitlabImporter{builder: b, provided: make(map[string]bool)}
+	if err = root.Decode(&g.defaults); err != nil {
+		return fmt.Errorf("parsing GitLab CI configuration: %w", err)
+	}
+
+	// Read the job defaults, that take precedence over the global keywords
+	stages := gitlabDefaultStages
+	type entry struct {
+		name string
+		job  gitlabJob
+		raw  *yaml.Node
+	}
+	var jobs []entry
+	for _, pair := range mapping(root) {
+		key := pair[0].Value
+		switch key {
+		case "image", "services", "variables", "before_script", "after_script", "cache":
+		case "default":
+			b.warnUnsupportedKeys(key, pair[1], "image", "services", "before_script", "after_script", "cache")
+			var defaults gitlabConfig
+			if err = pair[1].Decode(&defaults); err != nil {
+				return fmt.Errorf("parsing default: %w", err)
+			}
+			g.defaults = g.merge(defaults, g.defaults)
+		case "stages":
+			if err = pair[1].Decode(&stages); err != nil {
+				return fmt.Errorf("parsing stages: %w", err)
+			}
+		case "include", "workflow", "spec":
+			b.warn(key, "not supported, skipped")
+		default:
+			if strings.HasPrefix(key, ".") {
+				continue
+			}
+			if pair[1].Kind != yaml.MappingNode {
+				b.warn(key, "not supported, skipped")
+				continue
+			}
+			var job gitlabJob
+			if err = pair[1].Decode(&job); err != nil {
+				return fmt.Errorf("parsing %s: %w", key, err)
+			}
+			jobs = append(jobs, entry{name: key, job: job, raw: pair[1]})
+		}
+	}
+	if len(jobs) > 1 {
+		b.warn("", "the jobs are run sequentially, in the order of their stages")
+	}
+
+	b.checkout("", "")
+	b.env = g.variables("variables", g.defaults.Variables)
+	stages = append(append([]string{".pre"}, stages...), ".post")
+	for i := range jobs {
+		if jobs[i].job.Stage == "" {
+			jobs[i].job.Stage = "test"
+		}
+		if !slices.Contains(stages, jobs[i].job.Stage) {
+			b.warn(jobs[i].name+".stage", "unknown stage %s, the job is skipped", jobs[i].job.Stage)
+		}
+	}
+	for _, stage := range stages {
+		for _, job := range jobs {
+			if job.job.Stage == stage {
+				if step, ok := g.job(job.name, job.job, job.raw); ok {
+					b.spec.Steps = append(b.spec.Steps, step)
+				}
+			}
+		}
+	}
+
+	provided := slices.Sorted(maps.Keys(g.provided))
+	for _, name := range provided {
+		b.env = append(b.env, env(name, gitlabProvidedVariables[name]))
+	}
+	return nil
+}
+
+// merge applies the job configuration over the defaults
+func (g *gitlabImporter) merge(config, defaults gitlabConfig) gitlabConfig {
+	if config.Image == nil {
+		config.Image = defaults.Image
+	}
+	if config.Services == nil {
+		config.Services = defaults.Services
+	}
+	if config.BeforeScript == nil {
+		config.BeforeScript = defaults.BeforeScript
+	}
+	if config.AfterScript == nil {
+		config.AfterScript = defaults.AfterScript
+	}
+	if config.Cache == nil {
+		config.Cache = defaults.Cache
+	}
+	if config.Variables == nil {
+		config.Variables = defaults.Variables
+	}
+	return config
+}
+
+func (g *gitlabImporter) job(p string, job gitlabJob, raw *yaml.Node) (testworkflowsv1.Step, bool) {
+	g.warnUnsupportedKeys(p, raw, gitlabJobKeys...)
+	if len(job.Script) == 0 {
+		g.warn(p, "job without script, i.e. triggering the downstream pipeline, is not supported, skipped")
+		return testworkflowsv1.Step{}, false
+	}
+	jobVariables := job.Variables
+	job.Variables = nil
+	job.gitlabConfig = g.merge(job.gitlabConfig, g.defaults)
+
+	image := DefaultImage
+	if job.Image != nil && job.Image.Name != "" {
+		image = job.Image.Name
+		if len(job.Image.Entrypoint) > 0 {
+			g.warn(p+".image.entrypoint", "not supported, skipped")
+		}
+	} else {
+		g.warn(p, "no image specified, %s is used", DefaultImage)
+	}
+	container := &testworkflowsv1.ContainerConfig{Image: image, Env: g.variables(p+".variables", jobVariables)}
+	for _, cache := range job.Cache {
+		container.VolumeMounts = append(container.VolumeMounts, g.cacheMounts(p+".cache", cache.Paths)...)
+	}
+
+	var services map[string]testworkflowsv1.ServiceSpec
+	for i, svc := range job.Services {
+		if services == nil {
+			services = make(map[string]testworkflowsv1.ServiceSpec)
+		}
+		name := svc.Alias
+		if name == "" {
+			name = strings.SplitN(path.Base(svc.Name), ":", 2)[0]
+		}
+		name = sanitizeName(name)
+		servicePath := fmt.Sprintf("%s.services[%d]", p, i)
+		variables := maps.Clone(g.defaults.Variables)
+		if variables == nil {
+			variables = make(map[string]gitlabVariable)
+		}
+		maps.Copy(variables, jobVariables)
+		maps.Copy(variables, svc.Variables)
+		spec := service(svc.Name, envList(gitlabValues(variables), func(name, value string) testworkflowsv1.EnvVar {
+			return env(name, escapeText(value))
+		}))
+		if len(svc.Command) > 0 {
+			spec.Args = &svc.Command
+		}
+		services[name] = spec
+		g.warn(servicePath, "the service is available at {{ services.%s.0.ip }} address, instead of the %s host name", name, name)
+	}
+
+	var steps []testworkflowsv1.Step
+	script := append(slices.Clone(job.BeforeScript), job.Script...)
+	g.checkVariables(p+".script", script)
+	steps = append(steps, shellStep("Run script", escapeText(strings.Join(script, "\n"))))
+	if len(job.AfterScript) > 0 {
+		g.checkVariables(p+".after_script", job.AfterScript)
+		step := shellStep("Run after script", escapeText(strings.Join(job.AfterScript, "\n")))
+		step.Condition = "always"
+		step.Optional = true
+		steps = append(steps, step)
+	}
+	if job.Artifacts != nil {
+		paths := append(slices.Clone(job.Artifacts.Paths), job.Artifacts.Reports.Junit...)
+		if len(paths) > 0 {
+			steps = append(steps, artifactsStep("Save artifacts", common.MapSlice(paths, escapeText), g.when(p+".artifacts.when", job.Artifacts.When, "passed")))
+		}
+	}
+
+	name := escapeText(p)
+	var result testworkflowsv1.Step
+	switch {
+	case job.Parallel != nil && job.Parallel.Count > 0:
+		container.Env = append(container.Env, env("CI_NODE_INDEX", "{{ index + 1 }}"), env("CI_NODE_TOTAL", "{{ count }}"))
+		parallel := &testworkflowsv1.StepParallel{
+			StepExecuteStrategy: testworkflowsv1.StepExecuteStrategy{Count: common.Ptr(intstr.FromInt32(job.Parallel.Count))},
+			Container:           container,
+			Services:            services,
+			Steps:               steps,
+		}
+		result = g.parallelStep(name, parallel)
+	case job.Parallel != nil && len(job.Parallel.Matrix) > 0:
+		result = testworkflowsv1.Step{StepMeta: testworkflowsv1.StepMeta{Name: name}}
+		for _, axes := range job.Parallel.Matrix {
+			values := make(map[string][]interface{}, len(axes))
+			c := container.DeepCopy()
+			for _, name := range slices.Sorted(maps.Keys(axes)) {
+				values[matrixKey(name)] = common.MapSlice(axes[name], func(v string) interface{} { return v })
+				c.Env = append(c.Env, env(name, "{{ matrix."+matrixKey(name)+" }}"))
+			}
+			parallel := &testworkflowsv1.StepParallel{
+				StepExecuteStrategy: testworkflowsv1.StepExecuteStrategy{Matrix: matrixOf(values)},
+				Container:           c,
+				Services:            services,
+				Steps:               steps,
+			}
+			result.Steps = append(result.Steps, g.parallelStep(name, parallel))
+		}
+		if len(result.Steps) == 1 {
+			result = result.Steps[0]
+		}
+	default:
+		result = testworkflowsv1.Step{
+			StepMeta:     testworkflowsv1.StepMeta{Name: name},
+			StepDefaults: testworkflowsv1.StepDefaults{Container: container},
+			Services:     services,
+			Steps:        steps,
+		}
+	}
+
+	switch job.When {
+	case "manual":
+		result.Paused = true
+	case "never":
+		g.warn(p+".when", "the job never runs, skipped")
+		return result, false
+	default:
+		result.Condition = g.when(p+".when", job.When, "")
+	}
+	result.Optional = g.allowFailure(p+".allow_failure", &job.AllowFailure)
+	result.Retry = g.retry(p+".retry", &job.Retry)
+	result.Timeout = g.timeout(p+".timeout", job.Timeout)
+	return result, true
+}
+
+// variables converts the GitLab variables, using the config parameters for the ones with the description
+func (g *gitlabImporter) variables(p string, variables map[string]gitlabVariable) []testworkflowsv1.EnvVar {
+	return envList(gitlabValues(variables), func(name, value string) testworkflowsv1.EnvVar {
+		if variables[name].Description == "" {
+			return env(name, escapeText(value))
+		}
+		key := matrixKey(name)
+		g.addConfig(key, variables[name].Description)
+		if value != "" {
+			param := g.config[key]
+			param.Default = common.Ptr(intstr.FromString(value))
+			g.config[key] = param
+		}
+		return env(name, "{{ config."+key+" }}")
+	})
+}
+
+func gitlabValues(variables map[string]gitlabVariable) map[string]string {
+	result := make(map[string]string, len(variables))
+	for name, v := range variables {
+		result[name] = v.Value
+	}
+	return result
+}
+
+// checkVariables reports the GitLab predefined variables, that are not available in the workflow
+func (g *gitlabImporter) checkVariables(p string, script []string) {
+	for _, line := range script {
+		for _, match := range gitlabPredefinedRe.FindAllStringSubmatch(line, -1) {
+			name := match[1]
+			if _, ok := gitlabProvidedVariables[name]; ok {
+				g.provided[name] = true
+			} else if name != "CI_NODE_INDEX" && name != "CI_NODE_TOTAL" {
+				g.warn(p, "predefined variable %s is not available", name)
+			}
+		}
+	}
+}
+
+func (g *gitlabImporter) when(p, when, fallback string) string {
+	switch when {
+	case "":
+		return fallback
+	case "on_success":
+		return "passed"
+	case "on_failure":
+		return "failed"
+	case "always":
+		return "always"
+	}
+	g.warn(p, "%s is not supported, removed", when)
+	return fallback
+}
+
+func (g *gitlabImporter) allowFailure(p string, node *yaml.Node) bool {
+	if node.Kind == 0 {
+		return false
+	}
+	var v bool
+	if err := node.Decode(&v); err != nil {
+		g.warn(p, "only the boolean value is supported, the failure is allowed for all exit codes")
+		return true
+	}
+	return v
+}
+
+func (g *gitlabImporter) retry(p string, node *yaml.Node) *testworkflowsv1.RetryPolicy {
+	if node.Kind == 0 {
+		return nil
+	}
+	var count int32
+	if node.Kind == yaml.MappingNode {
+		var v struct {
+			Max  int32     `yaml:"max"`
+			When yaml.Node `yaml:"when"`
+		}
+		if err := node.Decode(&v); err != nil {
+			g.warn(p, "invalid value, removed")
+			return nil
+		}
+		if v.When.Kind != 0 {
+			g.warn(p+".when", "not supported, all the failures are retried")
+		}
+		count = v.Max
+	} else if err := node.Decode(&count); err != nil {
+		g.warn(p, "invalid value, removed")
+		return nil
+	}
+	if count <= 0 {
+		return nil
+	}
+	return &testworkflowsv1.RetryPolicy{Count: count}
+}
+
+// timeout converts the human-readable GitLab duration, i.e. "1h 30m"
+func (g *gitlabImporter) timeout(p, value string) string {
+	if value == "" {
+		return ""
+	}
+	replacer := strings.NewReplacer(" ", "", "hours", "h", "hour", "h", "minutes", "m", "minute", "m",
+		"mins", "m", "min", "m", "seconds", "s", "second", "s", "secs", "s", "sec", "s")
+	duration, err := time.ParseDuration(replacer.Replace(value))
+	if err != nil {
+		g.warn(p, "unsupported duration %s, removed", value)
+		return ""
+	}
+	return duration.String()
+}
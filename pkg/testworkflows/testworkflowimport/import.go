@@ -0,0 +1,400 @@
+// Package testworkflowimport converts the test jobs from other CI systems into Test Workflows.
+// The conversion is best-effort: every construct that can't be represented is reported as a warning.
+package testworkflowimport
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+type Source string
+
+const (
+	SourceGitHubActions Source = "github-actions"
+	SourceGitLabCI      Source = "gitlab-ci"
+	SourceJenkins       Source = "jenkins"
+
+	// RepositoryPath is where the repository is cloned in the generated workflow
+	RepositoryPath = "/data/repo"
+	// DefaultImage is used for the jobs that don't specify any image
+	DefaultImage = "ubuntu:24.04"
+	// RepositoryConfigName is the config parameter for the repository URI, when it's not provided
+	RepositoryConfigName = "repository"
+
+	cacheVolumeName = "cache"
+)
+
+var Sources = []Source{SourceGitHubActions, SourceGitLabCI, SourceJenkins}
+
+// DetectSource guesses the CI system from the conventional file location
+func DetectSource(filePath string) (Source, bool) {
+	filePath = filepath.ToSlash(filePath)
+	base := path.Base(filePath)
+	switch {
+	case strings.Contains(filePath, ".github/workflows/"):
+		return SourceGitHubActions, true
+	case strings.HasPrefix(base, ".gitlab-ci"):
+		return SourceGitLabCI, true
+	case strings.HasPrefix(base, "Jenkinsfile") || strings.HasSuffix(base, ".jenkinsfile"):
+		return SourceJenkins, true
+	}
+	return "", false
+}
+
+// Options configures the conversion
+type Options struct {
+	// Name of the generated Test Workflow
+	Name string
+	// Repository is the Git URI to clone, otherwise it's a required config parameter
+	Repository string
+	// Revision is the Git revision to clone
+	Revision string
+	// SecretName is the Kubernetes secret to read the CI secrets from, defaults to "<name>-secrets"
+	SecretName string
+}
+
+// Warning describes the construct that couldn't be converted exactly
+type Warning struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (w Warning) String() string {
+	if w.Path == "" {
+		return w.Message
+	}
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// Result is the converted Test Workflow along with the conversion warnings
+type Result struct {
+	Workflow *testworkflowsv1.TestWorkflow
+	Warnings []Warning
+}
+
+// Import converts the CI definition into the Test Workflow, and validates it
+func Import(source Source, content []byte, opts Options) (*Result, error) {
+	b := newBuilder(opts)
+	var err error
+	switch source {
+	case SourceGitHubActions:
+		err = importGitHubActions(b, content)
+	case SourceGitLabCI:
+		err = importGitLabCI(b, content)
+	case SourceJenkins:
+		err = importJenkins(b, content)
+	default:
+		return nil, fmt.Errorf("unsupported source: %s", source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	workflow := b.build()
+	if len(workflow.Spec.Steps) == 0 {
+		return nil, fmt.Errorf("no jobs to convert found")
+	}
+	if err = Validate(workflow, nil); err != nil {
+		return nil, fmt.Errorf("generated workflow is invalid: %w", err)
+	}
+	return &Result{Workflow: workflow, Warnings: b.warnings}, nil
+}
+
+// Validate checks if the workflow resolves with the provided templates,
+// using placeholders for the required config parameters
+func Validate(workflow *testworkflowsv1.TestWorkflow, templates map[string]*testworkflowsv1.TestWorkflowTemplate) error {
+	w := workflow.DeepCopy()
+	cfg := make(map[string]intstr.IntOrString)
+	for name, param := range w.Spec.Config {
+		if param.Default == nil {
+			cfg[name] = intstr.FromString("placeholder")
+		}
+	}
+	w, err := testworkflowresolver.ApplyWorkflowConfig(w, cfg, nil)
+	if err != nil {
+		return err
+	}
+	if templates == nil {
+		templates = map[string]*testworkflowsv1.TestWorkflowTemplate{}
+	}
+	return testworkflowresolver.ApplyTemplates(w, templates, nil)
+}
+
+// builder accumulates the shared parts of the workflow while the jobs are converted
+type builder struct {
+	opts     Options
+	warnings []Warning
+	spec     testworkflowsv1.TestWorkflowSpec
+	content  *testworkflowsv1.Content
+	secrets  []string
+	// secretKeys are all the keys read from the Kubernetes secret
+	secretKeys []string
+	config     map[string]testworkflowsv1.ParameterSchema
+	caches     bool
+	env        []testworkflowsv1.EnvVar
+	parallel   []*testworkflowsv1.StepParallel
+}
+
+func newBuilder(opts Options) *builder {
+	if opts.Name == "" {
+		opts.Name = "imported"
+	}
+	if opts.SecretName == "" {
+		opts.SecretName = opts.Name + "-secrets"
+	}
+	return &builder{opts: opts, config: make(map[string]testworkflowsv1.ParameterSchema)}
+}
+
+func (b *builder) warn(path, format string, args ...interface{}) {
+	w := Warning{Path: path, Message: fmt.Sprintf(format, args...)}
+	if !slices.Contains(b.warnings, w) {
+		b.warnings = append(b.warnings, w)
+	}
+}
+
+// checkout registers the repository to clone, and returns its content definition
+func (b *builder) checkout(uri, revision string) *testworkflowsv1.Content {
+	if b.content != nil {
+		return b.content
+	}
+	if uri == "" {
+		uri = b.opts.Repository
+	}
+	if revision == "" {
+		revision = b.opts.Revision
+	}
+	if uri == "" {
+		b.addConfig(RepositoryConfigName, "Git repository to clone")
+		uri = "{{ config." + RepositoryConfigName + " }}"
+	}
+	b.content = &testworkflowsv1.Content{Git: &testworkflowsv1.ContentGit{Uri: uri, Revision: revision, MountPath: RepositoryPath}}
+	return b.content
+}
+
+// secret registers the environment variable read from the Kubernetes secret, and returns the expression to read it
+func (b *builder) secret(name string) string {
+	if !slices.Contains(b.secrets, name) {
+		b.secrets = append(b.secrets, name)
+	}
+	return "{{ env." + name + " }}"
+}
+
+// secretEnv creates the environment variable reading the key from the Kubernetes secret
+func (b *builder) secretEnv(name, key string) testworkflowsv1.EnvVar {
+	b.secretKeys = append(b.secretKeys, key)
+	return testworkflowsv1.EnvVar{EnvVar: corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: b.opts.SecretName},
+			Key:                  key,
+		}},
+	}}
+}
+
+func (b *builder) addConfig(name, description string) string {
+	if _, ok := b.config[name]; !ok {
+		b.config[name] = testworkflowsv1.ParameterSchema{Description: description, Type: testworkflowsv1.ParameterTypeString}
+	}
+	return "{{ config." + name + " }}"
+}
+
+// cacheMounts returns the volume mounts persisting the cached paths on a shared volume
+func (b *builder) cacheMounts(pathPrefix string, paths []string) []corev1.VolumeMount {
+	mounts := make([]corev1.VolumeMount, 0, len(paths))
+	for _, p := range paths {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if strings.HasPrefix(p, "~/") {
+			p = path.Join("/root", p[2:])
+		} else if !path.IsAbs(p) {
+			p = path.Join(RepositoryPath, p)
+		}
+		if strings.ContainsAny(p, "*?[{$") {
+			b.warn(pathPrefix, "cache path %s with wildcards or variables is not supported", p)
+			continue
+		}
+		mounts = append(mounts, corev1.VolumeMount{Name: cacheVolumeName, MountPath: p, SubPath: strings.Trim(sanitizeName(p), "-")})
+	}
+	if len(mounts) > 0 && !b.caches {
+		b.caches = true
+		b.warn(pathPrefix, "caches are stored on the %s PersistentVolumeClaim, which needs to be created to persist them between executions", b.cacheClaimName())
+	}
+	return mounts
+}
+
+func (b *builder) cacheClaimName() string {
+	return b.opts.Name + "-cache"
+}
+
+func (b *builder) build() *testworkflowsv1.TestWorkflow {
+	spec := b.spec
+	// The parallel workers are separate pods, so they need the shared configuration too
+	for _, parallel := range b.parallel {
+		parallel.Content = b.content
+		parallel.Container = b.sharedContainer(parallel.Container)
+		parallel.Pod = b.sharedPod(parallel.Pod)
+	}
+	spec.Content = b.content
+	spec.Container = b.sharedContainer(spec.Container)
+	if len(b.secretKeys) > 0 {
+		slices.Sort(b.secretKeys)
+		b.warn("", "secrets are read from the %s Kubernetes secret: %s", b.opts.SecretName, strings.Join(slices.Compact(b.secretKeys), ", "))
+	}
+	spec.Pod = b.sharedPod(spec.Pod)
+	if len(b.config) > 0 {
+		spec.Config = b.config
+	}
+	return &testworkflowsv1.TestWorkflow{
+		TypeMeta:   metav1.TypeMeta{Kind: testworkflowsv1.Resource, APIVersion: testworkflowsv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: b.opts.Name},
+		Spec:       spec,
+	}
+}
+
+// sharedContainer adds the working directory, the global environment variables and the secrets to the container defaults
+func (b *builder) sharedContainer(container *testworkflowsv1.ContainerConfig) *testworkflowsv1.ContainerConfig {
+	if b.content == nil && len(b.secrets) == 0 && len(b.env) == 0 {
+		return container
+	}
+	if container == nil {
+		container = &testworkflowsv1.ContainerConfig{}
+	}
+	if b.content != nil && container.WorkingDir == nil {
+		container.WorkingDir = common.Ptr(RepositoryPath)
+	}
+	container.Env = append(slices.Clone(b.env), container.Env...)
+	for _, name := range b.secrets {
+		container.Env = append(container.Env, b.secretEnv(name, name))
+	}
+	return container
+}
+
+// sharedPod adds the cache volume to the pod configuration
+func (b *builder) sharedPod(pod *testworkflowsv1.PodConfig) *testworkflowsv1.PodConfig {
+	if !b.caches {
+		return pod
+	}
+	if pod == nil {
+		pod = &testworkflowsv1.PodConfig{}
+	}
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: cacheVolumeName,
+		VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: b.cacheClaimName(),
+		}},
+	})
+	return pod
+}
+
+var (
+	invalidNameCharsRe = regexp.MustCompile(`[^a-z0-9-]+`)
+	multipleDashesRe   = regexp.MustCompile(`-+`)
+)
+
+// sanitizeName converts the text into the valid Kubernetes name
+func sanitizeName(name string) string {
+	name = invalidNameCharsRe.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(multipleDashesRe.ReplaceAllString(name, "-"), "-")
+	if len(name) > 63 {
+		name = strings.Trim(name[:63], "-")
+	}
+	return name
+}
+
+// SanitizeName converts the text into the valid Test Workflow name
+func SanitizeName(name string) string {
+	return sanitizeName(name)
+}
+
+// escapeText escapes the "{{" in the literal text, so it's not read as the Test Workflow expression
+func escapeText(text string) string {
+	return strings.ReplaceAll(text, "{{", `{{"{{"}}`)
+}
+
+// replaceExpressions escapes the literal text, and replaces the CI expressions matched by the regular expression
+// with their translation, that receives the submatches of the expression
+func replaceExpressions(value string, re *regexp.Regexp, translate func(match []string) string) string {
+	var sb strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringSubmatchIndex(value, -1) {
+		match := make([]string, len(loc)/2)
+		for i := range match {
+			if loc[2*i] >= 0 {
+				match[i] = value[loc[2*i]:loc[2*i+1]]
+			}
+		}
+		text := escapeText(value[last:loc[0]])
+		translated := translate(match)
+		// Avoid merging the literal brace with the expression that follows it
+		if strings.HasSuffix(text, "{") && strings.HasPrefix(translated, "{") {
+			text = text[:len(text)-1] + `{{"{"}}`
+		}
+		sb.WriteString(text)
+		sb.WriteString(translated)
+		last = loc[1]
+	}
+	sb.WriteString(escapeText(value[last:]))
+	return sb.String()
+}
+
+func env(name, value string) testworkflowsv1.EnvVar {
+	return testworkflowsv1.EnvVar{EnvVar: corev1.EnvVar{Name: name, Value: value}}
+}
+
+func shellStep(name, script string) testworkflowsv1.Step {
+	return testworkflowsv1.Step{
+		StepMeta:       testworkflowsv1.StepMeta{Name: name},
+		StepOperations: testworkflowsv1.StepOperations{Shell: strings.TrimRight(script, "\n")},
+	}
+}
+
+func artifactsStep(name string, paths []string, condition string) testworkflowsv1.Step {
+	return testworkflowsv1.Step{
+		StepMeta:       testworkflowsv1.StepMeta{Name: name, Condition: condition},
+		StepOperations: testworkflowsv1.StepOperations{Artifacts: &testworkflowsv1.StepArtifacts{Paths: paths}},
+	}
+}
+
+func service(image string, envs []testworkflowsv1.EnvVar) testworkflowsv1.ServiceSpec {
+	return testworkflowsv1.ServiceSpec{IndependentServiceSpec: testworkflowsv1.IndependentServiceSpec{
+		StepRun: testworkflowsv1.StepRun{ContainerConfig: testworkflowsv1.ContainerConfig{Image: image, Env: envs}},
+	}}
+}
+
+// parallelStep creates the parallel step, that will receive the shared configuration when the workflow is built
+func (b *builder) parallelStep(name string, parallel *testworkflowsv1.StepParallel) testworkflowsv1.Step {
+	b.parallel = append(b.parallel, parallel)
+	return testworkflowsv1.Step{StepMeta: testworkflowsv1.StepMeta{Name: name}, Parallel: parallel}
+}
+
+func matrixOf(values map[string][]interface{}) map[string]testworkflowsv1.DynamicList {
+	result := make(map[string]testworkflowsv1.DynamicList, len(values))
+	for k, v := range values {
+		result[k] = testworkflowsv1.DynamicList{Static: v}
+	}
+	return result
+}
+
+// splitLines returns the non-empty lines, i.e. for the multi-line paths lists
+func splitLines(value string) []string {
+	result := make([]string, 0)
+	for _, line := range strings.Split(value, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
@@ -0,0 +1,296 @@
+package testworkflowimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/expressions"
+)
+
+func importFile(t *testing.T, source Source, file string, opts Options) *Result {
+	content, err := os.ReadFile(filepath.Join("testdata", file))
+	require.NoError(t, err)
+	result, err := Import(source, content, opts)
+	require.NoError(t, err)
+	return result
+}
+
+func warnings(result *Result) []string {
+	list := make([]string, len(result.Warnings))
+	for i, w := range result.Warnings {
+		list[i] = w.String()
+	}
+	return list
+}
+
+func envNames(envs []testworkflowsv1.EnvVar) []string {
+	names := make([]string, len(envs))
+	for i, e := range envs {
+		names[i] = e.Name
+	}
+	return names
+}
+
+func TestImport_GitHubActions(t *testing.T) {
+	result := importFile(t, SourceGitHubActions, "github.yaml", Options{Name: "ci", Repository: "https://github.com/kubeshop/testkube.git"})
+	spec := result.Workflow.Spec
+
+	assert.Equal(t, "ci", result.Workflow.Name)
+	assert.Equal(t, "https://github.com/kubeshop/testkube.git", spec.Content.Git.Uri)
+	assert.Empty(t, spec.Config)
+	assert.Equal(t, RepositoryPath, *spec.Container.WorkingDir)
+	assert.Equal(t, "ci-cache", spec.Pod.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+	// The jobs are sorted by their dependencies
+	require.Len(t, spec.Steps, 2)
+	unit := spec.Steps[0]
+	assert.Equal(t, "unit", unit.Name)
+	assert.Equal(t, "golang:1.22", unit.Container.Image)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "cache", MountPath: "/root/go/pkg/mod", SubPath: "root-go-pkg-mod"}}, unit.Container.VolumeMounts)
+	require.Len(t, unit.Steps, 1)
+	assert.Equal(t, "go test ./... -token=", unit.Steps[0].Shell)
+	assert.Equal(t, "10m", unit.Steps[0].Timeout)
+	assert.Equal(t, "/data/repo/backend", *unit.Steps[0].WorkingDir)
+
+	e2e := spec.Steps[1]
+	assert.Equal(t, "E2E tests", e2e.Name)
+	require.NotNil(t, e2e.Parallel)
+	assert.Equal(t, int32(2), e2e.Parallel.Parallelism)
+	assert.Equal(t, "node:20", e2e.Parallel.Container.Image)
+	assert.Equal(t, []interface{}{"chromium", "firefox"}, e2e.Parallel.Matrix["browser"].Static)
+	assert.Equal(t, []interface{}{20}, e2e.Parallel.Matrix["node_version"].Static)
+	assert.Equal(t, spec.Content, e2e.Parallel.Content)
+	assert.Equal(t, []string{"CI"}, envNames(e2e.Parallel.Container.Env))
+	assert.Equal(t, "DB_PASSWORD", e2e.Parallel.Services["postgres"].Env[0].ValueFrom.SecretKeyRef.Key)
+	require.Len(t, e2e.Parallel.Steps, 2)
+	assert.Equal(t, "npx playwright test --project={{ matrix.browser }}", e2e.Parallel.Steps[0].Shell)
+	assert.Equal(t, "{{ matrix.node_version }}", e2e.Parallel.Steps[0].Container.Env[0].Value)
+	assert.Equal(t, "always", e2e.Parallel.Steps[1].Condition)
+	assert.Equal(t, []string{"playwright-report", "test-results/*.xml"}, e2e.Parallel.Steps[1].Artifacts.Paths)
+
+	assert.Equal(t, []string{
+		"on: triggers are not converted, use the Test Workflow events or the Test Triggers instead",
+		"jobs: the jobs are run sequentially, in the order of their dependencies",
+		"jobs.unit.steps[1].with.path: caches are stored on the ci-cache PersistentVolumeClaim, which needs to be created to persist them between executions",
+		"jobs.unit.steps[2].run: expression ${{ github.token }} is not supported, removed",
+		"jobs.unit.steps[3].uses: the codecov/codecov-action@v4 action is not supported, skipped",
+		"jobs.e2e.services.postgres: the service is available at {{ services.postgres.0.ip }} address, instead of its host name or localhost",
+		"secrets are read from the ci-secrets Kubernetes secret: DB_PASSWORD",
+	}, warnings(result))
+}
+
+func TestImport_GitLabCI(t *testing.T) {
+	result := importFile(t, SourceGitLabCI, "gitlab-ci.yml", Options{Name: "ci"})
+	spec := result.Workflow.Spec
+
+	assert.Equal(t, "{{ config.repository }}", spec.Content.Git.Uri)
+	assert.Equal(t, "chromium", spec.Config["BROWSER"].Default.StrVal)
+	assert.Equal(t, []string{"BROWSER", "NODE_ENV", "CI_PROJECT_DIR"}, envNames(spec.Container.Env))
+
+	// The jobs are sorted by their stages, and the job from the undeclared stage is skipped
+	require.Len(t, spec.Steps, 3)
+	lint, unit, e2e := spec.Steps[0], spec.Steps[1], spec.Steps[2]
+	assert.Equal(t, "lint", lint.Name)
+	assert.Equal(t, "npm ci\nnpm run lint", lint.Steps[0].Shell)
+
+	assert.Equal(t, "unit", unit.Name)
+	assert.Equal(t, &testworkflowsv1.RetryPolicy{Count: 2}, unit.Retry)
+	assert.Equal(t, "1h30m0s", unit.Timeout)
+	assert.Equal(t, "postgres:16", unit.Services["db"].Image)
+	require.Len(t, unit.Steps, 3)
+	assert.Equal(t, "always", unit.Steps[1].Condition)
+	assert.Equal(t, "rm -rf tmp", unit.Steps[1].Shell)
+	assert.Equal(t, "always", unit.Steps[2].Condition)
+	assert.Equal(t, []string{"coverage/", "junit.xml"}, unit.Steps[2].Artifacts.Paths)
+
+	assert.Equal(t, "e2e", e2e.Name)
+	assert.True(t, e2e.Optional)
+	require.NotNil(t, e2e.Parallel)
+	assert.Equal(t, []interface{}{"1", "2"}, e2e.Parallel.Matrix["SHARD"].Static)
+	assert.Equal(t, []string{"BROWSER", "NODE_ENV", "CI_PROJECT_DIR", "PROJECT", "SHARD"}, envNames(e2e.Parallel.Container.Env))
+
+	assert.Contains(t, warnings(result), "deploy.stage: unknown stage deploy, the job is skipped")
+	assert.Contains(t, warnings(result), "lint.rules: not supported, skipped")
+	assert.Contains(t, warnings(result), "unit.script: predefined variable CI_COMMIT_SHA is not available")
+}
+
+func TestImport_Jenkins(t *testing.T) {
+	result := importFile(t, SourceJenkins, "Jenkinsfile", Options{Name: "ci", Repository: "https://example.com/repo.git", Revision: "main"})
+	spec := result.Workflow.Spec
+
+	assert.Equal(t, "main", spec.Content.Git.Revision)
+	assert.Equal(t, "maven:3.9-eclipse-temurin-21", spec.Container.Image)
+	assert.Equal(t, []string{"smoke", "full"}, spec.Config["SUITE"].Enum)
+	assert.Equal(t, []string{"PROFILE", "SUITE", "MAVEN_OPTS", "API_TOKEN"}, envNames(spec.Container.Env))
+	assert.Equal(t, "api-token", spec.Container.Env[3].ValueFrom.SecretKeyRef.Key)
+
+	require.Len(t, spec.Steps, 3)
+	build, test, e2e := spec.Steps[0], spec.Steps[1], spec.Steps[2]
+	assert.Equal(t, "mvn -B -P ${PROFILE} -DskipTests package", build.Steps[0].Shell)
+
+	assert.Equal(t, &testworkflowsv1.RetryPolicy{Count: 1}, test.Retry)
+	require.Len(t, test.Steps, 3)
+	assert.Equal(t, "/data/repo/backend", *test.Steps[0].WorkingDir)
+	assert.Equal(t, "mvn -B test -Dsuite={{ config.SUITE }} -Dbuild=${BUILD_NUMBER}", test.Steps[0].Steps[0].Shell)
+	assert.Equal(t, "registry-password", test.Steps[1].Container.Env[1].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "always", test.Steps[2].Condition)
+	assert.Equal(t, []string{"backend/target/surefire-reports/*.xml"}, test.Steps[2].Steps[0].Artifacts.Paths)
+
+	parallel := e2e.Steps[0].Parallel
+	require.NotNil(t, parallel)
+	assert.Equal(t, "mcr.microsoft.com/playwright:v1.48.0", parallel.Container.Image)
+	assert.Equal(t, []interface{}{"chromium", "firefox"}, parallel.Matrix["BROWSER"].Static)
+	assert.Equal(t, "npx playwright test --project=${BROWSER}", parallel.Steps[0].Steps[0].Shell)
+
+	require.Len(t, spec.After, 2)
+	assert.Equal(t, "failed", spec.After[0].Condition)
+	assert.Equal(t, []string{"target/*.jar", "reports/**"}, spec.After[1].Steps[0].Artifacts.Paths)
+
+	assert.Equal(t, []string{
+		"@Library: not supported, skipped",
+		"pipeline.agent.docker.args: not supported, skipped",
+		"pipeline.environment.API_TOKEN: only the secret text credentials are supported, the _USR and _PSW variables are not available",
+		"pipeline.options.timeout: not supported, skipped",
+		"pipeline.stages.stage(Test).when: conditions are not supported, the stage always runs",
+		"pipeline.stages.stage(Test).steps.dir.sh: predefined variable BUILD_NUMBER is not available",
+		"pipeline.stages.stage(Test).steps.script: scripted Groovy code is not supported, skipped",
+		"secrets are read from the ci-secrets Kubernetes secret: api-token, registry-password, registry-username",
+	}, warnings(result))
+}
+
+func TestImport_LiteralBraces(t *testing.T) {
+	const script = "docker inspect --format '{{.State.Status}}' db"
+	sources := map[Source]string{
+		SourceGitHubActions: `jobs:
+  check:
+    runs-on: ubuntu-latest
+    container: docker:27
+    env:
+      FORMAT: "{{.Name}}"
+    steps:
+    - run: docker inspect --format '{{.State.Status}}' db
+    - name: Tag {{ "${{ matrix.tag }}" }}
+      run: echo ${{ env.FORMAT }}{{x}}
+`,
+		SourceGitLabCI: `check:
+  image: docker:27
+  variables:
+    FORMAT: "{{.Name}}"
+  script:
+  - docker inspect --format '{{.State.Status}}' db
+`,
+		SourceJenkins: `pipeline {
+  agent { docker { image 'docker:27' } }
+  environment {
+    FORMAT = '{{.Name}}'
+  }
+  stages {
+    stage('Check {{ db }}') {
+      steps {
+        sh "docker inspect --format '{{.State.Status}}' db"
+      }
+    }
+  }
+}
+`,
+	}
+	for source, content := range sources {
+		t.Run(string(source), func(t *testing.T) {
+			result, err := Import(source, []byte(content), Options{Name: "check", Repository: "https://github.com/org/repo.git"})
+			require.NoError(t, err)
+			steps := result.Workflow.Spec.Steps
+			require.NotEmpty(t, steps)
+			require.NotEmpty(t, steps[0].Steps)
+
+			shell, err := expressions.EvalTemplate(steps[0].Steps[0].Shell)
+			require.NoError(t, err)
+			assert.Equal(t, script, shell)
+			name, err := expressions.EvalTemplate(steps[0].Name)
+			require.NoError(t, err)
+			assert.NotContains(t, name, `"{{"`)
+
+			var envs []testworkflowsv1.EnvVar
+			if result.Workflow.Spec.Container != nil {
+				envs = append(envs, result.Workflow.Spec.Container.Env...)
+			}
+			if steps[0].Container != nil {
+				envs = append(envs, steps[0].Container.Env...)
+			}
+			require.Contains(t, envNames(envs), "FORMAT")
+			for _, e := range envs {
+				if e.Name == "FORMAT" {
+					value, err := expressions.EvalTemplate(e.Value)
+					require.NoError(t, err)
+					assert.Equal(t, "{{.Name}}", value)
+				}
+			}
+		})
+	}
+
+	// The literal braces are kept next to the translated expressions
+	result, err := Import(SourceGitHubActions, []byte(sources[SourceGitHubActions]), Options{Name: "check", Repository: "https://github.com/org/repo.git"})
+	require.NoError(t, err)
+	step := result.Workflow.Spec.Steps[0].Steps[1]
+	assert.Equal(t, `echo {{ env.FORMAT }}{{"{{"}}x}}`, step.Shell)
+}
+
+func TestImport_Errors(t *testing.T) {
+	_, err := Import("travis", []byte("script: make"), Options{})
+	assert.EqualError(t, err, "unsupported source: travis")
+
+	_, err = Import(SourceGitHubActions, []byte("jobs:\n  a:\n    needs: b\n    steps:\n    - run: echo\n"), Options{})
+	assert.EqualError(t, err, "jobs have circular or missing dependencies")
+
+	_, err = Import(SourceGitLabCI, []byte("stages: [test]\n"), Options{})
+	assert.EqualError(t, err, "no jobs to convert found")
+
+	_, err = Import(SourceJenkins, []byte("node {\n  sh 'make'\n}\n"), Options{})
+	assert.EqualError(t, err, "only the declarative pipelines are supported: pipeline block not found")
+}
+
+func TestParseJenkinsfile(t *testing.T) {
+	nodes, err := parseJenkinsfile(`
+stage('Test') { // comment
+  steps {
+    sh script: """
+      echo \${HOME}
+    """, returnStdout: true
+    archiveArtifacts artifacts: 'a.txt',
+      fingerprint: true
+  }
+}`)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	name, _ := nodes[0].Arg("name", 0)
+	assert.Equal(t, "Test", name.Text)
+
+	steps := nodes[0].Children("steps")[0].Block
+	require.Len(t, steps, 2)
+	script, _ := steps[0].Arg("script", 0)
+	assert.Equal(t, jenkinsValue{Text: `echo \${HOME}`, Interpolated: true}, script)
+	fingerprint, ok := steps[1].Arg("fingerprint", -1)
+	assert.True(t, ok)
+	assert.Equal(t, "true", fingerprint.Text)
+
+	_, err = parseJenkinsfile("pipeline {\n  stages {\n")
+	assert.EqualError(t, err, "line 2: missing closing brace for 'stages'")
+}
+
+func TestDetectSource(t *testing.T) {
+	for file, expected := range map[string]Source{
+		".github/workflows/ci.yaml": SourceGitHubActions,
+		"repo/.gitlab-ci.yml":       SourceGitLabCI,
+		"Jenkinsfile":               SourceJenkins,
+		"ci/build.jenkinsfile":      SourceJenkins,
+		"workflow.yaml":             "",
+	} {
+		source, _ := DetectSource(file)
+		assert.Equal(t, expected, source, file)
+	}
+}
@@ -0,0 +1,573 @@
+package testworkflowimport
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+var (
+	jenkinsInterpolationRe = regexp.MustCompile(`\\(.)|\$\{([^}]*)}|\$([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*)`)
+	jenkinsIdentifierRe    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	jenkinsPredefinedRe    = regexp.MustCompile(`\$\{?(BUILD_NUMBER|BUILD_ID|BUILD_URL|BUILD_TAG|JOB_NAME|JOB_BASE_NAME|JOB_URL|BRANCH_NAME|CHANGE_ID|GIT_COMMIT|GIT_BRANCH|GIT_URL|NODE_NAME|EXECUTOR_NUMBER|JENKINS_URL|WORKSPACE)\b`)
+	jenkinsProvidedEnv     = map[string]string{
+		"WORKSPACE": RepositoryPath,
+	}
+	jenkinsTimeUnits = map[string]string{"SECONDS": "s", "MINUTES": "m", "HOURS": "h"}
+)
+
+type jenkinsImporter struct {
+	*builder
+	image string
+	// provided are the predefined Jenkins variables, that are available in the workflow
+	provided map[string]bool
+}
+
+func importJenkins(b *builder, content []byte) error {
+	nodes, err := parseJenkinsfile(string(content))
+	if err != nil {
+		return fmt.Errorf("parsing Jenkinsfile: %w", err)
+	}
+	var pipeline *jenkinsNode
+	for _, node := range nodes {
+		if node.Name == "pipeline" && node.Block != nil {
+			pipeline = node
+		} else {
+			b.warn(node.Name, "not supported, skipped")
+		}
+	}
+	if pipeline == nil {
+		return fmt.Errorf("only the declarative pipelines are supported: pipeline block not found")
+	}
+
+	j := jenkinsImporter{builder: b, provided: make(map[string]bool)}
+	for _, agent := range pipeline.Children("agent") {
+		j.image = j.agent("pipeline.agent", agent)
+	}
+	if j.image == "" {
+		j.image = DefaultImage
+		b.warn("pipeline.agent", "no Docker image specified, %s is used", DefaultImage)
+	}
+	b.spec.Container = &testworkflowsv1.ContainerConfig{Image: j.image}
+
+	for _, node := range pipeline.Block {
+		p := "pipeline." + node.Name
+		switch node.Name {
+		case "agent":
+		case "environment":
+			b.env = append(b.env, j.environment(p, node)...)
+		case "parameters":
+			j.parameters(p, node)
+		case "stages":
+			b.spec.Steps = append(b.spec.Steps, j.stages(p, node)...)
+		case "post":
+			b.spec.After = append(b.spec.After, j.post(p, node)...)
+		case "options":
+			for _, option := range node.Block {
+				if option.Name != "skipDefaultCheckout" {
+					b.warn(p+"."+option.Name, "not supported, skipped")
+				}
+			}
+		default:
+			b.warn(p, "not supported, skipped")
+		}
+	}
+
+	b.checkout("", "")
+	for _, name := range slices.Sorted(maps.Keys(j.provided)) {
+		b.env = append(b.env, env(name, jenkinsProvidedEnv[name]))
+	}
+	return nil
+}
+
+// agent returns the Docker image used by the agent
+func (j *jenkinsImporter) agent(p string, node *jenkinsNode) string {
+	if value, ok := node.Arg("", 0); ok && node.Block == nil {
+		if value.Text != "any" && value.Text != "none" {
+			j.warn(p, "agent %s is not supported, skipped", value.Text)
+		}
+		return ""
+	}
+	for _, child := range node.Block {
+		switch child.Name {
+		case "docker":
+			if value, ok := child.Arg("image", 0); ok {
+				return j.text(p+".docker", value)
+			}
+			image := ""
+			for _, property := range child.Block {
+				if value, ok := property.Arg("", 0); ok && property.Name == "image" {
+					image = j.text(p+".docker.image", value)
+				} else {
+					j.warn(p+".docker."+property.Name, "not supported, skipped")
+				}
+			}
+			return image
+		case "label":
+			j.warn(p+".label", "node labels are not supported, skipped")
+		default:
+			j.warn(p+"."+child.Name, "not supported, skipped")
+		}
+	}
+	return ""
+}
+
+func (j *jenkinsImporter) environment(p string, node *jenkinsNode) []testworkflowsv1.EnvVar {
+	var result []testworkflowsv1.EnvVar
+	for _, variable := range node.Block {
+		value, ok := variable.Arg("=", -1)
+		if !ok {
+			j.warn(p+"."+variable.Name, "invalid variable, skipped")
+			continue
+		}
+		if value.Call == "credentials" {
+			id, _ := findJenkinsArg(value.Args, "", 0)
+			result = append(result, j.secretEnv(variable.Name, id.Text))
+			j.warn(p+"."+variable.Name, "only the secret text credentials are supported, the _USR and _PSW variables are not available")
+			continue
+		}
+		result = append(result, env(variable.Name, j.text(p+"."+variable.Name, value)))
+	}
+	return result
+}
+
+// parameters converts the build parameters into the config parameters, that are exposed as environment variables too
+func (j *jenkinsImporter) parameters(p string, node *jenkinsNode) {
+	for _, param := range node.Block {
+		nameValue, _ := param.Arg("name", -1)
+		name := nameValue.Text
+		if name == "" {
+			j.warn(p+"."+param.Name, "parameter without name, skipped")
+			continue
+		}
+		description, _ := param.Arg("description", -1)
+		schema := testworkflowsv1.ParameterSchema{Description: description.Text, Type: testworkflowsv1.ParameterTypeString}
+		defaultValue, hasDefault := param.Arg("defaultValue", -1)
+		switch param.Name {
+		case "string", "text":
+		case "password":
+			schema.Sensitive = true
+		case "booleanParam":
+			schema.Type = testworkflowsv1.ParameterTypeBoolean
+			if !hasDefault {
+				defaultValue, hasDefault = jenkinsValue{Text: "false"}, true
+			}
+		case "choice":
+			choices, _ := param.Arg("choices", -1)
+			if len(choices.List) > 0 {
+				for _, choice := range choices.List {
+					schema.Enum = append(schema.Enum, choice.Text)
+				}
+			} else {
+				schema.Enum = splitLines(choices.Text)
+			}
+			if len(schema.Enum) > 0 {
+				defaultValue, hasDefault = jenkinsValue{Text: schema.Enum[0]}, true
+			}
+		default:
+			j.warn(p+"."+param.Name, "parameter type is not supported, skipped")
+			continue
+		}
+		if hasDefault {
+			schema.Default = common.Ptr(intstr.FromString(defaultValue.Text))
+		}
+		j.config[name] = schema
+		j.env = append(j.env, env(name, "{{ config."+name+" }}"))
+	}
+}
+
+func (j *jenkinsImporter) stages(p string, node *jenkinsNode) []testworkflowsv1.Step {
+	var result []testworkflowsv1.Step
+	for _, stage := range node.Block {
+		if stage.Name != "stage" {
+			j.warn(p+"."+stage.Name, "not supported, skipped")
+			continue
+		}
+		if step, ok := j.stage(p, stage); ok {
+			result = append(result, step)
+		}
+	}
+	return result
+}
+
+func (j *jenkinsImporter) stage(parent string, node *jenkinsNode) (testworkflowsv1.Step, bool) {
+	nameValue, _ := node.Arg("name", 0)
+	name := nameValue.Text
+	p := fmt.Sprintf("%s.stage(%s)", parent, name)
+	step := testworkflowsv1.Step{StepMeta: testworkflowsv1.StepMeta{Name: escapeText(name)}}
+
+	// Read the agent first, as the image is used by the matrix too
+	image := j.image
+	defer func() {
+		j.image = image
+	}()
+	for _, agent := range node.Children("agent") {
+		if stageImage := j.agent(p+".agent", agent); stageImage != "" {
+			j.image = stageImage
+			step.Container = &testworkflowsv1.ContainerConfig{Image: stageImage}
+		}
+	}
+
+	var post []testworkflowsv1.Step
+	for _, child := range node.Block {
+		childPath := p + "." + child.Name
+		switch child.Name {
+		case "agent":
+		case "environment":
+			if step.Container == nil {
+				step.Container = &testworkflowsv1.ContainerConfig{}
+			}
+			step.Container.Env = append(step.Container.Env, j.environment(childPath, child)...)
+		case "steps":
+			step.Steps = append(step.Steps, j.steps(childPath, child.Block)...)
+		case "stages":
+			step.Steps = append(step.Steps, j.stages(childPath, child)...)
+		case "parallel":
+			j.warn(childPath, "the parallel stages are run sequentially")
+			step.Steps = append(step.Steps, j.stages(childPath, child)...)
+		case "matrix":
+			if matrix, ok := j.matrix(childPath, child, step.Container); ok {
+				step.Container = nil
+				step.Steps = append(step.Steps, matrix)
+			}
+		case "post":
+			post = append(post, j.post(childPath, child)...)
+		case "options":
+			j.options(childPath, child, &step)
+		case "input":
+			message, _ := child.Arg("message", -1)
+			step.Paused = true
+			j.warn(childPath, "the stage is paused instead, resume the execution to continue: %s", message.Text)
+		case "when":
+			j.warn(childPath, "conditions are not supported, the stage always runs")
+		default:
+			j.warn(childPath, "not supported, skipped")
+		}
+	}
+	step.Steps = append(step.Steps, post...)
+	if len(step.Steps) == 0 {
+		j.warn(p, "stage without steps, skipped")
+		return step, false
+	}
+	return step, true
+}
+
+// matrix converts the matrix into the parallel step, with the axes exposed as the environment variables
+func (j *jenkinsImporter) matrix(p string, node *jenkinsNode, container *testworkflowsv1.ContainerConfig) (testworkflowsv1.Step, bool) {
+	if container == nil {
+		container = &testworkflowsv1.ContainerConfig{}
+	}
+	container = container.DeepCopy()
+	container.Image = j.image
+	values := make(map[string][]interface{})
+	var steps []testworkflowsv1.Step
+	for _, child := range node.Block {
+		childPath := p + "." + child.Name
+		switch child.Name {
+		case "axes":
+			for _, axis := range child.Children("axis") {
+				var name string
+				var list []interface{}
+				for _, property := range axis.Block {
+					switch property.Name {
+					case "name":
+						value, _ := property.Arg("", 0)
+						name = value.Text
+					case "values":
+						for _, arg := range property.Args {
+							list = append(list, arg.Value.Text)
+						}
+					}
+				}
+				if name == "" || len(list) == 0 {
+					j.warn(childPath+".axis", "invalid axis, skipped")
+					continue
+				}
+				values[matrixKey(name)] = list
+				container.Env = append(container.Env, env(name, "{{ matrix."+matrixKey(name)+" }}"))
+			}
+		case "agent":
+			if image := j.agent(childPath, child); image != "" {
+				container.Image = image
+			}
+		case "environment":
+			container.Env = append(container.Env, j.environment(childPath, child)...)
+		case "stages":
+			steps = append(steps, j.stages(childPath, child)...)
+		default:
+			j.warn(childPath, "not supported, skipped")
+		}
+	}
+	if len(values) == 0 || len(steps) == 0 {
+		j.warn(p, "matrix without axes or stages, skipped")
+		return testworkflowsv1.Step{}, false
+	}
+	return j.parallelStep("matrix", &testworkflowsv1.StepParallel{
+		StepExecuteStrategy: testworkflowsv1.StepExecuteStrategy{Matrix: matrixOf(values)},
+		Container:           container,
+		Steps:               steps,
+	}), true
+}
+
+func (j *jenkinsImporter) options(p string, node *jenkinsNode, step *testworkflowsv1.Step) {
+	for _, option := range node.Block {
+		switch option.Name {
+		case "timeout":
+			step.Timeout = j.timeout(p+".timeout", option)
+		case "retry":
+			step.Retry = j.retry(p+".retry", option)
+		default:
+			j.warn(p+"."+option.Name, "not supported, skipped")
+		}
+	}
+}
+
+func (j *jenkinsImporter) post(p string, node *jenkinsNode) []testworkflowsv1.Step {
+	var result []testworkflowsv1.Step
+	for _, child := range node.Block {
+		var condition string
+		switch child.Name {
+		case "always", "cleanup":
+			condition = "always"
+		case "failure", "unsuccessful":
+			condition = "failed"
+		case "success":
+			condition = "passed"
+		default:
+			j.warn(p+"."+child.Name, "not supported, skipped")
+			continue
+		}
+		steps := j.steps(p+"."+child.Name, child.Block)
+		if len(steps) == 0 {
+			continue
+		}
+		result = append(result, testworkflowsv1.Step{
+			StepMeta: testworkflowsv1.StepMeta{Name: "post (" + child.Name + ")", Condition: condition},
+			Steps:    steps,
+		})
+	}
+	return result
+}
+
+func (j *jenkinsImporter) steps(p string, nodes []*jenkinsNode) []testworkflowsv1.Step {
+	var result []testworkflowsv1.Step
+	for _, node := range nodes {
+		childPath := p + "." + node.Name
+		switch node.Name {
+		case "sh":
+			script, ok := node.Arg("script", 0)
+			if !ok {
+				j.warn(childPath, "missing script, skipped")
+				continue
+			}
+			if _, ok := node.Arg("returnStdout", -1); ok {
+				j.warn(childPath+".returnStdout", "not supported, skipped")
+			}
+			text := j.script(childPath, script)
+			result = append(result, shellStep(strings.SplitN(strings.TrimSpace(text), "\n", 2)[0], text))
+		case "echo":
+			message, _ := node.Arg("message", 0)
+			text := j.text(childPath, message)
+			result = append(result, shellStep("echo", "echo '"+strings.ReplaceAll(text, "'", `'"'"'`)+"'"))
+		case "junit":
+			value, _ := node.Arg("testResults", 0)
+			result = append(result, artifactsStep("Save JUnit reports", splitJenkinsPatterns(j.text(childPath, value)), ""))
+		case "archiveArtifacts":
+			value, _ := node.Arg("artifacts", 0)
+			result = append(result, artifactsStep("Save artifacts", splitJenkinsPatterns(j.text(childPath, value)), ""))
+		case "checkout":
+			if value, _ := node.Arg("", 0); value.Text != "scm" {
+				j.warn(childPath, "only the `checkout scm` is supported, skipped")
+			}
+			j.checkout("", "")
+		case "git":
+			url, _ := node.Arg("url", 0)
+			branch, _ := node.Arg("branch", -1)
+			if _, ok := node.Arg("credentialsId", -1); ok {
+				j.warn(childPath+".credentialsId", "not supported, configure the Git credentials in the workflow content")
+			}
+			if j.content != nil {
+				j.warn(childPath, "only one repository is supported, skipped")
+			}
+			j.checkout(j.text(childPath, url), j.text(childPath, branch))
+		case "dir":
+			value, _ := node.Arg("path", 0)
+			wd := j.text(childPath, value)
+			if !path.IsAbs(wd) {
+				wd = path.Join(RepositoryPath, wd)
+			}
+			result = append(result, testworkflowsv1.Step{
+				StepMeta:     testworkflowsv1.StepMeta{Name: "dir " + escapeText(value.Text)},
+				StepDefaults: testworkflowsv1.StepDefaults{WorkingDir: &wd},
+				Steps:        j.steps(childPath, node.Block),
+			})
+		case "withEnv":
+			value, _ := node.Arg("", 0)
+			container := &testworkflowsv1.ContainerConfig{}
+			for _, item := range value.List {
+				name, v, _ := strings.Cut(j.text(childPath, item), "=")
+				container.Env = append(container.Env, env(name, v))
+			}
+			result = append(result, testworkflowsv1.Step{
+				StepMeta:     testworkflowsv1.StepMeta{Name: "withEnv"},
+				StepDefaults: testworkflowsv1.StepDefaults{Container: container},
+				Steps:        j.steps(childPath, node.Block),
+			})
+		case "withCredentials":
+			value, _ := node.Arg("", 0)
+			container := &testworkflowsv1.ContainerConfig{}
+			for _, binding := range value.List {
+				container.Env = append(container.Env, j.credentials(childPath, binding)...)
+			}
+			result = append(result, testworkflowsv1.Step{
+				StepMeta:     testworkflowsv1.StepMeta{Name: "withCredentials"},
+				StepDefaults: testworkflowsv1.StepDefaults{Container: container},
+				Steps:        j.steps(childPath, node.Block),
+			})
+		case "timeout":
+			step := testworkflowsv1.Step{StepMeta: testworkflowsv1.StepMeta{Name: "timeout"}, Steps: j.steps(childPath, node.Block)}
+			step.Timeout = j.timeout(childPath, node)
+			result = append(result, step)
+		case "retry":
+			step := testworkflowsv1.Step{StepMeta: testworkflowsv1.StepMeta{Name: "retry"}, Steps: j.steps(childPath, node.Block)}
+			step.Retry = j.retry(childPath, node)
+			result = append(result, step)
+		case "sleep":
+			value, _ := node.Arg("time", 0)
+			unit, _ := node.Arg("unit", -1)
+			result = append(result, testworkflowsv1.Step{
+				StepMeta:       testworkflowsv1.StepMeta{Name: "sleep"},
+				StepOperations: testworkflowsv1.StepOperations{Delay: j.duration(childPath, value.Text, unit.Text, "SECONDS")},
+			})
+		case "script":
+			j.warn(childPath, "scripted Groovy code is not supported, skipped")
+		case "deleteDir", "cleanWs":
+			// The workspace is not shared between the executions
+		default:
+			j.warn(childPath, "not supported, skipped")
+		}
+	}
+	return result
+}
+
+// credentials converts the withCredentials binding into the environment variables read from the secret
+func (j *jenkinsImporter) credentials(p string, binding jenkinsValue) []testworkflowsv1.EnvVar {
+	id, _ := findJenkinsArg(binding.Args, "credentialsId", -1)
+	switch binding.Call {
+	case "string":
+		variable, _ := findJenkinsArg(binding.Args, "variable", -1)
+		return []testworkflowsv1.EnvVar{j.secretEnv(variable.Text, id.Text)}
+	case "usernamePassword":
+		username, _ := findJenkinsArg(binding.Args, "usernameVariable", -1)
+		password, _ := findJenkinsArg(binding.Args, "passwordVariable", -1)
+		return []testworkflowsv1.EnvVar{
+			j.secretEnv(username.Text, id.Text+"-username"),
+			j.secretEnv(password.Text, id.Text+"-password"),
+		}
+	}
+	j.warn(p, "%s credentials are not supported, skipped", binding.Call)
+	return nil
+}
+
+func (j *jenkinsImporter) timeout(p string, node *jenkinsNode) string {
+	value, _ := node.Arg("time", 0)
+	unit, _ := node.Arg("unit", -1)
+	return j.duration(p, value.Text, unit.Text, "MINUTES")
+}
+
+func (j *jenkinsImporter) duration(p, value, unit, defaultUnit string) string {
+	if unit == "" {
+		unit = defaultUnit
+	}
+	if _, err := strconv.Atoi(value); err != nil {
+		j.warn(p, "dynamic duration %s is not supported, removed", value)
+		return ""
+	}
+	suffix, ok := jenkinsTimeUnits[unit]
+	if !ok {
+		j.warn(p, "time unit %s is not supported, removed", unit)
+		return ""
+	}
+	return value + suffix
+}
+
+func (j *jenkinsImporter) retry(p string, node *jenkinsNode) *testworkflowsv1.RetryPolicy {
+	value, _ := node.Arg("count", 0)
+	count, err := strconv.Atoi(value.Text)
+	if err != nil {
+		j.warn(p, "dynamic retry count %s is not supported, removed", value.Text)
+		return nil
+	}
+	// Jenkins counts the first attempt too
+	if count <= 1 {
+		return nil
+	}
+	return &testworkflowsv1.RetryPolicy{Count: int32(count - 1)}
+}
+
+// script converts the shell script, reporting the Jenkins variables that are not available
+func (j *jenkinsImporter) script(p string, value jenkinsValue) string {
+	text := j.text(p, value)
+	for _, match := range jenkinsPredefinedRe.FindAllStringSubmatch(text, -1) {
+		if _, ok := jenkinsProvidedEnv[match[1]]; ok {
+			j.provided[match[1]] = true
+		} else {
+			j.warn(p, "predefined variable %s is not available", match[1])
+		}
+	}
+	return text
+}
+
+// text resolves the Groovy string, converting the interpolation into the shell variables and the expressions
+func (j *jenkinsImporter) text(p string, value jenkinsValue) string {
+	if value.Call != "" {
+		j.warn(p, "function call %s() is not supported, removed", value.Call)
+		return ""
+	}
+	if !value.Interpolated {
+		return escapeText(value.Text)
+	}
+	return replaceExpressions(value.Text, jenkinsInterpolationRe, func(m []string) string {
+		if m[1] != "" {
+			switch m[1] {
+			case "n":
+				return "\n"
+			case "t":
+				return "\t"
+			case "{":
+				return `{{"{"}}`
+			}
+			return m[1]
+		}
+		expr := strings.TrimSpace(m[2] + m[3])
+		switch {
+		case strings.HasPrefix(expr, "env.") && jenkinsIdentifierRe.MatchString(expr[4:]):
+			return "${" + expr[4:] + "}"
+		case strings.HasPrefix(expr, "params.") && jenkinsIdentifierRe.MatchString(expr[7:]):
+			return "{{ config." + expr[7:] + " }}"
+		case jenkinsIdentifierRe.MatchString(expr):
+			return "${" + expr + "}"
+		}
+		j.warn(p, "Groovy expression ${%s} is not supported, removed", expr)
+		return ""
+	})
+}
+
+func splitJenkinsPatterns(value string) []string {
+	var result []string
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			result = append(result, pattern)
+		}
+	}
+	return result
+}
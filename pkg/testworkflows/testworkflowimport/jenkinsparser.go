@@ -0,0 +1,414 @@
+package testworkflowimport
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The declarative Jenkinsfile is parsed into the tree of the statements,
+// that is sufficient to read the pipeline structure. The Groovy code is not evaluated.
+
+type jenkinsTokenKind int
+
+const (
+	jenkinsTokenEOF jenkinsTokenKind = iota
+	jenkinsTokenWord
+	jenkinsTokenString
+	jenkinsTokenPunct
+	jenkinsTokenNewline
+)
+
+type jenkinsToken struct {
+	kind  jenkinsTokenKind
+	value string
+	// interpolated marks the double-quoted GString, where the escape sequences are kept to process the interpolation
+	interpolated bool
+	line         int
+}
+
+// jenkinsNode is the statement with its arguments and the optional block, i.e. `stage('Build') { ... }`
+type jenkinsNode struct {
+	Name  string
+	Args  []jenkinsArg
+	Block []*jenkinsNode
+	Line  int
+}
+
+type jenkinsArg struct {
+	Key   string
+	Value jenkinsValue
+}
+
+type jenkinsValue struct {
+	Text         string
+	Interpolated bool
+	// Call is the function name, when the value is the function call, i.e. credentials('id')
+	Call string
+	Args []jenkinsArg
+	List []jenkinsValue
+}
+
+// Arg returns the named argument, or the positional argument at the index when the name is not found
+func (n *jenkinsNode) Arg(key string, index int) (jenkinsValue, bool) {
+	return findJenkinsArg(n.Args, key, index)
+}
+
+func (n *jenkinsNode) Children(name string) []*jenkinsNode {
+	var result []*jenkinsNode
+	for _, child := range n.Block {
+		if child.Name == name {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+func findJenkinsArg(args []jenkinsArg, key string, index int) (jenkinsValue, bool) {
+	for _, arg := range args {
+		if arg.Key == key && key != "" {
+			return arg.Value, true
+		}
+	}
+	position := 0
+	for _, arg := range args {
+		if arg.Key != "" {
+			continue
+		}
+		if position == index {
+			return arg.Value, true
+		}
+		position++
+	}
+	return jenkinsValue{}, false
+}
+
+func tokenizeJenkinsfile(content string) ([]jenkinsToken, error) {
+	var tokens []jenkinsToken
+	line := 1
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == '\n':
+			tokens = append(tokens, jenkinsToken{kind: jenkinsTokenNewline, line: line})
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(content[i:], "//"):
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(content[i:], "/*"):
+			end := strings.Index(content[i+2:], "*/")
+			if end == -1 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(content[i:i+2+end], "\n")
+			i += end + 4
+		case c == '\'' || c == '"':
+			quote := content[i : i+1]
+			if strings.HasPrefix(content[i:], strings.Repeat(quote, 3)) {
+				quote = strings.Repeat(quote, 3)
+			}
+			start := i + len(quote)
+			end := start
+			for ; end < len(content); end++ {
+				if content[end] == '\\' {
+					end++
+					continue
+				}
+				if strings.HasPrefix(content[end:], quote) {
+					break
+				}
+				if len(quote) == 1 && content[end] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated string", line)
+				}
+			}
+			if end >= len(content) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			value := content[start:end]
+			token := jenkinsToken{kind: jenkinsTokenString, line: line, interpolated: c == '"'}
+			if token.interpolated {
+				token.value = value
+			} else {
+				token.value = strings.NewReplacer(`\\`, `\`, `\'`, `'`, `\n`, "\n", `\t`, "\t", `\$`, `$`).Replace(value)
+			}
+			if len(quote) == 3 {
+				token.value = dedent(strings.TrimPrefix(token.value, "\\\n"))
+			}
+			tokens = append(tokens, token)
+			line += strings.Count(value, "\n")
+			i = end + len(quote)
+		case isJenkinsWordChar(c):
+			start := i
+			for i < len(content) && isJenkinsWordChar(content[i]) {
+				i++
+			}
+			tokens = append(tokens, jenkinsToken{kind: jenkinsTokenWord, value: content[start:i], line: line})
+		default:
+			tokens = append(tokens, jenkinsToken{kind: jenkinsTokenPunct, value: string(c), line: line})
+			i++
+		}
+	}
+	return append(tokens, jenkinsToken{kind: jenkinsTokenEOF, line: line}), nil
+}
+
+func isJenkinsWordChar(c byte) bool {
+	return c == '_' || c == '.' || c == '$' || c == '@' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+type jenkinsParser struct {
+	tokens []jenkinsToken
+	pos    int
+}
+
+func parseJenkinsfile(content string) ([]*jenkinsNode, error) {
+	tokens, err := tokenizeJenkinsfile(content)
+	if err != nil {
+		return nil, err
+	}
+	p := &jenkinsParser{tokens: tokens}
+	nodes, err := p.block()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != jenkinsTokenEOF {
+		return nil, fmt.Errorf("line %d: unexpected '%s'", p.peek().line, p.peek().value)
+	}
+	return nodes, nil
+}
+
+func (p *jenkinsParser) peek() jenkinsToken {
+	return p.tokens[p.pos]
+}
+
+func (p *jenkinsParser) next() jenkinsToken {
+	t := p.tokens[p.pos]
+	if t.kind != jenkinsTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *jenkinsParser) isPunct(value string) bool {
+	t := p.peek()
+	return t.kind == jenkinsTokenPunct && t.value == value
+}
+
+func (p *jenkinsParser) skipNewlines() {
+	for p.peek().kind == jenkinsTokenNewline || p.isPunct(";") {
+		p.next()
+	}
+}
+
+// block reads the statements until the closing brace or the end of file
+func (p *jenkinsParser) block() ([]*jenkinsNode, error) {
+	var nodes []*jenkinsNode
+	for {
+		p.skipNewlines()
+		if p.peek().kind == jenkinsTokenEOF || p.isPunct("}") {
+			return nodes, nil
+		}
+		node, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+}
+
+func (p *jenkinsParser) statement() (*jenkinsNode, error) {
+	start := p.next()
+	node := &jenkinsNode{Name: start.value, Line: start.line}
+
+	switch {
+	case p.isPunct("("):
+		p.next()
+		tokens, err := p.until(")")
+		if err != nil {
+			return nil, err
+		}
+		node.Args = parseJenkinsArgs(tokens)
+		// Ignore the rest of the statement, i.e. `@Library('name') _`
+		if !p.isPunct("{") {
+			if _, err = p.until(""); err != nil {
+				return nil, err
+			}
+		}
+	case p.isPunct("="):
+		p.next()
+		tokens, err := p.until("")
+		if err != nil {
+			return nil, err
+		}
+		value := parseJenkinsArgs(tokens)
+		if len(value) > 0 {
+			node.Args = []jenkinsArg{{Key: "=", Value: value[0].Value}}
+		}
+	case p.isPunct("{"):
+	default:
+		tokens, err := p.until("")
+		if err != nil {
+			return nil, err
+		}
+		node.Args = parseJenkinsArgs(tokens)
+	}
+
+	// Detect the block, that may start in the next line too
+	save := p.pos
+	for p.peek().kind == jenkinsTokenNewline {
+		p.next()
+	}
+	if !p.isPunct("{") {
+		p.pos = save
+		return node, nil
+	}
+	p.next()
+	block, err := p.block()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isPunct("}") {
+		return nil, fmt.Errorf("line %d: missing closing brace for '%s'", start.line, start.value)
+	}
+	p.next()
+	node.Block = block
+	if node.Block == nil {
+		node.Block = []*jenkinsNode{}
+	}
+	return node, nil
+}
+
+// until collects the tokens until the closing punctuation, or the end of statement when it's empty
+func (p *jenkinsParser) until(closing string) ([]jenkinsToken, error) {
+	var tokens []jenkinsToken
+	depth := 0
+	for {
+		t := p.peek()
+		if t.kind == jenkinsTokenEOF {
+			if closing != "" {
+				return nil, fmt.Errorf("line %d: missing '%s'", t.line, closing)
+			}
+			return tokens, nil
+		}
+		if depth == 0 {
+			if closing != "" && t.kind == jenkinsTokenPunct && t.value == closing {
+				p.next()
+				return tokens, nil
+			}
+			if closing == "" {
+				trailingComma := len(tokens) > 0 && tokens[len(tokens)-1].kind == jenkinsTokenPunct && tokens[len(tokens)-1].value == ","
+				if (t.kind == jenkinsTokenNewline && !trailingComma) || (t.kind == jenkinsTokenPunct && (t.value == ";" || t.value == "{" || t.value == "}")) {
+					return tokens, nil
+				}
+			}
+		}
+		p.next()
+		if t.kind == jenkinsTokenNewline {
+			continue
+		}
+		if t.kind == jenkinsTokenPunct {
+			switch t.value {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				depth--
+			}
+		}
+		tokens = append(tokens, t)
+	}
+}
+
+// parseJenkinsArgs splits the tokens by the top-level commas, and reads the named arguments
+func parseJenkinsArgs(tokens []jenkinsToken) []jenkinsArg {
+	var args []jenkinsArg
+	for _, segment := range splitJenkinsTokens(tokens, ",") {
+		if len(segment) == 0 {
+			continue
+		}
+		arg := jenkinsArg{}
+		if len(segment) > 2 && segment[0].kind == jenkinsTokenWord && segment[1].kind == jenkinsTokenPunct && segment[1].value == ":" {
+			arg.Key = segment[0].value
+			segment = segment[2:]
+		}
+		arg.Value = parseJenkinsValue(segment)
+		args = append(args, arg)
+	}
+	return args
+}
+
+func parseJenkinsValue(tokens []jenkinsToken) jenkinsValue {
+	if len(tokens) == 1 && tokens[0].kind != jenkinsTokenPunct {
+		return jenkinsValue{Text: tokens[0].value, Interpolated: tokens[0].interpolated}
+	}
+	last := tokens[len(tokens)-1]
+	if tokens[0].kind == jenkinsTokenPunct && tokens[0].value == "[" && last.kind == jenkinsTokenPunct && last.value == "]" {
+		value := jenkinsValue{}
+		for _, arg := range parseJenkinsArgs(tokens[1 : len(tokens)-1]) {
+			value.List = append(value.List, arg.Value)
+		}
+		return value
+	}
+	if len(tokens) > 2 && tokens[0].kind == jenkinsTokenWord && tokens[1].kind == jenkinsTokenPunct && tokens[1].value == "(" &&
+		last.kind == jenkinsTokenPunct && last.value == ")" {
+		return jenkinsValue{Call: tokens[0].value, Args: parseJenkinsArgs(tokens[2 : len(tokens)-1])}
+	}
+	parts := make([]string, len(tokens))
+	for i, t := range tokens {
+		parts[i] = t.value
+	}
+	return jenkinsValue{Text: strings.Join(parts, " ")}
+}
+
+func splitJenkinsTokens(tokens []jenkinsToken, separator string) [][]jenkinsToken {
+	var result [][]jenkinsToken
+	depth := 0
+	start := 0
+	for i, t := range tokens {
+		if t.kind != jenkinsTokenPunct {
+			continue
+		}
+		switch t.value {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		case separator:
+			if depth == 0 {
+				result = append(result, tokens[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(result, tokens[start:])
+}
+
+// dedent removes the common indentation and the surrounding empty lines of the multi-line string, as the Jenkins pipelines usually do
+func dedent(value string) string {
+	lines := strings.Split(value, "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		current := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent == -1 || current < indent {
+			indent = current
+		}
+	}
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		} else {
+			lines[i] = strings.TrimLeft(line, " \t")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
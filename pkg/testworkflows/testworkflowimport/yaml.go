@@ -0,0 +1,96 @@
+package testworkflowimport
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+)
+
+// stringList accepts either a single string or the list of strings
+type stringList []string
+
+func (s *stringList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*s = []string{node.Value}
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// mapping returns the key/value pairs of the YAML mapping in the file order
+func mapping(node *yaml.Node) [][2]*yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	pairs := make([][2]*yaml.Node, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs = append(pairs, [2]*yaml.Node{node.Content[i], node.Content[i+1]})
+	}
+	return pairs
+}
+
+func hasKey(node *yaml.Node, key string) bool {
+	for _, pair := range mapping(node) {
+		if pair[0].Value == key {
+			return true
+		}
+	}
+	return false
+}
+
+// documentRoot returns the top-level mapping of the YAML document
+func documentRoot(content []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		if doc.Content[0].Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("expected YAML mapping at the top level")
+		}
+		return doc.Content[0], nil
+	}
+	return nil, fmt.Errorf("empty document")
+}
+
+// warnUnsupportedKeys reports all the keys of the mapping, that are not handled by the importer (except the YAML merge keys)
+func (b *builder) warnUnsupportedKeys(path string, node *yaml.Node, supported ...string) {
+	for _, pair := range mapping(node) {
+		if pair[0].Value != "<<" && !slices.Contains(supported, pair[0].Value) {
+			b.warn(joinPath(path, pair[0].Value), "not supported, skipped")
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// envList converts the map into the environment variables list, sorted by name for the stable output
+func envList(vars map[string]string, convert func(name, value string) testworkflowsv1.EnvVar) []testworkflowsv1.EnvVar {
+	if len(vars) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]testworkflowsv1.EnvVar, len(names))
+	for i, name := range names {
+		result[i] = convert(name, vars[name])
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+package testworkflowlint
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// IgnoreAnnotationName is the annotation with comma-separated list of rule IDs or names to suppress for the resource
+	IgnoreAnnotationName = "testkube.io/lint-ignore"
+
+	ignoreAll = "*"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+var severityOrder = []Severity{SeverityInfo, SeverityWarning, SeverityError}
+
+// AtLeast determines if the severity is same or higher than the other one
+func (s Severity) AtLeast(other Severity) bool {
+	return slices.Index(severityOrder, s) >= slices.Index(severityOrder, other)
+}
+
+// Rule is a single static analysis check
+type Rule struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Severity    Severity `json:"severity"`
+	Description string   `json:"description"`
+	Hint        string   `json:"hint"`
+
+	check func(r *Resource) []Issue
+}
+
+// Issue is a problem detected by the rule at specific location in the resource
+type Issue struct {
+	Path    string
+	Message string
+}
+
+// Finding is the issue reported for the resource
+type Finding struct {
+	RuleID   string   `json:"ruleId"`
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Kind     string   `json:"kind"`
+	Name     string   `json:"name"`
+	File     string   `json:"file,omitempty"`
+	Path     string   `json:"path,omitempty"`
+	Message  string   `json:"message"`
+	Hint     string   `json:"hint,omitempty"`
+}
+
+type Options struct {
+	// IDs or names of the rules to skip
+	Disabled []string
+}
+
+// Lint runs all the rules from the catalog against the resources
+func Lint(resources []Resource, opts Options) []Finding {
+	findings := make([]Finding, 0)
+	for i := range resources {
+		resource := &resources[i]
+		ignored := append(slices.Clone(opts.Disabled), parseIgnored(resource.Annotations[IgnoreAnnotationName])...)
+		for _, rule := range Rules {
+			if matchesRule(rule, ignored) {
+				continue
+			}
+			for _, issue := range rule.check(resource) {
+				findings = append(findings, Finding{
+					RuleID:   rule.ID,
+					Rule:     rule.Name,
+					Severity: rule.Severity,
+					Kind:     resource.Kind,
+					Name:     resource.Name,
+					File:     resource.File,
+					Path:     issue.Path,
+					Message:  issue.Message,
+					Hint:     rule.Hint,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// HasSeverity determines if any of the findings is at least at the provided severity
+func HasSeverity(findings []Finding, severity Severity) bool {
+	for i := range findings {
+		if findings[i].Severity.AtLeast(severity) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseIgnored(annotation string) []string {
+	result := make([]string, 0)
+	for _, v := range strings.Split(annotation, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func matchesRule(rule Rule, names []string) bool {
+	for _, name := range names {
+		if name == ignoreAll || strings.EqualFold(name, rule.ID) || name == rule.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRule finds the rule in the catalog by its ID
+func GetRule(id string) (Rule, bool) {
+	for _, rule := range Rules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
@@ -0,0 +1,90 @@
+package testworkflowlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func summarize(findings []Finding) []string {
+	result := make([]string, len(findings))
+	for i, f := range findings {
+		result[i] = fmt.Sprintf("%s %s/%s %s", f.RuleID, f.Kind, f.Name, f.Path)
+	}
+	return result
+}
+
+func TestLint(t *testing.T) {
+	resources, err := Load("testdata")
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+
+	findings := Lint(resources, Options{})
+
+	assert.ElementsMatch(t, []string{
+		"TW001 TestWorkflow/insecure spec.steps[0].run.image",
+		"TW002 TestWorkflow/insecure spec.steps[0]",
+		"TW003 TestWorkflow/insecure spec.steps[1].run",
+		"TW004 TestWorkflow/insecure spec.steps[0].run.env[0]",
+		"TW005 TestWorkflow/insecure spec.config.unused",
+		"TW006 TestWorkflow/insecure spec.steps[0].run.args[3]",
+		"TW006 TestWorkflow/insecure spec.steps[0].run.args[4]",
+		"TW007 TestWorkflow/insecure spec.steps[2].parallel",
+	}, summarize(findings))
+	assert.Equal(t, filepath.Join("testdata", "workflows.yaml"), findings[0].File)
+	assert.True(t, HasSeverity(findings, SeverityError))
+}
+
+func TestLint_Disabled(t *testing.T) {
+	resources, err := Load("testdata")
+	require.NoError(t, err)
+
+	findings := Lint(resources, Options{Disabled: []string{"TW001", "resource-limits", "TW003", "TW004", "TW005", "TW006"}})
+
+	assert.Equal(t, []string{"TW007 TestWorkflow/insecure spec.steps[2].parallel"}, summarize(findings))
+	assert.False(t, HasSeverity(findings, SeverityWarning))
+	assert.True(t, HasSeverity(findings, SeverityInfo))
+}
+
+func TestLint_IgnoreAll(t *testing.T) {
+	resources, err := Parse("", []byte(`kind: TestWorkflow
+metadata:
+  name: a
+  annotations:
+    testkube.io/lint-ignore: "*"
+spec:
+  steps:
+  - shell: echo {{unknown}}
+`))
+	require.NoError(t, err)
+
+	assert.Empty(t, Lint(resources, Options{}))
+}
+
+func TestToSARIF(t *testing.T) {
+	findings := []Finding{{
+		RuleID:   "TW004",
+		Rule:     "plain-secret-env",
+		Severity: SeverityError,
+		Kind:     "TestWorkflow",
+		Name:     "insecure",
+		File:     "testdata/workflows.yaml",
+		Path:     "spec.container.env[0]",
+		Message:  "environment variable TOKEN has the sensitive value in plain text",
+	}}
+
+	report := ToSARIF(findings, "1.0.0")
+	b, err := json.Marshal(report)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.1.0", report.Version)
+	assert.Len(t, report.Runs[0].Tool.Driver.Rules, len(Rules))
+	assert.Equal(t, 3, report.Runs[0].Results[0].RuleIndex)
+	assert.Equal(t, "error", report.Runs[0].Results[0].Level)
+	assert.Contains(t, string(b), `"uri":"testdata/workflows.yaml"`)
+	assert.Contains(t, string(b), `"fullyQualifiedName":"TestWorkflow/insecure/spec.container.env[0]"`)
+}
@@ -0,0 +1,201 @@
+package testworkflowlint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+// Resource is the Test Workflow or Test Workflow Template to analyze
+type Resource struct {
+	Kind        string
+	Name        string
+	File        string
+	Annotations map[string]string
+	Spec        testworkflowsv1.TestWorkflowSpec
+}
+
+func NewWorkflowResource(workflow *testworkflowsv1.TestWorkflow, file string) Resource {
+	return Resource{
+		Kind:        "TestWorkflow",
+		Name:        workflow.Name,
+		File:        file,
+		Annotations: workflow.Annotations,
+		Spec:        workflow.Spec,
+	}
+}
+
+func NewTemplateResource(template *testworkflowsv1.TestWorkflowTemplate, file string) Resource {
+	return Resource{
+		Kind:        "TestWorkflowTemplate",
+		Name:        template.Name,
+		File:        file,
+		Annotations: template.Annotations,
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: template.Spec.TestWorkflowSpecBase,
+			Services:             common.MapMap(template.Spec.Services, testworkflowresolver.ConvertIndependentServiceToService),
+			Setup:                common.MapSlice(template.Spec.Setup, testworkflowresolver.ConvertIndependentStepToStep),
+			Steps:                common.MapSlice(template.Spec.Steps, testworkflowresolver.ConvertIndependentStepToStep),
+			After:                common.MapSlice(template.Spec.After, testworkflowresolver.ConvertIndependentStepToStep),
+			Pvcs:                 template.Spec.Pvcs,
+		},
+	}
+}
+
+// Load reads the Test Workflows and Test Workflow Templates from the YAML files, recursively for directories
+func Load(paths ...string) ([]Resource, error) {
+	resources := make([]Resource, 0)
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != root && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			ext := filepath.Ext(path)
+			if ext != ".yaml" && ext != ".yml" {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			v, err := Parse(path, content)
+			if err != nil {
+				return errors.Wrap(err, path)
+			}
+			resources = append(resources, v...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+// Parse reads the Test Workflows and Test Workflow Templates from the YAML content, ignoring other documents
+func Parse(file string, content []byte) ([]Resource, error) {
+	resources := make([]Resource, 0)
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBuffer(content), len(content))
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		var meta metav1.TypeMeta
+		if err = json.Unmarshal(raw, &meta); err != nil {
+			return nil, err
+		}
+		switch meta.Kind {
+		case "TestWorkflow":
+			var workflow testworkflowsv1.TestWorkflow
+			if err = json.Unmarshal(raw, &workflow); err != nil {
+				return nil, fmt.Errorf("invalid TestWorkflow: %w", err)
+			}
+			resources = append(resources, NewWorkflowResource(&workflow, file))
+		case "TestWorkflowTemplate":
+			var template testworkflowsv1.TestWorkflowTemplate
+			if err = json.Unmarshal(raw, &template); err != nil {
+				return nil, fmt.Errorf("invalid TestWorkflowTemplate: %w", err)
+			}
+			resources = append(resources, NewTemplateResource(&template, file))
+		}
+	}
+}
+
+// stepVisitor is called for each step, with information if the resource limits are inherited from the parents
+type stepVisitor func(path string, step *testworkflowsv1.Step, limited bool)
+
+func hasLimits(container *testworkflowsv1.ContainerConfig) bool {
+	return container != nil && container.Resources != nil && len(container.Resources.Limits) > 0
+}
+
+func walkSteps(path string, steps []testworkflowsv1.Step, limited bool, fn stepVisitor) {
+	for i := range steps {
+		walkStep(fmt.Sprintf("%s[%d]", path, i), &steps[i], limited, fn)
+	}
+}
+
+func walkStep(path string, step *testworkflowsv1.Step, limited bool, fn stepVisitor) {
+	limited = limited || hasLimits(step.Container)
+	fn(path, step, limited)
+	walkSteps(path+".setup", step.Setup, limited, fn)
+	walkSteps(path+".steps", step.Steps, limited, fn)
+	if step.Parallel != nil {
+		walkSpecSteps(path+".parallel", step.Parallel.NewTestWorkflowSpec(), false, fn)
+	}
+}
+
+func walkSpecSteps(path string, spec *testworkflowsv1.TestWorkflowSpec, limited bool, fn stepVisitor) {
+	limited = limited || hasLimits(spec.Container)
+	walkSteps(path+".setup", spec.Setup, limited, fn)
+	walkSteps(path+".steps", spec.Steps, limited, fn)
+	walkSteps(path+".after", spec.After, limited, fn)
+}
+
+// containerVisitor is called for each container configuration in the resource
+type containerVisitor func(path string, container *testworkflowsv1.ContainerConfig)
+
+func walkContainers(r *Resource, fn containerVisitor) {
+	walkSpecContainers("spec", &r.Spec, fn)
+}
+
+func walkSpecContainers(path string, spec *testworkflowsv1.TestWorkflowSpec, fn containerVisitor) {
+	if spec.Container != nil {
+		fn(path+".container", spec.Container)
+	}
+	walkServiceContainers(path+".services", spec.Services, fn)
+	walkSpecSteps(path, spec, false, func(path string, step *testworkflowsv1.Step, _ bool) {
+		if step.Container != nil {
+			fn(path+".container", step.Container)
+		}
+		if step.Run != nil {
+			fn(path+".run", &step.Run.ContainerConfig)
+		}
+		walkServiceContainers(path+".services", step.Services, fn)
+		if step.Parallel != nil {
+			if step.Parallel.Container != nil {
+				fn(path+".parallel.container", step.Parallel.Container)
+			}
+			if step.Parallel.Run != nil {
+				fn(path+".parallel.run", &step.Parallel.Run.ContainerConfig)
+			}
+			walkServiceContainers(path+".parallel.services", step.Parallel.Services, fn)
+		}
+	})
+}
+
+func walkServiceContainers(path string, services map[string]testworkflowsv1.ServiceSpec, fn containerVisitor) {
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		svc := services[name]
+		fn(fmt.Sprintf("%s.%s", path, name), &svc.ContainerConfig)
+	}
+}
@@ -0,0 +1,270 @@
+package testworkflowlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/expressions"
+)
+
+// Rules is the catalog of the available lint rules
+var Rules = []Rule{
+	{
+		ID:          "TW001",
+		Name:        "image-digest",
+		Severity:    SeverityWarning,
+		Description: "Image is not pinned to the digest, so its contents may change between the executions",
+		Hint:        "Reference the image by digest, e.g. image:tag@sha256:<digest>",
+		check:       checkImageDigest,
+	},
+	{
+		ID:          "TW002",
+		Name:        "resource-limits",
+		Severity:    SeverityWarning,
+		Description: "Container has no resource limits, so it may exhaust the node resources",
+		Hint:        "Set container.resources.limits for the step, or globally in spec.container",
+		check:       checkResourceLimits,
+	},
+	{
+		ID:          "TW003",
+		Name:        "shell-errexit",
+		Severity:    SeverityWarning,
+		Description: "Multi-line script passed to the shell command continues after the failed command",
+		Hint:        "Use the shell property, that runs with 'set -e', or start the script with 'set -e'",
+		check:       checkShellErrexit,
+	},
+	{
+		ID:          "TW004",
+		Name:        "plain-secret-env",
+		Severity:    SeverityError,
+		Description: "Sensitive value is passed as a plain environment variable",
+		Hint:        "Use valueFrom.secretKeyRef, or the sensitive config parameter",
+		check:       checkPlainSecretEnv,
+	},
+	{
+		ID:          "TW005",
+		Name:        "unused-config",
+		Severity:    SeverityWarning,
+		Description: "Config parameter is declared, but never used",
+		Hint:        "Remove the parameter, or reference it with {{config.<name>}}",
+		check:       checkUnusedConfig,
+	},
+	{
+		ID:          "TW006",
+		Name:        "undefined-variable",
+		Severity:    SeverityError,
+		Description: "Expression references the variable that is not defined",
+		Hint:        "Declare the config parameter, or fix the variable name",
+		check:       checkUndefinedVariable,
+	},
+	{
+		ID:          "TW007",
+		Name:        "parallel-fail-fast",
+		Severity:    SeverityInfo,
+		Description: "Parallel step spawning multiple workers keeps running all of them after the first failure",
+		Hint:        "Set parallel.failFast: true to abort the remaining workers on the first failure",
+		check:       checkParallelFailFast,
+	},
+}
+
+var (
+	secretEnvNameRe = regexp.MustCompile(`(?i)(password|passwd|secret|token|api_?key|credential|private_?key|access_?key)`)
+	errexitRe       = regexp.MustCompile(`(^|[\s;])set\s+(-[a-zA-Z]*e|-o\s+errexit)`)
+
+	shells = []string{"sh", "bash", "ash", "dash", "zsh", "ksh"}
+
+	// knownVariables are the top-level variables provided during the execution
+	knownVariables = []string{
+		"always", "never", "passed", "failed", "success", "error", "status", "self", "parent",
+		"step", "steps", "services", "retry", "output", "outputs",
+		"env", "secrets", "config", "workflow", "execution", "resource", "organization", "environment",
+		"dashboard", "internal", "labels", "pvcs",
+		"matrix", "shard", "index", "count", "_",
+		"file", "artifacts",
+	}
+
+	// expressionKeys are the properties holding the expressions instead of the templates
+	expressionKeys = []string{"condition", "until", "logs", "count", "maxCount"}
+)
+
+func checkImageDigest(r *Resource) (issues []Issue) {
+	walkContainers(r, func(path string, container *testworkflowsv1.ContainerConfig) {
+		if container.Image != "" && !strings.Contains(container.Image, "@sha256:") && !strings.Contains(container.Image, "{{") {
+			issues = append(issues, Issue{Path: path + ".image", Message: fmt.Sprintf("image %s is not pinned to the digest", container.Image)})
+		}
+	})
+	return issues
+}
+
+func checkResourceLimits(r *Resource) (issues []Issue) {
+	walkSpecSteps("spec", &r.Spec, false, func(path string, step *testworkflowsv1.Step, limited bool) {
+		if step.Run == nil && step.Shell == "" {
+			return
+		}
+		if limited || (step.Run != nil && hasLimits(&step.Run.ContainerConfig)) {
+			return
+		}
+		issues = append(issues, Issue{Path: path, Message: fmt.Sprintf("step %s has no resource limits", stepName(path, step))})
+	})
+	return issues
+}
+
+func checkShellErrexit(r *Resource) (issues []Issue) {
+	walkContainers(r, func(path string, container *testworkflowsv1.ContainerConfig) {
+		cmd := make([]string, 0)
+		if container.Command != nil {
+			cmd = append(cmd, *container.Command...)
+		}
+		if container.Args != nil {
+			cmd = append(cmd, *container.Args...)
+		}
+		if len(cmd) < 3 || !slices.Contains(shells, filepath.Base(cmd[0])) {
+			return
+		}
+		i := slices.Index(cmd, "-c")
+		if i == -1 || i+1 >= len(cmd) {
+			return
+		}
+		script := strings.TrimSpace(cmd[i+1])
+		if strings.Contains(script, "\n") && !errexitRe.MatchString(script) {
+			issues = append(issues, Issue{Path: path, Message: "script is not stopped on the first failed command"})
+		}
+	})
+	return issues
+}
+
+func checkPlainSecretEnv(r *Resource) (issues []Issue) {
+	walkContainers(r, func(path string, container *testworkflowsv1.ContainerConfig) {
+		for i, env := range container.Env {
+			if env.ValueFrom != nil || env.Value == "" || strings.Contains(env.Value, "{{") {
+				continue
+			}
+			if secretEnvNameRe.MatchString(env.Name) {
+				issues = append(issues, Issue{
+					Path:    fmt.Sprintf("%s.env[%d]", path, i),
+					Message: fmt.Sprintf("environment variable %s has the sensitive value in plain text", env.Name),
+				})
+			}
+		}
+	})
+	return issues
+}
+
+func checkUnusedConfig(r *Resource) (issues []Issue) {
+	used := make(map[string]struct{})
+	for _, ref := range collectVariables(r) {
+		if name, ok := strings.CutPrefix(ref.Name, "config."); ok {
+			used[strings.Split(name, ".")[0]] = struct{}{}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(r.Spec.Config)) {
+		if _, ok := used[name]; !ok {
+			issues = append(issues, Issue{Path: "spec.config." + name, Message: fmt.Sprintf("config parameter %s is not used", name)})
+		}
+	}
+	return issues
+}
+
+func checkUndefinedVariable(r *Resource) (issues []Issue) {
+	declared := declaredConfig(r)
+	for _, ref := range collectVariables(r) {
+		root, rest, _ := strings.Cut(ref.Name, ".")
+		if !slices.Contains(knownVariables, root) {
+			issues = append(issues, Issue{Path: ref.Path, Message: fmt.Sprintf("variable %s is not defined", ref.Name)})
+		} else if root == "config" && rest != "" {
+			if _, ok := declared[strings.Split(rest, ".")[0]]; !ok {
+				issues = append(issues, Issue{Path: ref.Path, Message: fmt.Sprintf("config parameter %s is not declared", rest)})
+			}
+		}
+	}
+	return issues
+}
+
+func checkParallelFailFast(r *Resource) (issues []Issue) {
+	walkSpecSteps("spec", &r.Spec, false, func(path string, step *testworkflowsv1.Step, _ bool) {
+		if step.Parallel == nil || step.Parallel.FailFast {
+			return
+		}
+		strategy := step.Parallel.StepExecuteStrategy
+		if len(strategy.Matrix) == 0 && len(strategy.Shards) == 0 && strategy.Count == nil && strategy.MaxCount == nil {
+			return
+		}
+		issues = append(issues, Issue{Path: path + ".parallel", Message: fmt.Sprintf("parallel step %s has no failFast enabled", stepName(path, step))})
+	})
+	return issues
+}
+
+func stepName(path string, step *testworkflowsv1.Step) string {
+	if step.Name != "" {
+		return fmt.Sprintf("%q", step.Name)
+	}
+	return path
+}
+
+func declaredConfig(r *Resource) map[string]struct{} {
+	declared := make(map[string]struct{})
+	for name := range r.Spec.Config {
+		declared[name] = struct{}{}
+	}
+	walkSpecSteps("spec", &r.Spec, false, func(_ string, step *testworkflowsv1.Step, _ bool) {
+		if step.Parallel != nil {
+			for name := range step.Parallel.Config {
+				declared[name] = struct{}{}
+			}
+		}
+	})
+	return declared
+}
+
+type variableRef struct {
+	Path string
+	Name string
+}
+
+// collectVariables finds all the variables referenced in the expressions and templates of the resource
+func collectVariables(r *Resource) []variableRef {
+	b, err := json.Marshal(r.Spec)
+	if err != nil {
+		return nil
+	}
+	var spec interface{}
+	if err = json.Unmarshal(b, &spec); err != nil {
+		return nil
+	}
+	refs := make([]variableRef, 0)
+	collectValueVariables("spec", "", spec, &refs)
+	return refs
+}
+
+func collectValueVariables(path, key string, value interface{}, refs *[]variableRef) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			collectValueVariables(path+"."+k, k, v[k], refs)
+		}
+	case []interface{}:
+		for i := range v {
+			collectValueVariables(fmt.Sprintf("%s[%d]", path, i), key, v[i], refs)
+		}
+	case string:
+		var expr expressions.Expression
+		var err error
+		if slices.Contains(expressionKeys, key) {
+			expr, err = expressions.Compile(v)
+		} else {
+			expr, err = expressions.CompileTemplate(v)
+		}
+		if err != nil {
+			return
+		}
+		for _, name := range slices.Sorted(maps.Keys(expr.Accessors())) {
+			*refs = append(*refs, variableRef{Path: path, Name: name})
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package testworkflowlogs
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/instructions"
+)
+
+const (
+	// IndexVersion is the version of the chunks index format
+	IndexVersion = 1
+	// IndexFileName is the name of the file with the chunks index, stored next to the chunks
+	IndexFileName = "index.json"
+	// DefaultChunkSize is the maximum size of the uncompressed log stored in a single chunk
+	DefaultChunkSize = 4 * 1024 * 1024
+)
+
+// Chunk describes a single compressed part of the execution log.
+// The chunk never spans across the steps, so it belongs to a single step.
+type Chunk struct {
+	Index int    `json:"index"`
+	Step  string `json:"step,omitempty"`
+	// Offset is the position of the chunk in the whole uncompressed log
+	Offset         int64 `json:"offset"`
+	Size           int64 `json:"size"`
+	CompressedSize int64 `json:"compressedSize"`
+	// FirstLine is the 1-based number of the first line in the whole log
+	FirstLine int       `json:"firstLine"`
+	Lines     int       `json:"lines"`
+	Start     time.Time `json:"start,omitempty"`
+	End       time.Time `json:"end,omitempty"`
+}
+
+// Name is the file name of the chunk in the storage
+func (c Chunk) Name() string {
+	return fmt.Sprintf("%06d.log.gz", c.Index)
+}
+
+// Index describes all the chunks of the execution log
+type Index struct {
+	Version int     `json:"version"`
+	Size    int64   `json:"size"`
+	Lines   int     `json:"lines"`
+	Chunks  []Chunk `json:"chunks"`
+}
+
+// Split reads the raw execution log and passes the compressed chunks to the save function,
+// starting the new chunk for each step, and whenever the chunk exceeds the chunk size.
+func Split(r io.Reader, chunkSize int, save func(chunk Chunk, data []byte) error) (Index, error) {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	index := Index{Version: IndexVersion, Chunks: make([]Chunk, 0)}
+	buffer := &bytes.Buffer{}
+	current := Chunk{FirstLine: 1}
+
+	flush := func() error {
+		if buffer.Len() == 0 {
+			return nil
+		}
+		compressed := &bytes.Buffer{}
+		writer := gzip.NewWriter(compressed)
+		if _, err := writer.Write(buffer.Bytes()); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+		current.Size = int64(buffer.Len())
+		current.CompressedSize = int64(compressed.Len())
+		if err := save(current, compressed.Bytes()); err != nil {
+			return err
+		}
+		index.Chunks = append(index.Chunks, current)
+		current = Chunk{
+			Index:     current.Index + 1,
+			Step:      current.Step,
+			Offset:    current.Offset + current.Size,
+			FirstLine: current.FirstLine + current.Lines,
+		}
+		buffer.Reset()
+		return nil
+	}
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			ts, text := SplitTimestamp(strings.TrimRight(line, "\r\n"))
+			if start := instructions.StartHintRe.FindStringSubmatch(text); len(start) > 0 {
+				if flushErr := flush(); flushErr != nil {
+					return index, flushErr
+				}
+				current.Step = start[1]
+			} else if buffer.Len() > 0 && buffer.Len()+len(line) > chunkSize {
+				if flushErr := flush(); flushErr != nil {
+					return index, flushErr
+				}
+			}
+			buffer.WriteString(line)
+			current.Lines++
+			if !ts.IsZero() {
+				if current.Start.IsZero() {
+					current.Start = ts
+				}
+				current.End = ts
+			}
+			index.Size += int64(len(line))
+			index.Lines++
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return index, err
+		}
+	}
+	if err := flush(); err != nil {
+		return index, err
+	}
+	return index, nil
+}
+
+// SplitTimestamp extracts the RFC3339 timestamp prefixing the log line
+func SplitTimestamp(line string) (time.Time, string) {
+	if len(line) < 20 || line[10] != 'T' {
+		return time.Time{}, line
+	}
+	space := strings.IndexByte(line, ' ')
+	if space < 0 {
+		space = len(line)
+	}
+	ts, err := time.Parse(time.RFC3339Nano, line[:space])
+	if err != nil {
+		return time.Time{}, line
+	}
+	if space == len(line) {
+		return ts, ""
+	}
+	return ts, line[space+1:]
+}
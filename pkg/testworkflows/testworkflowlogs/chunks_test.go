@@ -0,0 +1,95 @@
+package testworkflowlogs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/instructions"
+)
+
+var baseTs = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func line(seconds int, text string) string {
+	return fmt.Sprintf("%s %s\n", baseTs.Add(time.Duration(seconds)*time.Second).Format(time.RFC3339Nano), text)
+}
+
+func hint(seconds int, ref string) string {
+	return line(seconds, strings.Trim(instructions.SprintHint(ref, "start"), "\n"))
+}
+
+func sampleLog() string {
+	return line(0, "initializing") +
+		hint(1, "step1") +
+		line(2, "first 1") +
+		line(3, "first 2") +
+		hint(4, "step2") +
+		line(5, "second 1") +
+		line(6, "second 2") +
+		line(7, "second 3")
+}
+
+func split(t *testing.T, content string, chunkSize int) (Index, map[int][]byte) {
+	chunks := map[int][]byte{}
+	index, err := Split(strings.NewReader(content), chunkSize, func(chunk Chunk, data []byte) error {
+		chunks[chunk.Index] = data
+		return nil
+	})
+	require.NoError(t, err)
+	return index, chunks
+}
+
+func TestSplit_ByStep(t *testing.T) {
+	content := sampleLog()
+	index, chunks := split(t, content, 0)
+
+	require.Len(t, index.Chunks, 3)
+	assert.Equal(t, int64(len(content)), index.Size)
+	assert.Equal(t, 8, index.Lines)
+	assert.Equal(t, []string{"", "step1", "step2"}, []string{index.Chunks[0].Step, index.Chunks[1].Step, index.Chunks[2].Step})
+	assert.Equal(t, []int{1, 2, 5}, []int{index.Chunks[0].FirstLine, index.Chunks[1].FirstLine, index.Chunks[2].FirstLine})
+	assert.Equal(t, baseTs.Add(4*time.Second), index.Chunks[2].Start)
+	assert.Equal(t, baseTs.Add(7*time.Second), index.Chunks[2].End)
+
+	var whole bytes.Buffer
+	for i, chunk := range index.Chunks {
+		assert.Equal(t, int64(whole.Len()), chunk.Offset)
+		reader, err := gzip.NewReader(bytes.NewReader(chunks[i]))
+		require.NoError(t, err)
+		n, err := io.Copy(&whole, reader)
+		require.NoError(t, err)
+		assert.Equal(t, chunk.Size, n)
+		assert.Equal(t, int64(len(chunks[i])), chunk.CompressedSize)
+	}
+	assert.Equal(t, content, whole.String())
+}
+
+func TestSplit_BySize(t *testing.T) {
+	content := line(0, "a") + line(1, "b") + line(2, "c")
+	index, _ := split(t, content, len(line(0, "a"))+1)
+
+	require.Len(t, index.Chunks, 3)
+	names := []string{"000000.log.gz", "000001.log.gz", "000002.log.gz"}
+	for i, chunk := range index.Chunks {
+		assert.Equal(t, 1, chunk.Lines)
+		assert.Equal(t, i+1, chunk.FirstLine)
+		assert.Equal(t, names[i], chunk.Name())
+	}
+}
+
+func TestSplitTimestamp(t *testing.T) {
+	ts, text := SplitTimestamp("2026-10-19T12:00:00.5Z hello world")
+	assert.Equal(t, baseTs.Add(500*time.Millisecond), ts)
+	assert.Equal(t, "hello world", text)
+
+	ts, text = SplitTimestamp("no timestamp here at all")
+	assert.True(t, ts.IsZero())
+	assert.Equal(t, "no timestamp here at all", text)
+}
@@ -0,0 +1,262 @@
+package testworkflowlogs
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/instructions"
+)
+
+// ReadOptions narrows down the part of the execution log to read
+type ReadOptions struct {
+	// Step limits the log to the lines of the step with the reference
+	Step string
+	// Since limits the log to the lines printed since the time
+	Since time.Time
+	// Tail limits the log to the last lines
+	Tail int
+	// Offset and Length limit the log to the byte range of the whole log.
+	// When the lines are filtered too, the range selects the lines starting in it.
+	Offset int64
+	Length int64
+}
+
+// IsZero checks if the whole log should be read
+func (o ReadOptions) IsZero() bool {
+	return !o.filtersLines() && o.Offset == 0 && o.Length == 0
+}
+
+func (o ReadOptions) filtersLines() bool {
+	return o.Step != "" || !o.Since.IsZero() || o.Tail > 0
+}
+
+func (o ReadOptions) end() int64 {
+	if o.Length <= 0 {
+		return -1
+	}
+	return o.Offset + o.Length
+}
+
+// Select picks the chunks that may contain the lines matching the options
+func (i Index) Select(options ReadOptions) []Chunk {
+	end := options.end()
+	result := make([]Chunk, 0)
+	for _, c := range i.Chunks {
+		if options.Step != "" && c.Step != options.Step {
+			continue
+		}
+		if !options.Since.IsZero() && !c.End.IsZero() && c.End.Before(options.Since) {
+			continue
+		}
+		if c.Offset+c.Size <= options.Offset || (end >= 0 && c.Offset >= end) {
+			continue
+		}
+		result = append(result, c)
+	}
+
+	// Drop the chunks before the tail, as long as the later chunks are matching as a whole
+	if options.Tail > 0 {
+		lines := 0
+		for j := len(result) - 1; j >= 0; j-- {
+			c := result[j]
+			complete := (options.Since.IsZero() || (!c.Start.IsZero() && !c.Start.Before(options.Since))) &&
+				c.Offset >= options.Offset && (end < 0 || c.Offset+c.Size <= end)
+			if !complete {
+				break
+			}
+			lines += c.Lines
+			if lines >= options.Tail {
+				result = result[j:]
+				break
+			}
+		}
+	}
+	return result
+}
+
+// Segment is a part of the execution log to read
+type Segment struct {
+	// Offset is the position of the segment in the whole log
+	Offset int64
+	// Step is the reference of the step running at the beginning of the segment
+	Step string
+	Open func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// ChunkSegments builds the segments to read the selected chunks
+func ChunkSegments(chunks []Chunk, open func(ctx context.Context, chunk Chunk) (io.ReadCloser, error)) []Segment {
+	segments := make([]Segment, len(chunks))
+	for i := range chunks {
+		chunk := chunks[i]
+		segments[i] = Segment{
+			Offset: chunk.Offset,
+			Step:   chunk.Step,
+			Open: func(ctx context.Context) (io.ReadCloser, error) {
+				return open(ctx, chunk)
+			},
+		}
+	}
+	return segments
+}
+
+// Decompress wraps the compressed chunk content with the reader of the log
+func Decompress(rc io.ReadCloser) (io.ReadCloser, error) {
+	reader, err := gzip.NewReader(rc)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	return &decompressedReader{Reader: reader, source: rc}, nil
+}
+
+type decompressedReader struct {
+	*gzip.Reader
+	source io.Closer
+}
+
+func (d *decompressedReader) Close() error {
+	return errors.Join(d.Reader.Close(), d.source.Close())
+}
+
+// Read streams the segments of the log, skipping the parts not matching the options
+func Read(ctx context.Context, segments []Segment, options ReadOptions) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		var err error
+		if options.filtersLines() {
+			err = writeLines(ctx, writer, segments, options)
+		} else {
+			err = writeRange(ctx, writer, segments, options)
+		}
+		_ = writer.CloseWithError(err)
+	}()
+	return reader
+}
+
+func writeRange(ctx context.Context, w io.Writer, segments []Segment, options ReadOptions) error {
+	end := options.end()
+	for _, segment := range segments {
+		if end >= 0 && segment.Offset >= end {
+			return nil
+		}
+		rc, err := segment.Open(ctx)
+		if err != nil {
+			return err
+		}
+		err = copyRange(w, rc, segment.Offset, options.Offset, end)
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyRange(w io.Writer, r io.Reader, position, start, end int64) error {
+	if position < start {
+		skipped, err := io.CopyN(io.Discard, r, start-position)
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		position += skipped
+	}
+	if end < 0 {
+		_, err := io.Copy(w, r)
+		return err
+	}
+	_, err := io.CopyN(w, r, end-position)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+func writeLines(ctx context.Context, w io.Writer, segments []Segment, options ReadOptions) error {
+	end := options.end()
+	// Keep the last lines in the ring buffer for the tail
+	var tail []string
+	count := 0
+	if options.Tail > 0 {
+		tail = make([]string, options.Tail)
+	}
+	emit := func(line string) error {
+		if tail == nil {
+			_, err := io.WriteString(w, line)
+			return err
+		}
+		tail[count%len(tail)] = line
+		count++
+		return nil
+	}
+
+	for _, segment := range segments {
+		if end >= 0 && segment.Offset >= end {
+			break
+		}
+		rc, err := segment.Open(ctx)
+		if err != nil {
+			return err
+		}
+		finished, err := filterLines(rc, segment, options, emit)
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+		if finished {
+			break
+		}
+	}
+
+	for i := max(0, count-len(tail)); i < count; i++ {
+		if _, err := io.WriteString(w, tail[i%len(tail)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterLines passes the lines of the segment matching the options, and reports if the range has ended
+func filterLines(r io.Reader, segment Segment, options ReadOptions, emit func(line string) error) (bool, error) {
+	end := options.end()
+	position := segment.Offset
+	step := segment.Step
+	var lastTs time.Time
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			start := position
+			position += int64(len(line))
+			if end >= 0 && start >= end {
+				return true, nil
+			}
+			ts, text := SplitTimestamp(strings.TrimRight(line, "\r\n"))
+			if !ts.IsZero() {
+				lastTs = ts
+			}
+			if hint := instructions.StartHintRe.FindStringSubmatch(text); len(hint) > 0 {
+				step = hint[1]
+			}
+			matches := start >= options.Offset &&
+				(options.Step == "" || step == options.Step) &&
+				(options.Since.IsZero() || (!lastTs.IsZero() && !lastTs.Before(options.Since)))
+			if matches {
+				if emitErr := emit(line); emitErr != nil {
+					return true, emitErr
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		} else if err != nil {
+			return true, err
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package testworkflowlogs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readChunked(t *testing.T, content string, chunkSize int, options ReadOptions) (string, int) {
+	index, chunks := split(t, content, chunkSize)
+	opened := 0
+	segments := ChunkSegments(index.Select(options), func(_ context.Context, chunk Chunk) (io.ReadCloser, error) {
+		opened++
+		return Decompress(io.NopCloser(bytes.NewReader(chunks[chunk.Index])))
+	})
+	rc := Read(context.Background(), segments, options)
+	defer rc.Close()
+	result, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(result), opened
+}
+
+func readRaw(t *testing.T, content string, options ReadOptions) string {
+	rc := Read(context.Background(), []Segment{{Open: func(_ context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(content)), nil
+	}}}, options)
+	defer rc.Close()
+	result, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(result)
+}
+
+func TestRead(t *testing.T) {
+	content := sampleLog()
+	cases := map[string]struct {
+		options  ReadOptions
+		expected string
+		opened   int
+	}{
+		"step": {
+			options:  ReadOptions{Step: "step1"},
+			expected: hint(1, "step1") + line(2, "first 1") + line(3, "first 2"),
+			opened:   1,
+		},
+		"tail": {
+			options:  ReadOptions{Tail: 2},
+			expected: line(6, "second 2") + line(7, "second 3"),
+			opened:   1,
+		},
+		"step and tail": {
+			options:  ReadOptions{Step: "step1", Tail: 1},
+			expected: line(3, "first 2"),
+			opened:   1,
+		},
+		"since": {
+			options:  ReadOptions{Since: baseTs.Add(3 * time.Second)},
+			expected: line(3, "first 2") + hint(4, "step2") + line(5, "second 1") + line(6, "second 2") + line(7, "second 3"),
+			opened:   2,
+		},
+		"byte range": {
+			options:  ReadOptions{Offset: 10, Length: 20},
+			expected: content[10:30],
+			opened:   1,
+		},
+		"byte range with lines": {
+			options:  ReadOptions{Offset: 1, Length: int64(len(line(0, "initializing")+hint(1, "step1"))) - 1, Step: "step1"},
+			expected: hint(1, "step1"),
+			opened:   1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, opened := readChunked(t, content, 0, tc.options)
+			assert.Equal(t, tc.expected, result)
+			assert.Equal(t, tc.opened, opened)
+			assert.Equal(t, tc.expected, readRaw(t, content, tc.options))
+		})
+	}
+}
+
+func TestRead_TailAcrossChunks(t *testing.T) {
+	content := line(0, "a") + line(1, "b") + line(2, "c") + line(3, "d")
+	result, opened := readChunked(t, content, len(line(0, "a")), ReadOptions{Tail: 3})
+	assert.Equal(t, line(1, "b")+line(2, "c")+line(3, "d"), result)
+	assert.Equal(t, 3, opened)
+}
+
+func TestRead_Whole(t *testing.T) {
+	content := sampleLog()
+	result, opened := readChunked(t, content, 16, ReadOptions{})
+	assert.Equal(t, content, result)
+	assert.Equal(t, 8, opened)
+}
@@ -0,0 +1,229 @@
+package testworkflowlsp
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	documentSeparatorRe = regexp.MustCompile(`^---\s*$`)
+	kindRe              = regexp.MustCompile(`^kind:\s*["']?([A-Za-z]+)["']?\s*$`)
+	keyRe               = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s:#'"][^:#]*?)\s*:(\s|$)`)
+)
+
+// cursorContext describes the YAML location under the cursor, detected from the indentation,
+// so it works for the documents that are not valid while editing as well
+type cursorContext struct {
+	// Kind is the kind of the YAML document
+	Kind string
+	// Path is the list of keys of the parent mappings
+	Path []string
+	// Key is the key under the cursor (or the one that the value belongs to)
+	Key string
+	// KeyRange is the location of the key in the document
+	KeyRange Range
+	// InKey tells whether the cursor is at the key, otherwise it's the value
+	InKey bool
+	// Value is the scalar value in the line
+	Value string
+	// ValueStart is the column where the value begins
+	ValueStart int
+	// Prefix is the text before the cursor in the current key or value
+	Prefix string
+	// Indent is the column of the current mapping, the parent keys are placed before it
+	Indent int
+}
+
+type yamlLine struct {
+	// KeyCol is the column of the key, or the value for the sequence item without key
+	KeyCol int
+	// DashCol is the column of the sequence item marker, or -1
+	DashCol int
+	Key     string
+	HasKey  bool
+	Rest    string
+	RestCol int
+	Empty   bool
+}
+
+func parseYAMLLine(line string) yamlLine {
+	result := yamlLine{DashCol: -1}
+	trimmed := strings.TrimLeft(line, " ")
+	col := len(line) - len(trimmed)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		result.Empty = true
+		return result
+	}
+	for strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+		result.DashCol = col
+		rest := strings.TrimLeft(trimmed[1:], " ")
+		col += len(trimmed) - len(rest)
+		trimmed = rest
+	}
+	result.KeyCol = col
+	if m := keyRe.FindStringSubmatch(trimmed); m != nil {
+		result.HasKey = true
+		result.Key = strings.Trim(m[1], `"'`)
+		afterColon := len(m[1]) + strings.Index(trimmed[len(m[1]):], ":") + 1
+		value := trimmed[afterColon:]
+		valueTrimmed := strings.TrimLeft(value, " ")
+		result.Rest = valueTrimmed
+		result.RestCol = col + afterColon + len(value) - len(valueTrimmed)
+	} else {
+		result.Rest = trimmed
+		result.RestCol = col
+	}
+	return result
+}
+
+// documentBounds finds the lines range of the YAML document that contains the line
+func documentBounds(lines []string, line int) (int, int) {
+	start, end := 0, len(lines)
+	for i := line; i >= 0 && i < len(lines); i-- {
+		if documentSeparatorRe.MatchString(lines[i]) {
+			start = i + 1
+			break
+		}
+	}
+	for i := line + 1; i < len(lines); i++ {
+		if documentSeparatorRe.MatchString(lines[i]) {
+			end = i
+			break
+		}
+	}
+	return start, end
+}
+
+func documentKind(lines []string, start, end int) string {
+	for i := start; i < end && i < len(lines); i++ {
+		if m := kindRe.FindStringSubmatch(lines[i]); m != nil {
+			return m[1]
+		}
+	}
+	return KindTestWorkflow
+}
+
+// parentPath builds the list of the parent keys for the content starting at the column in the line
+func parentPath(lines []string, start, line, col int) []string {
+	path := make([]string, 0)
+	threshold := col
+	for i := line - 1; i >= start && threshold > 0; i-- {
+		l := parseYAMLLine(lines[i])
+		if l.Empty {
+			continue
+		}
+		if l.HasKey && l.KeyCol < threshold {
+			path = append(path, l.Key)
+			threshold = l.KeyCol
+			if l.DashCol != -1 {
+				// The sequence parent may be at the same column as the item marker
+				threshold = l.DashCol + 1
+			}
+		} else if l.DashCol != -1 && l.DashCol < threshold {
+			threshold = l.DashCol + 1
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// parentSiblingValue finds the value of the key placed next to the parent of the content starting at the column,
+// i.e. the template name for the config parameter being edited
+func parentSiblingValue(lines []string, start, line, col int, key string) string {
+	parent := -1
+	for i := line - 1; i >= start; i-- {
+		if l := parseYAMLLine(lines[i]); !l.Empty && l.HasKey && l.KeyCol < col {
+			parent = i
+			break
+		}
+	}
+	if parent == -1 {
+		return ""
+	}
+	parentCol := parseYAMLLine(lines[parent]).KeyCol
+	match := func(l yamlLine) bool {
+		return l.HasKey && l.KeyCol == parentCol && l.Key == key
+	}
+	for i := parent; i >= start; i-- {
+		l := parseYAMLLine(lines[i])
+		if l.Empty {
+			continue
+		}
+		if match(l) {
+			return strings.Trim(strings.TrimSpace(l.Rest), `"'`)
+		}
+		if l.KeyCol < parentCol || l.DashCol != -1 {
+			break
+		}
+	}
+	for i := parent + 1; i < len(lines) && !documentSeparatorRe.MatchString(lines[i]); i++ {
+		l := parseYAMLLine(lines[i])
+		if l.Empty {
+			continue
+		}
+		if l.KeyCol < parentCol || (l.DashCol != -1 && l.DashCol < parentCol) {
+			break
+		}
+		if match(l) {
+			return strings.Trim(strings.TrimSpace(l.Rest), `"'`)
+		}
+	}
+	return ""
+}
+
+func splitLines(text string) []string {
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
+
+// detectContext analyzes the document at the position
+func detectContext(text string, pos Position) (ctx cursorContext, ok bool) {
+	lines := splitLines(text)
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return ctx, false
+	}
+	start, end := documentBounds(lines, pos.Line)
+	ctx.Kind = documentKind(lines, start, end)
+
+	current := lines[pos.Line]
+	character := min(max(pos.Character, 0), len(current))
+	l := parseYAMLLine(current)
+	if l.Empty {
+		ctx.InKey = true
+		ctx.Indent = character
+		ctx.Path = parentPath(lines, start, pos.Line, character)
+		return ctx, true
+	}
+
+	threshold := l.KeyCol
+	if l.DashCol != -1 {
+		threshold = l.DashCol + 1
+	}
+	ctx.Indent = threshold
+	ctx.Path = parentPath(lines, start, pos.Line, threshold)
+	ctx.KeyRange = Range{Start: Position{Line: pos.Line, Character: l.KeyCol}, End: Position{Line: pos.Line, Character: l.KeyCol + len(l.Key)}}
+	if !l.HasKey {
+		ctx.InKey = character <= l.KeyCol+len(strings.TrimRight(l.Rest, " "))
+		ctx.Prefix = current[l.KeyCol:max(character, l.KeyCol)]
+		ctx.Value = l.Rest
+		ctx.ValueStart = l.RestCol
+		if l.DashCol != -1 {
+			// Scalar item of the sequence
+			ctx.InKey = false
+			ctx.Key = ""
+		}
+		return ctx, true
+	}
+	ctx.Key = l.Key
+	ctx.Value = l.Rest
+	ctx.ValueStart = l.RestCol
+	colon := strings.Index(current[l.KeyCol:], ":") + l.KeyCol
+	if character <= colon {
+		ctx.InKey = true
+		ctx.Prefix = current[l.KeyCol:max(character, l.KeyCol)]
+	} else {
+		ctx.Prefix = current[min(l.RestCol, character):character]
+	}
+	return ctx, true
+}
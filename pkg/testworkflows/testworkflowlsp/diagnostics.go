@@ -0,0 +1,147 @@
+package testworkflowlsp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+	k8syaml "sigs.k8s.io/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/crd"
+	"github.com/kubeshop/testkube/pkg/expressions"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+const diagnosticSource = "testkube"
+
+var yamlErrorLineRe = regexp.MustCompile(`line (\d+)`)
+
+// Diagnose analyzes all the Test Workflows and Test Workflow Templates in the YAML content
+func Diagnose(text string, templates map[string]*testworkflowsv1.TestWorkflowTemplate) []Diagnostic {
+	result := make([]Diagnostic, 0)
+	decoder := yaml.NewDecoder(bytes.NewReader([]byte(text)))
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			line := 0
+			if m := yamlErrorLineRe.FindStringSubmatch(err.Error()); m != nil {
+				line, _ = strconv.Atoi(m[1])
+				line--
+			}
+			result = append(result, lineDiagnostic(line, SeverityError, err.Error()))
+			break
+		}
+		if len(node.Content) == 0 || node.Content[0].Kind != yaml.MappingNode {
+			continue
+		}
+		result = append(result, diagnoseDocument(node.Content[0], templates)...)
+	}
+	return result
+}
+
+func lineDiagnostic(line int, severity DiagnosticSeverity, message string) Diagnostic {
+	return Diagnostic{
+		Range:    Range{Start: Position{Line: line}, End: Position{Line: line + 1}},
+		Severity: severity,
+		Source:   diagnosticSource,
+		Message:  message,
+	}
+}
+
+func nodeDiagnostic(node *yaml.Node, severity DiagnosticSeverity, message string) Diagnostic {
+	return Diagnostic{Range: nodeRange(node), Severity: severity, Source: diagnosticSource, Message: message}
+}
+
+func diagnoseDocument(root *yaml.Node, templates map[string]*testworkflowsv1.TestWorkflowTemplate) []Diagnostic {
+	kindNode := findValue(root, "kind")
+	if kindNode == nil || (kindNode.Value != KindTestWorkflow && kindNode.Value != KindTestWorkflowTemplate) {
+		return nil
+	}
+	kind := kindNode.Value
+	result := make([]Diagnostic, 0)
+
+	// Analyze the properties and expressions
+	missingTemplates := false
+	walkFields(root, RootField(kind), func(node *yaml.Node, field *Field) {
+		if field == nil {
+			result = append(result, nodeDiagnostic(node, SeverityWarning, fmt.Sprintf("unknown property: %s", node.Value)))
+			return
+		}
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!str" {
+			return
+		}
+		if IsTemplateRef(field) && kind == KindTestWorkflow {
+			if _, ok := templates[testworkflowresolver.GetInternalTemplateName(node.Value)]; !ok {
+				missingTemplates = true
+				result = append(result, nodeDiagnostic(node, SeverityError, fmt.Sprintf(`template "%s" not found`, node.Value)))
+			}
+			return
+		}
+		var err error
+		switch field.Kind() {
+		case ExprTemplate:
+			_, err = expressions.CompileTemplate(node.Value)
+		case ExprExpression:
+			_, err = expressions.Compile(node.Value)
+		}
+		if err != nil {
+			result = append(result, nodeDiagnostic(node, SeverityError, fmt.Sprintf("invalid %s: %v", field.Kind(), err)))
+		}
+	})
+
+	// Validate against the CRD schema
+	raw, err := yaml.Marshal(root)
+	if err != nil {
+		return result
+	}
+	if err = crd.ValidateYAMLAgainstSchema(rootSchemas[kind], raw); err != nil {
+		result = append(result, nodeDiagnostic(kindNode, SeverityError, fmt.Sprintf("invalid %s: %v", kind, err)))
+		return result
+	}
+
+	// Resolve the templates
+	if kind != KindTestWorkflow || missingTemplates {
+		return result
+	}
+	workflow := &testworkflowsv1.TestWorkflow{}
+	if err = k8syaml.Unmarshal(raw, workflow); err != nil {
+		result = append(result, nodeDiagnostic(kindNode, SeverityError, fmt.Sprintf("invalid %s: %v", kind, err)))
+		return result
+	}
+	if err = testworkflowresolver.ApplyTemplates(workflow, templates, nil); err != nil {
+		result = append(result, nodeDiagnostic(kindNode, SeverityError, fmt.Sprintf("resolving templates: %v", err)))
+	}
+	return result
+}
+
+// walkFields visits the keys (with field or nil when it's unknown) and the values of the YAML document
+func walkFields(node *yaml.Node, field *Field, fn func(node *yaml.Node, field *Field)) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		if AcceptsAnyKey(field) {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			child, ok := ChildField(field, node.Content[i].Value)
+			if !ok {
+				fn(node.Content[i], nil)
+				continue
+			}
+			walkFields(node.Content[i+1], child, fn)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			walkFields(item, field, fn)
+		}
+	case yaml.ScalarNode:
+		fn(node, field)
+	}
+}
@@ -0,0 +1,248 @@
+package testworkflowlsp
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/expressions"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+// builtinVariables are the values available in the expressions beside the config parameters
+var builtinVariables = map[string]string{
+	"env":                   "environment variables",
+	"workflow.name":         "name of the Test Workflow",
+	"workflow.labels":       "labels of the Test Workflow",
+	"labels":                "labels of the Test Workflow, with escaped keys",
+	"execution.id":          "ID of the execution",
+	"execution.groupId":     "ID of the execution group",
+	"execution.name":        "name of the execution",
+	"execution.number":      "sequence number of the execution",
+	"execution.scheduledAt": "time when the execution has been scheduled",
+	"execution.tags":        "tags of the execution",
+	"resource.id":           "ID of the current resource",
+	"resource.root":         "ID of the root resource",
+	"organization.id":       "ID of the organization",
+	"environment.id":        "ID of the environment",
+	"dashboard.url":         "URL of the Dashboard",
+	"passed":                "whether the previous steps have passed",
+	"failed":                "whether any of the previous steps have failed",
+	"always":                "always true, i.e. for conditions",
+	"never":                 "always false, i.e. for conditions",
+	"status":                "status of the current step",
+	"services":              "addresses of the started services",
+	"steps":                 "outputs of the finished steps",
+}
+
+func fieldType(field *Field) string {
+	t := deref(field.Type)
+	switch t.Kind() {
+	case reflect.Slice:
+		return "list"
+	case reflect.Map:
+		return "map"
+	case reflect.Struct:
+		if isLeaf(t) {
+			return "value"
+		}
+		return "object"
+	}
+	return t.Kind().String()
+}
+
+func fieldDocumentation(field *Field) string {
+	parts := make([]string, 0, 2)
+	if doc := Doc(field); doc != "" {
+		parts = append(parts, doc)
+	}
+	switch field.Kind() {
+	case ExprTemplate:
+		parts = append(parts, "Supports `{{ }}` expressions.")
+	case ExprExpression:
+		parts = append(parts, "The value is an expression.")
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// documentConfig lists the config parameters of the document at the line
+func documentConfig(lines []string, line int) []string {
+	start, end := documentBounds(lines, line)
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(strings.Join(lines[start:end], "\n")), &node); err != nil || len(node.Content) == 0 {
+		return nil
+	}
+	config := findValue(findValue(node.Content[0], "spec"), "config")
+	if config == nil || config.Kind != yaml.MappingNode {
+		return nil
+	}
+	keys := make([]string, 0, len(config.Content)/2)
+	for i := 0; i < len(config.Content); i += 2 {
+		keys = append(keys, config.Content[i].Value)
+	}
+	return keys
+}
+
+// isInExpression detects if the cursor is inside the "{{ }}" clause
+func isInExpression(prefix string) bool {
+	open := strings.LastIndex(prefix, "{{")
+	return open != -1 && !strings.Contains(prefix[open:], "}}")
+}
+
+// configuredTemplate finds the template which config parameters are edited at the position
+func configuredTemplate(text string, pos Position, ctx cursorContext, templates []Template) *Template {
+	if len(ctx.Path) == 0 || ctx.Path[len(ctx.Path)-1] != "config" {
+		return nil
+	}
+	ref := append(slices.Clone(ctx.Path[:len(ctx.Path)-1]), "name")
+	if field, ok := ResolveField(ctx.Kind, ref); !ok || !IsTemplateRef(field) {
+		return nil
+	}
+	lines := splitLines(text)
+	start, _ := documentBounds(lines, pos.Line)
+	name := testworkflowresolver.GetInternalTemplateName(parentSiblingValue(lines, start, pos.Line, ctx.Indent, "name"))
+	if name == "" {
+		return nil
+	}
+	for i := range templates {
+		if testworkflowresolver.GetInternalTemplateName(templates[i].Name) == name {
+			return &templates[i]
+		}
+	}
+	return nil
+}
+
+// templateConfigItems suggests the config parameters of the template
+func templateConfigItems(tpl *Template) []CompletionItem {
+	names := make([]string, 0, len(tpl.Template.Spec.Config))
+	for name := range tpl.Template.Spec.Config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := make([]CompletionItem, 0, len(names))
+	for _, name := range names {
+		param := tpl.Template.Spec.Config[name]
+		detail := string(param.Type)
+		if detail == "" {
+			detail = string(testworkflowsv1.ParameterTypeString)
+		}
+		if param.Default != nil {
+			detail += fmt.Sprintf(" (default: %s)", param.Default.String())
+		} else {
+			detail += " (required)"
+		}
+		items = append(items, CompletionItem{
+			Label:         name,
+			Kind:          CompletionKindField,
+			Detail:        detail,
+			Documentation: param.Description,
+			InsertText:    name + ": ",
+		})
+	}
+	return items
+}
+
+// Complete suggests the properties, template names or expression variables at the position
+func Complete(text string, pos Position, templates []Template) []CompletionItem {
+	ctx, ok := detectContext(text, pos)
+	if !ok {
+		return nil
+	}
+	items := make([]CompletionItem, 0)
+	if ctx.InKey {
+		if tpl := configuredTemplate(text, pos, ctx, templates); tpl != nil {
+			return templateConfigItems(tpl)
+		}
+		for _, f := range ChildFields(ctx.Kind, ctx.Path) {
+			items = append(items, CompletionItem{
+				Label:         f.Name,
+				Kind:          CompletionKindField,
+				Detail:        fieldType(&f),
+				Documentation: fieldDocumentation(&f),
+				InsertText:    f.Name + ": ",
+			})
+		}
+		return items
+	}
+
+	field, ok := ResolveField(ctx.Kind, append(ctx.Path, ctx.Key))
+	if !ok {
+		return nil
+	}
+	if IsTemplateRef(field) {
+		seen := make(map[string]struct{})
+		for _, tpl := range templates {
+			if _, ok := seen[tpl.Name]; ok {
+				continue
+			}
+			seen[tpl.Name] = struct{}{}
+			items = append(items, CompletionItem{
+				Label:         tpl.Name,
+				Kind:          CompletionKindModule,
+				Detail:        KindTestWorkflowTemplate,
+				Documentation: tpl.Template.Description,
+			})
+		}
+		return items
+	}
+	if field.Kind() == ExprExpression || (field.Kind() == ExprTemplate && isInExpression(ctx.Prefix)) {
+		for _, key := range documentConfig(splitLines(text), pos.Line) {
+			items = append(items, CompletionItem{Label: "config." + key, Kind: CompletionKindVariable, Detail: "config parameter"})
+		}
+		names := make([]string, 0, len(builtinVariables))
+		for name := range builtinVariables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			items = append(items, CompletionItem{Label: name, Kind: CompletionKindVariable, Detail: builtinVariables[name]})
+		}
+		for _, name := range expressions.StdFunctionNames() {
+			items = append(items, CompletionItem{Label: name, Kind: CompletionKindFunction, Detail: "function", InsertText: name + "("})
+		}
+	}
+	return items
+}
+
+// HoverAt describes the property at the position
+func HoverAt(text string, pos Position) *Hover {
+	ctx, ok := detectContext(text, pos)
+	if !ok || ctx.Key == "" {
+		return nil
+	}
+	field, ok := ResolveField(ctx.Kind, append(ctx.Path, ctx.Key))
+	if !ok {
+		return nil
+	}
+	content := fmt.Sprintf("**%s** (%s)", field.Name, fieldType(field))
+	if doc := fieldDocumentation(field); doc != "" {
+		content += "\n\n" + doc
+	}
+	r := ctx.KeyRange
+	return &Hover{Contents: MarkupContent{Kind: "markdown", Value: content}, Range: &r}
+}
+
+// DefinitionAt finds the location of the template referenced at the position
+func DefinitionAt(text string, pos Position, templates []Template) *Location {
+	ctx, ok := detectContext(text, pos)
+	if !ok || ctx.InKey {
+		return nil
+	}
+	field, ok := ResolveField(ctx.Kind, append(ctx.Path, ctx.Key))
+	if !ok || !IsTemplateRef(field) {
+		return nil
+	}
+	name := testworkflowresolver.GetInternalTemplateName(strings.Trim(strings.TrimSpace(ctx.Value), `"'`))
+	for _, tpl := range templates {
+		if testworkflowresolver.GetInternalTemplateName(tpl.Name) == name {
+			location := tpl.Location
+			return &location
+		}
+	}
+	return nil
+}
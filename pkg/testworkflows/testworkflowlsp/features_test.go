@@ -0,0 +1,223 @@
+package testworkflowlsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+)
+
+const templateYAML = `kind: TestWorkflowTemplate
+apiVersion: testworkflows.testkube.io/v1
+metadata:
+  name: official--k6
+description: Run k6 tests
+spec:
+  config:
+    vus: {type: integer, default: 1, description: number of virtual users}
+    script: {type: string, description: path to the test script}
+  steps:
+  - shell: k6 run --vus {{config.vus}}
+`
+
+const workflowYAML = `kind: TestWorkflow
+apiVersion: testworkflows.testkube.io/v1
+metadata:
+  name: load
+spec:
+  config:
+    target: {type: string}
+  steps:
+  - name: Run
+    condition: passed && config.target != ""
+    use:
+    - name: official/k6
+    shell: echo {{config.target}}
+`
+
+func localTemplates(t *testing.T) []Template {
+	templates := ParseTemplates("file:///repo/k6.yaml", []byte(templateYAML))
+	require.Len(t, templates, 1)
+	return templates
+}
+
+func templatesMap(templates []Template) map[string]*testworkflowsv1.TestWorkflowTemplate {
+	result := make(map[string]*testworkflowsv1.TestWorkflowTemplate)
+	for _, tpl := range templates {
+		result["official--k6"] = tpl.Template
+	}
+	return result
+}
+
+func TestParseTemplates(t *testing.T) {
+	templates := localTemplates(t)
+	assert.Equal(t, "official/k6", templates[0].Name)
+	assert.Equal(t, "Run k6 tests", templates[0].Template.Description)
+	assert.Equal(t, Location{URI: "file:///repo/k6.yaml", Range: Range{
+		Start: Position{Line: 3, Character: 8},
+		End:   Position{Line: 3, Character: 20},
+	}}, templates[0].Location)
+}
+
+func TestDiagnose_Valid(t *testing.T) {
+	templates := localTemplates(t)
+	assert.Empty(t, Diagnose(workflowYAML+"---\n"+templateYAML, templatesMap(templates)))
+}
+
+func TestDiagnose_Problems(t *testing.T) {
+	yaml := `kind: TestWorkflow
+apiVersion: testworkflows.testkube.io/v1
+metadata:
+  name: load
+spec:
+  steps:
+  - name: Run
+    condition: passed &&
+    shell: echo {{config.target
+    unknownKey: value
+    template:
+      name: missing
+`
+	diagnostics := Diagnose(yaml, nil)
+	messages := make(map[int]string)
+	for _, d := range diagnostics {
+		messages[d.Range.Start.Line] = d.Message
+	}
+	assert.Contains(t, messages[7], "invalid expression")
+	assert.Contains(t, messages[8], "invalid template")
+	assert.Contains(t, messages[9], "unknown property: unknownKey")
+	assert.Contains(t, messages[11], `template "missing" not found`)
+}
+
+func TestDiagnose_SyntaxError(t *testing.T) {
+	diagnostics := Diagnose("kind: TestWorkflow\nspec:\n  steps:\n  - shell: a\n   b: c\n", nil)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, SeverityError, diagnostics[0].Severity)
+	assert.Contains(t, diagnostics[0].Message, "did not find expected key")
+	assert.Equal(t, 1, diagnostics[0].Range.Start.Line)
+}
+
+func TestDiagnose_ResolvingTemplates(t *testing.T) {
+	template := ParseTemplates("file:///repo/k6.yaml", []byte(templateYAML))
+	diagnostics := Diagnose(`kind: TestWorkflow
+metadata:
+  name: load
+spec:
+  use:
+  - name: official/k6
+    config:
+      vus: many
+`, templatesMap(template))
+	require.Len(t, diagnostics, 1)
+	assert.Contains(t, diagnostics[0].Message, "resolving templates")
+	assert.Equal(t, 0, diagnostics[0].Range.Start.Line)
+}
+
+func TestComplete_Keys(t *testing.T) {
+	items := Complete(workflowYAML, Position{Line: 9, Character: 4}, nil)
+	labels := make([]string, 0, len(items))
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	assert.Contains(t, labels, "shell")
+	assert.Contains(t, labels, "template")
+	assert.Contains(t, labels, "retry")
+	assert.NotContains(t, labels, "metadata")
+
+	items = Complete("kind: TestWorkflowTemplate\nspec:\n  \n", Position{Line: 2, Character: 2}, nil)
+	labels = labels[:0]
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	assert.Contains(t, labels, "steps")
+	assert.NotContains(t, labels, "gates")
+}
+
+func TestComplete_TemplateNames(t *testing.T) {
+	items := Complete(workflowYAML, Position{Line: 11, Character: 12}, localTemplates(t))
+	require.Len(t, items, 1)
+	assert.Equal(t, "official/k6", items[0].Label)
+	assert.Equal(t, "Run k6 tests", items[0].Documentation)
+}
+
+func TestComplete_Expressions(t *testing.T) {
+	items := Complete(workflowYAML, Position{Line: 12, Character: 20}, nil)
+	labels := make([]string, 0, len(items))
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	assert.Contains(t, labels, "config.target")
+	assert.Contains(t, labels, "execution.id")
+	assert.Contains(t, labels, "shellquote")
+
+	// Expression fields
+	items = Complete(workflowYAML, Position{Line: 9, Character: 22}, nil)
+	assert.NotEmpty(t, items)
+
+	// Outside of the {{ }} clause
+	items = Complete(workflowYAML, Position{Line: 12, Character: 13}, nil)
+	assert.Empty(t, items)
+}
+
+func TestComplete_TemplateConfig(t *testing.T) {
+	templates := localTemplates(t)
+	tests := map[string]struct {
+		yaml string
+		pos  Position
+	}{
+		"use config": {
+			yaml: "kind: TestWorkflow\nspec:\n  use:\n  - name: official/k6\n    config:\n      \n",
+			pos:  Position{Line: 5, Character: 6},
+		},
+		"step template config": {
+			yaml: "kind: TestWorkflow\nspec:\n  steps:\n  - name: Load\n    template:\n      name: official--k6\n      config:\n        vu\n",
+			pos:  Position{Line: 7, Character: 10},
+		},
+		"config before the template name": {
+			yaml: "kind: TestWorkflow\nspec:\n  use:\n  - config:\n      \n    name: \"official/k6\"\n",
+			pos:  Position{Line: 4, Character: 6},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			items := Complete(tc.yaml, tc.pos, templates)
+			assert.Equal(t, []CompletionItem{
+				{Label: "script", Kind: CompletionKindField, Detail: "string (required)", Documentation: "path to the test script", InsertText: "script: "},
+				{Label: "vus", Kind: CompletionKindField, Detail: "integer (default: 1)", Documentation: "number of virtual users", InsertText: "vus: "},
+			}, items)
+		})
+	}
+
+	// The workflow config is not the template one
+	items := Complete("kind: TestWorkflow\nspec:\n  config:\n    \n", Position{Line: 3, Character: 4}, templates)
+	for _, item := range items {
+		assert.NotEqual(t, "vus", item.Label)
+	}
+	// Unknown template
+	assert.Empty(t, Complete("kind: TestWorkflow\nspec:\n  use:\n  - name: missing\n    config:\n      \n", Position{Line: 5, Character: 6}, templates))
+}
+
+func TestHoverAt(t *testing.T) {
+	hover := HoverAt(workflowYAML, Position{Line: 9, Character: 6})
+	require.NotNil(t, hover)
+	assert.Contains(t, hover.Contents.Value, "**condition**")
+	assert.Contains(t, hover.Contents.Value, "expression")
+	assert.Equal(t, Range{Start: Position{Line: 9, Character: 4}, End: Position{Line: 9, Character: 13}}, *hover.Range)
+
+	hover = HoverAt(workflowYAML, Position{Line: 12, Character: 5})
+	require.NotNil(t, hover)
+	assert.Contains(t, hover.Contents.Value, "Supports `{{ }}` expressions")
+
+	assert.Nil(t, HoverAt(workflowYAML, Position{Line: 100, Character: 0}))
+}
+
+func TestDefinitionAt(t *testing.T) {
+	templates := localTemplates(t)
+	location := DefinitionAt(workflowYAML, Position{Line: 11, Character: 14}, templates)
+	require.NotNil(t, location)
+	assert.Equal(t, templates[0].Location, *location)
+
+	assert.Nil(t, DefinitionAt(workflowYAML, Position{Line: 8, Character: 12}, templates))
+}
@@ -0,0 +1,274 @@
+package testworkflowlsp
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	opcrd "github.com/kubeshop/testkube/k8s"
+)
+
+// ExprKind describes how the string value is interpreted, based on the "expr" tag of the field
+type ExprKind string
+
+const (
+	ExprNone       ExprKind = ""
+	ExprTemplate   ExprKind = "template"
+	ExprExpression ExprKind = "expression"
+)
+
+const (
+	KindTestWorkflow         = "TestWorkflow"
+	KindTestWorkflowTemplate = "TestWorkflowTemplate"
+)
+
+var (
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+	rootTypes = map[string]reflect.Type{
+		KindTestWorkflow:         reflect.TypeOf(testworkflowsv1.TestWorkflow{}),
+		KindTestWorkflowTemplate: reflect.TypeOf(testworkflowsv1.TestWorkflowTemplate{}),
+	}
+	rootSchemas = map[string]opcrd.Schema{
+		KindTestWorkflow:         opcrd.SchemaTestWorkflow,
+		KindTestWorkflowTemplate: opcrd.SchemaTestWorkflowTemplate,
+	}
+)
+
+// Field is the property available in the YAML
+type Field struct {
+	Name string
+	Type reflect.Type
+	// Owner is the Go type that declares the field
+	Owner reflect.Type
+	// Expr is the "expr" tag for the value
+	Expr string
+	// Forced tells whether all the nested strings are templates
+	Forced bool
+}
+
+// Kind returns how the string values of this field are interpreted
+func (f *Field) Kind() ExprKind {
+	if f.Forced {
+		return ExprTemplate
+	}
+	switch f.Expr {
+	case "template", "force":
+		return ExprTemplate
+	case "expression":
+		return ExprExpression
+	}
+	return ExprNone
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// isLeaf detects the types that are represented as a scalar value in the YAML
+func isLeaf(t reflect.Type) bool {
+	t = deref(t)
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	p := reflect.PointerTo(t)
+	return p.Implements(unmarshalerType) || p.Implements(textUnmarshalerType)
+}
+
+// Fields lists the properties of the struct, including the inlined ones
+func Fields(t reflect.Type) []Field {
+	t = deref(t)
+	if t.Kind() != reflect.Struct || isLeaf(t) {
+		return nil
+	}
+	result := make([]Field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+		if strings.Contains(opts, "inline") || (f.Anonymous && name == "") {
+			result = append(result, Fields(f.Type)...)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		result = append(result, Field{Name: name, Type: f.Type, Owner: t, Expr: f.Tag.Get("expr")})
+	}
+	return result
+}
+
+// RootField returns the field describing the whole document of the kind
+func RootField(kind string) *Field {
+	root, ok := rootTypes[kind]
+	if !ok {
+		root = rootTypes[KindTestWorkflow]
+	}
+	return &Field{Type: root}
+}
+
+// ChildField finds the field for the key under the parent field.
+// The sequences are transparent, while the map keys are treated as a field.
+func ChildField(parent *Field, key string) (*Field, bool) {
+	t := deref(parent.Type)
+	for t.Kind() == reflect.Slice {
+		t = deref(t.Elem())
+	}
+	switch {
+	case t.Kind() == reflect.Struct && !isLeaf(t):
+		for _, f := range Fields(t) {
+			if f.Name == key {
+				f.Forced = parent.Forced || parent.Expr == "force" || f.Expr == "force"
+				return &f, true
+			}
+		}
+		return nil, false
+	case t.Kind() == reflect.Map:
+		// The map value is described with the second part of the tag, i.e. `expr:"template,include"`
+		valueTag := parent.Expr
+		if _, v, ok := strings.Cut(parent.Expr, ","); ok {
+			valueTag = v
+		}
+		return &Field{Name: key, Type: t.Elem(), Owner: parent.Owner, Expr: valueTag, Forced: parent.Forced}, true
+	}
+	return nil, false
+}
+
+// AcceptsAnyKey tells whether the field accepts properties that are not described in the schema
+func AcceptsAnyKey(field *Field) bool {
+	t := deref(field.Type)
+	for t.Kind() == reflect.Slice {
+		t = deref(t.Elem())
+	}
+	return t.Kind() == reflect.Interface || isLeaf(t)
+}
+
+// ResolveField finds the field for the YAML path, i.e. ["spec", "steps", "shell"]
+func ResolveField(kind string, path []string) (*Field, bool) {
+	current := RootField(kind)
+	for _, key := range path {
+		var ok bool
+		current, ok = ChildField(current, key)
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// IsTemplateRef tells whether the field is the name of the referenced template
+func IsTemplateRef(field *Field) bool {
+	return field != nil && field.Name == "name" && field.Owner == reflect.TypeOf(testworkflowsv1.TemplateRef{})
+}
+
+// ChildFields lists the properties available under the YAML path
+func ChildFields(kind string, path []string) []Field {
+	field, ok := ResolveField(kind, path)
+	if !ok {
+		return nil
+	}
+	t := deref(field.Type)
+	for t.Kind() == reflect.Slice {
+		t = deref(t.Elem())
+	}
+	return Fields(t)
+}
+
+var (
+	docsOnce sync.Once
+	docs     map[string]string
+)
+
+func docKey(owner reflect.Type, name string) string {
+	return fmt.Sprintf("%s.%s.%s", owner.PkgPath(), owner.Name(), name)
+}
+
+// Doc returns the description of the field, taken from the CRD schema generated from the field comments
+func Doc(field *Field) string {
+	if field == nil || field.Owner == nil {
+		return ""
+	}
+	docsOnce.Do(loadDocs)
+	return docs[docKey(field.Owner, field.Name)]
+}
+
+func loadDocs() {
+	docs = make(map[string]string)
+	kinds := make([]string, 0, len(rootSchemas))
+	for kind := range rootSchemas {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		schema, err := loadSchema(rootSchemas[kind])
+		if err != nil {
+			continue
+		}
+		indexDocs(rootTypes[kind], schema, map[reflect.Type]struct{}{})
+	}
+}
+
+func loadSchema(name opcrd.Schema) (*apiextv1.JSONSchemaProps, error) {
+	content, err := opcrd.SF.ReadFile(fmt.Sprintf("crd/%s.yaml", name))
+	if err != nil {
+		return nil, err
+	}
+	crd := apiextv1.CustomResourceDefinition{}
+	if err = yaml.Unmarshal(content, &crd); err != nil {
+		return nil, err
+	}
+	if len(crd.Spec.Versions) == 0 || crd.Spec.Versions[0].Schema == nil {
+		return nil, fmt.Errorf("schema not found")
+	}
+	return crd.Spec.Versions[0].Schema.OpenAPIV3Schema, nil
+}
+
+// indexDocs walks through the Go type and the schema together, to assign the descriptions to the fields.
+// The recursive types are not expanded in the CRD, so their fields are indexed from the first occurrence.
+func indexDocs(t reflect.Type, schema *apiextv1.JSONSchemaProps, visited map[reflect.Type]struct{}) {
+	t = deref(t)
+	for t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		if schema.Items != nil && schema.Items.Schema != nil {
+			schema = schema.Items.Schema
+		} else if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			schema = schema.AdditionalProperties.Schema
+		}
+		t = deref(t.Elem())
+	}
+	if t.Kind() != reflect.Struct || isLeaf(t) || len(schema.Properties) == 0 {
+		return
+	}
+	if _, ok := visited[t]; ok {
+		return
+	}
+	visited[t] = struct{}{}
+	for _, f := range Fields(t) {
+		prop, ok := schema.Properties[f.Name]
+		if !ok {
+			continue
+		}
+		key := docKey(f.Owner, f.Name)
+		if _, ok := docs[key]; !ok && prop.Description != "" {
+			docs[key] = prop.Description
+		}
+		indexDocs(f.Type, &prop, visited)
+	}
+}
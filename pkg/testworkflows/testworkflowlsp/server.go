@@ -0,0 +1,205 @@
+package testworkflowlsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+const serverName = "testkube-lsp"
+
+// Server is the language server for the Test Workflow and Test Workflow Template YAML files
+type Server struct {
+	version   string
+	sources   []TemplateSource
+	documents map[string]string
+	mu        sync.Mutex
+	conn      *conn
+	warn      func(format string, args ...interface{})
+}
+
+// NewServer creates the language server. The templates are read from the sources,
+// as well as from the workspace and the open documents.
+func NewServer(version string, sources ...TemplateSource) *Server {
+	return &Server{
+		version:   version,
+		sources:   sources,
+		documents: make(map[string]string),
+		warn:      func(string, ...interface{}) {},
+	}
+}
+
+// WithLogger sets the function to report the non-fatal problems
+func (s *Server) WithLogger(warn func(format string, args ...interface{})) *Server {
+	s.warn = warn
+	return s
+}
+
+// Serve handles the messages until the client exits or the input is closed
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	s.conn = newConn(in, out)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		msg, err := s.conn.read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		var respErr *responseError
+		if errors.As(err, &respErr) {
+			_ = s.conn.reply(nil, nil, respErr)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == MethodExit {
+			return nil
+		}
+		result, err := s.handle(msg)
+		if msg.ID == nil {
+			if err != nil {
+				s.warn("%s: %v", msg.Method, err)
+			}
+			continue
+		}
+		if err = s.conn.reply(msg.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) (interface{}, error) {
+	switch msg.Method {
+	case MethodInitialize:
+		var params InitializeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		if root := uriToPath(params.RootURI); root != "" {
+			s.sources = append(s.sources, NewLocalTemplateSource(root))
+		}
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":   1, // full content
+				"completionProvider": map[string]interface{}{"triggerCharacters": []string{".", "{", " ", ":"}},
+				"hoverProvider":      true,
+				"definitionProvider": true,
+			},
+			"serverInfo": map[string]string{"name": serverName, "version": s.version},
+		}, nil
+	case MethodInitialized:
+		return nil, nil
+	case MethodShutdown:
+		return nil, nil
+	case MethodDidOpen:
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		s.setDocument(params.TextDocument.URI, params.TextDocument.Text)
+		return nil, s.publishDiagnostics(params.TextDocument.URI)
+	case MethodDidChange:
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// Only the full content synchronization is supported, so the last change has the whole document
+		s.setDocument(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+		return nil, s.publishDiagnostics(params.TextDocument.URI)
+	case MethodDidClose:
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		delete(s.documents, params.TextDocument.URI)
+		s.mu.Unlock()
+		return nil, s.conn.notify(MethodPublishDiagnostics, PublishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []Diagnostic{}})
+	case MethodCompletion, MethodHover, MethodDefinition:
+		var params TextDocumentPositionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		text, ok := s.document(params.TextDocument.URI)
+		if !ok {
+			return nil, fmt.Errorf("document not open: %s", params.TextDocument.URI)
+		}
+		switch msg.Method {
+		case MethodCompletion:
+			return Complete(text, params.Position, s.templates()), nil
+		case MethodHover:
+			return HoverAt(text, params.Position), nil
+		default:
+			return DefinitionAt(text, params.Position, s.templates()), nil
+		}
+	}
+	if msg.ID == nil {
+		// Ignore the notifications that are not supported
+		return nil, nil
+	}
+	return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not supported: %s", msg.Method)}
+}
+
+func (s *Server) setDocument(uri, text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.documents[uri] = text
+}
+
+func (s *Server) document(uri string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	text, ok := s.documents[uri]
+	return text, ok
+}
+
+// templates lists all the available templates, where the ones from the open documents take precedence
+func (s *Server) templates() []Template {
+	s.mu.Lock()
+	result := make([]Template, 0)
+	open := make(map[string]struct{}, len(s.documents))
+	for uri, text := range s.documents {
+		open[uri] = struct{}{}
+		result = append(result, ParseTemplates(uri, []byte(text))...)
+	}
+	s.mu.Unlock()
+
+	for _, source := range s.sources {
+		templates, err := source.Templates()
+		if err != nil {
+			s.warn("reading templates: %v", err)
+		}
+		for _, tpl := range templates {
+			if _, ok := open[tpl.Location.URI]; !ok {
+				result = append(result, tpl)
+			}
+		}
+	}
+	return result
+}
+
+func (s *Server) publishDiagnostics(uri string) error {
+	text, ok := s.document(uri)
+	if !ok {
+		return nil
+	}
+	templates := make(map[string]*testworkflowsv1.TestWorkflowTemplate)
+	for _, tpl := range s.templates() {
+		name := testworkflowresolver.GetInternalTemplateName(tpl.Name)
+		if _, ok := templates[name]; !ok {
+			templates[name] = tpl.Template
+		}
+	}
+	return s.conn.notify(MethodPublishDiagnostics, PublishDiagnosticsParams{URI: uri, Diagnostics: Diagnose(text, templates)})
+}
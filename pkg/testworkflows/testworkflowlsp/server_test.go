@@ -0,0 +1,115 @@
+package testworkflowlsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/crdcommon"
+)
+
+type testClient struct {
+	t      *testing.T
+	writer io.Writer
+	reader *bufio.Reader
+}
+
+func (c *testClient) send(id int, method string, params interface{}) {
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if id != 0 {
+		msg["id"] = id
+	}
+	body, err := json.Marshal(msg)
+	require.NoError(c.t, err)
+	_, err = fmt.Fprintf(c.writer, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	require.NoError(c.t, err)
+}
+
+func (c *testClient) receive() map[string]interface{} {
+	header, err := textproto.NewReader(c.reader).ReadMIMEHeader()
+	require.NoError(c.t, err)
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	require.NoError(c.t, err)
+	body := make([]byte, length)
+	_, err = io.ReadFull(c.reader, body)
+	require.NoError(c.t, err)
+	var result map[string]interface{}
+	require.NoError(c.t, json.Unmarshal(body, &result))
+	return result
+}
+
+func TestServer(t *testing.T) {
+	clientIn, serverOut := io.Pipe()
+	serverIn, clientOut := io.Pipe()
+	client := &testClient{t: t, writer: clientOut, reader: bufio.NewReader(clientIn)}
+
+	dir := t.TempDir()
+	source := NewClusterTemplateSource(func() ([]testworkflowsv1.TestWorkflowTemplate, error) {
+		tpl := testworkflowsv1.TestWorkflowTemplate{}
+		err := crdcommon.DeserializeCRD(&tpl, []byte(templateYAML))
+		return []testworkflowsv1.TestWorkflowTemplate{tpl}, err
+	}, dir)
+	done := make(chan error)
+	go func() {
+		done <- NewServer("1.0.0", source).Serve(context.Background(), serverIn, serverOut)
+	}()
+
+	client.send(1, MethodInitialize, map[string]interface{}{})
+	response := client.receive()
+	assert.Equal(t, float64(1), response["id"])
+	assert.Equal(t, true, response["result"].(map[string]interface{})["capabilities"].(map[string]interface{})["hoverProvider"])
+
+	uri := "file:///repo/workflow.yaml"
+	client.send(0, MethodDidOpen, map[string]interface{}{"textDocument": map[string]interface{}{"uri": uri, "text": workflowYAML}})
+	notification := client.receive()
+	assert.Equal(t, MethodPublishDiagnostics, notification["method"])
+	assert.Empty(t, notification["params"].(map[string]interface{})["diagnostics"])
+
+	client.send(0, MethodDidChange, map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri},
+		"contentChanges": []map[string]interface{}{{"text": workflowYAML + "    unknown: true\n"}},
+	})
+	notification = client.receive()
+	diagnostics := notification["params"].(map[string]interface{})["diagnostics"].([]interface{})
+	require.NotEmpty(t, diagnostics)
+	assert.Contains(t, diagnostics[0].(map[string]interface{})["message"], "unknown property")
+
+	client.send(2, MethodDefinition, map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     map[string]interface{}{"line": 11, "character": 14},
+	})
+	response = client.receive()
+	assert.Equal(t, pathToURI(dir+"/official--k6.yaml"), response["result"].(map[string]interface{})["uri"])
+
+	client.send(3, MethodHover, map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     map[string]interface{}{"line": 50, "character": 0},
+	})
+	response = client.receive()
+	assert.Contains(t, response, "result")
+	assert.Nil(t, response["result"])
+
+	client.send(4, "textDocument/unknown", map[string]interface{}{})
+	response = client.receive()
+	assert.Equal(t, float64(codeMethodNotFound), response["error"].(map[string]interface{})["code"])
+
+	client.send(5, MethodShutdown, nil)
+	client.receive()
+	client.send(0, MethodExit, nil)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server has not stopped")
+	}
+}
@@ -0,0 +1,196 @@
+package testworkflowlsp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	k8syaml "sigs.k8s.io/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/crdcommon"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+const clusterTemplatesCacheTTL = 30 * time.Second
+
+// Template is the Test Workflow Template available for the workflows, along with its definition location
+type Template struct {
+	Name     string
+	Template *testworkflowsv1.TestWorkflowTemplate
+	Location Location
+}
+
+// TemplateSource provides the Test Workflow Templates
+type TemplateSource interface {
+	Templates() ([]Template, error)
+}
+
+// ParseTemplates reads the Test Workflow Templates from the YAML documents, along with the location of their names
+func ParseTemplates(uri string, content []byte) []Template {
+	result := make([]Template, 0)
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			// Skip the rest of the file when it is not valid
+			break
+		}
+		if len(node.Content) == 0 || findValue(node.Content[0], "kind") == nil ||
+			findValue(node.Content[0], "kind").Value != KindTestWorkflowTemplate {
+			continue
+		}
+		nameNode := findValue(findValue(node.Content[0], "metadata"), "name")
+		if nameNode == nil {
+			continue
+		}
+		raw, err := yaml.Marshal(&node)
+		if err != nil {
+			continue
+		}
+		tpl := &testworkflowsv1.TestWorkflowTemplate{}
+		if err = k8syaml.Unmarshal(raw, tpl); err != nil {
+			continue
+		}
+		result = append(result, Template{
+			Name:     testworkflowresolver.GetDisplayTemplateName(tpl.Name),
+			Template: tpl,
+			Location: Location{URI: uri, Range: nodeRange(nameNode)},
+		})
+	}
+	return result
+}
+
+// findValue returns the value node for the key in the YAML mapping
+func findValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func nodeRange(node *yaml.Node) Range {
+	start := Position{Line: node.Line - 1, Character: node.Column - 1}
+	end := Position{Line: start.Line, Character: start.Character + len(node.Value)}
+	if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+		end.Character += 2
+	}
+	return Range{Start: start, End: end}
+}
+
+func pathToURI(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}).String()
+}
+
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return ""
+	}
+	return filepath.FromSlash(u.Path)
+}
+
+type localTemplateSource struct {
+	root string
+}
+
+// NewLocalTemplateSource reads the templates from the YAML files in the directory, recursively
+func NewLocalTemplateSource(root string) TemplateSource {
+	return &localTemplateSource{root: root}
+}
+
+func (s *localTemplateSource) Templates() ([]Template, error) {
+	result := make([]Template, 0)
+	err := filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != s.root && (strings.HasPrefix(d.Name(), ".") || d.Name() == "node_modules" || d.Name() == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		ext := filepath.Ext(path)
+		if ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil || !bytes.Contains(content, []byte(KindTestWorkflowTemplate)) {
+			return nil
+		}
+		result = append(result, ParseTemplates(pathToURI(path), content)...)
+		return nil
+	})
+	return result, err
+}
+
+type clusterTemplateSource struct {
+	list     func() ([]testworkflowsv1.TestWorkflowTemplate, error)
+	dir      string
+	mu       sync.Mutex
+	cached   []Template
+	cachedAt time.Time
+}
+
+// NewClusterTemplateSource provides the templates from the cluster. To allow going to their definition,
+// they are stored as YAML files in the directory.
+func NewClusterTemplateSource(list func() ([]testworkflowsv1.TestWorkflowTemplate, error), dir string) TemplateSource {
+	return &clusterTemplateSource{list: list, dir: dir}
+}
+
+func (s *clusterTemplateSource) Templates() ([]Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cached != nil && time.Since(s.cachedAt) < clusterTemplatesCacheTTL {
+		return s.cached, nil
+	}
+	templates, err := s.list()
+	if err != nil {
+		return s.cached, err
+	}
+	if err = os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, err
+	}
+	result := make([]Template, 0, len(templates))
+	for i := range templates {
+		tpl := &templates[i]
+		content, err := crdcommon.SerializeCRD(tpl, crdcommon.SerializeOptions{
+			OmitCreationTimestamp: true,
+			CleanMeta:             true,
+			Kind:                  KindTestWorkflowTemplate,
+			GroupVersion:          &testworkflowsv1.GroupVersion,
+		})
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(s.dir, tpl.Name+".yaml")
+		if err = os.WriteFile(path, content, 0644); err != nil {
+			continue
+		}
+		result = append(result, ParseTemplates(pathToURI(path), content)...)
+	}
+	s.cached = result
+	s.cachedAt = time.Now()
+	return result, nil
+}
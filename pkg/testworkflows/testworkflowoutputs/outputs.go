@@ -0,0 +1,183 @@
+package testworkflowoutputs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/expressions"
+)
+
+// Parse converts the raw output value into the declared type
+func Parse(outputType testkube.TestWorkflowOutputType, raw string) (interface{}, error) {
+	switch outputType {
+	case "", testkube.STRING_TestWorkflowOutputType:
+		return raw, nil
+	case testkube.INTEGER_TestWorkflowOutputType:
+		return strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	case testkube.NUMBER_TestWorkflowOutputType:
+		return strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	case testkube.BOOLEAN_TestWorkflowOutputType:
+		return strconv.ParseBool(strings.TrimSpace(raw))
+	case testkube.JSON_TestWorkflowOutputType:
+		var v interface{}
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unknown output type: %s", outputType)
+}
+
+// Convert ensures that the already computed value matches the declared type
+func Convert(outputType testkube.TestWorkflowOutputType, value interface{}) (interface{}, error) {
+	if outputType == "" || outputType == testkube.JSON_TestWorkflowOutputType {
+		return value, nil
+	}
+	if str, ok := value.(string); ok {
+		return Parse(outputType, str)
+	}
+	serialized, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(outputType, string(serialized))
+}
+
+// Declared returns the outputs declared for the executed workflow
+func Declared(execution *testkube.TestWorkflowExecution) []testkube.TestWorkflowSpecOutput {
+	workflow := execution.ResolvedWorkflow
+	if workflow == nil || workflow.Spec == nil {
+		workflow = execution.Workflow
+	}
+	if workflow == nil || workflow.Spec == nil {
+		return nil
+	}
+	return workflow.Spec.Outputs
+}
+
+// StepOutputs returns the outputs reported by the steps, grouped by the step ID
+func StepOutputs(execution *testkube.TestWorkflowExecution) map[string]map[string]interface{} {
+	ids := make(map[string]string)
+	collectStepIds(execution.Signature, ids)
+
+	result := make(map[string]map[string]interface{})
+	for _, output := range execution.Output {
+		if output.Name != constants.OutputStepOutputs || output.Ref == "" || ids[output.Ref] == "" {
+			continue
+		}
+		id := ids[output.Ref]
+		if result[id] == nil {
+			result[id] = make(map[string]interface{}, len(output.Value))
+		}
+		for name, value := range output.Value {
+			result[id][name] = value
+		}
+	}
+	return result
+}
+
+func collectStepIds(signature []testkube.TestWorkflowSignature, ids map[string]string) {
+	for _, sig := range signature {
+		if sig.Id != "" {
+			ids[sig.Ref] = sig.Id
+		}
+		collectStepIds(sig.Children, ids)
+	}
+}
+
+// CreateMachine builds the expressions machine exposing the steps outputs as steps.<id>.outputs.<name>
+func CreateMachine(stepOutputs map[string]map[string]interface{}) expressions.Machine {
+	steps := make(map[string]interface{}, len(stepOutputs))
+	for id, values := range stepOutputs {
+		steps[id] = map[string]interface{}{"outputs": values}
+	}
+	return expressions.NewMachine().Register("steps", steps)
+}
+
+// Evaluate computes the values of the workflow outputs out of the steps outputs.
+// The outputs that can't be computed are skipped, and reported in the returned error.
+func Evaluate(declared []testkube.TestWorkflowSpecOutput, signature []testkube.TestWorkflowSignature, stepOutputs map[string]map[string]interface{}) (map[string]interface{}, error) {
+	machine := CreateMachine(stepOutputs)
+	sequence := stepIdsSequence(signature, nil)
+
+	values := make(map[string]interface{}, len(declared))
+	var errs []error
+	for _, output := range declared {
+		var value interface{}
+		found := false
+		if output.Value == "" {
+			// Use the latest step that has reported the output with the same name
+			for i := len(sequence) - 1; i >= 0 && !found; i-- {
+				value, found = stepOutputs[sequence[i]][output.Name]
+			}
+			if !found {
+				errs = append(errs, fmt.Errorf("%s: none of the steps has reported this output", output.Name))
+				continue
+			}
+		} else {
+			result, err := expressions.EvalExpression(output.Value, machine, expressions.FinalizerFail)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: failed to evaluate value: %w", output.Name, err))
+				continue
+			}
+			value = result.Value()
+		}
+		converted, err := Convert(outputType(output.Type), value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: value is not %s: %w", output.Name, outputType(output.Type), err))
+			continue
+		}
+		values[output.Name] = converted
+	}
+	return values, errors.Join(errs...)
+}
+
+func outputType(v *testkube.TestWorkflowOutputType) testkube.TestWorkflowOutputType {
+	if v == nil {
+		return testkube.STRING_TestWorkflowOutputType
+	}
+	return *v
+}
+
+func stepIdsSequence(signature []testkube.TestWorkflowSignature, ids []string) []string {
+	for _, sig := range signature {
+		if sig.Id != "" {
+			ids = append(ids, sig.Id)
+		}
+		ids = stepIdsSequence(sig.Children, ids)
+	}
+	return ids
+}
+
+// Apply computes the outputs declared for the workflow and stores them in the execution output,
+// under the execution-level reference. It returns true when there were any outputs declared.
+func Apply(execution *testkube.TestWorkflowExecution) (bool, error) {
+	declared := Declared(execution)
+	if len(declared) == 0 {
+		return false, nil
+	}
+	values, err := Evaluate(declared, execution.Signature, StepOutputs(execution))
+	output := make([]testkube.TestWorkflowOutput, 0, len(execution.Output)+1)
+	for _, item := range execution.Output {
+		if item.Ref != "" || item.Name != constants.OutputStepOutputs {
+			output = append(output, item)
+		}
+	}
+	execution.Output = append(output, testkube.TestWorkflowOutput{Name: constants.OutputStepOutputs, Value: values})
+	return true, err
+}
+
+// Values returns the outputs returned by the execution
+func Values(execution *testkube.TestWorkflowExecution) map[string]interface{} {
+	for _, output := range execution.Output {
+		if output.Ref == "" && output.Name == constants.OutputStepOutputs {
+			return output.Value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package testworkflowoutputs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/cmd/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		outputType testkube.TestWorkflowOutputType
+		raw        string
+		want       interface{}
+		wantErr    bool
+	}{
+		"default":         {raw: " abc ", want: " abc "},
+		"integer":         {outputType: testkube.INTEGER_TestWorkflowOutputType, raw: "42\n", want: int64(42)},
+		"invalid integer": {outputType: testkube.INTEGER_TestWorkflowOutputType, raw: "4.2", wantErr: true},
+		"number":          {outputType: testkube.NUMBER_TestWorkflowOutputType, raw: "4.2", want: 4.2},
+		"boolean":         {outputType: testkube.BOOLEAN_TestWorkflowOutputType, raw: "true", want: true},
+		"json":            {outputType: testkube.JSON_TestWorkflowOutputType, raw: `{"a":[1]}`, want: map[string]interface{}{"a": []interface{}{float64(1)}}},
+		"invalid json":    {outputType: testkube.JSON_TestWorkflowOutputType, raw: `{"a"`, wantErr: true},
+		"unknown type":    {outputType: "date", raw: "2026-10-19", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tc.outputType, tc.raw)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func executionWithOutputs(outputs ...testkube.TestWorkflowSpecOutput) testkube.TestWorkflowExecution {
+	return testkube.TestWorkflowExecution{
+		ResolvedWorkflow: &testkube.TestWorkflow{
+			Name: "release",
+			Spec: &testkube.TestWorkflowSpec{Outputs: outputs},
+		},
+		Signature: []testkube.TestWorkflowSignature{
+			{Ref: "r1", Id: "build"},
+			{Ref: "r2", Children: []testkube.TestWorkflowSignature{
+				{Ref: "r3", Id: "deploy"},
+			}},
+		},
+		Output: []testkube.TestWorkflowOutput{
+			{Ref: "r1", Name: "pod", Value: map[string]interface{}{"name": "abc"}},
+			{Ref: "r1", Name: constants.OutputStepOutputs, Value: map[string]interface{}{"version": "1.2.3", "port": float64(8080)}},
+			{Ref: "r3", Name: constants.OutputStepOutputs, Value: map[string]interface{}{"url": "http://app", "port": float64(9090)}},
+		},
+	}
+}
+
+func TestApply(t *testing.T) {
+	execution := executionWithOutputs(
+		testkube.TestWorkflowSpecOutput{Name: "version"},
+		testkube.TestWorkflowSpecOutput{Name: "port", Type: common.Ptr(testkube.INTEGER_TestWorkflowOutputType)},
+		testkube.TestWorkflowSpecOutput{Name: "endpoint", Value: `steps.deploy.outputs.url + "/api"`},
+		testkube.TestWorkflowSpecOutput{Name: "buildPort", Type: common.Ptr(testkube.STRING_TestWorkflowOutputType), Value: "steps.build.outputs.port"},
+	)
+
+	applied, err := Apply(&execution)
+	require.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, map[string]interface{}{
+		"version":   "1.2.3",
+		"port":      int64(9090),
+		"endpoint":  "http://app/api",
+		"buildPort": "8080",
+	}, Values(&execution))
+	assert.Len(t, execution.Output, 4)
+
+	// Applying again replaces the previous values
+	applied, err = Apply(&execution)
+	require.NoError(t, err)
+	assert.True(t, applied)
+	assert.Len(t, execution.Output, 4)
+}
+
+func TestApply_Errors(t *testing.T) {
+	execution := executionWithOutputs(
+		testkube.TestWorkflowSpecOutput{Name: "version"},
+		testkube.TestWorkflowSpecOutput{Name: "missing"},
+		testkube.TestWorkflowSpecOutput{Name: "broken", Value: "steps.unknown.outputs.url"},
+		testkube.TestWorkflowSpecOutput{Name: "invalid", Type: common.Ptr(testkube.BOOLEAN_TestWorkflowOutputType), Value: "steps.build.outputs.version"},
+	)
+
+	applied, err := Apply(&execution)
+	assert.True(t, applied)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing: none of the steps")
+	assert.Contains(t, err.Error(), "broken: failed to evaluate value")
+	assert.Contains(t, err.Error(), "invalid: value is not boolean")
+	assert.Equal(t, map[string]interface{}{"version": "1.2.3"}, Values(&execution))
+}
+
+func TestApply_NoOutputs(t *testing.T) {
+	execution := executionWithOutputs()
+	applied, err := Apply(&execution)
+	assert.NoError(t, err)
+	assert.False(t, applied)
+	assert.Nil(t, Values(&execution))
+}
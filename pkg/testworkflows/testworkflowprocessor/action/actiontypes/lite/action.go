@@ -11,10 +11,11 @@ type ActionResult struct {
 }
 
 type ActionDeclare struct {
-	Condition string   `json:"c"`
-	Ref       string   `json:"r"`
-	Id        string   `json:"i,omitempty"`
-	Parents   []string `json:"p,omitempty"`
+	Condition string            `json:"c"`
+	Ref       string            `json:"r"`
+	Id        string            `json:"i,omitempty"`
+	Parents   []string          `json:"p,omitempty"`
+	Outputs   map[string]string `json:"o,omitempty"`
 }
 
 type ActionExecute struct {
@@ -34,9 +35,15 @@ type ActionTimeout struct {
 }
 
 type ActionRetry struct {
-	Ref   string `json:"r"`
-	Count int32  `json:"c,omitempty"`
-	Until string `json:"u,omitempty"`
+	Ref       string  `json:"r"`
+	Count     int32   `json:"c,omitempty"`
+	Until     string  `json:"u,omitempty"`
+	Delay     string  `json:"d,omitempty"`
+	Backoff   string  `json:"b,omitempty"`
+	MaxDelay  string  `json:"m,omitempty"`
+	Jitter    int32   `json:"j,omitempty"`
+	ExitCodes []int32 `json:"e,omitempty"`
+	Logs      string  `json:"l,omitempty"`
 }
 
 type ActionSetup struct {
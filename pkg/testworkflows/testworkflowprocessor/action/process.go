@@ -29,8 +29,17 @@ func process(currentStatus string, parents []string, stage stage2.Stage, parentC
 		}
 	}
 
+	// Declare the outputs along with their types
+	var outputs map[string]string
+	if len(stage.Outputs()) > 0 {
+		outputs = make(map[string]string, len(stage.Outputs()))
+		for _, output := range stage.Outputs() {
+			outputs[output.Name] = string(output.Type)
+		}
+	}
+
 	actions = append(actions, actiontypes.Action{
-		Declare: &lite.ActionDeclare{Ref: stage.Ref(), Id: stage.Id(), Condition: condition, Parents: parents},
+		Declare: &lite.ActionDeclare{Ref: stage.Ref(), Id: stage.Id(), Condition: condition, Parents: parents, Outputs: outputs},
 	})
 
 	// Configure the container for action
@@ -64,10 +73,21 @@ func process(currentStatus string, parents []string, stage stage2.Stage, parentC
 	}
 
 	// Store the retry condition
-	if stage.RetryPolicy().Count != 0 {
-		actions = append(actions, actiontypes.Action{
-			Retry: &lite.ActionRetry{Ref: stage.Ref(), Count: stage.RetryPolicy().Count, Until: stage.RetryPolicy().Until},
-		})
+	if policy := stage.RetryPolicy(); policy.Count != 0 {
+		retry := &lite.ActionRetry{
+			Ref:      stage.Ref(),
+			Count:    policy.Count,
+			Until:    policy.Until,
+			Delay:    policy.Delay,
+			Backoff:  string(policy.Backoff),
+			MaxDelay: policy.MaxDelay,
+			Jitter:   policy.Jitter,
+		}
+		if policy.RetryOn != nil {
+			retry.ExitCodes = policy.RetryOn.ExitCodes
+			retry.Logs = policy.RetryOn.Logs
+		}
+		actions = append(actions, actiontypes.Action{Retry: retry})
 	}
 
 	// Handle pause
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -30,12 +31,15 @@ type BundleOptions struct {
 	CommonEnvVariables     []corev1.EnvVar
 	AllowLowSecurityFields bool
 	Runtime                *RuntimeOptions // Runtime configuration overrides
+	RefCounter             RefCounter      // Generates the step references, random by default
 }
 
 type Bundle struct {
 	Secrets       []corev1.Secret
 	ConfigMaps    []corev1.ConfigMap
 	Pvcs          []corev1.PersistentVolumeClaim
+	Namespaces    []corev1.Namespace
+	RoleBindings  []rbacv1.RoleBinding
 	Job           batchv1.Job
 	Signature     []stage.Signature
 	FullSignature []stage.Signature
@@ -62,6 +66,9 @@ func (b *Bundle) SetGroupId(groupId string) {
 	for i := range b.Pvcs {
 		AnnotateGroupId(&b.Pvcs[i], groupId)
 	}
+	for i := range b.Namespaces {
+		AnnotateGroupId(&b.Namespaces[i], groupId)
+	}
 }
 
 func (b *Bundle) SetRunnerId(runnerId string) {
@@ -75,12 +82,27 @@ func (b *Bundle) SetRunnerId(runnerId string) {
 	for i := range b.Pvcs {
 		AnnotateRunnerId(&b.Pvcs[i], runnerId)
 	}
+	for i := range b.Namespaces {
+		AnnotateRunnerId(&b.Namespaces[i], runnerId)
+	}
 }
 
 func (b *Bundle) Deploy(ctx context.Context, clientSet kubernetes.Interface, namespace string) (err error) {
 	if b.Job.Namespace != "" {
 		namespace = b.Job.Namespace
 	}
+	for _, item := range b.Namespaces {
+		_, err = clientSet.CoreV1().Namespaces().Create(ctx, &item, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "failed to deploy environment namespace")
+		}
+	}
+	for _, item := range b.RoleBindings {
+		_, err = clientSet.RbacV1().RoleBindings(item.Namespace).Create(ctx, &item, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "failed to deploy environment role binding")
+		}
+	}
 	for _, item := range b.Secrets {
 		_, err = clientSet.CoreV1().Secrets(namespace).Create(ctx, &item, metav1.CreateOptions{})
 		if err != nil {
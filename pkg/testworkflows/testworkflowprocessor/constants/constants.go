@@ -36,6 +36,14 @@ const (
 	RootOperationName               = "root"
 	AnnotationTerminationCode       = "testkube.io/termination-code"
 	AnnotationTerminationReason     = "testkube.io/termination-reason"
+	EnvironmentNamespacePrefix      = "tk-env-"
+	EnvironmentRoleBindingName      = "testkube-environment"
+	EnvironmentClusterRoleName      = "admin"
+	DefaultEnvironmentImage         = "alpine/k8s:1.31.2"
+	DefaultEnvironmentTimeout       = "5m"
+	DefaultEnvironmentRelease       = "environment"
+	DefaultS3Endpoint               = "s3.amazonaws.com"
+	DefaultGcsEndpoint              = "storage.googleapis.com"
 )
 
 var (
@@ -44,6 +52,7 @@ var (
 	DefaultInitPath        = filepath.Join(DefaultInternalPath, "init")
 	DefaultToolkitPath     = filepath.Join(DefaultInternalPath, "toolkit")
 	DefaultTransferDirPath = filepath.Join(DefaultInternalPath, "transfer")
+	DefaultContentCacheDir = filepath.Join(DefaultInternalPath, "cache")
 	DefaultTmpDirPath      = "/tmp"
 	DefaultTransferPort    = 60433
 	DefaultShellHeader     = "set -e\n"
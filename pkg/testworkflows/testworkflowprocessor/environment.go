@@ -0,0 +1,164 @@
+package testworkflowprocessor
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/expressions"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/constants"
+)
+
+var environmentNamespaceInvalidCharsRe = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// EnvironmentNamespaceName builds the name of the dedicated namespace for the ephemeral environment of the resource
+func EnvironmentNamespaceName(resourceId string) string {
+	name := constants.EnvironmentNamespacePrefix + environmentNamespaceInvalidCharsRe.ReplaceAllString(strings.ToLower(resourceId), "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+func createEnvironmentMachine(namespace string) expressions.Machine {
+	return expressions.NewMachine().Register("environment.namespace", namespace)
+}
+
+// buildEnvironmentStep builds the setup step that provisions the ephemeral environment and waits for its readiness
+func buildEnvironmentStep(spec *testworkflowsv1.EnvironmentSpec, namespace string) (testworkflowsv1.Step, error) {
+	timeout := spec.Timeout
+	if timeout == "" {
+		timeout = constants.DefaultEnvironmentTimeout
+	}
+	if _, err := time.ParseDuration(timeout); err != nil {
+		return testworkflowsv1.Step{}, errors.Wrap(err, fmt.Sprintf("invalid environment timeout: %s", timeout))
+	}
+	if spec.Helm != nil && spec.Helm.Chart == "" {
+		return testworkflowsv1.Step{}, errors.New("environment: helm chart is required")
+	}
+
+	image := spec.Image
+	if image == "" {
+		image = constants.DefaultEnvironmentImage
+	}
+	return testworkflowsv1.Step{
+		StepMeta:   testworkflowsv1.StepMeta{Name: "Set up environment"},
+		StepSource: testworkflowsv1.StepSource{Content: spec.Content},
+		StepDefaults: testworkflowsv1.StepDefaults{
+			WorkingDir: spec.WorkingDir,
+		},
+		StepOperations: testworkflowsv1.StepOperations{
+			Run: &testworkflowsv1.StepRun{
+				ContainerConfig: testworkflowsv1.ContainerConfig{Image: image},
+				Shell:           common.Ptr(buildEnvironmentScript(spec, namespace, timeout)),
+			},
+		},
+	}, nil
+}
+
+func quoteEnvironmentArg(v string) string {
+	return "'" + strings.ReplaceAll(v, "'", `'\''`) + "'"
+}
+
+func buildEnvironmentScript(spec *testworkflowsv1.EnvironmentSpec, namespace, timeout string) string {
+	script := []string{
+		"NAMESPACE=" + quoteEnvironmentArg(namespace),
+		"TIMEOUT=" + quoteEnvironmentArg(timeout),
+	}
+
+	// Apply the manifests, detecting kustomize directories
+	if len(spec.Manifests) > 0 {
+		script = append(script,
+			`apply() {`,
+			`  if [ -f "$1/kustomization.yaml" ] || [ -f "$1/kustomization.yml" ] || [ -f "$1/Kustomization" ]; then`,
+			`    kubectl apply --namespace "$NAMESPACE" -k "$1"`,
+			`  else`,
+			`    kubectl apply --namespace "$NAMESPACE" -R -f "$1"`,
+			`  fi`,
+			`}`,
+		)
+		for _, path := range spec.Manifests {
+			script = append(script, "apply "+quoteEnvironmentArg(path))
+		}
+	}
+
+	// Install the Helm chart
+	if spec.Helm != nil {
+		release := spec.Helm.Release
+		if release == "" {
+			release = constants.DefaultEnvironmentRelease
+		}
+		args := []string{"helm upgrade --install", quoteEnvironmentArg(release), quoteEnvironmentArg(spec.Helm.Chart), `--namespace "$NAMESPACE"`}
+		if spec.Helm.Repository != "" {
+			args = append(args, "--repo", quoteEnvironmentArg(spec.Helm.Repository))
+		}
+		if spec.Helm.Version != "" {
+			args = append(args, "--version", quoteEnvironmentArg(spec.Helm.Version))
+		}
+		for _, path := range spec.Helm.ValuesFiles {
+			args = append(args, "--values", quoteEnvironmentArg(path))
+		}
+		for _, key := range slices.Sorted(maps.Keys(spec.Helm.Values)) {
+			args = append(args, "--set", quoteEnvironmentArg(key+"="+spec.Helm.Values[key]))
+		}
+		args = append(args, `--wait --timeout "$TIMEOUT"`)
+		script = append(script, strings.Join(args, " "))
+	}
+
+	// Wait for the readiness of all the workloads
+	script = append(script,
+		`for resource in $(kubectl get deployment,statefulset,daemonset --namespace "$NAMESPACE" -o name); do`,
+		`  kubectl rollout status --namespace "$NAMESPACE" "$resource" --timeout "$TIMEOUT"`,
+		`done`,
+	)
+	return strings.Join(script, "\n")
+}
+
+// buildEnvironmentResources builds the namespace for the ephemeral environment,
+// along with the role binding that allows the execution to manage it
+func buildEnvironmentResources(spec *testworkflowsv1.EnvironmentSpec, namespace, serviceAccountNamespace, serviceAccountName string) (corev1.Namespace, rbacv1.RoleBinding) {
+	if serviceAccountName == "" {
+		serviceAccountName = "default"
+	}
+	ns := corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Namespace",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   namespace,
+			Labels: common.MergeMaps(spec.Labels),
+		},
+	}
+	roleBinding := rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RoleBinding",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.EnvironmentRoleBindingName,
+			Namespace: namespace,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccountName,
+			Namespace: serviceAccountNamespace,
+		}},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     constants.EnvironmentClusterRoleName,
+		},
+	}
+	return ns, roleBinding
+}
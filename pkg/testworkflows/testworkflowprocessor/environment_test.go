@@ -0,0 +1,114 @@
+package testworkflowprocessor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowconfig"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/constants"
+)
+
+func TestEnvironmentNamespaceName(t *testing.T) {
+	assert.Equal(t, "tk-env-68f4a2b1c3", EnvironmentNamespaceName("68F4a2b1c3"))
+	assert.Equal(t, "tk-env-abc-rsvc-1", EnvironmentNamespaceName("abc_rsvc.1"))
+
+	long := EnvironmentNamespaceName(strings.Repeat("a", 55) + "-" + strings.Repeat("b", 10))
+	assert.Len(t, long, 62)
+	assert.False(t, strings.HasSuffix(long, "-"))
+}
+
+func TestBuildEnvironmentScript(t *testing.T) {
+	script := buildEnvironmentScript(&testworkflowsv1.EnvironmentSpec{
+		Manifests: []string{"deploy/base", "it's.yaml"},
+		Helm: &testworkflowsv1.EnvironmentHelm{
+			Chart:       "charts/app",
+			Version:     "1.2.3",
+			ValuesFiles: []string{"values.yaml"},
+			Values:      map[string]string{"image.tag": "{{config.tag}}", "replicas": "1"},
+		},
+	}, "tk-env-abc", "2m")
+
+	assert.Contains(t, script, "NAMESPACE='tk-env-abc'\nTIMEOUT='2m'\n")
+	assert.Contains(t, script, "apply 'deploy/base'\napply 'it'\\''s.yaml'\n")
+	assert.Contains(t, script, `helm upgrade --install 'environment' 'charts/app' --namespace "$NAMESPACE" --version '1.2.3' --values 'values.yaml' --set 'image.tag={{config.tag}}' --set 'replicas=1' --wait --timeout "$TIMEOUT"`)
+	assert.Contains(t, script, `kubectl rollout status`)
+}
+
+func TestBundle_Environment(t *testing.T) {
+	proc := New(&dummyInspector{}).
+		Register(ProcessRunCommand).
+		Register(ProcessShellCommand).
+		Register(ProcessNestedSteps)
+	workflow := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Environment: &testworkflowsv1.EnvironmentSpec{
+				Manifests: []string{"k8s"},
+				Labels:    map[string]string{"team": "qa"},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepOperations: testworkflowsv1.StepOperations{Shell: "curl http://app.{{environment.namespace}}.svc"}},
+			},
+		},
+	}
+
+	bundle, err := proc.Bundle(context.Background(), workflow, BundleOptions{
+		Config: testworkflowconfig.InternalConfig{
+			Resource: testworkflowconfig.ResourceConfig{
+				Id:     "resource-id",
+				RootId: "resource-root-id",
+			},
+			Worker: testworkflowconfig.WorkerConfig{
+				Namespace: "testkube",
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, bundle.Namespaces, 1)
+	assert.Equal(t, "tk-env-resource-id", bundle.Namespaces[0].Name)
+	assert.Equal(t, "qa", bundle.Namespaces[0].Labels["team"])
+	assert.Equal(t, "resource-root-id", bundle.Namespaces[0].Labels[constants.RootResourceIdLabelName])
+	assert.Equal(t, "resource-id", bundle.Namespaces[0].Labels[constants.ResourceIdLabelName])
+
+	require.Len(t, bundle.RoleBindings, 1)
+	assert.Equal(t, "tk-env-resource-id", bundle.RoleBindings[0].Namespace)
+	assert.Equal(t, "testkube", bundle.RoleBindings[0].Subjects[0].Namespace)
+	assert.Equal(t, constants.EnvironmentClusterRoleName, bundle.RoleBindings[0].RoleRef.Name)
+
+	require.Len(t, bundle.Signature, 2)
+	assert.Equal(t, "Set up environment", bundle.Signature[0].Name())
+	assert.Contains(t, bundle.Job.Spec.Template.Annotations[constants.SpecAnnotationName], "http://app.tk-env-resource-id.svc")
+	images := make([]string, 0)
+	for _, c := range append(bundle.Job.Spec.Template.Spec.InitContainers, bundle.Job.Spec.Template.Spec.Containers...) {
+		images = append(images, c.Image)
+	}
+	assert.Contains(t, images, constants.DefaultEnvironmentImage)
+}
+
+func TestBundle_Environment_InvalidTimeout(t *testing.T) {
+	proc := New(&dummyInspector{}).
+		Register(ProcessRunCommand).
+		Register(ProcessShellCommand).
+		Register(ProcessNestedSteps)
+	workflow := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Environment: &testworkflowsv1.EnvironmentSpec{Manifests: []string{"k8s"}, Timeout: "soon"},
+			Steps: []testworkflowsv1.Step{
+				{StepOperations: testworkflowsv1.StepOperations{Shell: "echo hello"}},
+			},
+		},
+	}
+
+	_, err := proc.Bundle(context.Background(), workflow, BundleOptions{
+		Config: testworkflowconfig.InternalConfig{
+			Resource: testworkflowconfig.ResourceConfig{Id: "resource-id", RootId: "resource-root-id"},
+		},
+	})
+
+	assert.ErrorContains(t, err, "invalid environment timeout")
+}
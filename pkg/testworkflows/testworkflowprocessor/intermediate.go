@@ -63,7 +63,10 @@ type intermediate struct {
 }
 
 func NewIntermediate(defaultEmptyDirSizeLimit string) Intermediate {
-	ref := NewRefCounter()
+	return newIntermediate(defaultEmptyDirSizeLimit, NewRefCounter())
+}
+
+func newIntermediate(defaultEmptyDirSizeLimit string, ref RefCounter) Intermediate {
 	var defaultLimit *resource.Quantity
 	if defaultEmptyDirSizeLimit != "" {
 		// Bundle validates worker config up front; this extra parse keeps direct
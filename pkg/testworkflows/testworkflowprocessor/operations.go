@@ -273,26 +273,182 @@ func ProcessContentTarball(_ InternalProcessor, layer Intermediate, container st
 		EnableToolkit(stage.Ref())
 
 	// Build volume pair and share with all siblings
-	args := make([]string, len(step.Content.Tarball))
+	args := make([]string, 0, len(step.Content.Tarball))
 	for i, t := range step.Content.Tarball {
-		args[i] = fmt.Sprintf("%s=%s", t.Path, t.Url)
-		needsMount := t.Mount != nil && *t.Mount
-		if !needsMount {
-			needsMount = !selfContainer.HasVolumeAt(t.Path)
+		args = append(args, fmt.Sprintf("%s=%s", t.Path, t.Url))
+		if t.Sha256 != "" {
+			args = append(args, "--sha256", fmt.Sprintf("%s=%s", t.Path, t.Sha256))
 		}
+		if err := mountContentPath(layer, container, selfContainer, t.Path, t.Mount); err != nil {
+			return nil, fmt.Errorf("content.tarball[%d]: %s", i, err.Error())
+		}
+	}
+	selfContainer.SetArgs(args...)
+
+	return stage, nil
+}
 
-		if needsMount && t.Mount != nil && !*t.Mount {
-			return nil, fmt.Errorf("content.tarball[%d]: %s: is not part of any volume: should be mounted", i, t.Path)
+func ProcessContentOci(_ InternalProcessor, layer Intermediate, container stage.Container, step testworkflowsv1.Step) (stage.Stage, error) {
+	if step.Content == nil || len(step.Content.Oci) == 0 {
+		return nil, nil
+	}
+
+	group := stage.NewGroupStage(layer.NextRef(), true)
+	for i, o := range step.Content.Oci {
+		if o.Ref == "" {
+			return nil, fmt.Errorf("content.oci[%d]: ref is required", i)
+		}
+		selfContainer := container.CreateChild()
+		stage := stage.NewContainerStage(layer.NextRef(), selfContainer)
+		stage.SetRetryPolicy(step.Retry)
+		stage.SetCategory(fmt.Sprintf("Pull OCI artifact: %s", o.Ref))
+
+		selfContainer.
+			SetImage(constants.DefaultToolkitImage).
+			SetImagePullPolicy(corev1.PullIfNotPresent).
+			SetCommand(constants.DefaultToolkitPath, "oci").
+			EnableToolkit(stage.Ref())
+
+		if err := mountContentPath(layer, container, selfContainer, o.Path, o.Mount); err != nil {
+			return nil, fmt.Errorf("content.oci[%d]: %s", i, err.Error())
 		}
 
-		if needsMount {
-			volumeMount := layer.AddEmptyDirVolume(nil, t.Path)
-			container.AppendVolumeMounts(volumeMount)
+		args := []string{o.Ref, o.Path}
+
+		// Provide registry username
+		if o.UsernameFrom != nil {
+			selfContainer.AppendEnv(corev1.EnvVar{Name: "TK_OCI_USERNAME", ValueFrom: o.UsernameFrom})
+			args = append(args, "-u", "{{env.TK_OCI_USERNAME}}")
+		} else if o.Username != "" {
+			args = append(args, "-u", o.Username)
+		}
+
+		// Provide registry password
+		if o.PasswordFrom != nil {
+			selfContainer.AppendEnv(corev1.EnvVar{Name: "TK_OCI_PASSWORD", ValueFrom: o.PasswordFrom})
+			args = append(args, "-p", "{{env.TK_OCI_PASSWORD}}")
+		} else if o.Password != "" {
+			args = append(args, "-p", o.Password)
+		}
+
+		if o.Digest != "" {
+			args = append(args, "--digest", o.Digest)
 		}
+		if o.Insecure {
+			args = append(args, "--insecure")
+		}
+		selfContainer.SetArgs(args...)
+		group.Add(stage)
 	}
-	selfContainer.SetArgs(args...)
 
-	return stage, nil
+	return group, nil
+}
+
+func ProcessContentObjectStorage(_ InternalProcessor, layer Intermediate, container stage.Container, step testworkflowsv1.Step) (stage.Stage, error) {
+	if step.Content == nil || (len(step.Content.S3) == 0 && len(step.Content.Gcs) == 0) {
+		return nil, nil
+	}
+
+	group := stage.NewGroupStage(layer.NextRef(), true)
+	sources := []struct {
+		name     string
+		endpoint string
+		objects  []testworkflowsv1.ContentObjectStorage
+	}{
+		{name: "s3", endpoint: constants.DefaultS3Endpoint, objects: step.Content.S3},
+		{name: "gcs", endpoint: constants.DefaultGcsEndpoint, objects: step.Content.Gcs},
+	}
+	for _, source := range sources {
+		for i, o := range source.objects {
+			if o.Bucket == "" {
+				return nil, fmt.Errorf("content.%s[%d]: bucket is required", source.name, i)
+			}
+			if o.Sha256 != "" && (o.Key == "" || strings.HasSuffix(o.Key, "/")) {
+				return nil, fmt.Errorf("content.%s[%d]: sha256 is supported only for a single object, not for a prefix", source.name, i)
+			}
+			selfContainer := container.CreateChild()
+			stage := stage.NewContainerStage(layer.NextRef(), selfContainer)
+			stage.SetRetryPolicy(step.Retry)
+			stage.SetCategory(fmt.Sprintf("Fetch from %s: %s/%s", strings.ToUpper(source.name), o.Bucket, o.Key))
+
+			selfContainer.
+				SetImage(constants.DefaultToolkitImage).
+				SetImagePullPolicy(corev1.PullIfNotPresent).
+				SetCommand(constants.DefaultToolkitPath, "object").
+				EnableToolkit(stage.Ref())
+
+			if err := mountContentPath(layer, container, selfContainer, o.Path, o.Mount); err != nil {
+				return nil, fmt.Errorf("content.%s[%d]: %s", source.name, i, err.Error())
+			}
+
+			endpoint := o.Endpoint
+			if endpoint == "" {
+				endpoint = source.endpoint
+			}
+			args := []string{o.Path, "--endpoint", endpoint, "--bucket", o.Bucket}
+			if o.Key != "" {
+				args = append(args, "--key", o.Key)
+			}
+			if o.Region != "" {
+				args = append(args, "--region", o.Region)
+			}
+
+			// Provide access key ID
+			if o.AccessKeyIdFrom != nil {
+				selfContainer.AppendEnv(corev1.EnvVar{Name: "TK_OBJECT_ACCESS_KEY_ID", ValueFrom: o.AccessKeyIdFrom})
+				args = append(args, "--access-key-id", "{{env.TK_OBJECT_ACCESS_KEY_ID}}")
+			} else if o.AccessKeyId != "" {
+				args = append(args, "--access-key-id", o.AccessKeyId)
+			}
+
+			// Provide secret access key
+			if o.SecretAccessKeyFrom != nil {
+				selfContainer.AppendEnv(corev1.EnvVar{Name: "TK_OBJECT_SECRET_ACCESS_KEY", ValueFrom: o.SecretAccessKeyFrom})
+				args = append(args, "--secret-access-key", "{{env.TK_OBJECT_SECRET_ACCESS_KEY}}")
+			} else if o.SecretAccessKey != "" {
+				args = append(args, "--secret-access-key", o.SecretAccessKey)
+			}
+
+			if o.Sha256 != "" {
+				args = append(args, "--sha256", o.Sha256)
+			}
+			if o.Unpack {
+				args = append(args, "--unpack")
+			}
+			if o.DisableSsl {
+				args = append(args, "--disable-ssl")
+			}
+			if o.SkipVerify {
+				args = append(args, "--skip-verify")
+			}
+			selfContainer.SetArgs(args...)
+			group.Add(stage)
+		}
+	}
+
+	return group, nil
+}
+
+// mountContentPath ensures the content path is part of a volume shared with the sibling containers,
+// mounting a new one unless it's explicitly disabled.
+func mountContentPath(layer Intermediate, container, selfContainer stage.Container, path string, mount *bool) error {
+	if path == "" {
+		return errors.New("path is required")
+	}
+	needsMount := mount != nil && *mount
+	if !needsMount {
+		needsMount = !selfContainer.HasVolumeAt(path)
+	}
+
+	if needsMount && mount != nil && !*mount {
+		return fmt.Errorf("%s: is not part of any volume: should be mounted", path)
+	}
+
+	if needsMount {
+		volumeMount := layer.AddEmptyDirVolume(nil, path)
+		container.AppendVolumeMounts(volumeMount)
+	}
+	return nil
 }
 
 func ProcessArtifacts(_ InternalProcessor, layer Intermediate, container stage.Container, step testworkflowsv1.Step) (stage.Stage, error) {
@@ -338,6 +494,48 @@ func ProcessArtifacts(_ InternalProcessor, layer Intermediate, container stage.C
 	return stage, nil
 }
 
+func ProcessApproval(_ InternalProcessor, layer Intermediate, container stage.Container, step testworkflowsv1.Step) (stage.Stage, error) {
+	if step.Approval == nil {
+		return nil, nil
+	}
+
+	if step.Approval.Timeout != "" {
+		if _, err := time.ParseDuration(step.Approval.Timeout); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid approval timeout: %s", step.Approval.Timeout))
+		}
+	}
+
+	selfContainer := container.CreateChild()
+	stage := stage.NewContainerStage(layer.NextRef(), selfContainer)
+	stage.SetCategory("Wait for approval")
+
+	// Allow to combine it within other containers
+	stage.SetPure(true)
+
+	selfContainer.
+		SetImage(constants.DefaultToolkitImage).
+		SetImagePullPolicy(corev1.PullIfNotPresent).
+		SetCommand(constants.DefaultToolkitPath, "approval").
+		EnableToolkit(stage.Ref())
+
+	args := make([]string, 0)
+	if step.Approval.Message != "" {
+		args = append(args, "--message", step.Approval.Message)
+	}
+	for _, approver := range step.Approval.Approvers {
+		args = append(args, "--approver", approver)
+	}
+	if step.Approval.Timeout != "" {
+		args = append(args, "--timeout", step.Approval.Timeout)
+	}
+	if step.Approval.Default != "" {
+		args = append(args, "--default", string(step.Approval.Default))
+	}
+	selfContainer.SetArgs(args...)
+
+	return stage, nil
+}
+
 func StubExecute(_ InternalProcessor, _ Intermediate, _ stage.Container, step testworkflowsv1.Step) (stage.Stage, error) {
 	if step.Execute != nil {
 		return nil, constants.ErrOpenSourceExecuteOperationIsNotAvailable
@@ -0,0 +1,109 @@
+package testworkflowprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/constants"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/stage"
+)
+
+func contentStep(content testworkflowsv1.Content) testworkflowsv1.Step {
+	return testworkflowsv1.Step{StepSource: testworkflowsv1.StepSource{Content: &content}}
+}
+
+func TestProcessContentTarball_Sha256(t *testing.T) {
+	layer := NewIntermediate("")
+	container := stage.NewContainer()
+	step := contentStep(testworkflowsv1.Content{Tarball: []testworkflowsv1.ContentTarball{
+		{Url: "https://example.com/a.tar.gz", Path: "/data/a", Sha256: "abc"},
+		{Url: "https://example.com/b.tar.gz", Path: "/data/b"},
+	}})
+
+	result, err := ProcessContentTarball(nil, layer, container, step)
+	require.NoError(t, err)
+
+	stages := result.(stage.ContainerStage)
+	assert.Equal(t, []string{"/data/a=https://example.com/a.tar.gz", "--sha256", "/data/a=abc", "/data/b=https://example.com/b.tar.gz"}, stages.Container().Args())
+	assert.Len(t, container.VolumeMounts(), 2)
+}
+
+func TestProcessContentOci(t *testing.T) {
+	layer := NewIntermediate("")
+	container := stage.NewContainer()
+	passwordFrom := &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "registry"},
+		Key:                  "token",
+	}}
+	step := contentStep(testworkflowsv1.Content{Oci: []testworkflowsv1.ContentOci{
+		{Ref: "registry.example.com/tests/e2e:1.0.0", Digest: "sha256:abc", Path: "/data/e2e", Username: "user", PasswordFrom: passwordFrom},
+		{Ref: "registry.local/tests/smoke:latest", Path: "/data/smoke", Insecure: true},
+	}})
+
+	result, err := ProcessContentOci(nil, layer, container, step)
+	require.NoError(t, err)
+
+	stages := result.(stage.GroupStage).ContainerStages()
+	require.Len(t, stages, 2)
+	assert.Equal(t, []string{constants.DefaultToolkitPath, "oci"}, stages[0].Container().Command())
+	assert.Equal(t, []string{
+		"registry.example.com/tests/e2e:1.0.0", "/data/e2e",
+		"-u", "user", "-p", "{{env.TK_OCI_PASSWORD}}", "--digest", "sha256:abc",
+	}, stages[0].Container().Args())
+	assert.Contains(t, stages[0].Container().Env(), testworkflowsv1.EnvVar{EnvVar: corev1.EnvVar{Name: "TK_OCI_PASSWORD", ValueFrom: passwordFrom}})
+	assert.NotContains(t, stages[1].Container().Env(), testworkflowsv1.EnvVar{EnvVar: corev1.EnvVar{Name: "TK_OCI_PASSWORD", ValueFrom: passwordFrom}})
+	assert.Equal(t, []string{"registry.local/tests/smoke:latest", "/data/smoke", "--insecure"}, stages[1].Container().Args())
+	assert.Len(t, container.VolumeMounts(), 2)
+}
+
+func TestProcessContentOci_ExistingVolumeWithoutMount(t *testing.T) {
+	layer := NewIntermediate("")
+	container := stage.NewContainer()
+	step := contentStep(testworkflowsv1.Content{Oci: []testworkflowsv1.ContentOci{
+		{Ref: "registry.example.com/tests/e2e:1.0.0", Path: "/data/e2e", Mount: common.Ptr(false)},
+	}})
+
+	_, err := ProcessContentOci(nil, layer, container, step)
+	assert.EqualError(t, err, "content.oci[0]: /data/e2e: is not part of any volume: should be mounted")
+}
+
+func TestProcessContentObjectStorage(t *testing.T) {
+	layer := NewIntermediate("")
+	container := stage.NewContainer()
+	step := contentStep(testworkflowsv1.Content{
+		S3: []testworkflowsv1.ContentObjectStorage{
+			{Endpoint: "minio:9000", Bucket: "tests", Key: "suites/", Path: "/data/suites", DisableSsl: true, AccessKeyId: "key", SecretAccessKey: "secret"},
+		},
+		Gcs: []testworkflowsv1.ContentObjectStorage{
+			{Bucket: "tests", Key: "bundle.tar.gz", Path: "/data/bundle", Unpack: true, Sha256: "abc"},
+		},
+	})
+
+	result, err := ProcessContentObjectStorage(nil, layer, container, step)
+	require.NoError(t, err)
+
+	stages := result.(stage.GroupStage).ContainerStages()
+	require.Len(t, stages, 2)
+	assert.Equal(t, []string{
+		"/data/suites", "--endpoint", "minio:9000", "--bucket", "tests", "--key", "suites/",
+		"--access-key-id", "key", "--secret-access-key", "secret", "--disable-ssl",
+	}, stages[0].Container().Args())
+	assert.Equal(t, []string{
+		"/data/bundle", "--endpoint", constants.DefaultGcsEndpoint, "--bucket", "tests", "--key", "bundle.tar.gz",
+		"--sha256", "abc", "--unpack",
+	}, stages[1].Container().Args())
+}
+
+func TestProcessContentObjectStorage_Sha256ForPrefix(t *testing.T) {
+	step := contentStep(testworkflowsv1.Content{S3: []testworkflowsv1.ContentObjectStorage{
+		{Bucket: "tests", Key: "suites/", Path: "/data/suites", Sha256: "abc"},
+	}})
+
+	_, err := ProcessContentObjectStorage(nil, NewIntermediate(""), stage.NewContainer(), step)
+	assert.EqualError(t, err, "content.s3[0]: sha256 is supported only for a single object, not for a prefix")
+}
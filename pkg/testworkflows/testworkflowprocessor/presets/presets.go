@@ -12,8 +12,11 @@ func NewOpenSource(inspector imageinspector.Inspector) testworkflowprocessor.Pro
 		Register(testworkflowprocessor.ProcessContentFiles).
 		Register(testworkflowprocessor.ProcessContentGit).
 		Register(testworkflowprocessor.ProcessContentTarball).
+		Register(testworkflowprocessor.ProcessContentOci).
+		Register(testworkflowprocessor.ProcessContentObjectStorage).
 		Register(testworkflowprocessor.StubServices).
 		Register(testworkflowprocessor.ProcessNestedSetupSteps).
+		Register(testworkflowprocessor.ProcessApproval).
 		Register(testworkflowprocessor.ProcessRunCommand).
 		Register(testworkflowprocessor.ProcessShellCommand).
 		Register(testworkflowprocessor.StubExecute).
@@ -28,8 +31,11 @@ func NewPro(inspector imageinspector.Inspector) testworkflowprocessor.Processor
 		Register(testworkflowprocessor.ProcessContentFiles).
 		Register(testworkflowprocessor.ProcessContentGit).
 		Register(testworkflowprocessor.ProcessContentTarball).
+		Register(testworkflowprocessor.ProcessContentOci).
+		Register(testworkflowprocessor.ProcessContentObjectStorage).
 		Register(testworkflowprocessortcl.ProcessServicesStart).
 		Register(testworkflowprocessor.ProcessNestedSetupSteps).
+		Register(testworkflowprocessor.ProcessApproval).
 		Register(testworkflowprocessor.ProcessRunCommand).
 		Register(testworkflowprocessor.ProcessShellCommand).
 		Register(testworkflowprocessortcl.ProcessExecute).
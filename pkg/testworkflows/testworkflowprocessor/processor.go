@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -68,6 +69,7 @@ func (p *processor) process(layer Intermediate, container stage.Container, step
 	self := stage.NewGroupStage(ref, false)
 	self.SetPure(step.Pure)
 	self.SetId(step.Id)
+	self.SetOutputs(step.Outputs)
 	self.SetName(step.Name)
 	self.SetOptional(step.Optional).SetNegative(step.Negative).SetTimeout(step.Timeout).SetPaused(step.Paused)
 	if step.Condition == "" {
@@ -151,7 +153,11 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 	}
 
 	// Initialize intermediate layer
-	layer := NewIntermediate(options.Config.Worker.EmptyDirSizeLimit).
+	refCounter := options.RefCounter
+	if refCounter == nil {
+		refCounter = NewRefCounter()
+	}
+	layer := newIntermediate(options.Config.Worker.EmptyDirSizeLimit, refCounter).
 		AppendPodConfig(workflow.Spec.Pod).
 		AppendJobConfig(workflow.Spec.Job).
 		AppendPvcs(workflow.Spec.Pvcs)
@@ -188,6 +194,13 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 		return nil, errors.New("could not resolve resource.root")
 	}
 
+	// Expose the dedicated namespace of the ephemeral environment
+	environmentNamespace := ""
+	if workflow.Spec.Environment != nil {
+		environmentNamespace = EnvironmentNamespaceName(options.Config.Resource.Id)
+		machines = append(machines, createEnvironmentMachine(environmentNamespace))
+	}
+
 	err = expressions.Simplify(&workflow, machines...)
 	if err != nil {
 		return nil, errors.Wrap(err, "error while simplifying workflow instructions")
@@ -199,6 +212,16 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 		return nil, errors.Wrap(err, "step id validation")
 	}
 
+	// Provision the ephemeral environment before all the other steps
+	setup := workflow.Spec.Setup
+	if workflow.Spec.Environment != nil {
+		environmentStep, err := buildEnvironmentStep(workflow.Spec.Environment, environmentNamespace)
+		if err != nil {
+			return nil, err
+		}
+		setup = append([]testworkflowsv1.Step{environmentStep}, setup...)
+	}
+
 	// Process steps - must be after ResolveAndValidateStepIds so rootStep
 	// picks up the resolved IDs from the spec.
 	rootStep := testworkflowsv1.Step{
@@ -209,7 +232,7 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 		StepDefaults: testworkflowsv1.StepDefaults{
 			Container: workflow.Spec.Container,
 		},
-		Steps: append(workflow.Spec.Setup, append(workflow.Spec.Steps, workflow.Spec.After...)...),
+		Steps: append(setup, append(workflow.Spec.Steps, workflow.Spec.After...)...),
 	}
 
 	root, err := p.process(layer, layer.ContainerDefaults(), rootStep, constants.RootOperationName)
@@ -633,6 +656,24 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 	addEnvVarToContainerSpec(mapEnv, jobSpec.Spec.Template.Spec.InitContainers)
 	addEnvVarToContainerSpec(mapEnv, jobSpec.Spec.Template.Spec.Containers)
 
+	// Build the dedicated namespace for the ephemeral environment
+	var namespaces []corev1.Namespace
+	var roleBindings []rbacv1.RoleBinding
+	if workflow.Spec.Environment != nil {
+		serviceAccountNamespace := jobSpec.Namespace
+		if serviceAccountNamespace == "" {
+			serviceAccountNamespace = options.Config.Worker.Namespace
+		}
+		namespace, roleBinding := buildEnvironmentResources(workflow.Spec.Environment, environmentNamespace, serviceAccountNamespace, podSpec.Spec.ServiceAccountName)
+		AnnotateControlledBy(&namespace, options.Config.Resource.RootId, options.Config.Resource.Id)
+		err = expressions.FinalizeForce(&namespace, machines...)
+		if err != nil {
+			return nil, errors.Wrap(err, "finalizing environment namespace")
+		}
+		namespaces = append(namespaces, namespace)
+		roleBindings = append(roleBindings, roleBinding)
+	}
+
 	// Build running instructions
 	sigSerialized, _ := json.Marshal(sig)
 	actionGroupsSerialized, _ := json.Marshal(actionGroups)
@@ -652,6 +693,8 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 		ConfigMaps:    configMaps,
 		Secrets:       secrets,
 		Pvcs:          pvcs,
+		Namespaces:    namespaces,
+		RoleBindings:  roleBindings,
 		Job:           jobSpec,
 		Signature:     sig,
 		FullSignature: fullSig,
@@ -2,25 +2,66 @@ package testworkflowprocessor
 
 import (
 	"fmt"
+	mathrand "math/rand"
 	"strconv"
+	"sync"
 	"sync/atomic"
 
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
+const (
+	// refAlphanums are the characters of the random references part, the same as in the rand.String
+	refAlphanums         = "bcdfghjklmnpqrstvwxz2456789"
+	refAlphanumsIdxBits  = 5
+	refAlphanumsIdxMask  = 1<<refAlphanumsIdxBits - 1
+	refAlphanumsPerInt63 = 63 / refAlphanumsIdxBits
+)
+
 type RefCounter interface {
 	NextRef() string
 }
 
 type refCounter struct {
 	refCount atomic.Uint64
+	mu       sync.Mutex
+	random   *mathrand.Rand
 }
 
 func NewRefCounter() RefCounter {
 	return &refCounter{}
 }
 
+// NewSeededRefCounter creates the reference counter with its own random generator,
+// so it generates the same references for the same seed.
+func NewSeededRefCounter(seed int64) RefCounter {
+	return &refCounter{random: mathrand.New(mathrand.NewSource(seed))}
+}
+
 func (r *refCounter) NextRef() string {
 	next := r.refCount.Add(1)
-	return fmt.Sprintf("r%s%s", rand.String(5), strconv.FormatUint(next, 36))
+	return fmt.Sprintf("r%s%s", r.randomString(5), strconv.FormatUint(next, 36))
+}
+
+func (r *refCounter) randomString(n int) string {
+	if r.random == nil {
+		return rand.String(n)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b := make([]byte, n)
+	randomInt63 := r.random.Int63()
+	remaining := refAlphanumsPerInt63
+	for i := 0; i < n; {
+		if remaining == 0 {
+			randomInt63, remaining = r.random.Int63(), refAlphanumsPerInt63
+		}
+		if idx := int(randomInt63 & refAlphanumsIdxMask); idx < len(refAlphanums) {
+			b[i] = refAlphanums[idx]
+			i++
+		}
+		randomInt63 >>= refAlphanumsIdxBits
+		remaining--
+	}
+	return string(b)
 }
@@ -0,0 +1,16 @@
+package testworkflowprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeededRefCounter(t *testing.T) {
+	first, second := NewSeededRefCounter(0), NewSeededRefCounter(0)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, first.NextRef(), second.NextRef())
+	}
+	assert.NotEqual(t, NewSeededRefCounter(0).NextRef(), NewSeededRefCounter(1).NextRef())
+	assert.Regexp(t, "^r[bcdfghjklmnpqrstvwxz2456789]{5}1$", NewSeededRefCounter(0).NextRef())
+}
@@ -156,6 +156,7 @@ func (s *groupStage) Flatten() []Stage {
 		}
 		if first.Id() == "" {
 			first.SetId(s.id)
+			first.SetOutputs(s.outputs)
 		}
 		if first.Condition() == "" {
 			// Virtualize with the default condition
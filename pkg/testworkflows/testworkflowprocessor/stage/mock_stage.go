@@ -158,20 +158,6 @@ func (mr *MockStageMockRecorder) GetImages(isGroupNeeded any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImages", reflect.TypeOf((*MockStage)(nil).GetImages), isGroupNeeded)
 }
 
-// Id mocks base method.
-func (m *MockStage) Id() string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Id")
-	ret0, _ := ret[0].(string)
-	return ret0
-}
-
-// Id indicates an expected call of Id.
-func (mr *MockStageMockRecorder) Id() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockStage)(nil).Id))
-}
-
 // HasPause mocks base method.
 func (m *MockStage) HasPause() bool {
 	m.ctrl.T.Helper()
@@ -186,6 +172,20 @@ func (mr *MockStageMockRecorder) HasPause() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPause", reflect.TypeOf((*MockStage)(nil).HasPause))
 }
 
+// Id mocks base method.
+func (m *MockStage) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockStageMockRecorder) Id() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockStage)(nil).Id))
+}
+
 // Len mocks base method.
 func (m *MockStage) Len() int {
 	m.ctrl.T.Helper()
@@ -242,6 +242,20 @@ func (mr *MockStageMockRecorder) Optional() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Optional", reflect.TypeOf((*MockStage)(nil).Optional))
 }
 
+// Outputs mocks base method.
+func (m *MockStage) Outputs() []v1.StepOutput {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].([]v1.StepOutput)
+	return ret0
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockStageMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockStage)(nil).Outputs))
+}
+
 // Paused mocks base method.
 func (m *MockStage) Paused() bool {
 	m.ctrl.T.Helper()
@@ -302,20 +316,6 @@ func (mr *MockStageMockRecorder) RetryPolicy() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryPolicy", reflect.TypeOf((*MockStage)(nil).RetryPolicy))
 }
 
-// SetId mocks base method.
-func (m *MockStage) SetId(id string) StageMetadata {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetId", id)
-	ret0, _ := ret[0].(StageMetadata)
-	return ret0
-}
-
-// SetId indicates an expected call of SetId.
-func (mr *MockStageMockRecorder) SetId(id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetId", reflect.TypeOf((*MockStage)(nil).SetId), id)
-}
-
 // SetCategory mocks base method.
 func (m *MockStage) SetCategory(category string) StageMetadata {
 	m.ctrl.T.Helper()
@@ -344,6 +344,20 @@ func (mr *MockStageMockRecorder) SetCondition(expr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCondition", reflect.TypeOf((*MockStage)(nil).SetCondition), expr)
 }
 
+// SetId mocks base method.
+func (m *MockStage) SetId(id string) StageMetadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetId", id)
+	ret0, _ := ret[0].(StageMetadata)
+	return ret0
+}
+
+// SetId indicates an expected call of SetId.
+func (mr *MockStageMockRecorder) SetId(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetId", reflect.TypeOf((*MockStage)(nil).SetId), id)
+}
+
 // SetName mocks base method.
 func (m *MockStage) SetName(name string) StageMetadata {
 	m.ctrl.T.Helper()
@@ -386,6 +400,20 @@ func (mr *MockStageMockRecorder) SetOptional(optional any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOptional", reflect.TypeOf((*MockStage)(nil).SetOptional), optional)
 }
 
+// SetOutputs mocks base method.
+func (m *MockStage) SetOutputs(outputs []v1.StepOutput) StageMetadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutputs", outputs)
+	ret0, _ := ret[0].(StageMetadata)
+	return ret0
+}
+
+// SetOutputs indicates an expected call of SetOutputs.
+func (mr *MockStageMockRecorder) SetOutputs(outputs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutputs", reflect.TypeOf((*MockStage)(nil).SetOutputs), outputs)
+}
+
 // SetPaused mocks base method.
 func (m *MockStage) SetPaused(paused bool) StageLifecycle {
 	m.ctrl.T.Helper()
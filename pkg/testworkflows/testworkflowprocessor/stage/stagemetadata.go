@@ -1,14 +1,20 @@
 package stage
 
+import (
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+)
+
 type StageMetadata interface {
 	Ref() string
 	Id() string
 	Name() string
 	Category() string
+	Outputs() []testworkflowsv1.StepOutput
 
 	SetId(id string) StageMetadata
 	SetName(name string) StageMetadata
 	SetCategory(category string) StageMetadata
+	SetOutputs(outputs []testworkflowsv1.StepOutput) StageMetadata
 }
 
 type stageMetadata struct {
@@ -16,6 +22,7 @@ type stageMetadata struct {
 	id       string
 	name     string
 	category string
+	outputs  []testworkflowsv1.StepOutput
 }
 
 func NewStageMetadata(ref string) StageMetadata {
@@ -38,6 +45,10 @@ func (s *stageMetadata) Category() string {
 	return s.category
 }
 
+func (s *stageMetadata) Outputs() []testworkflowsv1.StepOutput {
+	return s.outputs
+}
+
 func (s *stageMetadata) SetId(id string) StageMetadata {
 	s.id = id
 	return s
@@ -52,3 +63,8 @@ func (s *stageMetadata) SetCategory(category string) StageMetadata {
 	s.category = category
 	return s
 }
+
+func (s *stageMetadata) SetOutputs(outputs []testworkflowsv1.StepOutput) StageMetadata {
+	s.outputs = outputs
+	return s
+}
@@ -76,6 +76,11 @@ func createConfigMachine(cfg map[string]intstr.IntOrString, schema map[string]te
 	return machine, nil
 }
 
+// CreateConfigMachine builds the machine for "config.*" parameters, falling back to the defaults from the schema
+func CreateConfigMachine(cfg map[string]intstr.IntOrString, schema map[string]testworkflowsv1.ParameterSchema) (expressions.Machine, error) {
+	return createConfigMachine(cfg, schema, nil)
+}
+
 func EnvVarSourceToSecretExpression(fn func(key, value string) (*corev1.EnvVarSource, error)) func(key, value string) (expressions.Expression, error) {
 	return func(key, value string) (expressions.Expression, error) {
 		envVar, err := fn(key, value)
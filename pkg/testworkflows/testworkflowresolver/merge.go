@@ -319,6 +319,9 @@ func MergeContent(dst, include *testworkflowsv1.Content) *testworkflowsv1.Conten
 	dst.Files = append(dst.Files, include.Files...)
 	dst.Git = MergeContentGit(dst.Git, include.Git)
 	dst.Tarball = append(dst.Tarball, include.Tarball...)
+	dst.Oci = append(dst.Oci, include.Oci...)
+	dst.S3 = append(dst.S3, include.S3...)
+	dst.Gcs = append(dst.Gcs, include.Gcs...)
 	return dst
 }
 
@@ -443,6 +446,9 @@ func MergeExecution(dst, include *testworkflowsv1.TestWorkflowExecutionSchema) *
 	if include.Silent != nil {
 		dst.Silent = include.Silent
 	}
+	if include.RetryOnInfrastructureFailure != nil {
+		dst.RetryOnInfrastructureFailure = include.RetryOnInfrastructureFailure
+	}
 	return dst
 }
 
@@ -0,0 +1,492 @@
+package testworkflowscaffold
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type Framework string
+
+const (
+	FrameworkPlaywright Framework = "playwright"
+	FrameworkCypress    Framework = "cypress"
+	FrameworkNode       Framework = "node"
+	FrameworkMaven      Framework = "maven"
+	FrameworkGradle     Framework = "gradle"
+	FrameworkGo         Framework = "go"
+	FrameworkPytest     Framework = "pytest"
+	FrameworkK6         Framework = "k6"
+	FrameworkJMeter     Framework = "jmeter"
+
+	// maxDepth is how deep the repository is inspected for the projects
+	maxDepth = 2
+	// maxScriptSize is the largest file that is read to detect the test scripts
+	maxScriptSize = 1024 * 1024
+)
+
+var (
+	// skippedDirs are never inspected, as they contain dependencies or build outputs
+	skippedDirs = []string{"node_modules", "vendor", "target", "build", "dist", "venv", "__pycache__"}
+
+	k6ImportRe        = regexp.MustCompile(`(?m)(from\s+|require\()\s*['"]k6(/[a-z0-9/-]+)?['"]`)
+	exactVersionRe    = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	versionRangeRe    = regexp.MustCompile(`^[\^~=v]*(\d+\.\d+\.\d+)$`)
+	majorVersionRe    = regexp.MustCompile(`\d+`)
+	goVersionRe       = regexp.MustCompile(`(?m)^go\s+(\d+\.\d+)`)
+	pythonVersionRe   = regexp.MustCompile(`^(\d+\.\d+)`)
+	mavenJavaRe       = regexp.MustCompile(`<(?:maven\.compiler\.release|maven\.compiler\.source|java\.version|release)>\s*(?:1\.)?(\d+)\s*<`)
+	gradleJavaRe      = regexp.MustCompile(`JavaLanguageVersion\.of\(\s*(\d+)\s*\)|JavaVersion\.VERSION_(?:1_)?(\d+)|sourceCompatibility\s*=\s*['"]?(?:1\.)?(\d+)`)
+	npmDefaultTestRe  = regexp.MustCompile(`no test specified`)
+	pytestConfigFiles = map[string]string{"pyproject.toml": "[tool.pytest", "setup.cfg": "[tool:pytest]", "tox.ini": "[pytest]"}
+	requirementsFiles = []string{"requirements.txt", "requirements-dev.txt", "requirements-test.txt", "dev-requirements.txt", "test-requirements.txt"}
+)
+
+// Detection is the test project found in the repository
+type Detection struct {
+	Framework Framework
+	// Dir is the project directory, relative to the repository root
+	Dir string
+	// Evidence lists the files the project was detected from
+	Evidence []string
+	// Version is the framework or the runtime version pinned by the project, if any
+	Version string
+	// Install is the command installing the project dependencies
+	Install string
+	// Run is the command running the tests, reporting the results in the JUnit format when possible
+	Run string
+	// Files are the test scripts or plans, for the tools that run them one by one
+	Files []string
+	// Env are the environment variables for the test commands
+	Env map[string]string
+	// Cache lists the package manager directories worth persisting between executions
+	Cache []string
+	// Reports are the JUnit reports and other artifacts to collect after the tests
+	Reports []string
+	// Warnings are the notes about the parts of the project that need attention
+	Warnings []string
+}
+
+// Detect inspects the repository for the test projects of the supported frameworks.
+// The root directory and its subdirectories are inspected up to 2 levels deep.
+func Detect(fsys fs.FS) ([]Detection, error) {
+	var detections []Detection
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if p != "." && (strings.HasPrefix(d.Name(), ".") || slices.Contains(skippedDirs, d.Name())) {
+			return fs.SkipDir
+		}
+		found, err := detectDir(fsys, p)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		for _, f := range found {
+			// The modules of a multi-module project are run by its root
+			if len(f.Files) == 0 && slices.ContainsFunc(detections, func(d Detection) bool {
+				return d.Framework == f.Framework && isParentDir(d.Dir, f.Dir)
+			}) {
+				continue
+			}
+			detections = append(detections, f)
+		}
+		if p != "." && strings.Count(p, "/")+1 >= maxDepth {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	return detections, err
+}
+
+func isParentDir(parent, dir string) bool {
+	return parent == "." || strings.HasPrefix(dir, parent+"/")
+}
+
+// project is the directory being inspected
+type project struct {
+	fsys  fs.FS
+	dir   string
+	files []string
+}
+
+func (p *project) has(name string) bool {
+	return slices.Contains(p.files, name)
+}
+
+func (p *project) read(name string) (string, error) {
+	content, err := fs.ReadFile(p.fsys, path.Join(p.dir, name))
+	return string(content), err
+}
+
+// readIfExists reads the file, returning an empty string when it's not there
+func (p *project) readIfExists(name string) (string, error) {
+	if !p.has(name) {
+		return "", nil
+	}
+	return p.read(name)
+}
+
+func (p *project) detection(framework Framework, evidence ...string) Detection {
+	return Detection{Framework: framework, Dir: p.dir, Evidence: evidence}
+}
+
+func detectDir(fsys fs.FS, dir string) ([]Detection, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	p := &project{fsys: fsys, dir: dir}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			p.files = append(p.files, entry.Name())
+		}
+	}
+
+	var detections []Detection
+	for _, detect := range []func(*project) ([]Detection, error){
+		detectNode, detectMaven, detectGradle, detectGo, detectPytest, detectK6, detectJMeter,
+	} {
+		found, err := detect(p)
+		if err != nil {
+			return nil, err
+		}
+		detections = append(detections, found...)
+	}
+	return detections, nil
+}
+
+type packageJSON struct {
+	Scripts         map[string]string `json:"scripts"`
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+	PackageManager  string            `json:"packageManager"`
+	Engines         struct {
+		Node string `json:"node"`
+	} `json:"engines"`
+}
+
+func (p *packageJSON) dependency(name string) (string, bool) {
+	if v, ok := p.DevDependencies[name]; ok {
+		return v, true
+	}
+	v, ok := p.Dependencies[name]
+	return v, ok
+}
+
+// nodePackageManager describes how the dependencies are installed and the binaries are run
+type nodePackageManager struct {
+	name    string
+	install string
+	exec    string
+	cache   string
+}
+
+func detectPackageManager(p *project, pkg *packageJSON) nodePackageManager {
+	switch {
+	case p.has("pnpm-lock.yaml") || strings.HasPrefix(pkg.PackageManager, "pnpm@"):
+		return nodePackageManager{name: "pnpm", install: "corepack enable && pnpm install --frozen-lockfile", exec: "pnpm exec", cache: "/root/.local/share/pnpm/store"}
+	case p.has("yarn.lock") || strings.HasPrefix(pkg.PackageManager, "yarn@"):
+		return nodePackageManager{name: "yarn", install: "corepack enable && yarn install", exec: "yarn", cache: "/usr/local/share/.cache/yarn"}
+	case p.has("package-lock.json") || p.has("npm-shrinkwrap.json"):
+		return nodePackageManager{name: "npm", install: "npm ci", exec: "npx", cache: "/root/.npm"}
+	}
+	return nodePackageManager{name: "npm", install: "npm install", exec: "npx", cache: "/root/.npm"}
+}
+
+// lockedVersion reads the installed package version from the npm lockfile
+func lockedVersion(p *project, name string) string {
+	content, err := p.readIfExists("package-lock.json")
+	if err != nil || content == "" {
+		return ""
+	}
+	var lock struct {
+		Packages map[string]struct {
+			Version string `json:"version"`
+		} `json:"packages"`
+	}
+	if json.Unmarshal([]byte(content), &lock) != nil {
+		return ""
+	}
+	return lock.Packages["node_modules/"+name].Version
+}
+
+// packageVersion finds the version of the package, to match the image with the installed package
+func packageVersion(p *project, pkg *packageJSON, name string) (version string, warning string) {
+	if v := lockedVersion(p, name); exactVersionRe.MatchString(v) {
+		return v, ""
+	}
+	v, _ := pkg.dependency(name)
+	if exactVersionRe.MatchString(v) {
+		return v, ""
+	}
+	if m := versionRangeRe.FindStringSubmatch(v); m != nil {
+		return m[1], fmt.Sprintf("%s version range %s is resolved to %s, pin the version to keep the image in sync", name, v, m[1])
+	}
+	return "", fmt.Sprintf("%s version %q can't be resolved, the default image is used", name, v)
+}
+
+func detectNode(p *project) ([]Detection, error) {
+	if !p.has("package.json") {
+		return nil, nil
+	}
+	content, err := p.read("package.json")
+	if err != nil {
+		return nil, err
+	}
+	var pkg packageJSON
+	if err = json.Unmarshal([]byte(content), &pkg); err != nil {
+		return nil, fmt.Errorf("parsing package.json: %w", err)
+	}
+	pm := detectPackageManager(p, &pkg)
+
+	var detections []Detection
+	if _, ok := pkg.dependency("@playwright/test"); ok {
+		d := p.detection(FrameworkPlaywright, "package.json")
+		version, warning := packageVersion(p, &pkg, "@playwright/test")
+		d.Version = version
+		if warning != "" {
+			d.Warnings = append(d.Warnings, warning)
+		}
+		d.Install = pm.install
+		d.Run = pm.exec + " playwright test --reporter=junit,list"
+		d.Env = map[string]string{"PLAYWRIGHT_JUNIT_OUTPUT_NAME": "junit.xml"}
+		d.Cache = []string{pm.cache}
+		d.Reports = []string{"junit.xml", "playwright-report/**", "test-results/**"}
+		detections = append(detections, d)
+	}
+	if _, ok := pkg.dependency("cypress"); ok {
+		d := p.detection(FrameworkCypress, "package.json")
+		version, warning := packageVersion(p, &pkg, "cypress")
+		d.Version = version
+		if warning != "" {
+			d.Warnings = append(d.Warnings, warning)
+		}
+		d.Install = pm.install
+		d.Run = pm.exec + ` cypress run --reporter junit --reporter-options "mochaFile=junit/results-[hash].xml"`
+		d.Cache = []string{pm.cache}
+		d.Reports = []string{"junit/*.xml", "cypress/videos/**", "cypress/screenshots/**"}
+		detections = append(detections, d)
+	}
+
+	d := p.detection(FrameworkNode, "package.json")
+	d.Install = pm.install
+	d.Cache = []string{pm.cache}
+	testScript := pkg.Scripts["test"]
+	_, hasJestJUnit := pkg.dependency("jest-junit")
+	_, hasMochaJUnit := pkg.dependency("mocha-junit-reporter")
+	switch {
+	case hasDependency(&pkg, "vitest"):
+		d.Run = pm.exec + " vitest run --reporter=default --reporter=junit --outputFile.junit=junit.xml"
+		d.Reports = []string{"junit.xml"}
+	case hasDependency(&pkg, "jest") && hasJestJUnit:
+		d.Run = pm.exec + " jest --ci --reporters=default --reporters=jest-junit"
+		d.Reports = []string{"junit.xml"}
+	case hasDependency(&pkg, "mocha") && hasMochaJUnit:
+		d.Run = pm.exec + " mocha --reporter mocha-junit-reporter --reporter-options mochaFile=junit.xml"
+		d.Reports = []string{"junit.xml"}
+	case hasDependency(&pkg, "jest"), hasDependency(&pkg, "mocha"),
+		testScript != "" && !npmDefaultTestRe.MatchString(testScript) && !strings.Contains(testScript, "playwright") && !strings.Contains(testScript, "cypress"):
+		d.Run = pm.name + " test"
+		d.Warnings = append(d.Warnings, "no JUnit reporter found, add jest-junit or mocha-junit-reporter to report the test results")
+	default:
+		return detections, nil
+	}
+	d.Version = nodeVersion(p, &pkg)
+	return append(detections, d), nil
+}
+
+func hasDependency(pkg *packageJSON, name string) bool {
+	_, ok := pkg.dependency(name)
+	return ok
+}
+
+// nodeVersion finds the major Node.js version the project is using
+func nodeVersion(p *project, pkg *packageJSON) string {
+	for _, name := range []string{".nvmrc", ".node-version"} {
+		if content, err := p.readIfExists(name); err == nil && content != "" {
+			if v := majorVersionRe.FindString(content); v != "" {
+				return v
+			}
+		}
+	}
+	return majorVersionRe.FindString(pkg.Engines.Node)
+}
+
+func detectMaven(p *project) ([]Detection, error) {
+	if !p.has("pom.xml") {
+		return nil, nil
+	}
+	content, err := p.read("pom.xml")
+	if err != nil {
+		return nil, err
+	}
+	d := p.detection(FrameworkMaven, "pom.xml")
+	if m := mavenJavaRe.FindStringSubmatch(content); m != nil {
+		d.Version = m[1]
+	}
+	d.Run = "mvn -B test"
+	d.Cache = []string{"/root/.m2/repository"}
+	d.Reports = []string{"**/target/surefire-reports/*.xml"}
+	return []Detection{d}, nil
+}
+
+func detectGradle(p *project) ([]Detection, error) {
+	var evidence []string
+	for _, name := range []string{"build.gradle", "build.gradle.kts"} {
+		if p.has(name) {
+			evidence = append(evidence, name)
+		}
+	}
+	if len(evidence) == 0 {
+		return nil, nil
+	}
+	content, err := p.read(evidence[0])
+	if err != nil {
+		return nil, err
+	}
+	d := p.detection(FrameworkGradle, evidence...)
+	if m := gradleJavaRe.FindStringSubmatch(content); m != nil {
+		d.Version = m[1] + m[2] + m[3]
+	}
+	d.Run = "gradle --no-daemon test"
+	if p.has("gradlew") {
+		d.Evidence = append(d.Evidence, "gradlew")
+		d.Run = "./gradlew --no-daemon test"
+	}
+	d.Env = map[string]string{"GRADLE_USER_HOME": "/root/.gradle"}
+	d.Cache = []string{"/root/.gradle"}
+	d.Reports = []string{"**/build/test-results/**/*.xml"}
+	return []Detection{d}, nil
+}
+
+func detectGo(p *project) ([]Detection, error) {
+	if !p.has("go.mod") {
+		return nil, nil
+	}
+	content, err := p.read("go.mod")
+	if err != nil {
+		return nil, err
+	}
+	d := p.detection(FrameworkGo, "go.mod")
+	if m := goVersionRe.FindStringSubmatch(content); m != nil {
+		d.Version = m[1]
+	}
+	d.Install = "go mod download"
+	d.Run = "go run gotest.tools/gotestsum@latest --junitfile junit.xml -- ./..."
+	d.Cache = []string{"/root/go/pkg/mod", "/root/.cache/go-build"}
+	d.Reports = []string{"junit.xml"}
+	return []Detection{d}, nil
+}
+
+func detectPytest(p *project) ([]Detection, error) {
+	var evidence []string
+	for _, name := range []string{"pytest.ini", "conftest.py"} {
+		if p.has(name) {
+			evidence = append(evidence, name)
+		}
+	}
+	for _, name := range []string{"pyproject.toml", "setup.cfg", "tox.ini"} {
+		content, err := p.readIfExists(name)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(content, pytestConfigFiles[name]) {
+			evidence = append(evidence, name)
+		}
+	}
+	var requirements []string
+	listed := false
+	for _, name := range requirementsFiles {
+		if !p.has(name) {
+			continue
+		}
+		content, err := p.read(name)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, name)
+		if strings.Contains(strings.ToLower(content), "pytest") {
+			listed = true
+			evidence = append(evidence, name)
+		}
+	}
+	if len(evidence) == 0 {
+		return nil, nil
+	}
+
+	d := p.detection(FrameworkPytest, evidence...)
+	install := []string{"pip install"}
+	if !listed {
+		install = append(install, "pytest")
+	}
+	for _, name := range requirements {
+		install = append(install, "-r", name)
+	}
+	if len(requirements) == 0 && (p.has("pyproject.toml") || p.has("setup.py")) {
+		install = append(install, "-e", ".")
+	}
+	d.Install = strings.Join(install, " ")
+	if content, err := p.readIfExists(".python-version"); err == nil {
+		if m := pythonVersionRe.FindStringSubmatch(strings.TrimSpace(content)); m != nil {
+			d.Version = m[1]
+		}
+	}
+	d.Run = "pytest --junitxml=junit.xml"
+	d.Cache = []string{"/root/.cache/pip"}
+	d.Reports = []string{"junit.xml"}
+	return []Detection{d}, nil
+}
+
+func detectK6(p *project) ([]Detection, error) {
+	var scripts []string
+	for _, name := range p.files {
+		ext := path.Ext(name)
+		if (ext != ".js" && ext != ".ts") || strings.Contains(name, ".config.") {
+			continue
+		}
+		info, err := fs.Stat(p.fsys, path.Join(p.dir, name))
+		if err != nil {
+			return nil, err
+		}
+		if info.Size() > maxScriptSize {
+			continue
+		}
+		content, err := p.read(name)
+		if err != nil {
+			return nil, err
+		}
+		if k6ImportRe.MatchString(content) {
+			scripts = append(scripts, name)
+		}
+	}
+	if len(scripts) == 0 {
+		return nil, nil
+	}
+	d := p.detection(FrameworkK6, scripts...)
+	d.Files = scripts
+	return []Detection{d}, nil
+}
+
+func detectJMeter(p *project) ([]Detection, error) {
+	var plans []string
+	for _, name := range p.files {
+		if path.Ext(name) == ".jmx" {
+			plans = append(plans, name)
+		}
+	}
+	if len(plans) == 0 {
+		return nil, nil
+	}
+	d := p.detection(FrameworkJMeter, plans...)
+	d.Files = plans
+	d.Reports = []string{"/data/artifacts/**"}
+	return []Detection{d}, nil
+}
@@ -0,0 +1,58 @@
+package testworkflowscaffold
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FindRepositoryRoot finds the Git repository containing the directory
+func FindRepositoryRoot(dir string) (string, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// GitOrigin reads the origin remote URI and the checked out branch of the Git repository.
+// Empty values are returned when they can't be determined, i.e. for the detached HEAD.
+func GitOrigin(fsys fs.FS) (uri, branch string) {
+	if head, err := fs.ReadFile(fsys, ".git/HEAD"); err == nil {
+		ref := strings.TrimSpace(string(head))
+		if strings.HasPrefix(ref, "ref: refs/heads/") {
+			branch = strings.TrimPrefix(ref, "ref: refs/heads/")
+		}
+	}
+
+	file, err := fsys.Open(".git/config")
+	if err != nil {
+		return "", branch
+	}
+	defer file.Close()
+	origin := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			origin = line == `[remote "origin"]`
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if origin && ok && strings.TrimSpace(key) == "url" {
+			return strings.TrimSpace(value), branch
+		}
+	}
+	return "", branch
+}
@@ -0,0 +1,98 @@
+package testworkflowscaffold
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/marketplace"
+)
+
+// CatalogEntry is the marketplace workflow running the framework tests
+type CatalogEntry struct {
+	Workflow marketplace.Workflow
+	YAML     []byte
+}
+
+// FindCatalogEntries looks up the marketplace catalog for the workflows of the detected frameworks.
+// The catalog entries are matched by their component, the frameworks without the entry are not included.
+func FindCatalogEntries(ctx context.Context, client *marketplace.Client, detections []Detection) (map[Framework]CatalogEntry, error) {
+	workflows, err := client.ListWorkflows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[Framework]CatalogEntry)
+	for _, d := range detections {
+		if _, ok := entries[d.Framework]; ok {
+			continue
+		}
+		i := slices.IndexFunc(workflows, func(w marketplace.Workflow) bool {
+			return strings.EqualFold(w.Component, string(d.Framework))
+		})
+		if i == -1 {
+			continue
+		}
+		content, err := client.GetWorkflowYAML(ctx, workflows[i])
+		if err != nil {
+			return nil, fmt.Errorf("fetching %s workflow: %w", workflows[i].Name, err)
+		}
+		entries[d.Framework] = CatalogEntry{Workflow: workflows[i], YAML: content}
+	}
+	return entries, nil
+}
+
+// catalogSteps adds the marketplace workflow steps, configured with the detected project settings.
+// The repository replaces the workflow content, and its config parameters are exposed by the generated workflow.
+func (g *generator) catalogSteps(step *testworkflowsv1.Step, container *testworkflowsv1.ContainerConfig, entry CatalogEntry, d Detection) error {
+	params, err := marketplace.ExtractParameters(entry.YAML)
+	if err != nil {
+		return err
+	}
+	config := templateConfig(d)
+	for i := range params {
+		if value := config[params[i].Key]; value != "" {
+			params[i].Value = value
+		}
+	}
+	content, err := marketplace.ApplyParameters(entry.YAML, params)
+	if err != nil {
+		return err
+	}
+	var workflow testworkflowsv1.TestWorkflow
+	if err = yaml.NewYAMLOrJSONDecoder(bytes.NewReader(content), len(content)).Decode(&workflow); err != nil {
+		return fmt.Errorf("parsing workflow: %w", err)
+	}
+	spec := workflow.Spec
+
+	if spec.Content != nil {
+		g.warn(d.Dir, "the content of the %s marketplace workflow is replaced with the repository", entry.Workflow.Name)
+	}
+	if len(spec.After) > 0 || spec.Pod != nil || spec.Job != nil || len(spec.Events) > 0 {
+		g.warn(d.Dir, "only the steps, services and container settings of the %s marketplace workflow are used", entry.Workflow.Name)
+	}
+	for name, param := range spec.Config {
+		if _, ok := g.config[name]; ok {
+			g.warn(d.Dir, "config parameter %s of the %s marketplace workflow is already defined, skipped", name, entry.Workflow.Name)
+			continue
+		}
+		g.config[name] = param
+	}
+
+	step.Container = spec.Container
+	if container != nil {
+		if step.Container == nil {
+			step.Container = &testworkflowsv1.ContainerConfig{}
+		}
+		step.Container.Env = append(step.Container.Env, container.Env...)
+		step.Container.VolumeMounts = append(step.Container.VolumeMounts, container.VolumeMounts...)
+	}
+	step.Use = spec.Use
+	step.Services = spec.Services
+	step.Steps = append(spec.Setup, spec.Steps...)
+	return nil
+}
@@ -0,0 +1,306 @@
+// Package testworkflowscaffold generates the Test Workflow for the test projects found in the repository.
+// The official Test Workflow Templates and the marketplace workflows are used for the frameworks they support.
+package testworkflowscaffold
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/k8s/templates"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowimport"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+const (
+	// ArtifactsPath is where the tools run per file store their reports
+	ArtifactsPath = "/data/artifacts"
+
+	cacheVolumeName = "cache"
+)
+
+// frameworkInfo describes how the framework tests are run
+type frameworkInfo struct {
+	displayName string
+	// template is the official template running the tests, if there is one
+	template string
+	// image is the image format for the frameworks without the template, with the version placeholder
+	image string
+	// defaultVersion is used for the image when the project doesn't pin the version
+	defaultVersion string
+}
+
+var frameworks = map[Framework]frameworkInfo{
+	FrameworkPlaywright: {displayName: "Playwright", template: "official/playwright/v1"},
+	FrameworkCypress:    {displayName: "Cypress", template: "official/cypress/v1"},
+	FrameworkNode:       {displayName: "Node.js", image: "node:%s", defaultVersion: "22"},
+	FrameworkMaven:      {displayName: "Maven", template: "official/maven/v1"},
+	FrameworkGradle:     {displayName: "Gradle", template: "official/gradle/v1"},
+	FrameworkGo:         {displayName: "Go", image: "golang:%s", defaultVersion: "1"},
+	FrameworkPytest:     {displayName: "pytest", image: "python:%s", defaultVersion: "3.12"},
+	FrameworkK6:         {displayName: "k6", template: "official/k6/v1"},
+	FrameworkJMeter:     {displayName: "JMeter", template: "official/jmeter/v2"},
+}
+
+// Options configures the generated Test Workflow
+type Options struct {
+	// Name of the generated Test Workflow
+	Name string
+	// Repository is the Git URI to clone, otherwise it's a required config parameter
+	Repository string
+	// Revision is the Git revision to clone
+	Revision string
+	// Cache persists the package manager caches on the "<name>-cache" PersistentVolumeClaim
+	Cache bool
+	// Inline resolves the official templates into the steps, so the workflow doesn't depend on them
+	Inline bool
+	// Catalog are the marketplace workflows to use for the frameworks, instead of the official templates
+	Catalog map[Framework]CatalogEntry
+}
+
+// Result is the generated Test Workflow along with the notes about the detected projects
+type Result struct {
+	Workflow *testworkflowsv1.TestWorkflow
+	Warnings []testworkflowimport.Warning
+}
+
+// OfficialTemplates returns the official templates embedded in the binary, by their internal name
+func OfficialTemplates() (map[string]*testworkflowsv1.TestWorkflowTemplate, error) {
+	officials, _, err := templates.ParseAllOfficialKubeTemplates()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*testworkflowsv1.TestWorkflowTemplate, len(officials))
+	for i := range officials {
+		result[officials[i].Name] = &officials[i]
+	}
+	return result, nil
+}
+
+// Generate creates the Test Workflow running the tests of all the detected projects
+func Generate(detections []Detection, opts Options) (*Result, error) {
+	if len(detections) == 0 {
+		return nil, fmt.Errorf("no supported test frameworks detected")
+	}
+	if opts.Name == "" {
+		opts.Name = "tests"
+	}
+	officials, err := OfficialTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("reading official templates: %w", err)
+	}
+
+	g := &generator{opts: opts, config: make(map[string]testworkflowsv1.ParameterSchema)}
+	for _, d := range detections {
+		step, err := g.step(d)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Dir, err)
+		}
+		g.steps = append(g.steps, step)
+	}
+	workflow := g.build()
+
+	if opts.Inline {
+		if err = testworkflowresolver.ApplyTemplates(workflow, officials, nil); err != nil {
+			return nil, fmt.Errorf("resolving templates: %w", err)
+		}
+	}
+	if err = testworkflowimport.Validate(workflow, officials); err != nil {
+		return nil, fmt.Errorf("generated workflow is invalid: %w", err)
+	}
+	return &Result{Workflow: workflow, Warnings: g.warnings}, nil
+}
+
+type generator struct {
+	opts     Options
+	warnings []testworkflowimport.Warning
+	config   map[string]testworkflowsv1.ParameterSchema
+	steps    []testworkflowsv1.Step
+	caches   bool
+}
+
+func (g *generator) warn(dir, format string, args ...interface{}) {
+	w := testworkflowimport.Warning{Path: dir, Message: fmt.Sprintf(format, args...)}
+	if !slices.Contains(g.warnings, w) {
+		g.warnings = append(g.warnings, w)
+	}
+}
+
+// step creates the group step running the tests of the project
+func (g *generator) step(d Detection) (testworkflowsv1.Step, error) {
+	info := frameworks[d.Framework]
+	name := info.displayName
+	if d.Dir != "." {
+		name = fmt.Sprintf("%s (%s)", info.displayName, d.Dir)
+	}
+	for _, warning := range d.Warnings {
+		g.warn(d.Dir, "%s", warning)
+	}
+
+	step := testworkflowsv1.Step{StepMeta: testworkflowsv1.StepMeta{Name: name}}
+	if d.Dir != "." {
+		step.WorkingDir = common.Ptr(path.Join(testworkflowimport.RepositoryPath, d.Dir))
+	}
+	container := g.container(d)
+
+	if entry, ok := g.opts.Catalog[d.Framework]; ok {
+		if err := g.catalogSteps(&step, container, entry, d); err != nil {
+			return step, fmt.Errorf("using %s marketplace workflow: %w", entry.Workflow.Name, err)
+		}
+	} else {
+		step.Container = container
+		g.frameworkSteps(&step, info, d)
+	}
+
+	if len(d.Reports) > 0 {
+		step.Steps = append(step.Steps, testworkflowsv1.Step{
+			StepMeta:       testworkflowsv1.StepMeta{Name: "Save test reports", Condition: "always"},
+			StepOperations: testworkflowsv1.StepOperations{Artifacts: &testworkflowsv1.StepArtifacts{Paths: d.Reports}},
+		})
+	}
+	return step, nil
+}
+
+// container creates the container defaults with the environment variables and the cache volumes
+func (g *generator) container(d Detection) *testworkflowsv1.ContainerConfig {
+	container := &testworkflowsv1.ContainerConfig{}
+	for _, key := range slices.Sorted(maps.Keys(d.Env)) {
+		container.Env = append(container.Env, testworkflowsv1.EnvVar{EnvVar: corev1.EnvVar{Name: key, Value: d.Env[key]}})
+	}
+	if g.opts.Cache {
+		for _, p := range d.Cache {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      cacheVolumeName,
+				MountPath: p,
+				SubPath:   testworkflowimport.SanitizeName(p),
+			})
+			g.caches = true
+		}
+	}
+	if len(container.Env) == 0 && len(container.VolumeMounts) == 0 {
+		return nil
+	}
+	return container
+}
+
+// templateConfig is the official template configuration for the detected project
+func templateConfig(d Detection) map[string]string {
+	config := map[string]string{"run": d.Run}
+	switch d.Framework {
+	case FrameworkPlaywright:
+		config["dependencies_command"] = d.Install
+		if d.Version != "" {
+			config["version"] = "v" + d.Version
+		}
+	case FrameworkCypress:
+		config["dependencies_command"] = d.Install
+		config["version"] = d.Version
+	case FrameworkMaven:
+		if d.Version != "" {
+			config["version"] = "3.9-eclipse-temurin-" + d.Version
+		}
+	case FrameworkGradle:
+		if d.Version != "" {
+			config["version"] = "8.7.0-jdk" + d.Version
+		}
+	}
+	return config
+}
+
+func templateStep(name, template string, config map[string]string) testworkflowsv1.Step {
+	ref := &testworkflowsv1.TemplateRef{Name: template, Config: make(map[string]intstr.IntOrString)}
+	for key, value := range config {
+		if value != "" {
+			ref.Config[key] = intstr.FromString(value)
+		}
+	}
+	return testworkflowsv1.Step{StepMeta: testworkflowsv1.StepMeta{Name: name}, Template: ref}
+}
+
+func shellStep(name, script string) testworkflowsv1.Step {
+	return testworkflowsv1.Step{
+		StepMeta:       testworkflowsv1.StepMeta{Name: name},
+		StepOperations: testworkflowsv1.StepOperations{Shell: script},
+	}
+}
+
+// frameworkSteps adds the steps running the tests with the official template, or directly in the runtime image
+func (g *generator) frameworkSteps(step *testworkflowsv1.Step, info frameworkInfo, d Detection) {
+	switch {
+	case len(d.Files) > 0:
+		for _, file := range d.Files {
+			base := strings.TrimSuffix(file, path.Ext(file))
+			run := ""
+			switch d.Framework {
+			case FrameworkK6:
+				run = fmt.Sprintf("mkdir -p %s && k6 run %s --summary-export %s/%s-summary.json", ArtifactsPath, file, ArtifactsPath, base)
+			case FrameworkJMeter:
+				run = fmt.Sprintf("jmeter -n -t %s -l %s/%s.jtl -e -o %s/%s-report", file, ArtifactsPath, base, ArtifactsPath, base)
+			}
+			step.Steps = append(step.Steps, templateStep(file, info.template, map[string]string{"run": run}))
+		}
+	case info.template != "":
+		step.Steps = append(step.Steps, templateStep("Run "+info.displayName+" tests", info.template, templateConfig(d)))
+	default:
+		if step.Container == nil {
+			step.Container = &testworkflowsv1.ContainerConfig{}
+		}
+		version := d.Version
+		if version == "" {
+			version = info.defaultVersion
+		}
+		step.Container.Image = fmt.Sprintf(info.image, version)
+		if d.Install != "" {
+			step.Steps = append(step.Steps, shellStep("Install dependencies", d.Install))
+		}
+		step.Steps = append(step.Steps, shellStep("Run "+info.displayName+" tests", d.Run))
+	}
+}
+
+func (g *generator) build() *testworkflowsv1.TestWorkflow {
+	uri := g.opts.Repository
+	if uri == "" {
+		g.config[testworkflowimport.RepositoryConfigName] = testworkflowsv1.ParameterSchema{
+			Description: "Git repository to clone",
+			Type:        testworkflowsv1.ParameterTypeString,
+		}
+		uri = "{{ config." + testworkflowimport.RepositoryConfigName + " }}"
+	}
+	spec := testworkflowsv1.TestWorkflowSpec{
+		TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+			Content: &testworkflowsv1.Content{Git: &testworkflowsv1.ContentGit{
+				Uri:       uri,
+				Revision:  g.opts.Revision,
+				MountPath: testworkflowimport.RepositoryPath,
+			}},
+			Container: &testworkflowsv1.ContainerConfig{WorkingDir: common.Ptr(testworkflowimport.RepositoryPath)},
+		},
+		Steps: g.steps,
+	}
+	if len(g.config) > 0 {
+		spec.Config = g.config
+	}
+	if g.caches {
+		claimName := g.opts.Name + "-cache"
+		spec.Pod = &testworkflowsv1.PodConfig{Volumes: []corev1.Volume{{
+			Name: cacheVolumeName,
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			}},
+		}}}
+		g.warn("", "caches are stored on the %s PersistentVolumeClaim, which needs to be created to persist them between executions", claimName)
+	}
+	return &testworkflowsv1.TestWorkflow{
+		TypeMeta:   metav1.TypeMeta{Kind: testworkflowsv1.Resource, APIVersion: testworkflowsv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: g.opts.Name},
+		Spec:       spec,
+	}
+}
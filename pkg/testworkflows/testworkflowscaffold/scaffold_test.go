@@ -0,0 +1,249 @@
+package testworkflowscaffold
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/marketplace"
+)
+
+func file(content string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(content)}
+}
+
+var repository = fstest.MapFS{
+	"go.mod": file("module example.com/app\n\ngo 1.22.3\n"),
+	"e2e/package.json": file(`{
+  "scripts": {"test": "playwright test"},
+  "devDependencies": {"@playwright/test": "^1.48.0", "vitest": "^2.0.0"}
+}`),
+	"e2e/package-lock.json":      file(`{"packages": {"node_modules/@playwright/test": {"version": "1.48.2"}}}`),
+	"e2e/.nvmrc":                 file("v20.11.0\n"),
+	"backend/pom.xml":            file("<project><properties><maven.compiler.release>21</maven.compiler.release></properties></project>"),
+	"backend/core/pom.xml":       file("<project></project>"),
+	"api/pytest.ini":             file("[pytest]\n"),
+	"api/requirements.txt":       file("requests==2.32.0\n"),
+	"api/.python-version":        file("3.11.4\n"),
+	"load/smoke.js":              file("import http from 'k6/http';\nexport default function () { http.get('https://test.k6.io'); }\n"),
+	"load/helpers.js":            file("export const url = 'https://test.k6.io';\n"),
+	"load/plan.jmx":              file("<jmeterTestPlan/>"),
+	"node_modules/x/pom.xml":     file("<project></project>"),
+	"tools/deep/nested/go.mod":   file("module example.com/tools\n"),
+	"docs/README.md":             file("# Docs\n"),
+	".github/workflows/ci.yaml":  file("jobs: {}\n"),
+	"frontend/package.json":      file(`{"scripts": {"test": "echo \"Error: no test specified\" && exit 1"}}`),
+	"gradle-app/build.gradle":    file("java {\n  toolchain {\n    languageVersion = JavaLanguageVersion.of(17)\n  }\n}\n"),
+	"gradle-app/gradlew":         file("#!/bin/sh\n"),
+	"cypress-app/package.json":   file(`{"devDependencies": {"cypress": "13.6.0"}}`),
+	"cypress-app/pnpm-lock.yaml": file("lockfileVersion: '9.0'\n"),
+}
+
+func detectionsByDir(t *testing.T) map[string][]Detection {
+	detections, err := Detect(repository)
+	require.NoError(t, err)
+	result := make(map[string][]Detection)
+	for _, d := range detections {
+		result[d.Dir] = append(result[d.Dir], d)
+	}
+	return result
+}
+
+func TestDetect(t *testing.T) {
+	detections := detectionsByDir(t)
+
+	// The dependencies, hidden directories, nested modules and too deep directories are not inspected
+	assert.ElementsMatch(t, []string{".", "e2e", "backend", "api", "load", "gradle-app", "cypress-app"}, func() []string {
+		var dirs []string
+		for dir := range detections {
+			dirs = append(dirs, dir)
+		}
+		return dirs
+	}())
+
+	assert.Equal(t, FrameworkGo, detections["."][0].Framework)
+	assert.Equal(t, "1.22", detections["."][0].Version)
+
+	require.Len(t, detections["e2e"], 2)
+	playwright, vitest := detections["e2e"][0], detections["e2e"][1]
+	assert.Equal(t, FrameworkPlaywright, playwright.Framework)
+	assert.Equal(t, "1.48.2", playwright.Version)
+	assert.Equal(t, "npm ci", playwright.Install)
+	assert.Equal(t, FrameworkNode, vitest.Framework)
+	assert.Equal(t, "20", vitest.Version)
+	assert.Equal(t, "npx vitest run --reporter=default --reporter=junit --outputFile.junit=junit.xml", vitest.Run)
+
+	assert.Equal(t, "21", detections["backend"][0].Version)
+
+	pytest := detections["api"][0]
+	assert.Equal(t, FrameworkPytest, pytest.Framework)
+	assert.Equal(t, "3.11", pytest.Version)
+	assert.Equal(t, "pip install pytest -r requirements.txt", pytest.Install)
+
+	require.Len(t, detections["load"], 2)
+	assert.Equal(t, []string{"smoke.js"}, detections["load"][0].Files)
+	assert.Equal(t, []string{"plan.jmx"}, detections["load"][1].Files)
+
+	gradle := detections["gradle-app"][0]
+	assert.Equal(t, "17", gradle.Version)
+	assert.Equal(t, "./gradlew --no-daemon test", gradle.Run)
+
+	cypress := detections["cypress-app"][0]
+	assert.Equal(t, "13.6.0", cypress.Version)
+	assert.Equal(t, "corepack enable && pnpm install --frozen-lockfile", cypress.Install)
+	assert.Equal(t, `pnpm exec cypress run --reporter junit --reporter-options "mochaFile=junit/results-[hash].xml"`, cypress.Run)
+}
+
+func TestGenerate(t *testing.T) {
+	detections, err := Detect(repository)
+	require.NoError(t, err)
+	result, err := Generate(detections, Options{Name: "app", Cache: true})
+	require.NoError(t, err)
+	spec := result.Workflow.Spec
+
+	assert.Equal(t, "app", result.Workflow.Name)
+	assert.Equal(t, "{{ config.repository }}", spec.Content.Git.Uri)
+	assert.Contains(t, spec.Config, "repository")
+	assert.Equal(t, "app-cache", spec.Pod.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+	steps := make(map[string]testworkflowsv1.Step)
+	for _, step := range spec.Steps {
+		steps[step.Name] = step
+	}
+	require.Len(t, steps, 9)
+
+	golang := steps["Go"]
+	assert.Nil(t, golang.WorkingDir)
+	assert.Equal(t, "golang:1.22", golang.Container.Image)
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "cache", MountPath: "/root/go/pkg/mod", SubPath: "root-go-pkg-mod"},
+		{Name: "cache", MountPath: "/root/.cache/go-build", SubPath: "root-cache-go-build"},
+	}, golang.Container.VolumeMounts)
+	require.Len(t, golang.Steps, 3)
+	assert.Equal(t, "go mod download", golang.Steps[0].Shell)
+	assert.Equal(t, "always", golang.Steps[2].Condition)
+	assert.Equal(t, []string{"junit.xml"}, golang.Steps[2].Artifacts.Paths)
+
+	playwright := steps["Playwright (e2e)"]
+	assert.Equal(t, "/data/repo/e2e", *playwright.WorkingDir)
+	assert.Equal(t, "PLAYWRIGHT_JUNIT_OUTPUT_NAME", playwright.Container.Env[0].Name)
+	assert.Equal(t, "official/playwright/v1", playwright.Steps[0].Template.Name)
+	assert.Equal(t, "v1.48.2", playwright.Steps[0].Template.Config["version"].StrVal)
+	assert.Equal(t, "npm ci", playwright.Steps[0].Template.Config["dependencies_command"].StrVal)
+
+	maven := steps["Maven (backend)"]
+	assert.Equal(t, "3.9-eclipse-temurin-21", maven.Steps[0].Template.Config["version"].StrVal)
+	assert.Equal(t, []string{"**/target/surefire-reports/*.xml"}, maven.Steps[1].Artifacts.Paths)
+
+	k6 := steps["k6 (load)"]
+	require.Len(t, k6.Steps, 1)
+	assert.Equal(t, "smoke.js", k6.Steps[0].Name)
+	assert.Equal(t, "mkdir -p /data/artifacts && k6 run smoke.js --summary-export /data/artifacts/smoke-summary.json", k6.Steps[0].Template.Config["run"].StrVal)
+
+	jmeter := steps["JMeter (load)"]
+	assert.Equal(t, "official/jmeter/v2", jmeter.Steps[0].Template.Name)
+	assert.Equal(t, []string{"/data/artifacts/**"}, jmeter.Steps[1].Artifacts.Paths)
+
+	assert.Equal(t, "python:3.11", steps["pytest (api)"].Container.Image)
+
+	assert.Contains(t, result.Warnings[len(result.Warnings)-1].String(), "app-cache PersistentVolumeClaim")
+}
+
+func TestGenerate_Inline(t *testing.T) {
+	detections := detectionsByDir(t)
+	result, err := Generate(detections["backend"], Options{Name: "backend", Repository: "https://github.com/org/repo.git", Revision: "main", Inline: true})
+	require.NoError(t, err)
+	spec := result.Workflow.Spec
+
+	assert.Empty(t, spec.Config)
+	assert.Equal(t, "main", spec.Content.Git.Revision)
+	require.Len(t, spec.Steps, 1)
+	run := spec.Steps[0].Steps[0]
+	assert.Nil(t, run.Template)
+	assert.Equal(t, "maven:3.9-eclipse-temurin-21", run.Steps[0].Run.Image)
+	assert.Equal(t, "mvn -B test", *run.Steps[0].Run.Shell)
+}
+
+func TestGenerate_Catalog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/catalog-index.json":
+			_, _ = w.Write([]byte(`[{"name": "k6-smoke", "component": "k6", "path": "k6/smoke.yaml"}]`))
+		case "/k6/smoke.yaml":
+			_, _ = w.Write([]byte(`kind: TestWorkflow
+apiVersion: testworkflows.testkube.io/v1
+metadata:
+  name: k6-smoke
+spec:
+  config:
+    run:
+      type: string
+      default: k6 run test.js
+    vus:
+      type: string
+      default: "10"
+  content:
+    files:
+    - path: test.js
+      content: ""
+  steps:
+  - name: Run k6
+    run:
+      image: grafana/k6:latest
+      shell: "{{ config.run }} --vus {{ config.vus }}"
+`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	detections := detectionsByDir(t)["load"]
+	entries, err := FindCatalogEntries(context.Background(), marketplace.NewClient(marketplace.WithBaseURL(server.URL)), detections)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "k6-smoke", entries[FrameworkK6].Workflow.Name)
+
+	result, err := Generate(detections, Options{Name: "load", Catalog: entries})
+	require.NoError(t, err)
+	spec := result.Workflow.Spec
+
+	assert.Equal(t, "10", spec.Config["vus"].Default.StrVal)
+	assert.Equal(t, "official/jmeter/v2", spec.Steps[1].Steps[0].Template.Name)
+	k6 := spec.Steps[0]
+	assert.Equal(t, "Run k6", k6.Steps[0].Name)
+	assert.Equal(t, []string{"load: the content of the k6-smoke marketplace workflow is replaced with the repository"}, func() []string {
+		var list []string
+		for _, w := range result.Warnings {
+			list = append(list, w.String())
+		}
+		return list
+	}())
+}
+
+func TestGenerate_NoDetections(t *testing.T) {
+	detections, err := Detect(fstest.MapFS{"README.md": file("# App\n")})
+	require.NoError(t, err)
+	_, err = Generate(detections, Options{})
+	assert.EqualError(t, err, "no supported test frameworks detected")
+}
+
+func TestGitOrigin(t *testing.T) {
+	uri, branch := GitOrigin(fstest.MapFS{
+		".git/HEAD":   file("ref: refs/heads/feature/scaffold\n"),
+		".git/config": file("[core]\n\tbare = false\n[remote \"upstream\"]\n\turl = https://github.com/kubeshop/testkube.git\n[remote \"origin\"]\n\turl = git@github.com:org/testkube.git\n\tfetch = +refs/heads/*:refs/remotes/origin/*\n"),
+	})
+	assert.Equal(t, "git@github.com:org/testkube.git", uri)
+	assert.Equal(t, "feature/scaffold", branch)
+
+	uri, branch = GitOrigin(fstest.MapFS{".git/HEAD": file("2b1c0e5f\n")})
+	assert.Empty(t, uri)
+	assert.Empty(t, branch)
+}
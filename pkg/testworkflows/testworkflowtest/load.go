@@ -0,0 +1,117 @@
+package testworkflowtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+)
+
+// Suite holds the Test Workflows, templates and test cases loaded from the file system
+type Suite struct {
+	Workflows map[string]*testworkflowsv1.TestWorkflow
+	Templates map[string]*testworkflowsv1.TestWorkflowTemplate
+	Tests     []*TestWorkflowTest
+}
+
+func NewSuite() *Suite {
+	return &Suite{
+		Workflows: make(map[string]*testworkflowsv1.TestWorkflow),
+		Templates: make(map[string]*testworkflowsv1.TestWorkflowTemplate),
+	}
+}
+
+// Load reads all the YAML files from the provided paths, recursively for directories
+func Load(paths ...string) (*Suite, error) {
+	suite := NewSuite()
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != root && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			// Ignore non-YAML files and the golden files with the expected output
+			ext := filepath.Ext(path)
+			if (ext != ".yaml" && ext != ".yml") || strings.Contains(d.Name(), ".golden.") {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			return errors.Wrap(suite.Add(path, content), path)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return suite, nil
+}
+
+// Add registers all the supported documents from the file content
+func (s *Suite) Add(path string, content []byte) error {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBuffer(content), len(content))
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		var meta metav1.TypeMeta
+		if err = json.Unmarshal(raw, &meta); err != nil {
+			return err
+		}
+		switch meta.Kind {
+		case "TestWorkflow":
+			var workflow testworkflowsv1.TestWorkflow
+			if err = json.Unmarshal(raw, &workflow); err != nil {
+				return errors.Wrap(err, "invalid TestWorkflow")
+			}
+			if _, ok := s.Workflows[workflow.Name]; ok {
+				return fmt.Errorf("duplicated TestWorkflow: %s", workflow.Name)
+			}
+			s.Workflows[workflow.Name] = &workflow
+		case "TestWorkflowTemplate":
+			var template testworkflowsv1.TestWorkflowTemplate
+			if err = json.Unmarshal(raw, &template); err != nil {
+				return errors.Wrap(err, "invalid TestWorkflowTemplate")
+			}
+			if _, ok := s.Templates[template.Name]; ok {
+				return fmt.Errorf("duplicated TestWorkflowTemplate: %s", template.Name)
+			}
+			s.Templates[template.Name] = &template
+		case TestKind:
+			var test TestWorkflowTest
+			if err = json.Unmarshal(raw, &test); err != nil {
+				return errors.Wrap(err, "invalid "+TestKind)
+			}
+			if test.Spec.Workflow == "" {
+				return fmt.Errorf("%s %s: workflow is required", TestKind, test.Name)
+			}
+			test.file = path
+			s.Tests = append(s.Tests, &test)
+		}
+	}
+}
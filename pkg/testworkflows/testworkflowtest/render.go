@@ -0,0 +1,134 @@
+package testworkflowtest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/expressions"
+	"github.com/kubeshop/testkube/pkg/imageinspector"
+	testworkflowmappers "github.com/kubeshop/testkube/pkg/mapper/testworkflows"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowconfig"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/presets"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/stage"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowresolver"
+)
+
+// scheduledAt is fixed, so the rendered output is stable across the runs
+var scheduledAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Output is the result of rendering the Test Workflow offline
+type Output struct {
+	Workflow *testworkflowsv1.TestWorkflow
+	Bundle   *testworkflowprocessor.Bundle
+}
+
+type staticInspector struct {
+	images map[string]ImageMetadata
+}
+
+func (s *staticInspector) Inspect(_ context.Context, _, image string, _ corev1.PullPolicy, _ []string) (*imageinspector.Info, error) {
+	metadata, ok := s.images[image]
+	if !ok {
+		if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+			metadata = s.images[image[:i]]
+		}
+	}
+	return &imageinspector.Info{
+		Entrypoint: metadata.Entrypoint,
+		Cmd:        metadata.Cmd,
+		Shell:      metadata.Shell,
+		WorkingDir: metadata.WorkingDir,
+		User:       metadata.User,
+		Group:      metadata.Group,
+	}, nil
+}
+
+func (s *staticInspector) ResolveName(_, image string) string {
+	return image
+}
+
+// offlineSecrets replaces the sensitive configuration with the references to the secret,
+// so the rendered output is not leaking them
+func offlineSecrets(workflowName string) func(key, value string) (expressions.Expression, error) {
+	return testworkflowresolver.EnvVarSourceToSecretExpression(func(key, _ string) (*corev1.EnvVarSource, error) {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretPrefix + workflowName},
+				Key:                  key,
+			},
+		}, nil
+	})
+}
+
+// Resolve applies the configuration and the templates to the Test Workflow, like it is done before the execution
+func (s *Suite) Resolve(name string, config map[string]string) (*testworkflowsv1.TestWorkflow, error) {
+	workflow, ok := s.Workflows[name]
+	if !ok {
+		return nil, fmt.Errorf(`workflow "%s" not found`, name)
+	}
+	workflow = workflow.DeepCopy()
+	externalize := offlineSecrets(workflow.Name)
+
+	_, err := testworkflowresolver.ApplyWorkflowConfig(workflow, testworkflowmappers.MapConfigValueAPIToKube(config), externalize)
+	if err != nil {
+		return nil, errors.Wrap(err, "applying config")
+	}
+	err = testworkflowresolver.ApplyTemplates(workflow, s.Templates, externalize)
+	if err != nil {
+		return nil, errors.Wrap(err, "applying templates")
+	}
+	machine := testworkflowconfig.CreateWorkflowMachine(&testworkflowconfig.WorkflowConfig{Name: workflow.Name, Labels: workflow.Labels})
+	err = expressions.Simplify(workflow, machine)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving workflow")
+	}
+	return workflow, nil
+}
+
+// Render resolves the Test Workflow and processes it into the Kubernetes resources without any cluster access
+func (s *Suite) Render(ctx context.Context, test *TestWorkflowTest, namespace string) (*Output, error) {
+	workflow, err := s.Resolve(test.Spec.Workflow, test.Spec.Config)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	processor := presets.NewOpenSource(&staticInspector{images: test.Spec.Images})
+	bundle, err := processor.Bundle(ctx, workflow.DeepCopy(), testworkflowprocessor.BundleOptions{
+		Config: testworkflowconfig.InternalConfig{
+			Execution: testworkflowconfig.ExecutionConfig{
+				Id:          executionId,
+				Name:        executionName,
+				Number:      1,
+				ScheduledAt: scheduledAt,
+			},
+			Workflow: testworkflowconfig.WorkflowConfig{Name: workflow.Name, Labels: workflow.Labels},
+			Resource: testworkflowconfig.ResourceConfig{Id: executionId, RootId: executionId},
+			Worker:   testworkflowconfig.WorkerConfig{Namespace: namespace},
+		},
+		ScheduledAt: scheduledAt,
+		// Use the same seed for the references, so they are stable across runs
+		RefCounter: testworkflowprocessor.NewSeededRefCounter(0),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "processing workflow")
+	}
+	return &Output{Workflow: workflow, Bundle: bundle}, nil
+}
+
+// normalize replaces the values that are random for each process with stable placeholders
+func normalize(content string) string {
+	return strings.NewReplacer(
+		stage.BypassToolkitCheck.Value, "<toolkit-check>",
+		stage.BypassPure.Value, "<pure>",
+	).Replace(content)
+}
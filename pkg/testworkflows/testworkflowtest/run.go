@@ -0,0 +1,171 @@
+package testworkflowtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/crdcommon"
+	"github.com/kubeshop/testkube/pkg/expressions"
+	"github.com/kubeshop/testkube/pkg/testworkflows/testworkflowprocessor/stage"
+)
+
+type RunOptions struct {
+	// Namespace where the execution would be scheduled
+	Namespace string
+	// UpdateGolden overrides the golden files with the rendered output instead of comparing it
+	UpdateGolden bool
+}
+
+// Run executes all the test cases from the suite
+func (s *Suite) Run(ctx context.Context, opts RunOptions) []Result {
+	results := make([]Result, 0, len(s.Tests))
+	for _, test := range s.Tests {
+		results = append(results, s.RunTest(ctx, test, opts))
+	}
+	return results
+}
+
+// RunTest renders the Test Workflow for the test case and verifies the output
+func (s *Suite) RunTest(ctx context.Context, test *TestWorkflowTest, opts RunOptions) Result {
+	result := Result{Test: test}
+	output, err := s.Render(ctx, test, opts.Namespace)
+
+	// Verify the expected error
+	if test.Spec.Error != "" {
+		if err == nil {
+			result.Failures = append(result.Failures, fmt.Sprintf("expected error containing %q, but rendered successfully", test.Spec.Error))
+		} else if !strings.Contains(err.Error(), test.Spec.Error) {
+			result.Failures = append(result.Failures, fmt.Sprintf("expected error containing %q, got: %s", test.Spec.Error, err.Error()))
+		}
+		return result
+	}
+	if err != nil {
+		result.Failures = append(result.Failures, err.Error())
+		return result
+	}
+
+	// Evaluate the assertions
+	machine := CreateMachine(output)
+	for _, expr := range test.Spec.Expect {
+		value, err := expressions.EvalExpression(expr, machine, expressions.FinalizerFail)
+		if err != nil {
+			result.Failures = append(result.Failures, fmt.Sprintf("%s: %s", expr, err.Error()))
+			continue
+		}
+		passed, err := value.BoolValue()
+		if err != nil {
+			result.Failures = append(result.Failures, fmt.Sprintf("%s: not a boolean: %s", expr, err.Error()))
+		} else if !passed {
+			result.Failures = append(result.Failures, fmt.Sprintf("%s: not satisfied", expr))
+		}
+	}
+
+	// Compare against the golden files
+	if test.Spec.Golden != nil {
+		s.verifyGolden(&result, test.Spec.Golden.Workflow, func() ([]byte, error) {
+			return SerializeWorkflow(output.Workflow)
+		}, opts.UpdateGolden)
+		s.verifyGolden(&result, test.Spec.Golden.Job, func() ([]byte, error) {
+			return SerializeJob(output)
+		}, opts.UpdateGolden)
+	}
+	return result
+}
+
+func (s *Suite) verifyGolden(result *Result, name string, serialize func() ([]byte, error), update bool) {
+	if name == "" {
+		return
+	}
+	path := name
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(result.Test.file), name)
+	}
+	actual, err := serialize()
+	if err != nil {
+		result.Failures = append(result.Failures, fmt.Sprintf("serializing output for %s: %s", name, err.Error()))
+		return
+	}
+	if update {
+		if err = os.WriteFile(path, actual, 0644); err != nil {
+			result.Failures = append(result.Failures, fmt.Sprintf("updating golden file %s: %s", name, err.Error()))
+			return
+		}
+		result.Updated = append(result.Updated, path)
+		return
+	}
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		result.Failures = append(result.Failures, fmt.Sprintf("reading golden file %s: %s", name, err.Error()))
+		return
+	}
+	if !bytes.Equal(bytes.TrimSpace(expected), bytes.TrimSpace(actual)) {
+		result.Failures = append(result.Failures, fmt.Sprintf("output differs from golden file %s:\n%s", name, diff(string(expected), string(actual))))
+	}
+}
+
+// SerializeWorkflow serializes the resolved Test Workflow for the golden files
+func SerializeWorkflow(workflow *testworkflowsv1.TestWorkflow) ([]byte, error) {
+	return crdcommon.SerializeCRD(workflow, crdcommon.SerializeOptions{
+		OmitCreationTimestamp: true,
+		CleanMeta:             true,
+		Kind:                  "TestWorkflow",
+		GroupVersion:          &testworkflowsv1.GroupVersion,
+	})
+}
+
+// SerializeJob serializes the Job produced for the execution for the golden files
+func SerializeJob(output *Output) ([]byte, error) {
+	b, err := yaml.Marshal(output.Bundle.Job)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(normalize(string(b))), nil
+}
+
+// CreateMachine builds the machine with the rendered output available for the assertions
+func CreateMachine(output *Output) expressions.Machine {
+	podSpec := output.Bundle.Job.Spec.Template.Spec
+	return expressions.NewMachine().
+		Register("workflow", toGeneric(output.Workflow)).
+		Register("job", toGeneric(output.Bundle.Job)).
+		Register("pod", toGeneric(podSpec)).
+		Register("containers", toGeneric(slices.Concat(podSpec.InitContainers, podSpec.Containers))).
+		Register("signature", toGeneric(stage.MapSignatureListToInternal(output.Bundle.Signature)))
+}
+
+func toGeneric(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var result interface{}
+	_ = json.Unmarshal([]byte(normalize(string(b))), &result)
+	return result
+}
+
+// diff builds the simple line-based difference between the expected and actual output
+func diff(expected, actual string) string {
+	expectedLines := strings.Split(strings.TrimSpace(expected), "\n")
+	actualLines := strings.Split(strings.TrimSpace(actual), "\n")
+	result := make([]string, 0)
+	for i := 0; i < len(expectedLines) || i < len(actualLines); i++ {
+		switch {
+		case i >= len(expectedLines):
+			result = append(result, fmt.Sprintf("%d: + %s", i+1, actualLines[i]))
+		case i >= len(actualLines):
+			result = append(result, fmt.Sprintf("%d: - %s", i+1, expectedLines[i]))
+		case expectedLines[i] != actualLines[i]:
+			result = append(result, fmt.Sprintf("%d: - %s", i+1, expectedLines[i]), fmt.Sprintf("%d: + %s", i+1, actualLines[i]))
+		}
+	}
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,87 @@
+package testworkflowtest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	suite, err := Load("testdata")
+
+	require.NoError(t, err)
+	assert.Contains(t, suite.Workflows, "load")
+	assert.Contains(t, suite.Templates, "k6")
+	require.Len(t, suite.Tests, 2)
+	assert.Equal(t, "passes virtual users to k6", suite.Tests[0].Name)
+	assert.Equal(t, filepath.Join("testdata", "tests.yaml"), suite.Tests[0].File())
+}
+
+func TestLoad_Duplicated(t *testing.T) {
+	suite := NewSuite()
+	content := []byte("kind: TestWorkflow\nmetadata:\n  name: a\n---\nkind: TestWorkflow\nmetadata:\n  name: a\n")
+
+	assert.ErrorContains(t, suite.Add("a.yaml", content), "duplicated TestWorkflow: a")
+}
+
+func TestSuite_Run(t *testing.T) {
+	suite, err := Load("testdata")
+	require.NoError(t, err)
+
+	results := suite.Run(context.Background(), RunOptions{})
+
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.True(t, result.Passed(), "%s: %v", result.Test.Name, result.Failures)
+	}
+}
+
+func TestSuite_Run_Failures(t *testing.T) {
+	suite, err := Load("testdata")
+	require.NoError(t, err)
+	test := *suite.Tests[0]
+	test.Spec.Config = map[string]string{"vus": "5", "token": "secret-value"}
+	test.Spec.Expect = []string{`workflow.spec.steps.1.run.args.2 == "10"`, `workflow.metadata.name == "other"`}
+
+	result := suite.RunTest(context.Background(), &test, RunOptions{})
+
+	assert.False(t, result.Passed())
+	assert.Contains(t, result.Failures, `workflow.spec.steps.1.run.args.2 == "10": not satisfied`)
+	assert.Contains(t, result.Failures, `workflow.metadata.name == "other": not satisfied`)
+	assert.Contains(t, result.Failures[2], "output differs from golden file load.golden.yaml")
+}
+
+func TestSuite_Run_UpdateGolden(t *testing.T) {
+	dir := t.TempDir()
+	suite, err := Load("testdata")
+	require.NoError(t, err)
+	test := *suite.Tests[0]
+	test.file = filepath.Join(dir, "tests.yaml")
+
+	result := suite.RunTest(context.Background(), &test, RunOptions{UpdateGolden: true})
+	require.True(t, result.Passed(), "%v", result.Failures)
+	assert.Len(t, result.Updated, 2)
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "load-job.golden.yaml"))
+	require.NoError(t, err)
+	actual, err := os.ReadFile(filepath.Join(dir, "load-job.golden.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+}
+
+func TestSuite_Render_SensitiveConfig(t *testing.T) {
+	suite, err := Load("testdata")
+	require.NoError(t, err)
+
+	output, err := suite.Render(context.Background(), suite.Tests[0], "")
+	require.NoError(t, err)
+	job, err := SerializeJob(output)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(job), "secret-value")
+	assert.Contains(t, string(job), "name: offline-load")
+}
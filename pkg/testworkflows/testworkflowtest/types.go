@@ -0,0 +1,76 @@
+package testworkflowtest
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TestKind is the kind of the documents describing the offline test cases
+	TestKind = "TestWorkflowTest"
+
+	defaultNamespace = "testkube"
+	executionId      = "offline-execution-id"
+	executionName    = "offline-execution"
+	secretPrefix     = "offline-"
+)
+
+// TestWorkflowTest describes a single test case for rendering the Test Workflow offline
+type TestWorkflowTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TestWorkflowTestSpec `json:"spec"`
+
+	// path to the file where the test case has been declared
+	file string
+}
+
+// File returns the path to the file where the test case has been declared
+func (t *TestWorkflowTest) File() string {
+	return t.file
+}
+
+type TestWorkflowTestSpec struct {
+	// name of the Test Workflow to render
+	Workflow string `json:"workflow"`
+	// configuration values passed to the Test Workflow
+	Config map[string]string `json:"config,omitempty"`
+	// metadata of the images used in the Test Workflow, as it is not inspected offline
+	Images map[string]ImageMetadata `json:"images,omitempty"`
+	// expressions that should be truthy for the rendered Test Workflow
+	Expect []string `json:"expect,omitempty"`
+	// part of the message of the error expected while rendering the Test Workflow
+	Error string `json:"error,omitempty"`
+	// golden files to compare the rendered output against
+	Golden *GoldenFiles `json:"golden,omitempty"`
+}
+
+// ImageMetadata describes the image metadata normally obtained by the Image Inspector
+type ImageMetadata struct {
+	Entrypoint []string `json:"entrypoint,omitempty"`
+	Cmd        []string `json:"cmd,omitempty"`
+	Shell      string   `json:"shell,omitempty"`
+	WorkingDir string   `json:"workingDir,omitempty"`
+	User       int64    `json:"user,omitempty"`
+	Group      int64    `json:"group,omitempty"`
+}
+
+// GoldenFiles points to the files with the expected output, relatively to the test case file
+type GoldenFiles struct {
+	// expected Test Workflow with templates and configuration resolved
+	Workflow string `json:"workflow,omitempty"`
+	// expected Job produced for the execution
+	Job string `json:"job,omitempty"`
+}
+
+// Result is the outcome of a single test case
+type Result struct {
+	Test     *TestWorkflowTest
+	Failures []string
+	Updated  []string
+}
+
+// Passed determines if all the assertions of the test case were satisfied
+func (r *Result) Passed() bool {
+	return len(r.Failures) == 0
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"bufio"
 	"crypto/rand"
+	"database/sql"
 	"encoding/base64"
 	"errors"
 	"math/big"
@@ -24,7 +25,7 @@ func IsNotFound(err error) bool {
 		return false
 	}
 
-	if errors.Is(err, mongo.ErrNoDocuments) || errors.Is(err, pgx.ErrNoRows) {
+	if errors.Is(err, mongo.ErrNoDocuments) || errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
 		return true
 	}
 